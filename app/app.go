@@ -27,14 +27,22 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
+	"github.com/sentinel-official/hub/streaming"
 	"github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/version"
+	"github.com/sentinel-official/hub/x/claims"
 	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/faucet"
+	"github.com/sentinel-official/hub/x/inflation"
 	"github.com/sentinel-official/hub/x/vpn"
+	vpnclient "github.com/sentinel-official/hub/x/vpn/client"
 )
 
 const (
 	appName = "Sentinel Hub App"
+
+	// metricsNamespace prefixes the names of the prometheus metrics of the modules
+	metricsNamespace = "sentinelhub"
 )
 
 var (
@@ -48,24 +56,32 @@ var (
 		bank.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
+		inflation.AppModuleBasic{},
 		distribution.AppModuleBasic{},
-		gov.NewAppModuleBasic(client.ProposalHandler, distribution.ProposalHandler),
+		gov.NewAppModuleBasic(client.ProposalHandler, distribution.ProposalHandler,
+			vpnclient.ReleaseEscrowProposalHandler, vpnclient.JailNodeProposalHandler,
+			vpnclient.ResolveDisputeProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		deposit.AppModuleBasic{},
 		vpn.AppModuleBasic{},
+		claims.AppModuleBasic{},
+		faucet.AppModuleBasic{},
 	)
 
 	moduleAccountPermissions = map[string][]string{
 		auth.FeeCollectorName:     nil,
 		distribution.ModuleName:   nil,
 		mint.ModuleName:           {supply.Minter},
+		inflation.ModuleName:      {supply.Burner},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		deposit.ModuleName:        nil,
+		claims.ModuleName:         nil,
+		faucet.ModuleName:         {supply.Minter},
 	}
 )
 
@@ -83,7 +99,8 @@ func MakeCodec() *codec.Codec {
 
 type HubApp struct {
 	*baseapp.BaseApp
-	cdc *codec.Codec
+	cdc     *codec.Codec
+	profile Profile
 
 	invCheckPeriod uint
 
@@ -96,19 +113,24 @@ type HubApp struct {
 	stakingKeeper      staking.Keeper
 	slashingKeeper     slashing.Keeper
 	mintKeeper         mint.Keeper
+	inflationKeeper    inflation.Keeper
 	distributionKeeper distribution.Keeper
 	govKeeper          gov.Keeper
 	crisisKeeper       crisis.Keeper
 	paramsKeeper       params.Keeper
 	depositKeeper      deposit.Keeper
 	vpnKeeper          vpn.Keeper
+	claimsKeeper       claims.Keeper
+	faucetKeeper       faucet.Keeper
 
-	mm *module.Manager
+	mm       *module.Manager
+	streamer *streaming.Service
 }
 
 // nolint:funlen
 func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest bool,
-	invCheckPeriod uint, baseAppOptions ...func(*baseapp.BaseApp)) *HubApp {
+	invCheckPeriod uint, profile Profile, metrics bool, stream io.Writer,
+	baseAppOptions ...func(*baseapp.BaseApp)) *HubApp {
 	cdc := MakeCodec()
 
 	bApp := baseapp.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
@@ -119,7 +141,8 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		baseapp.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distribution.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, deposit.StoreKey,
-		vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession,
+		vpn.StoreKeyNode, vpn.StoreKeySubscription, vpn.StoreKeySession, claims.StoreKey,
+		faucet.StoreKey,
 	)
 
 	transientKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
@@ -127,6 +150,7 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 	var app = &HubApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
+		profile:        profile,
 		invCheckPeriod: invCheckPeriod,
 		keys:           keys,
 		transientKeys:  transientKeys,
@@ -188,10 +212,48 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		app.supplyKeeper,
 		auth.FeeCollectorName)
 
+	app.depositKeeper = deposit.NewKeeper(app.cdc,
+		keys[deposit.StoreKey],
+		app.supplyKeeper)
+	if metrics {
+		app.depositKeeper = app.depositKeeper.WithMetrics(deposit.PrometheusMetrics(metricsNamespace))
+	}
+	app.vpnKeeper = vpn.NewKeeper(app.cdc,
+		keys[vpn.StoreKeyNode],
+		keys[vpn.StoreKeySubscription],
+		keys[vpn.StoreKeySession],
+		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
+		app.depositKeeper,
+		app.distributionKeeper)
+	if metrics {
+		app.vpnKeeper = app.vpnKeeper.WithMetrics(vpn.PrometheusMetrics(metricsNamespace))
+	}
+	if stream != nil {
+		app.streamer = streaming.NewService(stream)
+		app.vpnKeeper = app.vpnKeeper.WithListener(app.streamer)
+	}
+	app.claimsKeeper = claims.NewKeeper(app.cdc,
+		keys[claims.StoreKey],
+		app.supplyKeeper,
+		app.distributionKeeper)
+	app.faucetKeeper = faucet.NewKeeper(app.cdc,
+		keys[faucet.StoreKey],
+		app.paramsKeeper.Subspace(faucet.DefaultParamspace),
+		app.supplyKeeper)
+	app.inflationKeeper = inflation.NewKeeper(app.paramsKeeper.Subspace(inflation.DefaultParamspace),
+		app.mintKeeper,
+		app.supplyKeeper)
+	if profile.IsModuleEnabled(vpn.ModuleName) {
+		app.inflationKeeper = app.inflationKeeper.WithHooks(app.vpnKeeper.Hooks())
+	}
+
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper))
+	if profile.IsModuleEnabled(vpn.ModuleName) {
+		govRouter.AddRoute(vpn.RouterKey, vpn.NewProposalHandler(app.vpnKeeper))
+	}
 
 	app.govKeeper = gov.NewKeeper(app.cdc,
 		keys[gov.StoreKey],
@@ -205,17 +267,7 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(app.distributionKeeper.Hooks(), app.slashingKeeper.Hooks()))
 
-	app.depositKeeper = deposit.NewKeeper(app.cdc,
-		keys[deposit.StoreKey],
-		app.supplyKeeper)
-	app.vpnKeeper = vpn.NewKeeper(app.cdc,
-		keys[vpn.StoreKeyNode],
-		keys[vpn.StoreKeySubscription],
-		keys[vpn.StoreKeySession],
-		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
-		app.depositKeeper)
-
-	app.mm = module.NewManager(
+	modules := []module.AppModule{
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
 		auth.NewAppModule(app.accountKeeper),
@@ -225,20 +277,34 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 		distribution.NewAppModule(app.distributionKeeper, app.supplyKeeper),
 		gov.NewAppModule(app.govKeeper, app.supplyKeeper),
 		mint.NewAppModule(app.mintKeeper),
+		inflation.NewAppModule(app.inflationKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distributionKeeper, app.accountKeeper, app.supplyKeeper),
 		deposit.NewAppModule(app.depositKeeper),
 		vpn.NewAppModule(app.vpnKeeper),
-	)
+		claims.NewAppModule(app.claimsKeeper),
+		faucet.NewAppModule(app.faucetKeeper),
+	}
+
+	var enabledModules []module.AppModule
+	for _, m := range modules {
+		if profile.IsModuleEnabled(m.Name()) {
+			enabledModules = append(enabledModules, m)
+		}
+	}
+
+	app.mm = module.NewManager(enabledModules...)
 
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distribution.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, vpn.ModuleName)
-	app.mm.SetOrderInitGenesis(
+	// The inflation module mints the provisions instead of the begin blocker of the mint module.
+	app.mm.SetOrderBeginBlockers(inflation.ModuleName, distribution.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderEndBlockers(profile.filterModules(
+		crisis.ModuleName, gov.ModuleName, staking.ModuleName, vpn.ModuleName, claims.ModuleName)...)
+	app.mm.SetOrderInitGenesis(profile.filterModules(
 		genaccounts.ModuleName, distribution.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
-		deposit.ModuleName, vpn.ModuleName,
-	)
+		mint.ModuleName, inflation.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		deposit.ModuleName, vpn.ModuleName, claims.ModuleName, faucet.ModuleName,
+	)...)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
@@ -247,8 +313,19 @@ func NewHubApp(logger log.Logger, db db.DB, traceStore io.Writer, loadLatest boo
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(
-		auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	anteHandler := auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+	if profile.IsModuleEnabled(vpn.ModuleName) {
+		anteHandler = vpn.NewAnteHandler(
+			vpn.NewFeeDenomsAnteHandler(
+				vpn.NewFeeAllowanceAnteHandler(anteHandler, app.vpnKeeper, app.bankKeeper),
+				app.vpnKeeper, app.stakingKeeper.BondDenom),
+			app.vpnKeeper)
+	}
+	if profile.IsModuleEnabled(faucet.ModuleName) {
+		anteHandler = faucet.NewAnteHandler(anteHandler, app.accountKeeper)
+	}
+
+	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -275,6 +352,19 @@ func (app *HubApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	return app.mm.InitGenesis(ctx, state)
 }
 
+// Commit commits the block and streams the changes of the vpn stores when streaming is enabled.
+func (app *HubApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.streamer != nil {
+		ctx := app.NewContext(true, abci.Header{})
+		if err := app.streamer.Flush(ctx, app.LastBlockHeight()); err != nil {
+			app.Logger().Error("failed to stream the changes of the block", "err", err)
+		}
+	}
+
+	return res
+}
+
 func (app *HubApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[baseapp.MainStoreKey])
 }
@@ -285,5 +375,12 @@ func (app *HubApp) ModuleAccountAddrs() map[string]bool {
 		moduleAccounts[supply.NewModuleAddress(acc).String()] = true
 	}
 
+	// The vpn module keeps its escrows in the deposits, the coins sent to these addresses directly
+	// would not be accounted in the deposits.
+	moduleAccounts[supply.NewModuleAddress(vpn.ModuleName).String()] = true
+	moduleAccounts[vpn.PayoutPoolAddress.String()] = true
+	moduleAccounts[vpn.NodeRewardPoolAddress.String()] = true
+	moduleAccounts[vpn.DisputePoolAddress.String()] = true
+
 	return moduleAccounts
 }
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+func TestHubApp_ModuleAccountAddrs(t *testing.T) {
+	addresses := (&HubApp{}).ModuleAccountAddrs()
+	require.True(t, addresses[supply.NewModuleAddress(deposit.ModuleName).String()])
+	require.True(t, addresses[supply.NewModuleAddress(vpn.ModuleName).String()])
+	require.True(t, addresses[vpn.PayoutPoolAddress.String()])
+	require.True(t, addresses[vpn.NodeRewardPoolAddress.String()])
+}
+
+// TestHubApp_InitChainWithGenTx delivers a gentx without the fees, as the ones made by the gentx command,
+// before the genesis of the vpn module is initialized, and with the vpn module disabled.
+func TestHubApp_InitChainWithGenTx(t *testing.T) {
+	profile, err := GetProfile(ProfilePrivate)
+	require.Nil(t, err)
+	initChainWithGenTx(t, profile)
+
+	initChainWithGenTx(t, Profile{Name: "profile", Denom: "stake", Bech32Prefix: "sent",
+		Modules: []string{deposit.ModuleName}})
+}
+
+func initChainWithGenTx(t *testing.T, profile Profile) {
+	app := NewHubApp(log.NewNopLogger(), db.NewMemDB(), nil, true, 0, profile, false, nil)
+	cdc := MakeCodec()
+
+	key := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(key.PubKey().Address())
+
+	genesis := profile.DefaultGenesis(cdc)
+	genesis = genaccounts.SetGenesisStateInAppState(cdc, genesis, genaccounts.GenesisState{
+		genaccounts.NewGenesisAccountRaw(address, sdk.Coins{sdk.NewInt64Coin(profile.Denom, 1e9)},
+			sdk.Coins{}, 0, 0, ""),
+	})
+
+	msg := staking.NewMsgCreateValidator(sdk.ValAddress(address), ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin(profile.Denom, 1e8), staking.NewDescription("moniker", "", "", ""),
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt())
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.NewStdFee(200000, nil), nil, "")
+
+	signature, err := key.Sign(auth.StdSignBytes("test", 0, 0, tx.Fee, tx.Msgs, tx.Memo))
+	require.Nil(t, err)
+	tx.Signatures = []auth.StdSignature{{PubKey: key.PubKey(), Signature: signature}}
+
+	genesis[genutil.ModuleName] = cdc.MustMarshalJSON(genutil.NewGenesisStateFromStdTx([]auth.StdTx{tx}))
+
+	require.NotPanics(t, func() {
+		app.InitChain(abci.RequestInitChain{ChainId: "test", AppStateBytes: cdc.MustMarshalJSON(genesis)})
+	})
+
+	ctx := app.NewContext(false, abci.Header{ChainID: "test"})
+	_, found := app.stakingKeeper.GetValidator(ctx, sdk.ValAddress(address))
+	require.True(t, found)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/hub/x/vpn"
 )
 
 func (app *HubApp) ExportAppStateAndValidators(forZeroHeight bool,
@@ -50,6 +52,10 @@ func (app *HubApp) prepForZeroHeightGenesis(
 
 	app.crisisKeeper.AssertInvariants(ctx)
 
+	if app.profile.IsModuleEnabled(vpn.ModuleName) {
+		vpn.PrepForZeroHeightGenesis(ctx, app.vpnKeeper)
+	}
+
 	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val staking.ValidatorI) (stop bool) {
 		_, _ = app.distributionKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
 		return false
@@ -0,0 +1,128 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+// ValidateGenesisState validates the genesis state which spans the modules, after the genesis states of
+// the modules are validated by themselves. The deposits must match the node deposits, the remaining
+// deposits of the active subscriptions, the unbonding deposits and the pending payouts of the vpn
+// module, and must sum up to the coins of the deposit module account.
+func ValidateGenesisState(cdc *codec.Codec, genesis map[string]json.RawMessage) error {
+	if genesis[deposit.ModuleName] == nil {
+		return nil
+	}
+
+	var deposits deposit.GenesisState
+	if err := cdc.UnmarshalJSON(genesis[deposit.ModuleName], &deposits); err != nil {
+		return err
+	}
+
+	if genesis[vpn.ModuleName] != nil {
+		var vpnState vpn.GenesisState
+		if err := cdc.UnmarshalJSON(genesis[vpn.ModuleName], &vpnState); err != nil {
+			return err
+		}
+
+		if err := validateLockedDeposits(deposits, vpnState); err != nil {
+			return err
+		}
+	}
+
+	if genesis[genaccounts.ModuleName] != nil {
+		total := sdk.Coins{}
+		for _, d := range deposits {
+			total = total.Add(d.Coins)
+		}
+
+		var balance sdk.Coins
+		address := supply.NewModuleAddress(deposit.ModuleName)
+		for _, account := range genaccounts.GetGenesisStateFromAppState(cdc, genesis) {
+			if account.Address.Equals(address) {
+				balance = account.Coins
+			}
+		}
+
+		if !coinsEqual(balance, total) {
+			return fmt.Errorf("deposits %s do not match the coins %s of the deposit module account", total, balance)
+		}
+	}
+
+	return nil
+}
+
+func validateLockedDeposits(deposits deposit.GenesisState, data vpn.GenesisState) error {
+	locked := make(map[string]sdk.Coins)
+	lock := func(address sdk.AccAddress, coins sdk.Coins) {
+		locked[address.String()] = locked[address.String()].Add(coins)
+	}
+
+	for _, node := range data.Nodes {
+		if node.Status != vpn.StatusDeRegistered && node.Deposit.IsPositive() {
+			lock(node.Owner, sdk.Coins{node.Deposit})
+		}
+	}
+	for _, subscription := range data.Subscriptions {
+		if subscription.Status == vpn.StatusActive && subscription.RemainingDeposit.IsPositive() {
+			lock(subscription.Client, sdk.Coins{subscription.RemainingDeposit})
+		}
+	}
+	for _, unbonding := range data.NodeUnbondings {
+		lock(unbonding.Address, sdk.Coins{unbonding.Deposit})
+	}
+	for _, payout := range data.PendingPayouts {
+		lock(vpn.PayoutPoolAddress, payout.Coins)
+	}
+	for _, dispute := range data.Disputes {
+		if dispute.IsOpen() {
+			lock(vpn.DisputePoolAddress, sdk.Coins{dispute.Amount})
+		}
+	}
+
+	deposited := make(map[string]sdk.Coins, len(deposits))
+	for _, d := range deposits {
+		deposited[d.Address.String()] = d.Coins
+	}
+
+	addresses := make([]string, 0, len(locked)+len(deposited))
+	for address := range locked {
+		addresses = append(addresses, address)
+	}
+	for address := range deposited {
+		if _, ok := locked[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+
+	sort.Strings(addresses)
+
+	// The node reward pool is funded with the fees, nothing of it is locked by the nodes or the subscriptions.
+	rewardPool := vpn.NodeRewardPoolAddress.String()
+	for _, address := range addresses {
+		if address == rewardPool {
+			continue
+		}
+		if !coinsEqual(deposited[address], locked[address]) {
+			return fmt.Errorf("deposit %s of the address %s does not match the locked amount %s",
+				deposited[address], address, locked[address])
+		}
+	}
+
+	return nil
+}
+
+// coinsEqual is the IsEqual of the coins which does not panic on the different denoms.
+func coinsEqual(a, b sdk.Coins) bool {
+	diff, negative := a.SafeSub(b)
+	return !negative && diff.IsZero()
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestValidateGenesisState(t *testing.T) {
+	cdc := MakeCodec()
+
+	node := types.TestNode
+	node.Status = vpn.StatusRegistered
+
+	vpnState := vpn.DefaultGenesisState()
+	vpnState.Nodes = []vpn.Node{node}
+	vpnState.Subscriptions = []vpn.Subscription{types.TestSubscription}
+
+	newGenesis := func(deposits deposit.GenesisState, balance sdk.Coins) map[string]json.RawMessage {
+		account := genaccounts.NewGenesisAccountRaw(supply.NewModuleAddress(deposit.ModuleName), balance,
+			sdk.Coins{}, 0, 0, deposit.ModuleName)
+
+		genesis := map[string]json.RawMessage{
+			deposit.ModuleName: cdc.MustMarshalJSON(deposits),
+			vpn.ModuleName:     cdc.MustMarshalJSON(vpnState),
+		}
+
+		return genaccounts.SetGenesisStateInAppState(cdc, genesis, genaccounts.GenesisState{account})
+	}
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.Coins{sdk.NewInt64Coin("stake", amount)}
+	}
+
+	deposits := deposit.GenesisState{
+		{Address: node.Owner, Coins: coins(100)},
+		{Address: types.TestSubscription.Client, Coins: coins(100)},
+	}
+	require.Nil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(200))))
+	require.NotNil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(100))))
+
+	deposits[1].Coins = coins(50)
+	require.NotNil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(150))))
+
+	deposits[1].Coins = sdk.Coins{sdk.NewInt64Coin("coin", 100)}
+	require.NotNil(t, ValidateGenesisState(cdc, newGenesis(deposits, sdk.Coins{sdk.NewInt64Coin("coin", 100),
+		sdk.NewInt64Coin("stake", 100)})))
+
+	deposits[1].Coins = coins(100)
+	deposits = append(deposits, deposit.Deposit{Address: vpn.NodeRewardPoolAddress, Coins: coins(10)})
+	require.Nil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(210))))
+
+	deposits = append(deposits, deposit.Deposit{Address: vpn.PayoutPoolAddress, Coins: coins(10)})
+	require.NotNil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(220))))
+
+	vpnState.PendingPayouts = []vpn.PendingPayout{vpn.NewPendingPayout(node.ID, coins(10))}
+	require.Nil(t, ValidateGenesisState(cdc, newGenesis(deposits, coins(220))))
+}
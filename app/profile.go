@@ -0,0 +1,256 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/claims"
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/faucet"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+const (
+	ProfileMainnet = "mainnet"
+	ProfileTestnet = "testnet"
+	ProfilePrivate = "private"
+
+	DefaultProfile = ProfileMainnet
+)
+
+var (
+	// OptionalModules are the modules which a profile can leave out, the others are always enabled.
+	OptionalModules = []string{crisis.ModuleName, gov.ModuleName, deposit.ModuleName, vpn.ModuleName,
+		claims.ModuleName, faucet.ModuleName}
+
+	profiles = map[string]Profile{}
+)
+
+// Profile is the chain specific wiring of the app, so that the test and private networks
+// and the downstream forks do not need to patch the app construction.
+type Profile struct {
+	Name         string
+	Denom        string
+	Bech32Prefix string
+	Modules      []string
+
+	// Genesis adjusts the default genesis states of the modules after the denom is set.
+	Genesis func(cdc *codec.Codec, genesis map[string]json.RawMessage)
+}
+
+func init() {
+	RegisterProfile(Profile{
+		Name:         ProfileMainnet,
+		Denom:        "tsent",
+		Bech32Prefix: types.Bech32MainPrefix,
+		Modules:      []string{crisis.ModuleName, gov.ModuleName, deposit.ModuleName, vpn.ModuleName, claims.ModuleName},
+	})
+	RegisterProfile(Profile{
+		Name:         ProfileTestnet,
+		Denom:        "tsent",
+		Bech32Prefix: types.Bech32MainPrefix,
+		Modules:      OptionalModules,
+		Genesis: func(cdc *codec.Codec, genesis map[string]json.RawMessage) {
+			setGovPeriods(cdc, genesis, 24*time.Hour)
+		},
+	})
+	RegisterProfile(Profile{
+		Name:         ProfilePrivate,
+		Denom:        sdk.DefaultBondDenom,
+		Bech32Prefix: types.Bech32MainPrefix,
+		Modules:      []string{gov.ModuleName, deposit.ModuleName, vpn.ModuleName, faucet.ModuleName},
+		Genesis: func(cdc *codec.Codec, genesis map[string]json.RawMessage) {
+			setGovPeriods(cdc, genesis, 10*time.Minute)
+		},
+	})
+}
+
+// RegisterProfile adds the profile to the profiles which can be selected by the name,
+// a registered profile with the same name is replaced.
+func RegisterProfile(profile Profile) {
+	if err := profile.Validate(); err != nil {
+		panic(err)
+	}
+
+	profiles[profile.Name] = profile
+}
+
+func GetProfile(name string) (Profile, error) {
+	profile, ok := profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile %s", name)
+	}
+
+	return profile, nil
+}
+
+func (p Profile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("invalid profile name")
+	}
+	if !(sdk.Coin{Denom: p.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+		return fmt.Errorf("invalid denom for the profile %s", p.Name)
+	}
+	if p.Bech32Prefix == "" {
+		return fmt.Errorf("invalid bech32 prefix for the profile %s", p.Name)
+	}
+
+	for _, name := range p.Modules {
+		optional := false
+		for _, _name := range OptionalModules {
+			if name == _name {
+				optional = true
+				break
+			}
+		}
+
+		if !optional {
+			return fmt.Errorf("invalid module %s for the profile %s", name, p.Name)
+		}
+	}
+
+	if p.IsModuleEnabled(vpn.ModuleName) && !p.IsModuleEnabled(deposit.ModuleName) {
+		return fmt.Errorf("vpn module requires the deposit module for the profile %s", p.Name)
+	}
+	if p.Name == ProfileMainnet && p.IsModuleEnabled(faucet.ModuleName) {
+		return fmt.Errorf("faucet module can not be enabled for the profile %s", p.Name)
+	}
+
+	return nil
+}
+
+// IsModuleEnabled reports whether the module is enabled, the modules which are not optional are always enabled.
+func (p Profile) IsModuleEnabled(name string) bool {
+	for _, _name := range p.Modules {
+		if name == _name {
+			return true
+		}
+	}
+
+	for _, _name := range OptionalModules {
+		if name == _name {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p Profile) filterModules(names ...string) []string {
+	var enabled []string
+	for _, name := range names {
+		if p.IsModuleEnabled(name) {
+			enabled = append(enabled, name)
+		}
+	}
+
+	return enabled
+}
+
+func (p Profile) SetBech32AddressPrefixes(config *sdk.Config) {
+	config.SetBech32PrefixForAccount(p.Bech32Prefix,
+		p.Bech32Prefix+types.PrefixPublic)
+	config.SetBech32PrefixForValidator(p.Bech32Prefix+types.PrefixValidator+types.PrefixOperator,
+		p.Bech32Prefix+types.PrefixValidator+types.PrefixOperator+types.PrefixPublic)
+	config.SetBech32PrefixForConsensusNode(p.Bech32Prefix+types.PrefixValidator+types.PrefixConsensus,
+		p.Bech32Prefix+types.PrefixValidator+types.PrefixConsensus+types.PrefixPublic)
+}
+
+// DefaultGenesis returns the default genesis states of the enabled modules with the denom
+// and the params of the profile.
+func (p Profile) DefaultGenesis(cdc *codec.Codec) map[string]json.RawMessage {
+	genesis := make(map[string]json.RawMessage)
+	for name, basic := range ModuleBasics {
+		if p.IsModuleEnabled(name) {
+			genesis[name] = basic.DefaultGenesis()
+		}
+	}
+
+	updateGenesis(cdc, genesis, staking.ModuleName, &staking.GenesisState{}, func(state interface{}) {
+		state.(*staking.GenesisState).Params.BondDenom = p.Denom
+	})
+	updateGenesis(cdc, genesis, mint.ModuleName, &mint.GenesisState{}, func(state interface{}) {
+		state.(*mint.GenesisState).Params.MintDenom = p.Denom
+	})
+	updateGenesis(cdc, genesis, gov.ModuleName, &gov.GenesisState{}, func(state interface{}) {
+		s := state.(*gov.GenesisState)
+		for i := range s.DepositParams.MinDeposit {
+			s.DepositParams.MinDeposit[i].Denom = p.Denom
+		}
+	})
+	updateGenesis(cdc, genesis, crisis.ModuleName, &crisis.GenesisState{}, func(state interface{}) {
+		state.(*crisis.GenesisState).ConstantFee.Denom = p.Denom
+	})
+	updateGenesis(cdc, genesis, vpn.ModuleName, &vpn.GenesisState{}, func(state interface{}) {
+		state.(*vpn.GenesisState).Params.Deposit.Denom = p.Denom
+	})
+	updateGenesis(cdc, genesis, claims.ModuleName, &claims.GenesisState{}, func(state interface{}) {
+		state.(*claims.GenesisState).Airdrop.Denom = p.Denom
+	})
+	updateGenesis(cdc, genesis, faucet.ModuleName, &faucet.GenesisState{}, func(state interface{}) {
+		s := state.(*faucet.GenesisState)
+		for i := range s.Params.Limit {
+			s.Params.Limit[i].Denom = p.Denom
+		}
+	})
+
+	if p.Genesis != nil {
+		p.Genesis(cdc, genesis)
+	}
+
+	return genesis
+}
+
+// ModuleBasics returns the basic managers of the enabled modules, whose default genesis
+// states are the ones of the profile.
+func (p Profile) ModuleBasics(cdc *codec.Codec) module.BasicManager {
+	genesis := p.DefaultGenesis(cdc)
+
+	basics := make(module.BasicManager)
+	for name, basic := range ModuleBasics {
+		if p.IsModuleEnabled(name) {
+			basics[name] = profileModuleBasic{AppModuleBasic: basic, genesis: genesis[name]}
+		}
+	}
+
+	return basics
+}
+
+type profileModuleBasic struct {
+	module.AppModuleBasic
+	genesis json.RawMessage
+}
+
+func (b profileModuleBasic) DefaultGenesis() json.RawMessage {
+	return b.genesis
+}
+
+func updateGenesis(cdc *codec.Codec, genesis map[string]json.RawMessage,
+	name string, state interface{}, update func(state interface{})) {
+	bz, ok := genesis[name]
+	if !ok {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(bz, state)
+	update(state)
+	genesis[name] = cdc.MustMarshalJSON(state)
+}
+
+func setGovPeriods(cdc *codec.Codec, genesis map[string]json.RawMessage, period time.Duration) {
+	updateGenesis(cdc, genesis, gov.ModuleName, &gov.GenesisState{}, func(state interface{}) {
+		s := state.(*gov.GenesisState)
+		s.DepositParams.MaxDepositPeriod = period
+		s.VotingParams.VotingPeriod = period
+	})
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/faucet"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+func TestGetProfile(t *testing.T) {
+	for _, name := range []string{ProfileMainnet, ProfileTestnet, ProfilePrivate} {
+		profile, err := GetProfile(name)
+		require.Nil(t, err)
+		require.Equal(t, name, profile.Name)
+		require.Nil(t, profile.Validate())
+	}
+
+	_, err := GetProfile("invalid")
+	require.NotNil(t, err)
+}
+
+func TestProfile_Validate(t *testing.T) {
+	profile := Profile{Name: "profile", Denom: "stake", Bech32Prefix: "sent"}
+	require.Nil(t, profile.Validate())
+
+	profile.Modules = []string{staking.ModuleName}
+	require.NotNil(t, profile.Validate())
+
+	profile.Modules = []string{vpn.ModuleName}
+	require.NotNil(t, profile.Validate())
+
+	profile.Modules = OptionalModules
+	require.Nil(t, profile.Validate())
+
+	profile.Name = ProfileMainnet
+	require.NotNil(t, profile.Validate())
+
+	profile.Name = "profile"
+	profile.Denom = "S"
+	require.NotNil(t, profile.Validate())
+}
+
+func TestProfile_DefaultGenesis(t *testing.T) {
+	cdc := MakeCodec()
+
+	profile, err := GetProfile(ProfilePrivate)
+	require.Nil(t, err)
+	require.True(t, profile.IsModuleEnabled(staking.ModuleName))
+	require.False(t, profile.IsModuleEnabled(crisis.ModuleName))
+
+	profile.Denom = "udvpn"
+	genesis := profile.DefaultGenesis(cdc)
+	require.NotContains(t, genesis, crisis.ModuleName)
+
+	var stakingGenesis staking.GenesisState
+	cdc.MustUnmarshalJSON(genesis[staking.ModuleName], &stakingGenesis)
+	require.Equal(t, "udvpn", stakingGenesis.Params.BondDenom)
+
+	var vpnGenesis vpn.GenesisState
+	cdc.MustUnmarshalJSON(genesis[vpn.ModuleName], &vpnGenesis)
+	require.Equal(t, "udvpn", vpnGenesis.Params.Deposit.Denom)
+
+	var faucetGenesis faucet.GenesisState
+	cdc.MustUnmarshalJSON(genesis[faucet.ModuleName], &faucetGenesis)
+	require.Equal(t, "udvpn", faucetGenesis.Params.Limit[0].Denom)
+
+	basics := profile.ModuleBasics(cdc)
+	require.NotContains(t, basics, crisis.ModuleName)
+	require.Equal(t, genesis[vpn.ModuleName], basics[vpn.ModuleName].DefaultGenesis())
+	require.Nil(t, basics.ValidateGenesis(genesis))
+}
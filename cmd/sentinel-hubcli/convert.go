@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func convertAddressCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-address [address]",
+		Short: "Convert a bech32 address of any prefix to the account, validator and consensus addresses of the hub",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := sdk.GetConfig()
+			for _, item := range []struct {
+				name   string
+				prefix string
+			}{
+				{"Account", config.GetBech32AccountAddrPrefix()},
+				{"Validator", config.GetBech32ValidatorAddrPrefix()},
+				{"Consensus", config.GetBech32ConsensusAddrPrefix()},
+			} {
+				address, err := hub.ConvertBech32Prefix(args[0], item.prefix)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("%-10s %s\n", item.name+":", address)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// verifyLedgerAddress shows the address of the key of the --from flag on the Ledger device
+// for the user to verify it before a transaction is signed with the --ledger flag.
+func verifyLedgerAddress(cmd *cobra.Command) error {
+	if ledger, err := cmd.Flags().GetBool(client.FlagUseLedger); err != nil || !ledger {
+		return nil
+	}
+	if generateOnly, _ := cmd.Flags().GetBool(client.FlagGenerateOnly); generateOnly {
+		return nil
+	}
+
+	kb, err := keys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return err
+	}
+
+	from, err := cmd.Flags().GetString(client.FlagFrom)
+	if err != nil {
+		return err
+	}
+
+	info, err := kb.Get(from)
+	if err != nil {
+		address, _err := sdk.AccAddressFromBech32(from)
+		if _err != nil {
+			return err
+		}
+
+		if info, err = kb.GetByAddress(address); err != nil {
+			return err
+		}
+	}
+
+	if info.GetType() != crkeys.TypeLedger {
+		return fmt.Errorf("key %s is not a Ledger key", info.GetName())
+	}
+
+	path, err := info.GetPath()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Verify the address %s of the path %s on the Ledger device\n", info.GetAddress(), path)
+	return crypto.LedgerShowAddress(*path, info.GetPubKey())
+}
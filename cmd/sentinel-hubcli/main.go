@@ -20,17 +20,18 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/sentinel-official/hub/app"
-	"github.com/sentinel-official/hub/simapp"
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/version"
+	vpnCli "github.com/sentinel-official/hub/x/vpn/client/cli"
+)
+
+const (
+	flagProfile = "profile"
 )
 
 func main() {
 	cdc := app.MakeCodec()
 
-	config := sdk.GetConfig()
-	simapp.SetBech32AddressPrefixes(config)
-	config.Seal()
-
 	cobra.EnableCommandSorting = false
 	rootCmd := &cobra.Command{
 		Use:   "sentinel-hubcli",
@@ -38,8 +39,24 @@ func main() {
 	}
 
 	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
-	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		return initConfig(rootCmd)
+	rootCmd.PersistentFlags().String(flagProfile, app.DefaultProfile, "Chain profile of the address prefixes")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if err := initConfig(rootCmd); err != nil {
+			return err
+		}
+
+		profile, err := app.GetProfile(viper.GetString(flagProfile))
+		if err != nil {
+			return err
+		}
+
+		config := sdk.GetConfig()
+		profile.SetBech32AddressPrefixes(config)
+		config.SetCoinType(hub.CoinType)
+		config.SetFullFundraiserPath(hub.FullFundraiserPath)
+		config.Seal()
+
+		return verifyLedgerAddress(cmd)
 	}
 
 	rootCmd.AddCommand(
@@ -49,8 +66,10 @@ func main() {
 		txCmd(cdc),
 		client.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
+		rosettaCmd(cdc),
 		client.LineBreak,
 		keys.Commands(),
+		convertAddressCmd(),
 		client.LineBreak,
 		version.Cmd,
 		client.NewCompletionCmd(rootCmd, true),
@@ -71,6 +90,7 @@ func queryCmd(cdc *_amino.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		authCli.GetAccountCmd(cdc),
+		client.GetCommands(vpnCli.QueryDepositCmd(cdc))[0],
 		client.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
@@ -116,6 +136,7 @@ func txCmd(cdc *_amino.Codec) *cobra.Command {
 func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authRest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
+	registerMultisigRoutes(rs.CliCtx, rs.Mux)
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
 }
 
@@ -136,6 +157,9 @@ func initConfig(cmd *cobra.Command) error {
 	if err := viper.BindPFlag(client.FlagChainID, cmd.PersistentFlags().Lookup(client.FlagChainID)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag(flagProfile, cmd.PersistentFlags().Lookup(flagProfile)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag(cli.EncodingFlag, cmd.PersistentFlags().Lookup(cli.EncodingFlag)); err != nil {
 		return err
 	}
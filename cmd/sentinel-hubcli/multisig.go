@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+// multisigSigner is a key of a multisig account, every key of a threshold multisig key has the
+// weight of one and a transaction needs the signatures of the threshold number of keys.
+type multisigSigner struct {
+	Address sdk.AccAddress `json:"address"`
+	PubKey  string         `json:"pub_key"`
+	Weight  uint           `json:"weight"`
+}
+
+type multisigAccount struct {
+	Address       sdk.AccAddress   `json:"address"`
+	AccountNumber uint64           `json:"account_number"`
+	Sequence      uint64           `json:"sequence"`
+	Threshold     uint             `json:"threshold"`
+	Signers       []multisigSigner `json:"signers"`
+}
+
+type multisignReq struct {
+	Tx             auth.StdTx          `json:"tx"`
+	ChainID        string              `json:"chain_id"`
+	Address        sdk.AccAddress      `json:"address"`
+	MultisigPubKey string              `json:"multisig_pub_key"`
+	Signatures     []auth.StdSignature `json:"signatures"`
+}
+
+func registerMultisigRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/accounts/{address}/multisig", getMultisigAccountHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/txs/multisign", multisignHandlerFunc(ctx)).
+		Methods("POST")
+}
+
+func getMultisigAccountHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		account, err := auth.NewAccountRetriever(ctx).GetAccount(address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		pubKey, ok := account.GetPubKey().(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusNotFound,
+				fmt.Sprintf("account %s has no multisig public key, it is set by its first transaction", address))
+			return
+		}
+
+		res := multisigAccount{
+			Address:       address,
+			AccountNumber: account.GetAccountNumber(),
+			Sequence:      account.GetSequence(),
+			Threshold:     pubKey.K,
+			Signers:       make([]multisigSigner, 0, len(pubKey.PubKeys)),
+		}
+
+		for _, key := range pubKey.PubKeys {
+			res.Signers = append(res.Signers, multisigSigner{
+				Address: sdk.AccAddress(key.Address()),
+				PubKey:  sdk.MustBech32ifyAccPub(key),
+				Weight:  1,
+			})
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+// multisignHandlerFunc combines the signatures of the keys of the multisig account into the
+// signature of the transaction, like the multisign command. The multisig public key is taken
+// from the account unless it is given, as the account has no public key before its first transaction.
+func multisignHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req multisignReq
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+
+		account, err := auth.NewAccountRetriever(ctx).GetAccount(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pubKey := account.GetPubKey()
+		if req.MultisigPubKey != "" {
+			if pubKey, err = sdk.GetAccPubKeyBech32(req.MultisigPubKey); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+		if !ok || !sdk.AccAddress(multisigPubKey.Address()).Equals(req.Address) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid multisig public key of the account")
+			return
+		}
+
+		signBytes := auth.StdSignBytes(req.ChainID, account.GetAccountNumber(), account.GetSequence(),
+			req.Tx.Fee, req.Tx.GetMsgs(), req.Tx.GetMemo())
+
+		signature := multisig.NewMultisig(len(multisigPubKey.PubKeys))
+		for _, s := range req.Signatures {
+			if !s.PubKey.VerifyBytes(signBytes, s.Signature) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest,
+					fmt.Sprintf("invalid signature of %s", sdk.AccAddress(s.PubKey.Address())))
+				return
+			}
+
+			if err := signature.AddSignatureFromPubKey(s.Signature, s.PubKey, multisigPubKey.PubKeys); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		tx := auth.NewStdTx(req.Tx.GetMsgs(), req.Tx.Fee, []auth.StdSignature{
+			{
+				PubKey:    multisigPubKey,
+				Signature: ctx.Codec.MustMarshalBinaryBare(signature),
+			},
+		}, req.Tx.GetMemo())
+
+		rest.PostProcessResponse(w, ctx, tx)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	_amino "github.com/tendermint/go-amino"
+
+	"github.com/sentinel-official/hub/rosetta"
+)
+
+const (
+	flagListenAddr = "listen-addr"
+)
+
+func rosettaCmd(cdc *_amino.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Start the Rosetta Data and Construction API server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			if viper.GetString(client.FlagChainID) == "" {
+				node, err := ctx.GetNode()
+				if err != nil {
+					return err
+				}
+
+				status, err := node.Status()
+				if err != nil {
+					return err
+				}
+
+				viper.Set(client.FlagChainID, status.NodeInfo.Network)
+			}
+
+			return http.ListenAndServe(viper.GetString(flagListenAddr), rosetta.NewServer(ctx))
+		},
+	}
+
+	cmd.Flags().String(flagListenAddr, "localhost:8080", "Address to serve the Rosetta API on")
+	return client.GetCommands(cmd)[0]
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+func debugCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Debugging utilities for the transactions, the addresses and the public keys",
+	}
+
+	cmd.AddCommand(
+		decodeTxCmd(cdc),
+		addrCmd(),
+		pubKeyCmd(cdc),
+	)
+
+	return cmd
+}
+
+// decodeBytes decodes the hex or the base64 encoded string, the hex encoding is tried first.
+func decodeBytes(s string) ([]byte, error) {
+	if bz, err := hex.DecodeString(s); err == nil {
+		return bz, nil
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither hex nor base64 encoded", s)
+	}
+
+	return bz, nil
+}
+
+func decodeTxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-tx [tx]",
+		Short: "Decode an amino encoded transaction from hex or base64 to JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := decodeBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var tx auth.StdTx
+			if err = cdc.UnmarshalBinaryLengthPrefixed(bz, &tx); err != nil {
+				if err = cdc.UnmarshalBinaryBare(bz, &tx); err != nil {
+					return err
+				}
+			}
+
+			res, err := codec.MarshalJSONIndent(cdc, tx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+func addrCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "addr [address]",
+		Short: "Convert an address between hex and the bech32 encodings of the hub",
+		Long: `Convert an address between hex and the bech32 encodings of the hub, a bech32 address
+with any prefix is accepted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				_, bz, err = bech32.DecodeAndConvert(args[0])
+				if err != nil {
+					return fmt.Errorf("%s is neither a hex nor a bech32 address", args[0])
+				}
+			}
+
+			fmt.Printf("Address:   %X\n", bz)
+			fmt.Printf("Account:   %s\n", sdk.AccAddress(bz))
+			fmt.Printf("Validator: %s\n", sdk.ValAddress(bz))
+			fmt.Printf("Consensus: %s\n", sdk.ConsAddress(bz))
+			return nil
+		},
+	}
+}
+
+// parsePubKey parses the bech32 encoded account, validator or consensus public key, or the
+// hex or base64 encoded amino bytes of a public key, or the raw bytes of an ed25519 public key.
+func parsePubKey(cdc *codec.Codec, s string) (crypto.PubKey, error) {
+	for _, fn := range []func(string) (crypto.PubKey, error){
+		sdk.GetAccPubKeyBech32, sdk.GetValPubKeyBech32, sdk.GetConsPubKeyBech32,
+	} {
+		if pubKey, err := fn(s); err == nil {
+			return pubKey, nil
+		}
+	}
+
+	bz, err := decodeBytes(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey crypto.PubKey
+	if err = cdc.UnmarshalBinaryBare(bz, &pubKey); err == nil {
+		return pubKey, nil
+	}
+
+	if len(bz) == ed25519.PubKeyEd25519Size {
+		var _pubKey ed25519.PubKeyEd25519
+		copy(_pubKey[:], bz)
+
+		return _pubKey, nil
+	}
+
+	return nil, fmt.Errorf("invalid public key %s", s)
+}
+
+func pubKeyCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pubkey [pubkey]",
+		Short: "Inspect a public key and show its addresses and encodings",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubKey, err := parsePubKey(cdc, strings.TrimSpace(args[0]))
+			if err != nil {
+				return err
+			}
+
+			accPubKey, err := sdk.Bech32ifyAccPub(pubKey)
+			if err != nil {
+				return err
+			}
+			valPubKey, err := sdk.Bech32ifyValPub(pubKey)
+			if err != nil {
+				return err
+			}
+			consPubKey, err := sdk.Bech32ifyConsPub(pubKey)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Type:             %T\n", pubKey)
+			fmt.Printf("Address:          %X\n", pubKey.Address())
+			fmt.Printf("Account:          %s\n", sdk.AccAddress(pubKey.Address()))
+			fmt.Printf("Hex:              %X\n", pubKey.Bytes())
+			fmt.Printf("Base64:           %s\n", base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+			fmt.Printf("Account PubKey:   %s\n", accPubKey)
+			fmt.Printf("Validator PubKey: %s\n", valPubKey)
+			fmt.Printf("Consensus PubKey: %s\n", consPubKey)
+			return nil
+		},
+	}
+}
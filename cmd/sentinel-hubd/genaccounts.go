@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+// genesisAccount is an account of the accounts file of the add-genesis-accounts command. The account
+// vests continuously from the start to the end time if the start time is set, or all at once at the
+// end time otherwise. The times are in unix seconds.
+type genesisAccount struct {
+	Address          string `json:"address"`
+	Coins            string `json:"coins"`
+	VestingAmount    string `json:"vesting_amount"`
+	VestingStartTime int64  `json:"vesting_start_time"`
+	VestingEndTime   int64  `json:"vesting_end_time"`
+}
+
+func (a genesisAccount) GenesisAccount() (account genaccounts.GenesisAccount, err error) {
+	address, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		return account, err
+	}
+
+	coins, err := sdk.ParseCoins(a.Coins)
+	if err != nil {
+		return account, err
+	}
+
+	vestingAmount, err := sdk.ParseCoins(a.VestingAmount)
+	if err != nil {
+		return account, err
+	}
+
+	if vestingAmount.IsZero() && (a.VestingStartTime != 0 || a.VestingEndTime != 0) {
+		return account, fmt.Errorf("vesting times of the account %s without the vesting amount", a.Address)
+	}
+	if !vestingAmount.IsZero() && a.VestingEndTime <= 0 {
+		return account, fmt.Errorf("invalid vesting end time of the account %s", a.Address)
+	}
+
+	account = genaccounts.NewGenesisAccountRaw(address, coins, vestingAmount,
+		a.VestingStartTime, a.VestingEndTime, "", "")
+	if err = account.Validate(); err != nil {
+		return account, fmt.Errorf("invalid account %s: %s", a.Address, err)
+	}
+
+	return account, nil
+}
+
+func addGenesisAccountsCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts [accounts-file]",
+		Short: "Add the accounts of the JSON file, with their vesting schedules, to genesis.json",
+		Long: `Add the accounts of the JSON file to genesis.json. The file is a list of the accounts:
+
+[
+  {
+    "address": "sent1...",
+    "coins": "1000000tsent",
+    "vesting_amount": "500000tsent",
+    "vesting_start_time": 1577836800,
+    "vesting_end_time": 1609459200
+  }
+]
+
+An account with the vesting amount vests continuously from the start to the end time, or all at
+once at the end time if the start time is zero. The times are in unix seconds.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			bytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var items []genesisAccount
+			if err = json.Unmarshal(bytes, &items); err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			accounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+			for _, item := range items {
+				account, err := item.GenesisAccount()
+				if err != nil {
+					return err
+				}
+				for _, acc := range accounts {
+					if acc.Address.Equals(account.Address) {
+						return fmt.Errorf("account %s already exists", account.Address)
+					}
+				}
+
+				accounts = append(accounts, account)
+			}
+
+			appState = genaccounts.SetGenesisStateInAppState(cdc, appState, accounts)
+
+			genDoc.AppState, err = cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+
+	return cmd
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutilCli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingCli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagGenTxDir = "gentx-dir"
+
+	defaultGenTxAmount = "100000000"
+)
+
+// genTxCmd is the gentx command of the SDK, which bonds the default amount in the bond denom of the genesis
+// instead of the stake denom of the SDK.
+func genTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
+	defaultNodeHome, defaultClientHome string) *cobra.Command {
+	cmd := genutilCli.GenTxCmd(ctx, cdc, mbm, staking.AppModuleBasic{}, genaccounts.AppModuleBasic{},
+		defaultNodeHome, defaultClientHome)
+
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if viper.GetString(stakingCli.FlagAmount) == "" {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			bondDenom, err := genesisBondDenom(cdc, config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			viper.Set(stakingCli.FlagAmount, defaultGenTxAmount+bondDenom)
+		}
+
+		return runE(cmd, args)
+	}
+
+	return cmd
+}
+
+// collectGenTxsCmd is the collect-gentxs command of the SDK, which also rejects the gentxs bonding
+// coins other than the bond denom of the genesis before writing them to genesis.json.
+func collectGenTxsCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := genutilCli.CollectGenTxsCmd(ctx, cdc, genaccounts.AppModuleBasic{}, defaultNodeHome)
+
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		config := ctx.Config
+		config.SetRoot(viper.GetString(cli.HomeFlag))
+
+		bondDenom, err := genesisBondDenom(cdc, config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		genTxsDir := viper.GetString(flagGenTxDir)
+		if genTxsDir == "" {
+			genTxsDir = filepath.Join(config.RootDir, "config", "gentx")
+		}
+
+		if err = validateGenTxsDenom(cdc, genTxsDir, bondDenom); err != nil {
+			return err
+		}
+
+		return runE(cmd, args)
+	}
+
+	return cmd
+}
+
+func genesisBondDenom(cdc *codec.Codec, genFile string) (string, error) {
+	appState, _, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	if err != nil {
+		return "", err
+	}
+	if appState[staking.ModuleName] == nil {
+		return "", fmt.Errorf("staking module is not present in the genesis")
+	}
+
+	var stakingGenesis staking.GenesisState
+	if err = cdc.UnmarshalJSON(appState[staking.ModuleName], &stakingGenesis); err != nil {
+		return "", err
+	}
+
+	return stakingGenesis.Params.BondDenom, nil
+}
+
+func validateGenTxsDenom(cdc *codec.Codec, dir, bondDenom string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		bytes, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return err
+		}
+
+		var tx auth.StdTx
+		if err = cdc.UnmarshalJSON(bytes, &tx); err != nil {
+			return fmt.Errorf("error unmarshalling gentx %s: %s", file.Name(), err)
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			msg, ok := msg.(staking.MsgCreateValidator)
+			if !ok {
+				continue
+			}
+
+			if msg.Value.Denom != bondDenom {
+				return fmt.Errorf("gentx %s bonds %s, but the bond denom of the genesis is %s",
+					file.Name(), msg.Value, bondDenom)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/deposit"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+const (
+	flagVersion       = "version"
+	flagMoniker       = "moniker"
+	flagUploadSpeed   = "upload-speed"
+	flagDownloadSpeed = "download-speed"
+	flagEncryption    = "encryption"
+)
+
+func addGenesisVPNNodeCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-vpn-node [owner] [prices-per-gb] [category] [deposit]",
+		Short: "Add a registered vpn node to genesis.json",
+		Long: `Add a registered vpn node of the owner to genesis.json. The category is one of OpenVPN, WireGuard
+and V2Ray. The deposit is moved from the genesis account of the owner to the deposits, a zero deposit
+registers the node without a deposit.
+
+Example:
+	sentinel-hubd add-genesis-vpn-node sent1... 1000000tsent WireGuard 0tsent --moniker demo-node`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pricesPerGB, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			category, err := vpn.NewNodeCategoryFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			internetSpeed, err := hub.NewBandwidthFromString(viper.GetString(flagUploadSpeed),
+				viper.GetString(flagDownloadSpeed))
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+			if appState[vpn.ModuleName] == nil {
+				return fmt.Errorf("vpn module is not enabled in the genesis")
+			}
+
+			var vpnState vpn.GenesisState
+			if err = cdc.UnmarshalJSON(appState[vpn.ModuleName], &vpnState); err != nil {
+				return err
+			}
+			if amount.Denom != vpnState.Params.Deposit.Denom {
+				return fmt.Errorf("invalid deposit denom %s", amount.Denom)
+			}
+
+			node := vpn.Node{
+				ID:            hub.NewNodeID(uint64(len(vpnState.Nodes))),
+				Owner:         owner,
+				Deposit:       amount,
+				Type:          category,
+				Version:       viper.GetString(flagVersion),
+				Moniker:       viper.GetString(flagMoniker),
+				PricesPerGB:   pricesPerGB,
+				InternetSpeed: internetSpeed,
+				Encryption:    viper.GetString(flagEncryption),
+				Status:        vpn.StatusRegistered,
+			}
+			if err = node.IsValid(); err != nil {
+				return err
+			}
+
+			for _, _node := range vpnState.Nodes {
+				if node.Moniker != "" && _node.Status != vpn.StatusDeRegistered &&
+					strings.EqualFold(node.Moniker, _node.Moniker) {
+					return fmt.Errorf("moniker %s is already taken", node.Moniker)
+				}
+			}
+
+			if amount.IsPositive() {
+				if err = lockGenesisDeposit(cdc, appState, owner, amount); err != nil {
+					return err
+				}
+			}
+
+			vpnState.Nodes = append(vpnState.Nodes, node)
+			vpnState.NodeUptimes = append(vpnState.NodeUptimes, vpn.NewNodeUptime(node.ID, 0))
+
+			appState[vpn.ModuleName], err = cdc.MarshalJSON(vpnState)
+			if err != nil {
+				return err
+			}
+
+			genDoc.AppState, err = cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagVersion, "0.1.0", "VPN node version")
+	cmd.Flags().String(flagMoniker, "", "Moniker")
+	cmd.Flags().String(flagUploadSpeed, hub.MB.MulRaw(100).String(), "Internet upload speed in bytes/sec")
+	cmd.Flags().String(flagDownloadSpeed, hub.MB.MulRaw(100).String(), "Internet download speed in bytes/sec")
+	cmd.Flags().String(flagEncryption, "AES-256-CBC", "VPN encryption method")
+
+	return cmd
+}
+
+// lockGenesisDeposit moves the amount from the genesis account of the owner to the genesis account of
+// the deposit module, and adds it to the deposit of the owner.
+func lockGenesisDeposit(cdc *codec.Codec, appState map[string]json.RawMessage,
+	owner sdk.AccAddress, amount sdk.Coin) error {
+	if appState[deposit.ModuleName] == nil {
+		return fmt.Errorf("deposit module is not enabled in the genesis")
+	}
+
+	coins := sdk.Coins{amount}
+	moduleAddress := supply.NewModuleAddress(deposit.ModuleName)
+
+	ownerIndex, moduleIndex := -1, -1
+	accounts := genaccounts.GetGenesisStateFromAppState(cdc, appState)
+	for i, account := range accounts {
+		if account.Address.Equals(owner) {
+			ownerIndex = i
+		} else if account.Address.Equals(moduleAddress) {
+			moduleIndex = i
+		}
+	}
+
+	if ownerIndex < 0 {
+		return fmt.Errorf("account %s does not exist in the genesis", owner)
+	}
+
+	balance, negative := accounts[ownerIndex].Coins.SafeSub(coins)
+	if negative {
+		return fmt.Errorf("insufficient coins of the account %s for the deposit %s", owner, amount)
+	}
+
+	accounts[ownerIndex].Coins = balance
+	if err := accounts[ownerIndex].Validate(); err != nil {
+		return fmt.Errorf("invalid account %s after the deposit: %s", owner, err)
+	}
+
+	if moduleIndex < 0 {
+		accounts = append(accounts, genaccounts.NewGenesisAccountRaw(moduleAddress, coins, sdk.Coins{},
+			0, 0, deposit.ModuleName))
+	} else {
+		accounts[moduleIndex].Coins = accounts[moduleIndex].Coins.Add(coins)
+	}
+
+	genaccounts.SetGenesisStateInAppState(cdc, appState, accounts)
+
+	var deposits deposit.GenesisState
+	if err := cdc.UnmarshalJSON(appState[deposit.ModuleName], &deposits); err != nil {
+		return err
+	}
+
+	found := false
+	for i := range deposits {
+		if deposits[i].Address.Equals(owner) {
+			deposits[i].Coins = deposits[i].Coins.Add(coins)
+			found = true
+		}
+	}
+
+	if !found {
+		deposits = append(deposits, deposit.Deposit{Address: owner, Coins: coins})
+	}
+
+	bz, err := cdc.MarshalJSON(deposits)
+	if err != nil {
+		return err
+	}
+
+	appState[deposit.ModuleName] = bz
+	return nil
+}
@@ -6,13 +6,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	genaccountsCli "github.com/cosmos/cosmos-sdk/x/genaccounts/client/cli"
 	genutilCli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
-	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -23,45 +22,63 @@ import (
 
 	"github.com/sentinel-official/hub/app"
 	_server "github.com/sentinel-official/hub/server"
+	"github.com/sentinel-official/hub/streaming"
 	hub "github.com/sentinel-official/hub/types"
 )
 
 const (
 	flagInvCheckPeriod = "inv-check-period"
+	flagProfile        = "profile"
+	flagStream         = "stream"
+
+	// keyPrometheus is the key of the tendermint config which enables the prometheus endpoint.
+	keyPrometheus = "instrumentation.prometheus"
 )
 
 var (
 	invCheckPeriod uint
+	profile        app.Profile
+
+	// moduleBasics are filled with the module basics of the profile once the flags are parsed.
+	moduleBasics = module.BasicManager{}
 )
 
 func main() {
 	cdc := app.MakeCodec()
 
-	config := sdk.GetConfig()
-	config.SetBech32PrefixForAccount(hub.Bech32PrefixAccAddr, hub.Bech32PrefixAccPub)
-	config.SetBech32PrefixForValidator(hub.Bech32PrefixValAddr, hub.Bech32PrefixValPub)
-	config.SetBech32PrefixForConsensusNode(hub.Bech32PrefixConsAddr, hub.Bech32PrefixConsPub)
-	config.Seal()
-
 	ctx := server.NewDefaultContext()
 	cobra.EnableCommandSorting = false
 	rootCmd := &cobra.Command{
-		Use:               "sentinel-hubd",
-		Short:             "Sentinel Hub Daemon (server)",
-		PersistentPreRunE: server.PersistentPreRunEFn(ctx),
+		Use:   "sentinel-hubd",
+		Short: "Sentinel Hub Daemon (server)",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) (err error) {
+			if err = server.PersistentPreRunEFn(ctx)(cmd, args); err != nil {
+				return err
+			}
+
+			return initProfile(cdc)
+		},
 	}
 
-	rootCmd.AddCommand(genutilCli.InitCmd(ctx, cdc, app.ModuleBasics, app.DefaultNodeHome))
-	rootCmd.AddCommand(genutilCli.CollectGenTxsCmd(ctx, cdc, genaccounts.AppModuleBasic{}, app.DefaultNodeHome))
-	rootCmd.AddCommand(genutilCli.GenTxCmd(ctx, cdc, app.ModuleBasics, staking.AppModuleBasic{},
-		genaccounts.AppModuleBasic{}, app.DefaultNodeHome, app.DefaultCLIHome))
-	rootCmd.AddCommand(genutilCli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics))
+	rootCmd.AddCommand(genutilCli.InitCmd(ctx, cdc, moduleBasics, app.DefaultNodeHome))
+	rootCmd.AddCommand(collectGenTxsCmd(ctx, cdc, app.DefaultNodeHome))
+	rootCmd.AddCommand(genTxCmd(ctx, cdc, moduleBasics, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(validateGenesisCmd(ctx, cdc, moduleBasics))
 	rootCmd.AddCommand(genaccountsCli.AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(addGenesisAccountsCmd(ctx, cdc, app.DefaultNodeHome))
+	rootCmd.AddCommand(addGenesisVPNNodeCmd(ctx, cdc, app.DefaultNodeHome))
+	rootCmd.AddCommand(migrateGenesisCmd(cdc))
+	rootCmd.AddCommand(testnetCmd(ctx, cdc))
+	rootCmd.AddCommand(debugCmd(cdc))
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 
 	_server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
 		0, "Assert registered invariants every N blocks")
+	rootCmd.PersistentFlags().String(flagProfile, app.DefaultProfile,
+		"Chain profile of the denom, address prefixes, modules and default params")
+	rootCmd.PersistentFlags().String(flagStream, "",
+		"Stream the changes of the vpn stores of every block to the file, or to the unix:// or tcp:// socket")
 
 	executor := cli.PrepareBaseCmd(rootCmd, "SENT_HUB", app.DefaultNodeHome)
 	if err := executor.Execute(); err != nil {
@@ -69,10 +86,45 @@ func main() {
 	}
 }
 
+func initProfile(cdc *codec.Codec) (err error) {
+	profile, err = app.GetProfile(viper.GetString(flagProfile))
+	if err != nil {
+		return err
+	}
+
+	config := sdk.GetConfig()
+	profile.SetBech32AddressPrefixes(config)
+	config.SetCoinType(hub.CoinType)
+	config.SetFullFundraiserPath(hub.FullFundraiserPath)
+	config.Seal()
+
+	for name, basic := range profile.ModuleBasics(cdc) {
+		moduleBasics[name] = basic
+	}
+
+	return nil
+}
+
 func newApp(logger log.Logger, db db.DB, traceStore io.Writer) abci.Application {
+	var stream io.Writer
+	if address := viper.GetString(flagStream); address != "" {
+		writer, err := streaming.NewWriter(address)
+		if err != nil {
+			panic(err)
+		}
+
+		stream = writer
+	}
+
+	pruning, err := _server.NewPruningOptions(viper.GetString(_server.FlagPruning),
+		viper.GetInt64(_server.FlagPruningKeepRecent), viper.GetInt64(_server.FlagPruningKeepEvery))
+	if err != nil {
+		panic(err)
+	}
+
 	return app.NewHubApp(
-		logger, db, traceStore, true, invCheckPeriod,
-		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
+		logger, db, traceStore, true, invCheckPeriod, profile, viper.GetBool(keyPrometheus), stream,
+		baseapp.SetPruning(pruning),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
 		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
 	)
@@ -81,13 +133,13 @@ func newApp(logger log.Logger, db db.DB, traceStore io.Writer) abci.Application
 func exportAppStateAndTMValidators(logger log.Logger, db db.DB, traceStore io.Writer, height int64, forZeroHeight bool,
 	jailWhiteList []string) (json.RawMessage, []tm.GenesisValidator, error) {
 	if height != -1 {
-		hubApp := app.NewHubApp(logger, db, traceStore, false, uint(1))
+		hubApp := app.NewHubApp(logger, db, traceStore, false, uint(1), profile, false, nil)
 		err := hubApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
 		}
 		return hubApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
-	hubApp := app.NewHubApp(logger, db, traceStore, true, uint(1))
+	hubApp := app.NewHubApp(logger, db, traceStore, true, uint(1), profile, false, nil)
 	return hubApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
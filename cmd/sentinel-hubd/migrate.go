@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/hub/x/vpn/legacy"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+const (
+	flagGenesisTime = "genesis-time"
+	flagChainID     = "chain-id"
+)
+
+func migrateGenesisCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [genesis-file]",
+		Short: "Migrate the exported genesis of the previous chain to the current version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			doc, err := tm.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var state map[string]json.RawMessage
+			if err = cdc.UnmarshalJSON(doc.AppState, &state); err != nil {
+				return err
+			}
+
+			if state[types.ModuleName] != nil {
+				var vpnState legacy.GenesisState
+				if err = cdc.UnmarshalJSON(state[types.ModuleName], &vpnState); err != nil {
+					return err
+				}
+
+				migrated, err := legacy.Migrate(vpnState)
+				if err != nil {
+					return err
+				}
+
+				state[types.ModuleName] = cdc.MustMarshalJSON(migrated)
+			}
+
+			doc.AppState, err = cdc.MarshalJSON(state)
+			if err != nil {
+				return err
+			}
+
+			if s, _ := cmd.Flags().GetString(flagGenesisTime); s != "" {
+				var t time.Time
+				if err = t.UnmarshalText([]byte(s)); err != nil {
+					return err
+				}
+
+				doc.GenesisTime = t
+			}
+			if s, _ := cmd.Flags().GetString(flagChainID); s != "" {
+				doc.ChainID = s
+			}
+
+			bytes, err := codec.MarshalJSONIndent(cdc, doc)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(bytes))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "Override the genesis time, in RFC3339 format")
+	cmd.Flags().String(flagChainID, "", "Override the chain ID")
+
+	return cmd
+}
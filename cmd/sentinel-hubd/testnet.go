@@ -0,0 +1,322 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tm "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+const (
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagNumValidators     = "v"
+	flagOutputDir         = "output-dir"
+	flagNodeDaemonHome    = "node-daemon-home"
+	flagNodeCLIHome       = "node-cli-home"
+	flagStartingIPAddress = "starting-ip-address"
+)
+
+const (
+	nodeDirPerm = 0755
+)
+
+func testnetCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize the files of a local multi-validator testnet",
+		Long: `testnet creates the home directories of N validators, with the keys, the gentxs and a
+combined genesis which funds the validator accounts and registers a sample vpn node per validator.
+
+Example:
+	sentinel-hubd testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return initTestnet(cmd, ctx.Config, cdc,
+				viper.GetString(flagOutputDir),
+				viper.GetString(client.FlagChainID),
+				viper.GetString(server.FlagMinGasPrices),
+				viper.GetString(flagNodeDirPrefix),
+				viper.GetString(flagNodeDaemonHome),
+				viper.GetString(flagNodeCLIHome),
+				viper.GetString(flagStartingIPAddress),
+				viper.GetInt(flagNumValidators))
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet", "Directory to store the initialization data of the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix of the directory name of each node")
+	cmd.Flags().String(flagNodeDaemonHome, "sentinel-hubd", "Home directory of the daemon of each node")
+	cmd.Flags().String(flagNodeCLIHome, "sentinel-hubcli", "Home directory of the client of each node")
+	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1",
+		"Starting IP address, the IP addresses of the other nodes are incremented from it")
+	cmd.Flags().String(client.FlagChainID, "", "Chain ID of the testnet, a random one is generated if empty")
+	cmd.Flags().String(server.FlagMinGasPrices, "",
+		"Minimum gas prices to accept for transactions, a fraction of the smallest denom (e.g. 0.01tsent)")
+
+	return cmd
+}
+
+// nolint:funlen
+func initTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
+	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress string,
+	numValidators int) error {
+	if chainID == "" {
+		chainID = "chain-" + cmn.RandStr(6)
+	}
+
+	var (
+		monikers   = make([]string, numValidators)
+		nodeIDs    = make([]string, numValidators)
+		valPubKeys = make([]crypto.PubKey, numValidators)
+		accounts   = make([]genaccounts.GenesisAccount, numValidators)
+		genFiles   = make([]string, numValidators)
+	)
+
+	appConfig := srvconfig.DefaultConfig()
+	appConfig.MinGasPrices = minGasPrices
+
+	gentxsDir := filepath.Join(outputDir, "gentxs")
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		clientDir := filepath.Join(outputDir, nodeDirName, nodeCLIHome)
+
+		config.SetRoot(nodeDir)
+		config.Moniker = nodeDirName
+		config.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		if err := os.MkdirAll(clientDir, nodeDirPerm); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		ip, err := calculateIP(startingIPAddress, i)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		monikers[i] = nodeDirName
+		genFiles[i] = config.GenesisFile()
+
+		kb, err := keys.NewKeyBaseFromDir(clientDir)
+		if err != nil {
+			return err
+		}
+
+		address, secret, err := server.GenerateSaveCoinKey(clientDir, nodeDirName, client.DefaultKeyPass, true)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		seed, err := json.Marshal(map[string]string{"secret": secret})
+		if err != nil {
+			return err
+		}
+		if err = writeFile("key_seed.json", clientDir, seed); err != nil {
+			return err
+		}
+
+		accounts[i] = genaccounts.GenesisAccount{
+			Address: address,
+			Coins: sdk.Coins{
+				sdk.NewCoin(profile.Denom, sdk.TokensFromConsensusPower(1000)),
+			},
+		}
+
+		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
+		msg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(address),
+			valPubKeys[i],
+			sdk.NewCoin(profile.Denom, sdk.TokensFromConsensusPower(100)),
+			staking.NewDescription(nodeDirName, "", "", ""),
+			staking.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			sdk.OneInt(),
+		)
+
+		tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, []auth.StdSignature{}, memo)
+		txBuilder := auth.NewTxBuilderFromCLI().WithChainID(chainID).WithMemo(memo).WithKeybase(kb)
+
+		signedTx, err := txBuilder.SignStdTx(nodeDirName, client.DefaultKeyPass, tx, false)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		txBytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		if err = writeFile(fmt.Sprintf("%s.json", nodeDirName), gentxsDir, txBytes); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
+	}
+
+	if err := initGenFiles(cdc, moduleBasics, chainID, accounts, genFiles); err != nil {
+		return err
+	}
+
+	if err := collectGenFiles(cdc, config, chainID, monikers, nodeIDs, valPubKeys,
+		outputDir, nodeDirPrefix, nodeDaemonHome); err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
+	return nil
+}
+
+// sampleVPNNodes returns a registered vpn node owned by each of the accounts, so that the
+// subscriptions and the sessions can be exercised on the testnet right after the start.
+func sampleVPNNodes(accounts []genaccounts.GenesisAccount) []vpn.Node {
+	nodes := make([]vpn.Node, 0, len(accounts))
+	for i, account := range accounts {
+		nodes = append(nodes, vpn.Node{
+			ID:            hub.NewNodeID(uint64(i)),
+			Owner:         account.Address,
+			Deposit:       sdk.NewInt64Coin(profile.Denom, 0),
+			Type:          "OpenVPN",
+			Version:       "0.1.0",
+			Moniker:       fmt.Sprintf("vpn-node-%d", i),
+			PricesPerGB:   sdk.Coins{sdk.NewInt64Coin(profile.Denom, 1000000)},
+			InternetSpeed: hub.NewBandwidth(hub.MB.MulRaw(100), hub.MB.MulRaw(100)),
+			Encryption:    "AES-256-CBC",
+			Status:        vpn.StatusRegistered,
+		})
+	}
+
+	return nodes
+}
+
+func initGenFiles(cdc *codec.Codec, mbm module.BasicManager, chainID string,
+	accounts []genaccounts.GenesisAccount, genFiles []string) error {
+	state := mbm.DefaultGenesis()
+	state = genaccounts.SetGenesisStateInAppState(cdc, state, accounts)
+
+	if state[vpn.ModuleName] != nil {
+		var vpnState vpn.GenesisState
+		cdc.MustUnmarshalJSON(state[vpn.ModuleName], &vpnState)
+
+		vpnState.Nodes = sampleVPNNodes(accounts)
+		state[vpn.ModuleName] = cdc.MustMarshalJSON(vpnState)
+	}
+
+	stateBytes, err := codec.MarshalJSONIndent(cdc, state)
+	if err != nil {
+		return err
+	}
+
+	doc := tm.GenesisDoc{
+		ChainID:  chainID,
+		AppState: stateBytes,
+	}
+
+	for _, file := range genFiles {
+		if err := doc.SaveAs(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func collectGenFiles(cdc *codec.Codec, config *tmconfig.Config, chainID string,
+	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
+	outputDir, nodeDirPrefix, nodeDaemonHome string) error {
+	var (
+		appState json.RawMessage
+		genTime  = tmtime.Now()
+	)
+
+	gentxsDir := filepath.Join(outputDir, "gentxs")
+	for i := range monikers {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+
+		config.SetRoot(nodeDir)
+		config.Moniker = nodeDirName
+
+		initConfig := genutil.NewInitConfig(chainID, gentxsDir, monikers[i], nodeIDs[i], valPubKeys[i])
+
+		doc, err := tm.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cdc, config, initConfig, *doc, genaccounts.AppModuleBasic{})
+		if err != nil {
+			return err
+		}
+
+		// The app states of the nodes do not differ, the first one is the canonical one
+		if appState == nil {
+			appState = nodeAppState
+		}
+
+		if err := genutil.ExportGenesisFileWithTime(config.GenesisFile(), chainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func calculateIP(ip string, i int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("%s is not an ipv4 address", ip)
+	}
+
+	for j := 0; j < i; j++ {
+		ipv4[3]++
+	}
+
+	return ipv4.String(), nil
+}
+
+func writeFile(name, dir string, contents []byte) error {
+	if err := cmn.EnsureDir(dir, 0700); err != nil {
+		return err
+	}
+
+	return cmn.WriteFile(filepath.Join(dir, name), contents, 0600)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/spf13/cobra"
+	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/hub/app"
+)
+
+// validateGenesisCmd is the validate-genesis command of the SDK, which also validates the state
+// spanning the modules, like the deposits locked by the vpn module.
+func validateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "Validate the genesis file at the default location or at the location passed as an arg",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			genFile := ctx.Config.GenesisFile()
+			if len(args) > 0 {
+				genFile = args[0]
+			}
+
+			fmt.Fprintf(os.Stderr, "validating genesis file at %s\n", genFile)
+
+			genDoc, err := tm.GenesisDocFromFile(genFile)
+			if err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genFile, err)
+			}
+
+			var genesis map[string]json.RawMessage
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &genesis); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genFile, err)
+			}
+
+			if err = mbm.ValidateGenesis(genesis); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genFile, err)
+			}
+			if err = app.ValidateGenesisState(cdc, genesis); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genFile, err)
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genFile)
+			return nil
+		},
+	}
+
+	return cmd
+}
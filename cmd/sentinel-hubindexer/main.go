@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/lib/pq"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/sentinel-official/hub/app"
+	"github.com/sentinel-official/hub/indexer"
+	"github.com/sentinel-official/hub/streaming"
+)
+
+const (
+	flagListen   = "listen"
+	flagDatabase = "database"
+	flagNode     = "node"
+)
+
+func main() {
+	cmd := &cobra.Command{
+		Use:   "sentinel-hubindexer",
+		Short: "Index the vpn state streamed by sentinel-hubd --stream into PostgreSQL",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := sql.Open("postgres", viper.GetString(flagDatabase))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			i := indexer.NewIndexer(db, app.MakeCodec())
+			if err := i.Init(); err != nil {
+				return err
+			}
+
+			address := viper.GetString(flagListen)
+			network := "tcp"
+			if strings.HasPrefix(address, "unix://") {
+				network = "unix"
+			}
+
+			listener, err := net.Listen(network, strings.TrimPrefix(strings.TrimPrefix(address, "unix://"), "tcp://"))
+			if err != nil {
+				return err
+			}
+			defer listener.Close()
+
+			node := rpcclient.NewHTTP(viper.GetString(flagNode), "/websocket")
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return err
+				}
+
+				if err := serve(conn, node, i); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				}
+			}
+		},
+	}
+
+	cmd.Flags().String(flagListen, "unix:///tmp/sentinel-hubindexer.sock",
+		"Address to accept the stream of sentinel-hubd on, unix:// or tcp://")
+	cmd.Flags().String(flagDatabase, "postgres://localhost:5432/sentinel?sslmode=disable",
+		"Connection string of the PostgreSQL database")
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "Tendermint RPC address of the node to query the block results from")
+	_ = viper.BindPFlags(cmd.Flags())
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// serve indexes the blocks of the stream until the connection is closed.
+func serve(conn net.Conn, node rpcclient.Client, i *indexer.Indexer) error {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var block streaming.Block
+		if err := json.Unmarshal(line, &block); err != nil {
+			return err
+		}
+
+		events, err := blockEvents(node, block.Height)
+		if err != nil {
+			return err
+		}
+
+		if err := i.Index(block, events); err != nil {
+			return err
+		}
+	}
+}
+
+// blockEvents returns the events of the successful transactions and the end block of the height.
+func blockEvents(node rpcclient.Client, height int64) (events sdk.Events, err error) {
+	res, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range res.Results.DeliverTx {
+		if tx.IsOK() {
+			for _, event := range tx.Events {
+				events = append(events, sdk.Event(event))
+			}
+		}
+	}
+
+	if res.Results.EndBlock != nil {
+		for _, event := range res.Results.EndBlock.Events {
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	return events, nil
+}
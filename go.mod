@@ -3,13 +3,18 @@ module github.com/sentinel-official/hub
 go 1.13
 
 require (
+	github.com/btcsuite/btcd v0.0.0-20190115013929-ed77733ec07d
 	github.com/cosmos/cosmos-sdk v0.37.8
+	github.com/go-kit/kit v0.9.0
 	github.com/gorilla/mux v1.7.4
+	github.com/lib/pq v1.3.0
+	github.com/prometheus/client_golang v0.9.3
 	github.com/spf13/cobra v0.0.7
 	github.com/spf13/viper v1.6.2
 	github.com/stretchr/testify v1.5.1
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.32.9
 	github.com/tendermint/tm-db v0.2.0
+	golang.org/x/crypto v0.0.0-20190313024323-a1f597ede03a
 	gopkg.in/yaml.v2 v2.2.8
 )
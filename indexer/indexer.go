@@ -0,0 +1,175 @@
+package indexer
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/streaming"
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/compact"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// Indexer writes the nodes, the subscriptions and the sessions streamed by the hub, along with
+// the settlements and the payouts of the blocks, into the relational tables of the Schema.
+type Indexer struct {
+	db  *sql.DB
+	cdc *codec.Codec
+}
+
+func NewIndexer(db *sql.DB, cdc *codec.Codec) *Indexer {
+	return &Indexer{
+		db:  db,
+		cdc: cdc,
+	}
+}
+
+func (i *Indexer) Init() error {
+	_, err := i.db.Exec(Schema)
+	return err
+}
+
+// Index writes the changes of the block and the settlements and the payouts of its events
+// in a single transaction, the blocks which are already indexed are skipped.
+func (i *Indexer) Index(block streaming.Block, events sdk.Events) error {
+	tx, err := i.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(`INSERT INTO blocks (height) VALUES ($1) ON CONFLICT DO NOTHING`, block.Height)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return tx.Rollback()
+	}
+
+	if err := i.index(tx, block, events); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (i *Indexer) index(tx *sql.Tx, block streaming.Block, events sdk.Events) error {
+	for _, change := range block.Changes {
+		key, err := hex.DecodeString(change.Key)
+		if err != nil {
+			return err
+		}
+
+		value, err := hex.DecodeString(change.Value)
+		if err != nil {
+			return err
+		}
+
+		if err := i.indexChange(tx, block.Height, change.Store, key, value, change.Delete); err != nil {
+			return err
+		}
+	}
+
+	_block, err := compact.FromEvents(events)
+	if err != nil {
+		return err
+	}
+
+	for _, settlement := range _block.SettleSessions {
+		if _, err := tx.Exec(`INSERT INTO settlements (height, session_id, amount, fee, final) VALUES ($1, $2, $3, $4, $5)`,
+			block.Height, settlement.SessionID.String(), settlement.Amount.String(), settlement.Fee.String(),
+			settlement.Final); err != nil {
+			return err
+		}
+	}
+
+	for _, payout := range _block.PayoutNodes {
+		if _, err := tx.Exec(`INSERT INTO payouts (height, node_id, address, amount) VALUES ($1, $2, $3, $4)`,
+			block.Height, payout.NodeID.String(), payout.Address.String(), payout.Amount.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexChange writes the change of a node, a subscription or a session, the changes of the
+// other keys of the stores are ignored.
+func (i *Indexer) indexChange(tx *sql.Tx, height int64, store string, key, value []byte, _delete bool) error {
+	switch {
+	case store == types.StoreKeyNode && bytes.HasPrefix(key, types.NodeKeyPrefix):
+		if _delete {
+			_, err := tx.Exec(`DELETE FROM nodes WHERE id = $1`, hub.NodeID(key[1:]).String())
+			return err
+		}
+
+		var node types.Node
+		if err := i.cdc.UnmarshalBinaryLengthPrefixed(value, &node); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`INSERT INTO nodes (id, owner, deposit, type, version, moniker, prices_per_gb,
+	upload_speed, download_speed, encryption, private, jailed, status, status_modified_at, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (id) DO UPDATE SET owner = EXCLUDED.owner, deposit = EXCLUDED.deposit, type = EXCLUDED.type,
+	version = EXCLUDED.version, moniker = EXCLUDED.moniker, prices_per_gb = EXCLUDED.prices_per_gb,
+	upload_speed = EXCLUDED.upload_speed, download_speed = EXCLUDED.download_speed,
+	encryption = EXCLUDED.encryption, private = EXCLUDED.private, jailed = EXCLUDED.jailed,
+	status = EXCLUDED.status, status_modified_at = EXCLUDED.status_modified_at, height = EXCLUDED.height`,
+			node.ID.String(), node.Owner.String(), node.Deposit.String(), node.Type, node.Version, node.Moniker,
+			node.PricesPerGB.String(), node.InternetSpeed.Upload.String(), node.InternetSpeed.Download.String(),
+			node.Encryption, node.Private, node.Jailed, node.Status, node.StatusModifiedAt, height)
+		return err
+	case store == types.StoreKeySubscription && bytes.HasPrefix(key, types.SubscriptionKeyPrefix):
+		if _delete {
+			_, err := tx.Exec(`DELETE FROM subscriptions WHERE id = $1`, hub.SubscriptionID(key[1:]).String())
+			return err
+		}
+
+		var subscription types.Subscription
+		if err := i.cdc.UnmarshalBinaryLengthPrefixed(value, &subscription); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`INSERT INTO subscriptions (id, node_id, client, price_per_gb, total_deposit,
+	remaining_deposit, remaining_upload, remaining_download, seats, paused, status, status_modified_at, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+ON CONFLICT (id) DO UPDATE SET node_id = EXCLUDED.node_id, client = EXCLUDED.client,
+	price_per_gb = EXCLUDED.price_per_gb, total_deposit = EXCLUDED.total_deposit,
+	remaining_deposit = EXCLUDED.remaining_deposit, remaining_upload = EXCLUDED.remaining_upload,
+	remaining_download = EXCLUDED.remaining_download, seats = EXCLUDED.seats, paused = EXCLUDED.paused,
+	status = EXCLUDED.status, status_modified_at = EXCLUDED.status_modified_at, height = EXCLUDED.height`,
+			subscription.ID.String(), subscription.NodeID.String(), subscription.Client.String(),
+			subscription.PricePerGB.String(), subscription.TotalDeposit.String(), subscription.RemainingDeposit.String(),
+			subscription.RemainingBandwidth.Upload.String(), subscription.RemainingBandwidth.Download.String(),
+			subscription.Seats, subscription.Paused, subscription.Status, subscription.StatusModifiedAt, height)
+		return err
+	case store == types.StoreKeySession && bytes.HasPrefix(key, types.SessionKeyPrefix):
+		if _delete {
+			_, err := tx.Exec(`DELETE FROM sessions WHERE id = $1`, hub.SessionID(key[1:]).String())
+			return err
+		}
+
+		var session types.Session
+		if err := i.cdc.UnmarshalBinaryLengthPrefixed(value, &session); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`INSERT INTO sessions (id, subscription_id, upload, download, paid, status,
+	status_modified_at, height)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE SET subscription_id = EXCLUDED.subscription_id, upload = EXCLUDED.upload,
+	download = EXCLUDED.download, paid = EXCLUDED.paid, status = EXCLUDED.status,
+	status_modified_at = EXCLUDED.status_modified_at, height = EXCLUDED.height`,
+			session.ID.String(), session.SubscriptionID.String(), session.Bandwidth.Upload.String(),
+			session.Bandwidth.Download.String(), session.Paid.String(), session.Status, session.StatusModifiedAt, height)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package indexer
+
+// Schema creates the tables of the indexer, the coins are stored in their string form and the
+// heights are of the blocks which have last changed the rows.
+const Schema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height BIGINT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS nodes (
+	id                 TEXT PRIMARY KEY,
+	owner              TEXT NOT NULL,
+	deposit            TEXT NOT NULL,
+	type               TEXT NOT NULL,
+	version            TEXT NOT NULL,
+	moniker            TEXT NOT NULL,
+	prices_per_gb      TEXT NOT NULL,
+	upload_speed       NUMERIC NOT NULL,
+	download_speed     NUMERIC NOT NULL,
+	encryption         TEXT NOT NULL,
+	private            BOOLEAN NOT NULL,
+	jailed             BOOLEAN NOT NULL,
+	status             TEXT NOT NULL,
+	status_modified_at BIGINT NOT NULL,
+	height             BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+	id                 TEXT PRIMARY KEY,
+	node_id            TEXT NOT NULL,
+	client             TEXT NOT NULL,
+	price_per_gb       TEXT NOT NULL,
+	total_deposit      TEXT NOT NULL,
+	remaining_deposit  TEXT NOT NULL,
+	remaining_upload   NUMERIC NOT NULL,
+	remaining_download NUMERIC NOT NULL,
+	seats              BIGINT NOT NULL,
+	paused             BOOLEAN NOT NULL,
+	status             TEXT NOT NULL,
+	status_modified_at BIGINT NOT NULL,
+	height             BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS subscriptions_node_id ON subscriptions (node_id);
+CREATE INDEX IF NOT EXISTS subscriptions_client ON subscriptions (client);
+
+CREATE TABLE IF NOT EXISTS sessions (
+	id                 TEXT PRIMARY KEY,
+	subscription_id    TEXT NOT NULL,
+	upload             NUMERIC NOT NULL,
+	download           NUMERIC NOT NULL,
+	paid               TEXT NOT NULL,
+	status             TEXT NOT NULL,
+	status_modified_at BIGINT NOT NULL,
+	height             BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS sessions_subscription_id ON sessions (subscription_id);
+
+CREATE TABLE IF NOT EXISTS settlements (
+	height     BIGINT NOT NULL,
+	session_id TEXT NOT NULL,
+	amount     TEXT NOT NULL,
+	fee        TEXT NOT NULL,
+	final      BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS settlements_session_id ON settlements (session_id);
+
+CREATE TABLE IF NOT EXISTS payouts (
+	height  BIGINT NOT NULL,
+	node_id TEXT NOT NULL,
+	address TEXT NOT NULL,
+	amount  TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS payouts_node_id ON payouts (node_id);
+`
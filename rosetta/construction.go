@@ -0,0 +1,298 @@
+package rosetta
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+// The construction API builds the transactions of a single bank send message, signed by the
+// sender with a secp256k1 key.
+
+const (
+	metadataAccountNumber = "account_number"
+	metadataSequence      = "sequence"
+	metadataChainID       = "chain_id"
+	metadataGas           = "gas"
+	metadataFee           = "fee"
+
+	optionSender = "sender"
+)
+
+func (s *Server) constructionDerive(body []byte) (interface{}, *Error) {
+	var req ConstructionDeriveRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	pubKey, _err := parsePubKey(req.PublicKey)
+	if _err != nil {
+		return nil, _err
+	}
+
+	return ConstructionDeriveResponse{
+		Address: sdk.AccAddress(pubKey.Address()).String(),
+	}, nil
+}
+
+func (s *Server) constructionPreprocess(body []byte) (interface{}, *Error) {
+	var req ConstructionPreprocessRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	msg, err := MsgSendFromOperations(req.Operations)
+	if err != nil {
+		return nil, ErrInvalidOperations.Wrap(err)
+	}
+
+	return ConstructionPreprocessResponse{
+		Options: map[string]interface{}{
+			optionSender: msg.FromAddress.String(),
+		},
+	}, nil
+}
+
+func (s *Server) constructionMetadata(body []byte) (interface{}, *Error) {
+	var req ConstructionMetadataRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	sender, ok := req.Options[optionSender].(string)
+	if !ok {
+		return nil, ErrInvalidRequest
+	}
+
+	address, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	account, err := auth.NewAccountRetriever(s.ctx).GetAccount(address)
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	return ConstructionMetadataResponse{
+		Metadata: map[string]interface{}{
+			metadataAccountNumber: strconv.FormatUint(account.GetAccountNumber(), 10),
+			metadataSequence:      strconv.FormatUint(account.GetSequence(), 10),
+			metadataChainID:       s.chainID,
+			metadataGas:           strconv.FormatUint(DefaultGas, 10),
+		},
+	}, nil
+}
+
+func (s *Server) constructionPayloads(body []byte) (interface{}, *Error) {
+	var req ConstructionPayloadsRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	msg, err := MsgSendFromOperations(req.Operations)
+	if err != nil {
+		return nil, ErrInvalidOperations.Wrap(err)
+	}
+
+	var values [3]uint64
+	for i, key := range []string{metadataAccountNumber, metadataSequence, metadataGas} {
+		value, ok := req.Metadata[key].(string)
+		if !ok {
+			return nil, ErrInvalidRequest.Wrap(fmt.Errorf("metadata %s is missing", key))
+		}
+
+		if values[i], err = strconv.ParseUint(value, 10, 64); err != nil {
+			return nil, ErrInvalidRequest.Wrap(err)
+		}
+	}
+
+	chainID, ok := req.Metadata[metadataChainID].(string)
+	if !ok {
+		return nil, ErrInvalidRequest.Wrap(fmt.Errorf("metadata %s is missing", metadataChainID))
+	}
+
+	fee := auth.NewStdFee(values[2], nil)
+	if value, ok := req.Metadata[metadataFee].(string); ok {
+		if fee.Amount, err = sdk.ParseCoins(value); err != nil {
+			return nil, ErrInvalidRequest.Wrap(err)
+		}
+	}
+
+	tx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "")
+	bytes, err := s.ctx.Codec.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	signBytes := auth.StdSignBytes(chainID, values[0], values[1], fee, tx.Msgs, tx.Memo)
+	hash := sha256.Sum256(signBytes)
+
+	return ConstructionPayloadsResponse{
+		UnsignedTransaction: hex.EncodeToString(bytes),
+		Payloads: []SigningPayload{
+			{
+				AccountIdentifier: &AccountIdentifier{Address: msg.FromAddress.String()},
+				HexBytes:          hex.EncodeToString(hash[:]),
+				SignatureType:     SignatureECDSA,
+			},
+		},
+	}, nil
+}
+
+func (s *Server) constructionCombine(body []byte) (interface{}, *Error) {
+	var req ConstructionCombineRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	tx, _err := s.decodeHexTx(req.UnsignedTransaction)
+	if _err != nil {
+		return nil, _err
+	}
+	if len(req.Signatures) != len(tx.GetSigners()) {
+		return nil, ErrInvalidRequest.Wrap(fmt.Errorf("expected %d signatures", len(tx.GetSigners())))
+	}
+
+	tx.Signatures = make([]auth.StdSignature, 0, len(req.Signatures))
+	for _, signature := range req.Signatures {
+		pubKey, _err := parsePubKey(signature.PublicKey)
+		if _err != nil {
+			return nil, _err
+		}
+
+		bytes, err := hex.DecodeString(signature.HexBytes)
+		if err != nil {
+			return nil, ErrInvalidRequest.Wrap(err)
+		}
+
+		tx.Signatures = append(tx.Signatures, auth.StdSignature{
+			PubKey:    pubKey,
+			Signature: bytes,
+		})
+	}
+
+	bytes, err := s.ctx.Codec.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return ConstructionCombineResponse{
+		SignedTransaction: hex.EncodeToString(bytes),
+	}, nil
+}
+
+func (s *Server) constructionParse(body []byte) (interface{}, *Error) {
+	var req ConstructionParseRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	tx, _err := s.decodeHexTx(req.Transaction)
+	if _err != nil {
+		return nil, _err
+	}
+
+	var operations []Operation
+	for _, msg := range tx.Msgs {
+		msg, ok := msg.(bank.MsgSend)
+		if !ok {
+			return nil, ErrUnsupported
+		}
+
+		operations = append(operations, MsgSendOperations(int64(len(operations)), msg)...)
+	}
+
+	var signers []AccountIdentifier
+	if req.Signed {
+		for _, signature := range tx.Signatures {
+			signers = append(signers, AccountIdentifier{
+				Address: sdk.AccAddress(signature.PubKey.Address()).String(),
+			})
+		}
+	}
+
+	return ConstructionParseResponse{
+		Operations:               operations,
+		AccountIdentifierSigners: signers,
+	}, nil
+}
+
+func (s *Server) constructionHash(body []byte) (interface{}, *Error) {
+	var req ConstructionHashRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bytes, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return TransactionIdentifierResponse{
+		TransactionIdentifier: TransactionIdentifier{Hash: fmt.Sprintf("%X", tm.Tx(bytes).Hash())},
+	}, nil
+}
+
+func (s *Server) constructionSubmit(body []byte) (interface{}, *Error) {
+	var req ConstructionSubmitRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	bytes, err := hex.DecodeString(req.SignedTransaction)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	res, err := node.BroadcastTxSync(bytes)
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+	if res.Code != 0 {
+		return nil, ErrBroadcast.Wrap(errors.New(res.Log))
+	}
+
+	return TransactionIdentifierResponse{
+		TransactionIdentifier: TransactionIdentifier{Hash: res.Hash.String()},
+	}, nil
+}
+
+func (s *Server) decodeHexTx(tx string) (auth.StdTx, *Error) {
+	bytes, err := hex.DecodeString(tx)
+	if err != nil {
+		return auth.StdTx{}, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return s.decodeTx(bytes)
+}
+
+func parsePubKey(key PublicKey) (pubKey secp256k1.PubKeySecp256k1, _ *Error) {
+	if key.CurveType != CurveSecp256k1 {
+		return pubKey, ErrUnsupported
+	}
+
+	bytes, err := hex.DecodeString(key.HexBytes)
+	if err != nil {
+		return pubKey, ErrInvalidRequest.Wrap(err)
+	}
+	if len(bytes) != len(pubKey) {
+		return pubKey, ErrInvalidRequest.Wrap(fmt.Errorf("invalid public key length %d", len(bytes)))
+	}
+
+	copy(pubKey[:], bytes)
+	return pubKey, nil
+}
@@ -0,0 +1,446 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/hub/version"
+	deposit "github.com/sentinel-official/hub/x/deposit/types"
+)
+
+const (
+	suffixBeginBlock = "-begin_block"
+	suffixEndBlock   = "-end_block"
+)
+
+func (s *Server) networkList(body []byte) (interface{}, *Error) {
+	return NetworkListResponse{
+		NetworkIdentifiers: []NetworkIdentifier{s.network()},
+	}, nil
+}
+
+func (s *Server) networkStatus(body []byte) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	genesis, _err := s.blockIdentifier(1)
+	if _err != nil {
+		return nil, _err
+	}
+
+	info, err := node.NetInfo()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	peers := make([]Peer, 0, len(info.Peers))
+	for _, peer := range info.Peers {
+		peers = append(peers, Peer{PeerID: string(peer.NodeInfo.ID())})
+	}
+
+	return NetworkStatusResponse{
+		CurrentBlockIdentifier: BlockIdentifier{
+			Index: status.SyncInfo.LatestBlockHeight,
+			Hash:  status.SyncInfo.LatestBlockHash.String(),
+		},
+		CurrentBlockTimestamp:  status.SyncInfo.LatestBlockTime.UnixNano() / 1e6,
+		GenesisBlockIdentifier: *genesis,
+		Peers:                  peers,
+	}, nil
+}
+
+func (s *Server) networkOptions(body []byte) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	return NetworkOptionsResponse{
+		Version: Version{
+			RosettaVersion: RosettaVersion,
+			NodeVersion:    version.Version,
+		},
+		Allow: Allow{
+			OperationStatuses: []OperationStatus{
+				{Status: StatusSuccess, Successful: true},
+				{Status: StatusFailure, Successful: false},
+			},
+			OperationTypes:          OperationTypes,
+			Errors:                  Errors,
+			HistoricalBalanceLookup: true,
+		},
+	}, nil
+}
+
+// accountBalance returns the spendable coins of the account, or the coins locked in the deposit
+// module by the account for its nodes and subscriptions when the sub account is deposit.
+func (s *Server) accountBalance(body []byte) (interface{}, *Error) {
+	var req AccountBalanceRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.AccountIdentifier.Address)
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	height, _err := s.height(req.BlockIdentifier)
+	if _err != nil {
+		return nil, _err
+	}
+
+	identifier, _err := s.blockIdentifier(height)
+	if _err != nil {
+		return nil, _err
+	}
+
+	ctx := s.ctx.WithHeight(height)
+
+	var coins sdk.Coins
+	switch {
+	case req.AccountIdentifier.SubAccount == nil:
+		account, err := auth.NewAccountRetriever(ctx).GetAccount(address)
+		if err != nil && !isNotFound(err) {
+			return nil, ErrNode.Wrap(err)
+		}
+		if account != nil {
+			coins = account.GetCoins()
+		}
+	case req.AccountIdentifier.SubAccount.Address == SubAccountDeposit:
+		bytes, err := ctx.Codec.MarshalJSON(deposit.NewQueryDepositOfAddressParams(address))
+		if err != nil {
+			return nil, ErrInvalidRequest.Wrap(err)
+		}
+
+		path := fmt.Sprintf("custom/%s/%s", deposit.QuerierRoute, deposit.QueryDepositOfAddress)
+		res, _, err := ctx.QueryWithData(path, bytes)
+		if err != nil {
+			return nil, ErrNode.Wrap(err)
+		}
+		if res != nil {
+			var _deposit deposit.Deposit
+			if err := ctx.Codec.UnmarshalJSON(res, &_deposit); err != nil {
+				return nil, ErrNode.Wrap(err)
+			}
+
+			coins = _deposit.Coins
+		}
+	default:
+		return nil, ErrUnsupported
+	}
+
+	balances := make([]Amount, 0, len(coins))
+	for _, coin := range coins {
+		balances = append(balances, *newAmount(coin, false))
+	}
+
+	return AccountBalanceResponse{
+		BlockIdentifier: *identifier,
+		Balances:        balances,
+	}, nil
+}
+
+func (s *Server) block(body []byte) (interface{}, *Error) {
+	var req BlockRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	height, _err := s.height(&req.BlockIdentifier)
+	if _err != nil {
+		return nil, _err
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	block, err := node.Block(&height)
+	if err != nil {
+		return nil, ErrNotFound.Wrap(err)
+	}
+
+	results, err := node.BlockResults(&height)
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	hash := block.BlockMeta.BlockID.Hash.String()
+	if req.BlockIdentifier.Hash != nil && !strings.EqualFold(*req.BlockIdentifier.Hash, hash) {
+		return nil, ErrNotFound
+	}
+
+	var transactions []Transaction
+	if results.Results.BeginBlock != nil {
+		transaction, _err := s.eventsTransaction(hash+suffixBeginBlock, results.Results.BeginBlock.Events)
+		if _err != nil {
+			return nil, _err
+		}
+
+		transactions = append(transactions, *transaction)
+	}
+
+	for i, tx := range block.Block.Data.Txs {
+		transaction, _err := s.transaction(tx, *results.Results.DeliverTx[i])
+		if _err != nil {
+			return nil, _err
+		}
+
+		transactions = append(transactions, *transaction)
+	}
+
+	if results.Results.EndBlock != nil {
+		transaction, _err := s.eventsTransaction(hash+suffixEndBlock, results.Results.EndBlock.Events)
+		if _err != nil {
+			return nil, _err
+		}
+
+		transactions = append(transactions, *transaction)
+	}
+
+	parent := BlockIdentifier{
+		Index: height - 1,
+		Hash:  block.Block.Header.LastBlockID.Hash.String(),
+	}
+	if height == 1 {
+		parent = BlockIdentifier{Index: height, Hash: hash}
+	}
+
+	return BlockResponse{
+		Block: Block{
+			BlockIdentifier:       BlockIdentifier{Index: height, Hash: hash},
+			ParentBlockIdentifier: parent,
+			Timestamp:             block.Block.Header.Time.UnixNano() / 1e6,
+			Transactions:          transactions,
+		},
+	}, nil
+}
+
+func (s *Server) blockTransaction(body []byte) (interface{}, *Error) {
+	var req BlockTransactionRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	hash := req.TransactionIdentifier.Hash
+	if strings.HasSuffix(hash, suffixBeginBlock) || strings.HasSuffix(hash, suffixEndBlock) {
+		res, _err := s.block(body)
+		if _err != nil {
+			return nil, _err
+		}
+
+		for _, transaction := range res.(BlockResponse).Block.Transactions {
+			if transaction.TransactionIdentifier.Hash == hash {
+				return BlockTransactionResponse{Transaction: transaction}, nil
+			}
+		}
+
+		return nil, ErrNotFound
+	}
+
+	bytes, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, ErrInvalidRequest.Wrap(err)
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	res, err := node.Tx(bytes, false)
+	if err != nil {
+		return nil, ErrNotFound.Wrap(err)
+	}
+	if res.Height != req.BlockIdentifier.Index {
+		return nil, ErrNotFound
+	}
+
+	transaction, _err := s.transaction(res.Tx, res.TxResult)
+	if _err != nil {
+		return nil, _err
+	}
+
+	return BlockTransactionResponse{Transaction: *transaction}, nil
+}
+
+func (s *Server) mempool(body []byte) (interface{}, *Error) {
+	var req NetworkRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	res, err := node.UnconfirmedTxs(100)
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	identifiers := make([]TransactionIdentifier, 0, len(res.Txs))
+	for _, tx := range res.Txs {
+		identifiers = append(identifiers, TransactionIdentifier{Hash: fmt.Sprintf("%X", tx.Hash())})
+	}
+
+	return MempoolResponse{TransactionIdentifiers: identifiers}, nil
+}
+
+func (s *Server) mempoolTransaction(body []byte) (interface{}, *Error) {
+	var req MempoolTransactionRequest
+	if err := s.decode(body, &req, &req.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	res, err := node.UnconfirmedTxs(100)
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	for _, tx := range res.Txs {
+		if !strings.EqualFold(fmt.Sprintf("%X", tx.Hash()), req.TransactionIdentifier.Hash) {
+			continue
+		}
+
+		stdTx, _err := s.decodeTx(tx)
+		if _err != nil {
+			return nil, _err
+		}
+
+		operations := FeeOperations(0, stdTx)
+		for _, msg := range stdTx.Msgs {
+			if msg, ok := msg.(bank.MsgSend); ok {
+				operations = append(operations, MsgSendOperations(int64(len(operations)), msg)...)
+			}
+		}
+
+		return MempoolTransactionResponse{
+			Transaction: Transaction{
+				TransactionIdentifier: req.TransactionIdentifier,
+				Operations:            operations,
+			},
+		}, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// height returns the height of the partial block identifier, or the latest height when it
+// is nil or empty. The blocks can't be looked up by their hashes alone.
+func (s *Server) height(identifier *PartialBlockIdentifier) (int64, *Error) {
+	if identifier != nil && identifier.Index != nil {
+		return *identifier.Index, nil
+	}
+	if identifier != nil && identifier.Hash != nil {
+		return 0, ErrUnsupported
+	}
+
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return 0, ErrNode.Wrap(err)
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return 0, ErrNode.Wrap(err)
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (s *Server) blockIdentifier(height int64) (*BlockIdentifier, *Error) {
+	node, err := s.ctx.GetNode()
+	if err != nil {
+		return nil, ErrNode.Wrap(err)
+	}
+
+	block, err := node.Block(&height)
+	if err != nil {
+		return nil, ErrNotFound.Wrap(err)
+	}
+
+	return &BlockIdentifier{
+		Index: height,
+		Hash:  block.BlockMeta.BlockID.Hash.String(),
+	}, nil
+}
+
+func (s *Server) decodeTx(tx tm.Tx) (auth.StdTx, *Error) {
+	_tx, err := auth.DefaultTxDecoder(s.ctx.Codec)(tx)
+	if err != nil {
+		return auth.StdTx{}, ErrInvalidTransaction.Wrap(err)
+	}
+
+	stdTx, ok := _tx.(auth.StdTx)
+	if !ok {
+		return auth.StdTx{}, ErrInvalidTransaction
+	}
+
+	return stdTx, nil
+}
+
+// transaction returns the fee of the transaction along with the transfers of its events, the
+// events of a failed transaction are empty as its changes are reverted.
+func (s *Server) transaction(tx tm.Tx, result abci.ResponseDeliverTx) (*Transaction, *Error) {
+	stdTx, _err := s.decodeTx(tx)
+	if _err != nil {
+		return nil, _err
+	}
+
+	operations := FeeOperations(0, stdTx)
+
+	status := StatusSuccess
+	if !result.IsOK() {
+		status = StatusFailure
+	}
+
+	_operations, err := EventOperations(int64(len(operations)), status, result.Events)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return &Transaction{
+		TransactionIdentifier: TransactionIdentifier{Hash: fmt.Sprintf("%X", tx.Hash())},
+		Operations:            append(operations, _operations...),
+	}, nil
+}
+
+func (s *Server) eventsTransaction(hash string, events []abci.Event) (*Transaction, *Error) {
+	operations, err := EventOperations(0, StatusSuccess, events)
+	if err != nil {
+		return nil, ErrInvalidTransaction.Wrap(err)
+	}
+
+	return &Transaction{
+		TransactionIdentifier: TransactionIdentifier{Hash: hash},
+		Operations:            operations,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package rosetta
+
+type Error struct {
+	Code      int32                  `json:"code"`
+	Message   string                 `json:"message"`
+	Retriable bool                   `json:"retriable"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+var (
+	ErrInvalidNetwork     = &Error{Code: 1, Message: "invalid network identifier"}
+	ErrInvalidRequest     = &Error{Code: 2, Message: "invalid request"}
+	ErrNode               = &Error{Code: 3, Message: "unable to query the node", Retriable: true}
+	ErrNotFound           = &Error{Code: 4, Message: "not found"}
+	ErrUnsupported        = &Error{Code: 5, Message: "unsupported"}
+	ErrInvalidTransaction = &Error{Code: 6, Message: "invalid transaction"}
+	ErrInvalidOperations  = &Error{Code: 7, Message: "invalid operations"}
+	ErrBroadcast          = &Error{Code: 8, Message: "unable to broadcast the transaction"}
+
+	Errors = []*Error{
+		ErrInvalidNetwork, ErrInvalidRequest, ErrNode, ErrNotFound, ErrUnsupported,
+		ErrInvalidTransaction, ErrInvalidOperations, ErrBroadcast,
+	}
+)
+
+// Wrap returns a copy of the error with the message of the cause in its details.
+func (e *Error) Wrap(err error) *Error {
+	_e := *e
+	_e.Details = map[string]interface{}{
+		"error": err.Error(),
+	}
+
+	return &_e
+}
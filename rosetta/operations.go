@@ -0,0 +1,230 @@
+package rosetta
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	vpn "github.com/sentinel-official/hub/x/vpn/types"
+)
+
+var (
+	// OperationTypes are the types of the operations of the transactions, the balance changes of a
+	// message are of the type of the message, those of the begin and the end blocks are transfers.
+	OperationTypes = []string{
+		OperationFee,
+		OperationTransfer,
+		bank.MsgSend{}.Type(),
+		bank.MsgMultiSend{}.Type(),
+		staking.MsgCreateValidator{}.Type(),
+		staking.MsgDelegate{}.Type(),
+		staking.MsgUndelegate{}.Type(),
+		staking.MsgBeginRedelegate{}.Type(),
+		distribution.MsgWithdrawDelegatorReward{}.Type(),
+		distribution.MsgWithdrawValidatorCommission{}.Type(),
+		gov.MsgSubmitProposal{}.Type(),
+		gov.MsgDeposit{}.Type(),
+		vpn.MsgRegisterNode{}.Type(),
+		vpn.MsgDeregisterNode{}.Type(),
+		vpn.MsgWithdrawNodeDeposit{}.Type(),
+		vpn.MsgStartSubscription{}.Type(),
+		vpn.MsgEndSubscription{}.Type(),
+		vpn.MsgTopUpSubscription{}.Type(),
+		vpn.MsgUpdateSessionInfo{}.Type(),
+		vpn.MsgUpdateSessionsInfo{}.Type(),
+	}
+)
+
+func operationType(action string) string {
+	for _, t := range OperationTypes {
+		if t == action {
+			return t
+		}
+	}
+
+	return OperationTransfer
+}
+
+func newAmount(coin sdk.Coin, negative bool) *Amount {
+	value := coin.Amount
+	if negative {
+		value = value.Neg()
+	}
+
+	return &Amount{
+		Value: value.String(),
+		Currency: Currency{
+			Symbol:   coin.Denom,
+			Decimals: 0,
+		},
+	}
+}
+
+func newOperation(index int64, _type, status string, address sdk.AccAddress, amount *Amount) Operation {
+	return Operation{
+		OperationIdentifier: OperationIdentifier{Index: index},
+		Type:                _type,
+		Status:              status,
+		Account:             &AccountIdentifier{Address: address.String()},
+		Amount:              amount,
+	}
+}
+
+// transferOperations returns a debit of the sender and a credit of the recipient for every coin,
+// the credits are related to their debits.
+func transferOperations(index int64, _type, status string, from, to sdk.AccAddress, coins sdk.Coins) []Operation {
+	var operations []Operation
+	for _, coin := range coins {
+		debit := newOperation(index, _type, status, from, newAmount(coin, true))
+		credit := newOperation(index+1, _type, status, to, newAmount(coin, false))
+		credit.RelatedOperations = []OperationIdentifier{debit.OperationIdentifier}
+
+		operations = append(operations, debit, credit)
+		index += 2
+	}
+
+	return operations
+}
+
+// FeeOperations returns the debits of the fee from the fee payer of the transaction.
+func FeeOperations(index int64, tx auth.StdTx) []Operation {
+	var operations []Operation
+	for _, coin := range tx.Fee.Amount {
+		operations = append(operations, newOperation(index, OperationFee, StatusSuccess,
+			tx.GetSigners()[0], newAmount(coin, true)))
+		index++
+	}
+
+	return operations
+}
+
+// EventOperations returns the balance changes of the transfer events of a transaction or a block.
+// The bank module emits a transfer event with the recipient and the amount followed by a message
+// event with the sender, the changes are of the type of the action of the last message event.
+func EventOperations(index int64, status string, events []abci.Event) ([]Operation, error) {
+	var (
+		operations []Operation
+		_type      = OperationTransfer
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		pending    bool
+	)
+
+	for _, event := range events {
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		switch event.Type {
+		case bank.EventTypeTransfer:
+			var err error
+			if recipient, err = sdk.AccAddressFromBech32(attributes[bank.AttributeKeyRecipient]); err != nil {
+				return nil, err
+			}
+			if amount, err = sdk.ParseCoins(attributes[sdk.AttributeKeyAmount]); err != nil {
+				return nil, err
+			}
+
+			pending = true
+		case sdk.EventTypeMessage:
+			if action, ok := attributes[sdk.AttributeKeyAction]; ok {
+				_type = operationType(action)
+			}
+
+			sender, ok := attributes[bank.AttributeKeySender]
+			if !ok || !pending {
+				continue
+			}
+
+			from, err := sdk.AccAddressFromBech32(sender)
+			if err != nil {
+				return nil, err
+			}
+
+			_operations := transferOperations(index, _type, status, from, recipient, amount)
+			operations = append(operations, _operations...)
+			index += int64(len(_operations))
+			pending = false
+		}
+	}
+
+	return operations, nil
+}
+
+// MsgSendOperations returns the operations of the send message of a transaction constructed by
+// the construction API.
+func MsgSendOperations(index int64, msg bank.MsgSend) []Operation {
+	return transferOperations(index, msg.Type(), "", msg.FromAddress, msg.ToAddress, msg.Amount)
+}
+
+// MsgSendFromOperations builds the send message of the debits and the credits of the operations,
+// all the debits must be of a single sender and all the credits of a single recipient.
+func MsgSendFromOperations(operations []Operation) (msg bank.MsgSend, err error) {
+	var debits, credits sdk.Coins
+	for _, operation := range operations {
+		if operation.Type != msg.Type() && operation.Type != OperationTransfer {
+			return msg, fmt.Errorf("invalid operation type %s", operation.Type)
+		}
+		if operation.Account == nil || operation.Amount == nil {
+			return msg, fmt.Errorf("operation %d has no account or amount", operation.OperationIdentifier.Index)
+		}
+
+		address, err := sdk.AccAddressFromBech32(operation.Account.Address)
+		if err != nil {
+			return msg, err
+		}
+
+		amount, ok := sdk.NewIntFromString(operation.Amount.Value)
+		if !ok || amount.IsZero() {
+			return msg, fmt.Errorf("invalid amount %s", operation.Amount.Value)
+		}
+
+		negative := amount.IsNegative()
+		if negative {
+			amount = amount.Neg()
+		}
+
+		coin, err := sdk.ParseCoin(amount.String() + operation.Amount.Currency.Symbol)
+		if err != nil {
+			return msg, err
+		}
+
+		if negative {
+			if msg.FromAddress != nil && !msg.FromAddress.Equals(address) {
+				return msg, fmt.Errorf("more than one sender")
+			}
+
+			msg.FromAddress = address
+			debits = debits.Add(sdk.Coins{coin})
+		} else {
+			if msg.ToAddress != nil && !msg.ToAddress.Equals(address) {
+				return msg, fmt.Errorf("more than one recipient")
+			}
+
+			msg.ToAddress = address
+			credits = credits.Add(sdk.Coins{coin})
+		}
+	}
+
+	if msg.FromAddress == nil || msg.ToAddress == nil {
+		return msg, fmt.Errorf("no sender or recipient")
+	}
+	if diff, negative := debits.SafeSub(credits); negative || !diff.IsZero() {
+		return msg, fmt.Errorf("debits %s are not equal to the credits %s", debits, credits)
+	}
+
+	msg.Amount = credits
+	return msg, msg.ValidateBasic()
+}
+
+func isNotFound(err error) bool {
+	return strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found")
+}
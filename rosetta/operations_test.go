@@ -0,0 +1,91 @@
+package rosetta
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+var (
+	testFrom = sdk.AccAddress([]byte("from_address________"))
+	testTo   = sdk.AccAddress([]byte("to_address__________"))
+)
+
+func testEvent(_type string, attributes ...string) abci.Event {
+	event := abci.Event{Type: _type}
+	for i := 0; i < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, cmn.KVPair{
+			Key:   []byte(attributes[i]),
+			Value: []byte(attributes[i+1]),
+		})
+	}
+
+	return event
+}
+
+func TestEventOperations(t *testing.T) {
+	events := []abci.Event{
+		testEvent(sdk.EventTypeMessage, sdk.AttributeKeyAction, "start_subscription"),
+		testEvent(bank.EventTypeTransfer, bank.AttributeKeyRecipient, testTo.String(), sdk.AttributeKeyAmount, "10stake"),
+		testEvent(sdk.EventTypeMessage, bank.AttributeKeySender, testFrom.String()),
+		testEvent(sdk.EventTypeMessage, sdk.AttributeKeyAction, "unknown"),
+		testEvent(bank.EventTypeTransfer, bank.AttributeKeyRecipient, testFrom.String(), sdk.AttributeKeyAmount, "5stake,1tsent"),
+		testEvent(sdk.EventTypeMessage, bank.AttributeKeySender, testTo.String()),
+	}
+
+	operations, err := EventOperations(2, StatusSuccess, events)
+	require.Nil(t, err)
+	require.Len(t, operations, 6)
+
+	require.Equal(t, int64(2), operations[0].OperationIdentifier.Index)
+	require.Equal(t, "start_subscription", operations[0].Type)
+	require.Equal(t, testFrom.String(), operations[0].Account.Address)
+	require.Equal(t, "-10", operations[0].Amount.Value)
+	require.Equal(t, testTo.String(), operations[1].Account.Address)
+	require.Equal(t, "10", operations[1].Amount.Value)
+	require.Equal(t, []OperationIdentifier{{Index: 2}}, operations[1].RelatedOperations)
+
+	require.Equal(t, OperationTransfer, operations[2].Type)
+	require.Equal(t, "tsent", operations[4].Amount.Currency.Symbol)
+	require.Equal(t, "-1", operations[4].Amount.Value)
+	require.Equal(t, int64(7), operations[5].OperationIdentifier.Index)
+
+	_, err = EventOperations(0, StatusSuccess, []abci.Event{
+		testEvent(bank.EventTypeTransfer, bank.AttributeKeyRecipient, "invalid", sdk.AttributeKeyAmount, "10stake"),
+	})
+	require.NotNil(t, err)
+}
+
+func TestMsgSendFromOperations(t *testing.T) {
+	msg := bank.MsgSend{
+		FromAddress: testFrom,
+		ToAddress:   testTo,
+		Amount:      sdk.Coins{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("tsent", 1)},
+	}
+
+	_msg, err := MsgSendFromOperations(MsgSendOperations(0, msg))
+	require.Nil(t, err)
+	require.Equal(t, msg, _msg)
+
+	operations := MsgSendOperations(0, msg)
+	operations[1].Amount.Value = "11"
+	_, err = MsgSendFromOperations(operations)
+	require.NotNil(t, err)
+
+	operations = MsgSendOperations(0, msg)
+	operations[2].Account.Address = testTo.String()
+	_, err = MsgSendFromOperations(operations)
+	require.NotNil(t, err)
+
+	operations = MsgSendOperations(0, msg)
+	operations[0].Type = OperationFee
+	_, err = MsgSendFromOperations(operations)
+	require.NotNil(t, err)
+
+	_, err = MsgSendFromOperations(nil)
+	require.NotNil(t, err)
+}
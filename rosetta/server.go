@@ -0,0 +1,93 @@
+package rosetta
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+)
+
+// Server serves the Rosetta Data and Construction APIs of the hub from the node of the context.
+type Server struct {
+	ctx     context.CLIContext
+	chainID string
+	router  *mux.Router
+}
+
+func NewServer(ctx context.CLIContext) *Server {
+	s := &Server{
+		ctx:     ctx,
+		chainID: viper.GetString(client.FlagChainID),
+		router:  mux.NewRouter(),
+	}
+
+	s.route("/network/list", s.networkList)
+	s.route("/network/status", s.networkStatus)
+	s.route("/network/options", s.networkOptions)
+	s.route("/account/balance", s.accountBalance)
+	s.route("/block", s.block)
+	s.route("/block/transaction", s.blockTransaction)
+	s.route("/mempool", s.mempool)
+	s.route("/mempool/transaction", s.mempoolTransaction)
+
+	s.route("/construction/derive", s.constructionDerive)
+	s.route("/construction/preprocess", s.constructionPreprocess)
+	s.route("/construction/metadata", s.constructionMetadata)
+	s.route("/construction/payloads", s.constructionPayloads)
+	s.route("/construction/combine", s.constructionCombine)
+	s.route("/construction/parse", s.constructionParse)
+	s.route("/construction/hash", s.constructionHash)
+	s.route("/construction/submit", s.constructionSubmit)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.router.ServeHTTP(w, r)
+}
+
+func (s *Server) route(path string, handler func(body []byte) (interface{}, *Error)) {
+	s.router.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(ErrInvalidRequest.Wrap(err))
+			return
+		}
+
+		res, _err := handler(body)
+		if _err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(_err)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}).Methods("POST")
+}
+
+// decode unmarshals the body into the request and checks the network identifier of it.
+func (s *Server) decode(body []byte, req interface{}, network *NetworkIdentifier) *Error {
+	if err := json.Unmarshal(body, req); err != nil {
+		return ErrInvalidRequest.Wrap(err)
+	}
+
+	if *network != s.network() {
+		return ErrInvalidNetwork
+	}
+
+	return nil
+}
+
+func (s *Server) network() NetworkIdentifier {
+	return NetworkIdentifier{
+		Blockchain: Blockchain,
+		Network:    s.chainID,
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tm "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	flagDBBackend = "db_backend"
+
+	appDBName = "application"
+)
+
+// ValidateDBBackend returns an error if the state of the app can not be stored in the backend. The database
+// of the app is always created as a goleveldb database first, so only the backends which read the same
+// directory layout are allowed. The cleveldb and rocksdb backends need the gcc and rocksdb build tags.
+func ValidateDBBackend(backend string) error {
+	switch dbm.DBBackendType(backend) {
+	case "", dbm.GoLevelDBBackend, dbm.CLevelDBBackend, dbm.RocksDBBackend:
+		return nil
+	default:
+		return fmt.Errorf("invalid db backend %s", backend)
+	}
+}
+
+// reopenDB closes the goleveldb database opened by the start and export commands and opens it again
+// with the db_backend of the config.
+func reopenDB(ctx *server.Context, db dbm.DB) dbm.DB {
+	backend := ctx.Config.DBBackend
+	if err := ValidateDBBackend(backend); err != nil {
+		panic(err)
+	}
+
+	if backend == "" || dbm.DBBackendType(backend) == dbm.GoLevelDBBackend {
+		return db
+	}
+
+	db.Close()
+	return dbm.NewDB(appDBName, dbm.DBBackendType(backend), ctx.Config.DBDir())
+}
+
+func withDBBackend(ctx *server.Context, creator server.AppCreator) server.AppCreator {
+	return func(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
+		return creator(logger, reopenDB(ctx, db), traceStore)
+	}
+}
+
+func withDBBackendExporter(ctx *server.Context, export server.AppExporter) server.AppExporter {
+	return func(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool,
+		jailWhiteList []string) (json.RawMessage, []tm.GenesisValidator, error) {
+		return export(logger, reopenDB(ctx, db), traceStore, height, forZeroHeight, jailWhiteList)
+	}
+}
@@ -0,0 +1,18 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestValidateDBBackend(t *testing.T) {
+	require.Nil(t, ValidateDBBackend(""))
+	require.Nil(t, ValidateDBBackend(string(dbm.GoLevelDBBackend)))
+	require.Nil(t, ValidateDBBackend(string(dbm.CLevelDBBackend)))
+	require.Nil(t, ValidateDBBackend(string(dbm.RocksDBBackend)))
+	require.NotNil(t, ValidateDBBackend(string(dbm.BoltDBBackend)))
+	require.NotNil(t, ValidateDBBackend(string(dbm.MemDBBackend)))
+	require.NotNil(t, ValidateDBBackend("badgerdb"))
+}
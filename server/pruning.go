@@ -0,0 +1,42 @@
+package server
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	FlagPruning           = "pruning"
+	FlagPruningKeepRecent = "pruning-keep-recent"
+	FlagPruningKeepEvery  = "pruning-keep-every"
+
+	PruningNothing    = "nothing"
+	PruningEverything = "everything"
+	PruningSyncable   = "syncable"
+	PruningCustom     = "custom"
+)
+
+// NewPruningOptions returns the pruning options of the strategy. The custom strategy keeps the
+// recent states along with every state at the interval of heights, the others ignore them.
+func NewPruningOptions(strategy string, keepRecent, keepEvery int64) (storetypes.PruningOptions, error) {
+	switch strategy {
+	case PruningNothing:
+		return storetypes.PruneNothing, nil
+	case PruningEverything:
+		return storetypes.PruneEverything, nil
+	case PruningSyncable:
+		return storetypes.PruneSyncable, nil
+	case PruningCustom:
+		if keepRecent < 0 {
+			return storetypes.PruningOptions{}, fmt.Errorf("invalid %s %d", FlagPruningKeepRecent, keepRecent)
+		}
+		if keepEvery < 0 {
+			return storetypes.PruningOptions{}, fmt.Errorf("invalid %s %d", FlagPruningKeepEvery, keepEvery)
+		}
+
+		return storetypes.NewPruningOptions(keepRecent, keepEvery), nil
+	default:
+		return storetypes.PruningOptions{}, fmt.Errorf("invalid pruning strategy %s", strategy)
+	}
+}
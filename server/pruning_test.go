@@ -0,0 +1,31 @@
+package server
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPruningOptions(t *testing.T) {
+	options, err := NewPruningOptions(PruningNothing, 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, storetypes.PruneNothing, options)
+
+	options, err = NewPruningOptions(PruningSyncable, 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, storetypes.PruneSyncable, options)
+
+	options, err = NewPruningOptions(PruningCustom, 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, storetypes.NewPruningOptions(5, 10), options)
+
+	_, err = NewPruningOptions(PruningCustom, -1, 10)
+	require.NotNil(t, err)
+
+	_, err = NewPruningOptions(PruningCustom, 5, -1)
+	require.NotNil(t, err)
+
+	_, err = NewPruningOptions("archive", 5, 10)
+	require.NotNil(t, err)
+}
@@ -1,10 +1,13 @@
 package server
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/sentinel-official/hub/version"
 )
@@ -25,12 +28,22 @@ func AddCommands(ctx *server.Context, cdc *codec.Codec, root *cobra.Command,
 		server.VersionCmd(ctx),
 	)
 
+	startCmd := server.StartCmd(ctx, withDBBackend(ctx, creator))
+	startCmd.Flags().Lookup(FlagPruning).Usage = fmt.Sprintf("Pruning strategy: %s, %s, %s or %s",
+		PruningSyncable, PruningNothing, PruningEverything, PruningCustom)
+	startCmd.Flags().Int64(FlagPruningKeepRecent, 100,
+		"Number of the recent states to keep with the custom pruning strategy")
+	startCmd.Flags().Int64(FlagPruningKeepEvery, 10000,
+		"Interval of the heights of the states to keep with the custom pruning strategy, 0 keeps none")
+	startCmd.Flags().Lookup(flagDBBackend).Usage = fmt.Sprintf("Database backend of the node and the app: %s, %s or %s",
+		dbm.GoLevelDBBackend, dbm.CLevelDBBackend, dbm.RocksDBBackend)
+
 	root.AddCommand(
-		server.StartCmd(ctx, creator),
+		startCmd,
 		server.UnsafeResetAllCmd(ctx),
 		client.LineBreak,
 		cmd,
-		server.ExportCmd(ctx, cdc, export),
+		server.ExportCmd(ctx, cdc, withDBBackendExporter(ctx, export)),
 		client.LineBreak,
 		version.Cmd,
 	)
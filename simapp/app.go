@@ -220,7 +220,8 @@ func NewSimApp(logger log.Logger, db db.DB,
 		keys[vpn.StoreKeySubscription],
 		keys[vpn.StoreKeySession],
 		app.paramsKeeper.Subspace(vpn.DefaultParamspace),
-		app.depositKeeper)
+		app.depositKeeper,
+		app.distributionKeeper)
 
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
@@ -255,7 +256,9 @@ func NewSimApp(logger log.Logger, db db.DB,
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+		vpn.NewAnteHandler(
+			auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer),
+			app.vpnKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
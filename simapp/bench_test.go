@@ -0,0 +1,151 @@
+package simapp
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn"
+)
+
+var (
+	benchNodes    int
+	benchSessions int
+)
+
+func init() {
+	flag.IntVar(&benchNodes, "BenchNodes", 100, "number of nodes seeded by the keeper benchmarks")
+	flag.IntVar(&benchSessions, "BenchSessions", 1000, "number of active sessions seeded by the keeper benchmarks")
+}
+
+// setupBenchmark returns a SimApp at the height 1 with the benchNodes registered nodes and the benchSessions
+// active sessions spread over them, each session on a subscription of its own client.
+func setupBenchmark(b *testing.B) (*SimApp, sdk.Context) {
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+
+	state, err := codec.MarshalJSONIndent(app.cdc, ModuleBasics.DefaultGenesis())
+	require.Nil(b, err)
+
+	app.InitChain(abci.RequestInitChain{ChainId: "bench", AppStateBytes: state})
+	ctx := app.NewContext(false, abci.Header{ChainID: "bench", Height: 1})
+
+	handler := vpn.NewHandler(app.vpnKeeper)
+	deposit := app.vpnKeeper.Deposit(ctx)
+	coins := sdk.Coins{sdk.NewInt64Coin(deposit.Denom, 1e12)}
+
+	owners := make([]ed25519.PrivKeyEd25519, benchNodes)
+	for i := range owners {
+		owners[i] = ed25519.GenPrivKey()
+
+		address := sdk.AccAddress(owners[i].PubKey().Address())
+		require.Nil(b, app.bankKeeper.SetCoins(ctx, address, coins))
+
+		msg := vpn.NewMsgRegisterNode(address, vpn.NodeCategoryWireGuard, "0.1.0", fmt.Sprintf("node-%d", i),
+			sdk.Coins{sdk.NewInt64Coin(deposit.Denom, 1000)}, hub.NewBandwidthFromInt64(1e8, 1e8),
+			"AES-256-CBC", "", "")
+		require.True(b, handler(ctx, *msg).IsOK())
+	}
+
+	bandwidth := hub.NewBandwidthFromInt64(1e6, 1e6)
+	for i := 0; i < benchSessions; i++ {
+		client := ed25519.GenPrivKey()
+
+		address := sdk.AccAddress(client.PubKey().Address())
+		require.Nil(b, app.bankKeeper.SetCoins(ctx, address, coins))
+
+		id := hub.NewSubscriptionID(app.vpnKeeper.GetSubscriptionsCount(ctx))
+		start := vpn.NewMsgStartSubscription(address, hub.NewNodeID(uint64(i%benchNodes)),
+			sdk.NewInt64Coin(deposit.Denom, 1000), 0, nil, nil)
+		require.True(b, handler(ctx, *start).IsOK())
+
+		data := vpn.BandwidthSignBytes(id, 0, bandwidth)
+		update := vpn.NewMsgUpdateSessionInfo(address, id, bandwidth,
+			benchSignature(owners[i%benchNodes], data), benchSignature(client, data))
+		require.True(b, handler(ctx, *update).IsOK())
+	}
+
+	return app, ctx
+}
+
+func benchSignature(key ed25519.PrivKeyEd25519, data []byte) auth.StdSignature {
+	signature, _ := key.Sign(data)
+
+	return auth.StdSignature{
+		PubKey:    key.PubKey(),
+		Signature: signature,
+	}
+}
+
+func BenchmarkSetSession(b *testing.B) {
+	app, ctx := setupBenchmark(b)
+	sessions := app.vpnKeeper.GetAllSessions(ctx)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		session := sessions[i%len(sessions)]
+		session.Bandwidth = session.Bandwidth.Add(hub.NewBandwidthFromInt64(1, 1))
+		app.vpnKeeper.SetSession(ctx, session)
+	}
+}
+
+func BenchmarkIterateNodes(b *testing.B) {
+	app, ctx := setupBenchmark(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		count := 0
+		app.vpnKeeper.IterateNodes(ctx, func(_ int64, _ vpn.Node) bool {
+			count++
+			return false
+		})
+
+		if count != benchNodes {
+			b.Fatalf("iterated %d nodes, expected %d", count, benchNodes)
+		}
+	}
+}
+
+// BenchmarkSettlement settles every active session by the stream settlement of the end blocker.
+func BenchmarkSettlement(b *testing.B) {
+	app, ctx := setupBenchmark(b)
+
+	params := app.vpnKeeper.GetParams(ctx)
+	params.SettlementInterval = 1
+	app.vpnKeeper.SetParams(ctx, params)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager()).CacheContext()
+		vpn.EndBlock(cacheCtx, app.vpnKeeper)
+	}
+}
+
+// BenchmarkEndBlock ends every active session by the timeout of the end blocker.
+func BenchmarkEndBlock(b *testing.B) {
+	app, ctx := setupBenchmark(b)
+	height := 1 + app.vpnKeeper.SessionInactiveInterval(ctx)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager()).CacheContext()
+		vpn.EndBlock(cacheCtx, app.vpnKeeper)
+	}
+}
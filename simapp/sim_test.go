@@ -47,6 +47,7 @@ func init() {
 	flag.BoolVar(&onOperation, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&allInvariants, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
 	flag.Int64Var(&genesisTime, "GenesisTime", 0, "override genesis UNIX time instead of using a random UNIX time")
+	flag.StringVar(&dbBackend, "DBBackend", string(dbm.GoLevelDBBackend), "database backend of the simulation: goleveldb, cleveldb or rocksdb")
 }
 
 func getSimulateFromSeedInput(tb testing.TB, w io.Writer, app *SimApp) (
@@ -257,7 +258,7 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 
 	var db dbm.DB
 	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
-	db, _ = sdk.NewLevelDB("Simulation", dir)
+	db = dbm.NewDB("Simulation", dbm.DBBackendType(dbBackend), dir)
 	defer func() {
 		db.Close()
 		os.RemoveAll(dir)
@@ -326,7 +327,7 @@ func TestFullAppSimulation(t *testing.T) {
 
 	var db dbm.DB
 	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
-	db, _ = sdk.NewLevelDB("Simulation", dir)
+	db = dbm.NewDB("Simulation", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		db.Close()
@@ -380,7 +381,7 @@ func TestAppImportExport(t *testing.T) {
 
 	var db dbm.DB
 	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
-	db, _ = sdk.NewLevelDB("Simulation", dir)
+	db = dbm.NewDB("Simulation", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		db.Close()
@@ -425,7 +426,7 @@ func TestAppImportExport(t *testing.T) {
 	fmt.Printf("Importing genesis...\n")
 
 	newDir, _ := ioutil.TempDir("", "goleveldb-app-sim-2")
-	newDB, _ := sdk.NewLevelDB("Simulation-2", dir)
+	newDB := dbm.NewDB("Simulation-2", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		newDB.Close()
@@ -489,7 +490,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	}
 
 	dir, _ := ioutil.TempDir("", "goleveldb-app-sim")
-	db, _ := sdk.NewLevelDB("Simulation", dir)
+	db := dbm.NewDB("Simulation", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		db.Close()
@@ -542,7 +543,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	fmt.Printf("Importing genesis...\n")
 
 	newDir, _ := ioutil.TempDir("", "goleveldb-app-sim-2")
-	newDB, _ := sdk.NewLevelDB("Simulation-2", dir)
+	newDB := dbm.NewDB("Simulation-2", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		newDB.Close()
@@ -602,7 +603,7 @@ func TestAppStateDeterminism(t *testing.T) {
 func BenchmarkInvariants(b *testing.B) {
 	logger := log.NewNopLogger()
 	dir, _ := ioutil.TempDir("", "goleveldb-app-invariant-bench")
-	db, _ := sdk.NewLevelDB("simulation", dir)
+	db := dbm.NewDB("simulation", dbm.DBBackendType(dbBackend), dir)
 
 	defer func() {
 		db.Close()
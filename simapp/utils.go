@@ -56,6 +56,7 @@ var (
 	onOperation        bool
 	allInvariants      bool
 	genesisTime        int64
+	dbBackend          string
 )
 
 func NewSimAppUNSAFE(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
@@ -385,7 +386,7 @@ func GenVpnGenesisState(cdc *codec.Codec, r *rand.Rand, accs []simulation.Accoun
 		subscription.Client = simulation.RandomAcc(r, accs).Address
 		subscriptions = append(subscriptions, subscription)
 
-		session := vpnsim.GenerateRandomSession(r, subscriptions[i].ID)
+		session := vpnsim.GenerateRandomSession(r, subscriptions[i])
 		sessions = append(sessions, session)
 	}
 
@@ -415,6 +416,143 @@ func GenVpnGenesisState(cdc *codec.Codec, r *rand.Rand, accs []simulation.Accoun
 					})
 				return v
 			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SessionPruningRetention, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 10, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) uint64 {
+				var v uint64
+				ap.GetOrGenerate(cdc, vpnsim.PruneGasRefund, &v, r,
+					func(r *rand.Rand) {
+						v = uint64(simulation.RandIntBetween(r, 0, 1e4))
+					})
+				return v
+			}(r),
+			vpn.DefaultMinClientVersion,
+			vpn.DefaultMaintenanceBanners,
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SettlementInterval, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SettlementEpoch, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, vpnsim.ProtocolFeeRate, &v, r,
+					func(r *rand.Rand) {
+						v = sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 10)), 2)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) uint64 {
+				var v uint64
+				ap.GetOrGenerate(cdc, vpnsim.FreeUpdatesPerBlock, &v, r,
+					func(r *rand.Rand) {
+						v = uint64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SubscriptionGCEpoch, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SubscriptionGCRetention, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.SettlementGracePeriod, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeStatsEpoch, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeStatsRetention, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeInactiveInterval, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeJailCooldown, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 1000))
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) []vpn.FeeDenom {
+				var v []vpn.FeeDenom
+				ap.GetOrGenerate(cdc, vpnsim.FeeDenoms, &v, r,
+					func(r *rand.Rand) {
+						v = nil
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, vpnsim.ReferralRate, &v, r,
+					func(r *rand.Rand) {
+						v = sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 10)), 2)
+					})
+				return v
+			}(r),
+			vpn.DefaultMinNodeVersion,
+			func(r *rand.Rand) int64 {
+				var v int64
+				ap.GetOrGenerate(cdc, vpnsim.NodeUnbondingPeriod, &v, r,
+					func(r *rand.Rand) {
+						v = int64(simulation.RandIntBetween(r, 0, 100))
+					})
+				return v
+			}(r),
+			vpn.DefaultNodeRewardRate,
+			vpn.DefaultReputationEpoch,
+			vpn.DefaultDisputeWindow,
+			vpn.DefaultDisputeTimeout,
+			vpn.DefaultEscrowTimeout,
+			vpn.DefaultEscrowReleaseEpoch,
 		),
 		Nodes:         nodes,
 		Subscriptions: subscriptions,
@@ -0,0 +1,123 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Change is the change of a key of a store in a block, the value is empty for the deleted keys.
+type Change struct {
+	Store  string `json:"store"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Delete bool   `json:"delete"`
+}
+
+// Block is written as a line of JSON for every committed block, the changes are sorted by the
+// store and the key, and the keys and the values are hex encoded.
+type Block struct {
+	Height  int64    `json:"height"`
+	Changes []Change `json:"changes"`
+}
+
+type entry struct {
+	key      sdk.StoreKey
+	k        []byte
+	original []byte
+}
+
+// Service records the keys written by the delivered transactions and the blocks, and streams the
+// ones whose values have changed once the block is committed. The writes of the failed
+// transactions are recorded too, but they are dropped as their values are unchanged.
+type Service struct {
+	mtx     sync.Mutex
+	writer  io.Writer
+	entries map[string]*entry
+}
+
+func NewService(writer io.Writer) *Service {
+	return &Service{
+		writer:  writer,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Listen wraps the store of the key to record the writes to it, the stores of the check and
+// the simulation of the transactions and the queries are not wrapped.
+func (s *Service) Listen(ctx sdk.Context, key sdk.StoreKey, store sdk.KVStore) sdk.KVStore {
+	if ctx.IsCheckTx() {
+		return store
+	}
+
+	return &listenStore{
+		KVStore: store,
+		key:     key,
+		service: s,
+	}
+}
+
+func (s *Service) record(key sdk.StoreKey, store sdk.KVStore, k []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	id := key.Name() + "/" + string(k)
+	if _, found := s.entries[id]; found {
+		return
+	}
+
+	s.entries[id] = &entry{
+		key:      key,
+		k:        append([]byte{}, k...),
+		original: store.Get(k),
+	}
+}
+
+// Flush writes the changes of the block at the height, the context has to be on the
+// committed state of the height.
+func (s *Service) Flush(ctx sdk.Context, height int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	block := Block{
+		Height:  height,
+		Changes: []Change{},
+	}
+
+	for _, entry := range s.entries {
+		value := ctx.KVStore(entry.key).Get(entry.k)
+		if bytes.Equal(value, entry.original) {
+			continue
+		}
+
+		block.Changes = append(block.Changes, Change{
+			Store:  entry.key.Name(),
+			Key:    hex.EncodeToString(entry.k),
+			Value:  hex.EncodeToString(value),
+			Delete: value == nil,
+		})
+	}
+
+	s.entries = make(map[string]*entry)
+
+	sort.Slice(block.Changes, func(i, j int) bool {
+		if block.Changes[i].Store != block.Changes[j].Store {
+			return block.Changes[i].Store < block.Changes[j].Store
+		}
+
+		return block.Changes[i].Key < block.Changes[j].Key
+	})
+
+	bz, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.writer.Write(append(bz, '\n'))
+	return err
+}
@@ -0,0 +1,69 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestService(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ms := store.NewCommitMultiStore(db.NewMemDB())
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	var buf bytes.Buffer
+	s := NewService(&buf)
+
+	deliver := func(isCheckTx bool, fn func(store sdk.KVStore)) {
+		cms := ms.CacheMultiStore()
+		ctx := sdk.NewContext(cms, abci.Header{}, isCheckTx, log.NewNopLogger())
+		fn(s.Listen(ctx, key, ctx.KVStore(key)))
+		cms.Write()
+	}
+	flush := func(height int64) (block Block) {
+		ms.Commit()
+		ctx := sdk.NewContext(ms.CacheMultiStore(), abci.Header{}, true, log.NewNopLogger())
+		require.Nil(t, s.Flush(ctx, height))
+		require.Nil(t, json.Unmarshal(buf.Bytes(), &block))
+		buf.Reset()
+		return block
+	}
+
+	deliver(false, func(store sdk.KVStore) {
+		store.Set([]byte{0x01}, []byte{0x0A})
+		store.Set([]byte{0x02}, []byte{0x0B})
+		store.Set([]byte{0x03}, []byte{0x0C})
+		store.Delete([]byte{0x03})
+	})
+	deliver(true, func(store sdk.KVStore) {
+		store.Set([]byte{0x04}, []byte{0x0D})
+	})
+
+	block := flush(1)
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, []Change{
+		{Store: "test", Key: "01", Value: "0a"},
+		{Store: "test", Key: "02", Value: "0b"},
+	}, block.Changes)
+
+	deliver(false, func(store sdk.KVStore) {
+		store.Set([]byte{0x01}, []byte{0x0A})
+		store.Delete([]byte{0x02})
+	})
+
+	block = flush(2)
+	require.Equal(t, []Change{
+		{Store: "test", Key: "02", Delete: true},
+	}, block.Changes)
+
+	block = flush(3)
+	require.Equal(t, []Change{}, block.Changes)
+}
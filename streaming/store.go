@@ -0,0 +1,26 @@
+package streaming
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.KVStore = (*listenStore)(nil)
+)
+
+// listenStore records the keys of the writes to the store before applying them.
+type listenStore struct {
+	sdk.KVStore
+	key     sdk.StoreKey
+	service *Service
+}
+
+func (s *listenStore) Set(key, value []byte) {
+	s.service.record(s.key, s.KVStore, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *listenStore) Delete(key []byte) {
+	s.service.record(s.key, s.KVStore, key)
+	s.KVStore.Delete(key)
+}
@@ -0,0 +1,20 @@
+package streaming
+
+import (
+	"io"
+	"net"
+	"os"
+	"strings"
+)
+
+// NewWriter opens the file at the address to append to, or connects to the socket of the
+// address when it starts with unix:// or tcp://.
+func NewWriter(address string) (io.WriteCloser, error) {
+	for _, network := range []string{"unix", "tcp"} {
+		if strings.HasPrefix(address, network+"://") {
+			return net.Dial(network, strings.TrimPrefix(address, network+"://"))
+		}
+	}
+
+	return os.OpenFile(address, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
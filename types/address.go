@@ -1,5 +1,9 @@
 package types
 
+import (
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
 const (
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32MainPrefix = "sent"
@@ -25,4 +29,20 @@ const (
 	Bech32PrefixConsAddr = Bech32MainPrefix + PrefixValidator + PrefixConsensus
 	// Bech32PrefixConsPub defines the Bech32 prefix of a consensus node public key
 	Bech32PrefixConsPub = Bech32MainPrefix + PrefixValidator + PrefixConsensus + PrefixPublic
+
+	// CoinType is the SLIP-44 coin type of the HD path of the keys, also of the Ledger keys
+	CoinType = 118
+	// FullFundraiserPath is the HD path of the first key of the coin type
+	FullFundraiserPath = "44'/118'/0'/0/0"
 )
+
+// ConvertBech32Prefix re-encodes the bech32 string with the prefix, the data part is kept as is,
+// so that an address of another chain with the same key can be converted to the address of the hub.
+func ConvertBech32Prefix(s, prefix string) (string, error) {
+	_, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.ConvertAndEncode(prefix, bz)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/bech32"
+)
+
+func TestConvertBech32Prefix(t *testing.T) {
+	bz := []byte("address_1___________")
+	cosmos, err := bech32.ConvertAndEncode("cosmos", bz)
+	require.Nil(t, err)
+	sent, err := bech32.ConvertAndEncode(Bech32PrefixAccAddr, bz)
+	require.Nil(t, err)
+	sentValoper, err := bech32.ConvertAndEncode(Bech32PrefixValAddr, bz)
+	require.Nil(t, err)
+
+	res, err := ConvertBech32Prefix(cosmos, Bech32PrefixAccAddr)
+	require.Nil(t, err)
+	require.Equal(t, sent, res)
+
+	res, err = ConvertBech32Prefix(sent, Bech32PrefixValAddr)
+	require.Nil(t, err)
+	require.Equal(t, sentValoper, res)
+
+	res, err = ConvertBech32Prefix(sent, Bech32PrefixAccAddr)
+	require.Nil(t, err)
+	require.Equal(t, sent, res)
+
+	_, err = ConvertBech32Prefix("", Bech32PrefixAccAddr)
+	require.NotNil(t, err)
+	_, err = ConvertBech32Prefix(sent[:len(sent)-1], Bech32PrefixAccAddr)
+	require.NotNil(t, err)
+}
@@ -28,10 +28,16 @@ func NewBandwidth(upload, download sdk.Int) Bandwidth {
 }
 
 func (b Bandwidth) String() string {
-	return fmt.Sprintf("%d upload, %d download", b.Upload.Int64(), b.Download.Int64())
+	return fmt.Sprintf("%s upload, %s download", b.Upload, b.Download)
 }
 
+// CeilTo rounds up the upload and the download to the multiples of the precision, the bandwidth
+// is returned as it is for a precision which is not positive.
 func (b Bandwidth) CeilTo(precision sdk.Int) Bandwidth {
+	if !precision.IsPositive() {
+		return b
+	}
+
 	_b := Bandwidth{
 		Upload: precision.Sub(sdk.NewIntFromBigInt(
 			big.NewInt(0).Rem(b.Upload.BigInt(), precision.BigInt()))),
@@ -68,6 +74,24 @@ func (b Bandwidth) Sub(bandwidth Bandwidth) Bandwidth {
 	return b
 }
 
+// SaturatingSub subtracts the bandwidth, the upload and the download are not reduced below zero.
+func (b Bandwidth) SaturatingSub(bandwidth Bandwidth) Bandwidth {
+	b = b.Sub(bandwidth)
+	if b.Upload.IsNegative() {
+		b.Upload = sdk.ZeroInt()
+	}
+	if b.Download.IsNegative() {
+		b.Download = sdk.ZeroInt()
+	}
+
+	return b
+}
+
+// Cost returns the amount of the bandwidth at the price per GB, it is rounded down.
+func (b Bandwidth) Cost(pricePerGB sdk.Int) sdk.Int {
+	return b.Sum().Mul(pricePerGB).Quo(GB)
+}
+
 func (b Bandwidth) AllLT(bandwidth Bandwidth) bool {
 	return b.Upload.LT(bandwidth.Upload) &&
 		b.Download.LT(bandwidth.Download)
@@ -107,10 +131,44 @@ func (b Bandwidth) AnyNil() bool {
 		b.Download == sdk.Int{}
 }
 
+// IsValid returns an error if the upload or the download is either not set or negative.
+func (b Bandwidth) IsValid() error {
+	if b.AnyNil() {
+		return fmt.Errorf("invalid bandwidth")
+	}
+	if b.AnyNegative() {
+		return fmt.Errorf("negative bandwidth")
+	}
+
+	return nil
+}
+
+// NewBandwidthFromCost returns the bandwidth of the amount at the price per GB, it is split equally
+// between the upload and the download.
+func NewBandwidthFromCost(amount, pricePerGB sdk.Int) Bandwidth {
+	x := amount.Mul(MB500).Quo(pricePerGB)
+	return NewBandwidth(x, x)
+}
+
 func NewBandwidthFromInt64(upload, download int64) Bandwidth {
 	return NewBandwidth(sdk.NewInt(upload), sdk.NewInt(download))
 }
 
+// NewBandwidthFromString parses the decimal upload and download, which are not limited to int64.
+func NewBandwidthFromString(upload, download string) (Bandwidth, error) {
+	_upload, ok := sdk.NewIntFromString(upload)
+	if !ok {
+		return Bandwidth{}, fmt.Errorf("invalid upload %s", upload)
+	}
+
+	_download, ok := sdk.NewIntFromString(download)
+	if !ok {
+		return Bandwidth{}, fmt.Errorf("invalid download %s", download)
+	}
+
+	return NewBandwidth(_upload, _download), nil
+}
+
 type BandwidthSignatureData struct {
 	ID        SubscriptionID `json:"id"`
 	Index     uint64         `json:"index"`
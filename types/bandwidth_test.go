@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBandwidthFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		upload   string
+		download string
+		err      bool
+	}{
+		{"empty", "", "", true},
+		{"invalid upload", "a", "1", true},
+		{"invalid download", "1", "1.5", true},
+		{"zero", "0", "0", false},
+		{"int64", "9223372036854775807", "1", false},
+		{"overflow int64", "9223372036854775808", "18446744073709551616000", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bandwidth, err := NewBandwidthFromString(tc.upload, tc.download)
+			if tc.err {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			require.Equal(t, tc.upload, bandwidth.Upload.String())
+			require.Equal(t, tc.download, bandwidth.Download.String())
+		})
+	}
+}
+
+func TestBandwidth_Overflow(t *testing.T) {
+	bandwidth, err := NewBandwidthFromString("9223372036854775807", "9223372036854775807")
+	require.Nil(t, err)
+
+	sum, _ := sdk.NewIntFromString("18446744073709551614")
+	require.True(t, sum.Equal(bandwidth.Sum()))
+	require.Equal(t, "9223372036854775807 upload, 9223372036854775807 download", bandwidth.String())
+
+	bandwidth = bandwidth.Add(NewBandwidthFromInt64(1, 1))
+	require.Equal(t, "9223372036854775808 upload, 9223372036854775808 download", bandwidth.String())
+	require.True(t, bandwidth.CeilTo(GB).Upload.Mod(GB).IsZero())
+	require.True(t, NewBandwidthFromInt64(1, 1).AllLT(bandwidth))
+
+	cdc := codec.New()
+
+	bz, err := cdc.MarshalBinaryLengthPrefixed(bandwidth)
+	require.Nil(t, err)
+
+	var _bandwidth Bandwidth
+	require.Nil(t, cdc.UnmarshalBinaryLengthPrefixed(bz, &_bandwidth))
+	require.True(t, bandwidth.AllEqual(_bandwidth))
+
+	bz, err = cdc.MarshalJSON(bandwidth)
+	require.Nil(t, err)
+	require.Equal(t, `{"upload":"9223372036854775808","download":"9223372036854775808"}`, string(bz))
+
+	_bandwidth = Bandwidth{}
+	require.Nil(t, cdc.UnmarshalJSON(bz, &_bandwidth))
+	require.True(t, bandwidth.AllEqual(_bandwidth))
+}
+
+func TestBandwidth_IsValid(t *testing.T) {
+	require.NotNil(t, Bandwidth{}.IsValid())
+	require.NotNil(t, Bandwidth{Upload: sdk.NewInt(1)}.IsValid())
+	require.NotNil(t, NewBandwidthFromInt64(-1, 1).IsValid())
+	require.NotNil(t, NewBandwidthFromInt64(1, -1).IsValid())
+	require.Nil(t, NewBandwidthFromInt64(0, 0).IsValid())
+	require.Nil(t, NewBandwidthFromInt64(1, 1).IsValid())
+}
+
+func TestBandwidth_SaturatingSub(t *testing.T) {
+	bandwidth := NewBandwidthFromInt64(100, 100)
+	require.True(t, NewBandwidthFromInt64(60, 0).AllEqual(bandwidth.SaturatingSub(NewBandwidthFromInt64(40, 200))))
+	require.True(t, NewBandwidthFromInt64(0, 0).AllEqual(bandwidth.SaturatingSub(NewBandwidthFromInt64(100, 100))))
+	require.True(t, bandwidth.AllEqual(NewBandwidthFromInt64(100, 100)))
+}
+
+func TestBandwidth_Cost(t *testing.T) {
+	require.True(t, sdk.NewInt(100).Equal(NewBandwidthFromInt64(500000000, 500000000).Cost(sdk.NewInt(100))))
+	require.True(t, sdk.NewInt(0).Equal(NewBandwidthFromInt64(1, 1).Cost(sdk.NewInt(100))))
+
+	bandwidth := NewBandwidthFromCost(sdk.NewInt(50), sdk.NewInt(100))
+	require.True(t, NewBandwidthFromInt64(250000000, 250000000).AllEqual(bandwidth))
+	require.True(t, sdk.NewInt(50).Equal(bandwidth.Cost(sdk.NewInt(100))))
+}
+
+func TestBandwidth_CeilTo(t *testing.T) {
+	bandwidth := NewBandwidthFromInt64(1, 10)
+	require.True(t, NewBandwidthFromInt64(10, 10).AllEqual(bandwidth.CeilTo(sdk.NewInt(10))))
+	require.True(t, NewBandwidthFromInt64(3, 12).AllEqual(bandwidth.CeilTo(sdk.NewInt(3))))
+	require.True(t, bandwidth.AllEqual(bandwidth.CeilTo(sdk.ZeroInt())))
+}
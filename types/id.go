@@ -39,13 +39,9 @@ func NewNodeID(i uint64) NodeID {
 }
 
 func NewNodeIDFromString(s string) (NodeID, error) {
-	if len(s) < 5 {
-		return nil, fmt.Errorf("invalid node is length")
-	}
-
-	i, err := strconv.ParseUint(s[4:], 16, 64)
+	i, err := parseID(s, NodeIDPrefix)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid node id: %s", err)
 	}
 
 	return NewNodeID(i), nil
@@ -98,13 +94,9 @@ func NewSessionID(i uint64) SessionID {
 }
 
 func NewSessionIDFromString(s string) (SessionID, error) {
-	if len(s) < 5 {
-		return nil, fmt.Errorf("invalid session id length")
-	}
-
-	i, err := strconv.ParseUint(s[4:], 16, 64)
+	i, err := parseID(s, SessionIDPrefix)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("invalid session id: %s", err)
 	}
 
 	return NewSessionID(i), nil
@@ -157,13 +149,9 @@ func NewSubscriptionID(i uint64) SubscriptionID {
 }
 
 func NewSubscriptionIDFromString(s string) (SubscriptionID, error) {
-	if len(s) < 5 {
-		return nil, fmt.Errorf("invalid subscription id length")
-	}
-
-	i, err := strconv.ParseUint(s[4:], 16, 64)
+	i, err := parseID(s, SubscriptionIDPrefix)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid subscription id: %s", err)
 	}
 
 	return NewSubscriptionID(i), nil
@@ -209,6 +197,29 @@ func (id *SubscriptionID) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
+// parseID parses the canonical string form of an ID, which is the prefix followed by
+// the value in lowercase hexadecimal without leading zeros.
+func parseID(s, prefix string) (uint64, error) {
+	if !strings.HasPrefix(s, prefix) {
+		return 0, fmt.Errorf("prefix should be %s", prefix)
+	}
+
+	s = s[len(prefix):]
+	if len(s) == 0 || len(s) > 16 {
+		return 0, fmt.Errorf("value length should be between 1 and 16")
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return 0, fmt.Errorf("value should not have leading zeros")
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return 0, fmt.Errorf("value should be in lowercase hexadecimal")
+		}
+	}
+
+	return strconv.ParseUint(s, 16, 64)
+}
+
 var _ sort.Interface = (*IDs)(nil)
 
 type IDs []ID
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodeIDFromString(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want NodeID
+		err  bool
+	}{
+		{"empty", "", nil, true},
+		{"prefix only", "node", nil, true},
+		{"zero", "node0", NewNodeID(0), false},
+		{"one", "node1", NewNodeID(1), false},
+		{"hex", "nodeff", NewNodeID(255), false},
+		{"max", "nodeffffffffffffffff", NewNodeID(math.MaxUint64), false},
+		{"overflow", "node10000000000000000", nil, true},
+		{"leading zero", "node01", nil, true},
+		{"double zero", "node00", nil, true},
+		{"uppercase value", "nodeFF", nil, true},
+		{"uppercase prefix", "NODE1", nil, true},
+		{"other prefix", "subs1", nil, true},
+		{"other prefix same length", "xxxx1", nil, true},
+		{"sign", "node+1", nil, true},
+		{"negative", "node-1", nil, true},
+		{"hex prefix", "node0x1", nil, true},
+		{"underscore", "node1_0", nil, true},
+		{"space", "node 1", nil, true},
+		{"trailing space", "node1 ", nil, true},
+		{"non hex", "nodeg", nil, true},
+		{"unicode", "node１", nil, true},
+		{"long", "node" + strings.Repeat("f", 1024), nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := NewNodeIDFromString(tc.s)
+			if tc.err {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			require.Equal(t, tc.want, id)
+		})
+	}
+}
+
+func TestNewSessionIDFromString(t *testing.T) {
+	require.NotPanics(t, func() {
+		_, err := NewSessionIDFromString("sessxyz")
+		require.NotNil(t, err)
+	})
+
+	_, err := NewSessionIDFromString("node1")
+	require.NotNil(t, err)
+	_, err = NewSessionIDFromString("sess")
+	require.NotNil(t, err)
+
+	id, err := NewSessionIDFromString("sessa")
+	require.Nil(t, err)
+	require.Equal(t, NewSessionID(10), id)
+}
+
+func TestNewSubscriptionIDFromString(t *testing.T) {
+	_, err := NewSubscriptionIDFromString("sess1")
+	require.NotNil(t, err)
+	_, err = NewSubscriptionIDFromString("subs")
+	require.NotNil(t, err)
+	_, err = NewSubscriptionIDFromString("subs0a")
+	require.NotNil(t, err)
+
+	id, err := NewSubscriptionIDFromString("subs1f")
+	require.Nil(t, err)
+	require.Equal(t, NewSubscriptionID(31), id)
+}
+
+func TestFuzzIDRoundTrip(t *testing.T) {
+	f := func(i uint64) bool {
+		node, err := NewNodeIDFromString(NewNodeID(i).String())
+		if err != nil || node.Uint64() != i {
+			return false
+		}
+
+		session, err := NewSessionIDFromString(NewSessionID(i).String())
+		if err != nil || session.Uint64() != i {
+			return false
+		}
+
+		subscription, err := NewSubscriptionIDFromString(NewSubscriptionID(i).String())
+		return err == nil && subscription.Uint64() == i
+	}
+
+	require.Nil(t, quick.Check(f, &quick.Config{MaxCount: 10000}))
+}
+
+func TestFuzzIDFromString(t *testing.T) {
+	alphabet := []byte("0123456789abcdefABCDEFnodesubsxyz+-_ \x00\xff")
+	r := rand.New(rand.NewSource(1))
+
+	f := func(s string) bool {
+		for _, prefix := range []string{"", NodeIDPrefix, SessionIDPrefix, SubscriptionIDPrefix} {
+			input := prefix + s
+
+			node, err := NewNodeIDFromString(input)
+			if err == nil && node.String() != input {
+				return false
+			}
+
+			session, err := NewSessionIDFromString(input)
+			if err == nil && session.String() != input {
+				return false
+			}
+
+			subscription, err := NewSubscriptionIDFromString(input)
+			if err == nil && subscription.String() != input {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	require.Nil(t, quick.Check(f, &quick.Config{MaxCount: 10000, Rand: r}))
+
+	for i := 0; i < 10000; i++ {
+		bz := make([]byte, r.Intn(24))
+		for j := range bz {
+			bz[j] = alphabet[r.Intn(len(alphabet))]
+		}
+
+		require.True(t, f(string(bz)), string(bz))
+	}
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// PageRequest selects a page of a store prefix. Key is the cursor returned as NextKey by the
+// previous page; an empty key starts from the beginning. Offset skips the entries before the page,
+// for the clients which select the pages by number.
+type PageRequest struct {
+	Key        []byte `json:"key"`
+	Offset     uint64 `json:"offset"`
+	Limit      uint64 `json:"limit"`
+	CountTotal bool   `json:"count_total"`
+}
+
+func NewPageRequest(key []byte, limit uint64, countTotal bool) PageRequest {
+	if len(key) == 0 {
+		key = nil
+	}
+
+	return PageRequest{
+		Key:        key,
+		Limit:      limit,
+		CountTotal: countTotal,
+	}
+}
+
+func (p PageRequest) GetLimit() uint64 {
+	if p.Limit == 0 {
+		return DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+
+	return p.Limit
+}
+
+// NewPageRequestFromString builds a page request from a base64 encoded key, as returned
+// in the next_key field of a page response.
+func NewPageRequestFromString(key string, limit uint64, countTotal bool) (PageRequest, error) {
+	bytes, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return PageRequest{}, err
+	}
+
+	return NewPageRequest(bytes, limit, countTotal), nil
+}
+
+type PageResponse struct {
+	NextKey []byte `json:"next_key"`
+	Total   uint64 `json:"total"`
+}
+
+func (p PageResponse) String() string {
+	return fmt.Sprintf(`Pagination
+  Next key: %s
+  Total:    %d`, base64.StdEncoding.EncodeToString(p.NextKey), p.Total)
+}
+
+// Paginate iterates a prefixed store from the page key and calls fn with every key/value pair
+// of the page. Total is computed over the whole store only if the request asks for it.
+func Paginate(store types.KVStore, page PageRequest, fn func(key, value []byte)) (res PageResponse) {
+	limit := page.GetLimit()
+
+	iterator := store.Iterator(page.Key, nil)
+	defer iterator.Close()
+
+	for skipped := uint64(0); skipped < page.Offset && iterator.Valid(); iterator.Next() {
+		skipped++
+	}
+
+	for count := uint64(0); iterator.Valid(); iterator.Next() {
+		if count == limit {
+			res.NextKey = append([]byte{}, iterator.Key()...)
+			break
+		}
+
+		fn(iterator.Key(), iterator.Value())
+		count++
+	}
+
+	if page.CountTotal {
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			res.Total++
+		}
+	}
+
+	return res
+}
+
+// FilteredPaginate is Paginate over the key/value pairs which match the filter. fn reports whether
+// the pair matches and adds it to the page only if accumulate is true, it is also called without
+// accumulating to find the next key of the page and to count the total.
+func FilteredPaginate(store types.KVStore, page PageRequest,
+	fn func(key, value []byte, accumulate bool) bool) (res PageResponse) {
+	limit := page.GetLimit()
+
+	iterator := store.Iterator(page.Key, nil)
+	defer iterator.Close()
+
+	for skipped := uint64(0); skipped < page.Offset && iterator.Valid(); iterator.Next() {
+		if fn(iterator.Key(), iterator.Value(), false) {
+			skipped++
+		}
+	}
+
+	for count := uint64(0); iterator.Valid(); iterator.Next() {
+		if count == limit {
+			if fn(iterator.Key(), iterator.Value(), false) {
+				res.NextKey = append([]byte{}, iterator.Key()...)
+				break
+			}
+
+			continue
+		}
+
+		if fn(iterator.Key(), iterator.Value(), true) {
+			count++
+		}
+	}
+
+	if page.CountTotal {
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if fn(iterator.Key(), iterator.Value(), false) {
+				res.Total++
+			}
+		}
+	}
+
+	return res
+}
+
+// PaginateSlice returns the bounds of the page of a slice of the length, for the entries which can not be
+// iterated in the order of their keys. The key of the page is the big-endian offset of its first entry.
+func PaginateSlice(length uint64, page PageRequest) (start, end uint64, res PageResponse, err error) {
+	start = page.Offset
+	if page.Key != nil {
+		if len(page.Key) != 8 {
+			return 0, 0, res, fmt.Errorf("invalid page key length %d", len(page.Key))
+		}
+
+		start += binary.BigEndian.Uint64(page.Key)
+	}
+	if start > length {
+		start = length
+	}
+
+	end = start + page.GetLimit()
+	if end < length {
+		res.NextKey = types.Uint64ToBigEndian(end)
+	} else {
+		end = length
+	}
+
+	if page.CountTotal {
+		res.Total = length
+	}
+
+	return start, end, res, nil
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestPageRequest_GetLimit(t *testing.T) {
+	require.Equal(t, uint64(DefaultPageLimit), NewPageRequest(nil, 0, false).GetLimit())
+	require.Equal(t, uint64(10), NewPageRequest(nil, 10, false).GetLimit())
+	require.Equal(t, uint64(MaxPageLimit), NewPageRequest(nil, MaxPageLimit+1, false).GetLimit())
+}
+
+func TestNewPageRequestFromString(t *testing.T) {
+	page, err := NewPageRequestFromString("", 10, true)
+	require.Nil(t, err)
+	require.Equal(t, PageRequest{Key: nil, Limit: 10, CountTotal: true}, page)
+
+	page, err = NewPageRequestFromString("AAAAAAAAAAI=", 0, false)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Uint64ToBigEndian(2), page.Key)
+
+	_, err = NewPageRequestFromString("invalid key", 0, false)
+	require.NotNil(t, err)
+}
+
+func TestPaginate(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+
+	mdb := db.NewMemDB()
+	ms := store.NewCommitMultiStore(mdb)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, mdb)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	parent := ctx.KVStore(key)
+	for i := uint64(0); i < 5; i++ {
+		parent.Set(append([]byte{0x01}, sdk.Uint64ToBigEndian(i)...), sdk.Uint64ToBigEndian(i))
+	}
+	parent.Set([]byte{0x02}, []byte{0x02})
+
+	prefixStore := prefix.NewStore(parent, []byte{0x01})
+
+	var values []uint64
+	fn := func(_, value []byte) {
+		values = append(values, binary.BigEndian.Uint64(value))
+	}
+
+	res := Paginate(prefixStore, NewPageRequest(nil, 2, true), fn)
+	require.Equal(t, []uint64{0, 1}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(2), res.NextKey)
+	require.Equal(t, uint64(5), res.Total)
+
+	values = nil
+	res = Paginate(prefixStore, NewPageRequest(res.NextKey, 2, false), fn)
+	require.Equal(t, []uint64{2, 3}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(4), res.NextKey)
+	require.Equal(t, uint64(0), res.Total)
+
+	values = nil
+	res = Paginate(prefixStore, NewPageRequest(res.NextKey, 2, false), fn)
+	require.Equal(t, []uint64{4}, values)
+	require.Nil(t, res.NextKey)
+
+	values = nil
+	page := NewPageRequest(nil, 2, false)
+	page.Offset = 2
+	res = Paginate(prefixStore, page, fn)
+	require.Equal(t, []uint64{2, 3}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(4), res.NextKey)
+
+	values = nil
+	page.Offset = 6
+	res = Paginate(prefixStore, page, fn)
+	require.Nil(t, values)
+	require.Nil(t, res.NextKey)
+}
+
+func TestFilteredPaginate(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+
+	mdb := db.NewMemDB()
+	ms := store.NewCommitMultiStore(mdb)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, mdb)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	parent := ctx.KVStore(key)
+	for i := uint64(0); i < 8; i++ {
+		parent.Set(append([]byte{0x01}, sdk.Uint64ToBigEndian(i)...), sdk.Uint64ToBigEndian(i))
+	}
+
+	prefixStore := prefix.NewStore(parent, []byte{0x01})
+
+	var values []uint64
+	fn := func(_, value []byte, accumulate bool) bool {
+		v := binary.BigEndian.Uint64(value)
+		if v%3 != 0 {
+			return false
+		}
+		if accumulate {
+			values = append(values, v)
+		}
+
+		return true
+	}
+
+	res := FilteredPaginate(prefixStore, NewPageRequest(nil, 2, true), fn)
+	require.Equal(t, []uint64{0, 3}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(6), res.NextKey)
+	require.Equal(t, uint64(3), res.Total)
+
+	values = nil
+	res = FilteredPaginate(prefixStore, NewPageRequest(res.NextKey, 2, false), fn)
+	require.Equal(t, []uint64{6}, values)
+	require.Nil(t, res.NextKey)
+	require.Equal(t, uint64(0), res.Total)
+
+	values = nil
+	res = FilteredPaginate(prefixStore, NewPageRequest(nil, 1, false), fn)
+	require.Equal(t, []uint64{0}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(3), res.NextKey)
+
+	values = nil
+	page := NewPageRequest(nil, 1, false)
+	page.Offset = 1
+	res = FilteredPaginate(prefixStore, page, fn)
+	require.Equal(t, []uint64{3}, values)
+	require.Equal(t, sdk.Uint64ToBigEndian(6), res.NextKey)
+}
+
+func TestPaginateSlice(t *testing.T) {
+	start, end, res, err := PaginateSlice(5, NewPageRequest(nil, 2, true))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{0, 2}, []uint64{start, end})
+	require.Equal(t, sdk.Uint64ToBigEndian(2), res.NextKey)
+	require.Equal(t, uint64(5), res.Total)
+
+	start, end, res, err = PaginateSlice(5, NewPageRequest(res.NextKey, 2, false))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{2, 4}, []uint64{start, end})
+	require.Equal(t, sdk.Uint64ToBigEndian(4), res.NextKey)
+	require.Equal(t, uint64(0), res.Total)
+
+	start, end, res, err = PaginateSlice(5, NewPageRequest(res.NextKey, 2, false))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{4, 5}, []uint64{start, end})
+	require.Nil(t, res.NextKey)
+
+	start, end, res, err = PaginateSlice(5, PageRequest{Offset: 3, Limit: 1})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{3, 4}, []uint64{start, end})
+	require.Equal(t, sdk.Uint64ToBigEndian(4), res.NextKey)
+
+	start, end, _, err = PaginateSlice(5, PageRequest{Offset: 10})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{5, 5}, []uint64{start, end})
+
+	_, _, _, err = PaginateSlice(5, NewPageRequest([]byte{0x01}, 2, false))
+	require.NotNil(t, err)
+}
+
+func TestPaginateSlice_Key(t *testing.T) {
+	start, end, res, err := PaginateSlice(5, NewPageRequest(sdk.Uint64ToBigEndian(1), 3, false))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 4}, []uint64{start, end})
+	require.Equal(t, uint64(4), binary.BigEndian.Uint64(res.NextKey))
+
+	start, end, res, err = PaginateSlice(5, PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 2, Limit: 1})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{3, 4}, []uint64{start, end})
+	require.Equal(t, sdk.Uint64ToBigEndian(4), res.NextKey)
+
+	start, end, res, err = PaginateSlice(5, NewPageRequest(sdk.Uint64ToBigEndian(5), 2, false))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{5, 5}, []uint64{start, end})
+	require.Nil(t, res.NextKey)
+
+	start, end, res, err = PaginateSlice(5, NewPageRequest(sdk.Uint64ToBigEndian(7), 2, true))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{5, 5}, []uint64{start, end})
+	require.Nil(t, res.NextKey)
+	require.Equal(t, uint64(5), res.Total)
+
+	_, _, _, err = PaginateSlice(5, NewPageRequest(make([]byte, 9), 2, false))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,30 @@
+package claims
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/claims/keeper"
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+// EndBlock sends the unclaimed coins to the community pool once the airdrop has expired.
+func EndBlock(ctx sdk.Context, k keeper.Keeper) {
+	airdrop := k.GetAirdrop(ctx)
+	if airdrop.MerkleRoot == "" || !airdrop.IsExpired(ctx.BlockHeight()) {
+		return
+	}
+
+	coins := k.GetUnclaimedCoins(ctx)
+	if coins.IsZero() {
+		return
+	}
+
+	if err := k.FundCommunityPool(ctx, coins); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExpireAirdrop,
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	))
+}
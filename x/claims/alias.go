@@ -0,0 +1,74 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/sentinel-official/hub/x/claims/types/
+// ALIASGEN: github.com/sentinel-official/hub/x/claims/keeper/
+// ALIASGEN: github.com/sentinel-official/hub/x/claims/querier/
+package claims
+
+import (
+	"github.com/sentinel-official/hub/x/claims/keeper"
+	"github.com/sentinel-official/hub/x/claims/querier"
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+const (
+	Codespace              = types.Codespace
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	RouterKey              = types.RouterKey
+	QuerierRoute           = types.QuerierRoute
+	QueryAirdrop           = types.QueryAirdrop
+	QueryClaim             = types.QueryClaim
+	EventTypeClaim         = types.EventTypeClaim
+	EventTypeExpireAirdrop = types.EventTypeExpireAirdrop
+	AttributeKeyIndex      = types.AttributeKeyIndex
+	AttributeKeyEthAddress = types.AttributeKeyEthAddress
+	AttributeKeyRecipient  = types.AttributeKeyRecipient
+	AttributeKeyAmount     = types.AttributeKeyAmount
+	MaxProofLength         = types.MaxProofLength
+)
+
+var (
+	// functions aliases
+	RegisterCodec         = types.RegisterCodec
+	ErrorMarshal          = types.ErrorMarshal
+	ErrorUnmarshal        = types.ErrorUnmarshal
+	ErrorUnknownMsgType   = types.ErrorUnknownMsgType
+	ErrorInvalidQueryType = types.ErrorInvalidQueryType
+	ErrorInvalidField     = types.ErrorInvalidField
+	ErrorNoAirdrop        = types.ErrorNoAirdrop
+	ErrorAirdropExpired   = types.ErrorAirdropExpired
+	ErrorAlreadyClaimed   = types.ErrorAlreadyClaimed
+	ErrorInvalidSignature = types.ErrorInvalidSignature
+	ErrorInvalidProof     = types.ErrorInvalidProof
+	NewAirdrop            = types.NewAirdrop
+	NewClaim              = types.NewClaim
+	ParseEthAddress       = types.ParseEthAddress
+	ClaimSignBytes        = types.ClaimSignBytes
+	RecoverEthAddress     = types.RecoverEthAddress
+	NewGenesisState       = types.NewGenesisState
+	DefaultGenesisState   = types.DefaultGenesisState
+	ClaimKey              = types.ClaimKey
+	Keccak256             = types.Keccak256
+	Leaf                  = types.Leaf
+	VerifyProof           = types.VerifyProof
+	NewMsgClaim           = types.NewMsgClaim
+	NewQueryClaimParams   = types.NewQueryClaimParams
+	NewKeeper             = keeper.NewKeeper
+	NewQuerier            = querier.NewQuerier
+
+	// variable aliases
+	ModuleCdc      = types.ModuleCdc
+	AirdropKey     = types.AirdropKey
+	ClaimKeyPrefix = types.ClaimKeyPrefix
+)
+
+type (
+	Airdrop          = types.Airdrop
+	Claim            = types.Claim
+	GenesisState     = types.GenesisState
+	MsgClaim         = types.MsgClaim
+	QueryClaimParams = types.QueryClaimParams
+	Keeper           = keeper.Keeper
+)
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+const (
+	flagProof = "proof"
+)
+
+func ClaimTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [index] [eth-address] [amount] [signature]",
+		Short: "Claim the amount of the Ethereum address at the index of the snapshot",
+		Long: `Claim the amount of the Ethereum address at the index of the snapshot. The signature is the
+personal_sign signature of the Ethereum address of the message "Claim the Sentinel Hub airdrop to <from address>".`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[2])
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgClaim(fromAddress, index, args[1], amount, viper.GetStringSlice(flagProof), args[3])
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringSlice(flagProof, nil, "Hex encoded nodes of the merkle proof of the claim")
+
+	return cmd
+}
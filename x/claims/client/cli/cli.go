@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Querying commands for the claims module",
+	}
+
+	cmd.AddCommand(client.GetCommands(
+		QueryAirdropCmd(cdc),
+		QueryClaimCmd(cdc),
+	)...)
+
+	return cmd
+}
+
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Claims transactions subcommands",
+	}
+
+	cmd.AddCommand(client.PostCommands(
+		ClaimTxCmd(cdc),
+	)...)
+
+	return cmd
+}
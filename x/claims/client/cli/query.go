@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/claims/client/common"
+)
+
+func QueryAirdropCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop",
+		Short: "Query the merkle root, the denom and the expiry of the airdrop",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			airdrop, err := common.QueryAirdrop(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(airdrop)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryClaimCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [index]",
+		Short: "Query the claim of the index of the snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			claim, err := common.QueryClaim(ctx, index)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(claim)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func QueryAirdrop(ctx context.CLIContext) (*types.Airdrop, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAirdrop)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var airdrop types.Airdrop
+	if err := ctx.Codec.UnmarshalJSON(res, &airdrop); err != nil {
+		return nil, err
+	}
+
+	return &airdrop, nil
+}
+
+func QueryClaim(ctx context.CLIContext, index uint64) (*types.Claim, error) {
+	params := types.NewQueryClaimParams(index)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryClaim)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no claim found")
+	}
+
+	var claim types.Claim
+	if err := ctx.Codec.UnmarshalJSON(res, &claim); err != nil {
+		return nil, err
+	}
+
+	return &claim, nil
+}
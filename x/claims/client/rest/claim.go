@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+type msgClaim struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Index      uint64       `json:"index"`
+	EthAddress string       `json:"eth_address"`
+	Amount     sdk.Int      `json:"amount"`
+	Proof      []string     `json:"proof"`
+	Signature  string       `json:"signature"`
+}
+
+func claimHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgClaim
+
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaim(fromAddress, req.Index, req.EthAddress, req.Amount, req.Proof, req.Signature)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,45 @@
+package claims
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	k.SetAirdrop(ctx, data.Airdrop)
+
+	for _, claim := range data.Claims {
+		k.SetClaim(ctx, claim)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetAirdrop(ctx), k.GetAllClaims(ctx))
+}
+
+func ValidateGenesis(data types.GenesisState) error {
+	if err := data.Airdrop.IsValid(); err != nil {
+		return err
+	}
+
+	claimsMap := make(map[uint64]bool, len(data.Claims))
+	for _, claim := range data.Claims {
+		if err := claim.IsValid(); err != nil {
+			return err
+		}
+		if claim.Amount.Denom != data.Airdrop.Denom {
+			return fmt.Errorf("invalid denom for the %s", claim)
+		}
+
+		if claimsMap[claim.Index] {
+			return fmt.Errorf("duplicate index for the %s", claim)
+		}
+
+		claimsMap[claim.Index] = true
+	}
+
+	return nil
+}
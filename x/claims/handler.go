@@ -0,0 +1,69 @@
+package claims
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/claims/keeper"
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case types.MsgClaim:
+			return handleClaim(ctx, k, msg)
+		default:
+			return types.ErrorUnknownMsgType(reflect.TypeOf(msg).Name()).Result()
+		}
+	}
+}
+
+func handleClaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaim) sdk.Result {
+	airdrop := k.GetAirdrop(ctx)
+	if airdrop.MerkleRoot == "" {
+		return types.ErrorNoAirdrop().Result()
+	}
+	if airdrop.IsExpired(ctx.BlockHeight()) {
+		return types.ErrorAirdropExpired().Result()
+	}
+	if _, found := k.GetClaim(ctx, msg.Index); found {
+		return types.ErrorAlreadyClaimed().Result()
+	}
+
+	ethAddress, _ := types.ParseEthAddress(msg.EthAddress)
+	signer, err := types.RecoverEthAddress(types.ClaimSignBytes(msg.From), msg.SignatureBytes())
+	if err != nil || !bytes.Equal(signer, ethAddress) {
+		return types.ErrorInvalidSignature().Result()
+	}
+
+	leaf, err := types.Leaf(msg.Index, ethAddress, msg.Amount)
+	if err != nil {
+		return types.ErrorInvalidField("amount").Result()
+	}
+
+	proof, err := msg.ProofBytes()
+	if err != nil || !types.VerifyProof(airdrop.Root(), leaf, proof) {
+		return types.ErrorInvalidProof().Result()
+	}
+
+	amount := sdk.NewCoin(airdrop.Denom, msg.Amount)
+	if err := k.SendClaimedCoins(ctx, msg.From, sdk.Coins{amount}); err != nil {
+		return err.Result()
+	}
+
+	k.SetClaim(ctx, types.NewClaim(msg.Index, msg.EthAddress, msg.From, amount))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaim,
+		sdk.NewAttribute(types.AttributeKeyIndex, strconv.FormatUint(msg.Index, 10)),
+		sdk.NewAttribute(types.AttributeKeyEthAddress, msg.EthAddress),
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.From.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,114 @@
+package claims
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/claims/keeper"
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+var (
+	testAddress1 = sdk.AccAddress([]byte("address-1"))
+	testAddress2 = sdk.AccAddress([]byte("address-2"))
+)
+
+func fundModuleAccount(ctx sdk.Context, sk supply.Keeper, coins sdk.Coins) {
+	account := sk.GetModuleAccount(ctx, types.ModuleName)
+	if err := account.SetCoins(coins); err != nil {
+		panic(err)
+	}
+
+	sk.SetModuleAccount(ctx, account)
+	sk.SetSupply(ctx, supply.NewSupply(coins))
+}
+
+func signClaim(t *testing.T, key *btcec.PrivateKey, recipient sdk.AccAddress) string {
+	compact, err := btcec.SignCompact(btcec.S256(), key, types.ClaimSignBytes(recipient), false)
+	require.Nil(t, err)
+
+	return hex.EncodeToString(append(compact[1:], compact[0]))
+}
+
+func Test_handleClaim(t *testing.T) {
+	ctx, k, sk, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	key1, err := btcec.NewPrivateKey(btcec.S256())
+	require.Nil(t, err)
+	key2, err := btcec.NewPrivateKey(btcec.S256())
+	require.Nil(t, err)
+
+	ethAddress1 := types.Keccak256(key1.PubKey().SerializeUncompressed()[1:])[12:]
+	ethAddress2 := types.Keccak256(key2.PubKey().SerializeUncompressed()[1:])[12:]
+
+	leaf1, err := types.Leaf(0, ethAddress1, sdk.NewInt(100))
+	require.Nil(t, err)
+	leaf2, err := types.Leaf(1, ethAddress2, sdk.NewInt(200))
+	require.Nil(t, err)
+
+	root := types.Keccak256(leaf1, leaf2)
+	if hex.EncodeToString(leaf1) > hex.EncodeToString(leaf2) {
+		root = types.Keccak256(leaf2, leaf1)
+	}
+
+	msg := NewMsgClaim(testAddress1, 0, hex.EncodeToString(ethAddress1), sdk.NewInt(100),
+		[]string{hex.EncodeToString(leaf2)}, signClaim(t, key1, testAddress1))
+	res := handler(ctx, *msg)
+	require.Equal(t, ErrorNoAirdrop().Result(), res)
+
+	k.SetAirdrop(ctx, NewAirdrop(hex.EncodeToString(root), "stake", 10))
+	fundModuleAccount(ctx, sk, sdk.Coins{sdk.NewInt64Coin("stake", 300)})
+
+	_msg := NewMsgClaim(testAddress2, 0, hex.EncodeToString(ethAddress1), sdk.NewInt(100),
+		[]string{hex.EncodeToString(leaf2)}, signClaim(t, key1, testAddress1))
+	res = handler(ctx, *_msg)
+	require.Equal(t, ErrorInvalidSignature().Result(), res)
+
+	_msg = NewMsgClaim(testAddress1, 0, hex.EncodeToString(ethAddress1), sdk.NewInt(200),
+		[]string{hex.EncodeToString(leaf2)}, signClaim(t, key1, testAddress1))
+	res = handler(ctx, *_msg)
+	require.Equal(t, ErrorInvalidProof().Result(), res)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	claim, found := k.GetClaim(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, testAddress1, claim.Recipient)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), claim.Amount)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, k.GetUnclaimedCoins(ctx))
+
+	res = handler(ctx, *msg)
+	require.Equal(t, ErrorAlreadyClaimed().Result(), res)
+
+	ctx = ctx.WithBlockHeight(10)
+	msg = NewMsgClaim(testAddress2, 1, hex.EncodeToString(ethAddress2), sdk.NewInt(200),
+		[]string{hex.EncodeToString(leaf1)}, signClaim(t, key2, testAddress2))
+	res = handler(ctx, *msg)
+	require.Equal(t, ErrorAirdropExpired().Result(), res)
+}
+
+func TestEndBlock(t *testing.T) {
+	ctx, k, sk, distrk := keeper.CreateTestInput(t, false)
+
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 300)}
+	fundModuleAccount(ctx, sk, coins)
+
+	EndBlock(ctx, k)
+	require.Equal(t, coins, k.GetUnclaimedCoins(ctx))
+
+	k.SetAirdrop(ctx, NewAirdrop(hex.EncodeToString(make([]byte, 32)), "stake", 10))
+
+	EndBlock(ctx.WithBlockHeight(9), k)
+	require.Equal(t, coins, k.GetUnclaimedCoins(ctx))
+
+	EndBlock(ctx.WithBlockHeight(10), k)
+	require.True(t, k.GetUnclaimedCoins(ctx).IsZero())
+	require.Equal(t, sdk.NewDecCoins(coins), distrk.GetFeePool(ctx).CommunityPool)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func (k Keeper) SetAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(airdrop)
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.AirdropKey, value)
+}
+
+func (k Keeper) GetAirdrop(ctx sdk.Context) (airdrop types.Airdrop) {
+	store := ctx.KVStore(k.key)
+
+	value := store.Get(types.AirdropKey)
+	if value == nil {
+		return airdrop
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &airdrop)
+	return airdrop
+}
+
+func (k Keeper) SetClaim(ctx sdk.Context, claim types.Claim) {
+	key := types.ClaimKey(claim.Index)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(claim)
+
+	store := ctx.KVStore(k.key)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetClaim(ctx sdk.Context, index uint64) (claim types.Claim, found bool) {
+	store := ctx.KVStore(k.key)
+
+	key := types.ClaimKey(index)
+	value := store.Get(key)
+	if value == nil {
+		return claim, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &claim)
+	return claim, true
+}
+
+func (k Keeper) GetAllClaims(ctx sdk.Context) (claims []types.Claim) {
+	store := ctx.KVStore(k.key)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ClaimKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &claim)
+		claims = append(claims, claim)
+	}
+
+	return claims
+}
+
+// GetUnclaimedCoins returns the coins of the module account which are left to be claimed.
+func (k Keeper) GetUnclaimedCoins(ctx sdk.Context) sdk.Coins {
+	return k.supply.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+}
+
+func (k Keeper) SendClaimedCoins(ctx sdk.Context, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	return k.supply.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+}
+
+// FundCommunityPool moves the coins of the module account to the community pool.
+func (k Keeper) FundCommunityPool(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	if err := k.supply.SendCoinsFromModuleToModule(ctx, types.ModuleName, distribution.ModuleName, coins); err != nil {
+		return err
+	}
+
+	feePool := k.distribution.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(coins))
+	k.distribution.SetFeePool(ctx, feePool)
+
+	return nil
+}
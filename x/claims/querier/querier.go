@@ -0,0 +1,52 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sentinel-official/hub/x/claims/keeper"
+	"github.com/sentinel-official/hub/x/claims/types"
+)
+
+func NewQuerier(k keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryAirdrop:
+			return queryAirdrop(ctx, k)
+		case types.QueryClaim:
+			return queryClaim(ctx, req, k)
+		default:
+			return nil, types.ErrorInvalidQueryType(path[0])
+		}
+	}
+}
+
+func queryAirdrop(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	airdrop := k.GetAirdrop(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(airdrop)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryClaim(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryClaimParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	claim, found := k.GetClaim(ctx, params.Index)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(claim)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Airdrop is the merkle root of the snapshot of the ERC-20 holders and the denom of the coins they
+// claim from the module account. The coins which are not claimed before the expiry height are sent
+// to the community pool, zero expiry never expires.
+type Airdrop struct {
+	MerkleRoot string `json:"merkle_root"`
+	Denom      string `json:"denom"`
+	Expiry     int64  `json:"expiry"`
+}
+
+func NewAirdrop(merkleRoot, denom string, expiry int64) Airdrop {
+	return Airdrop{
+		MerkleRoot: merkleRoot,
+		Denom:      denom,
+		Expiry:     expiry,
+	}
+}
+
+func (a Airdrop) String() string {
+	return fmt.Sprintf(`Airdrop
+  Merkle Root: %s
+  Denom:       %s
+  Expiry:      %d`, a.MerkleRoot, a.Denom, a.Expiry)
+}
+
+func (a Airdrop) Root() []byte {
+	root, _ := hex.DecodeString(trimHexPrefix(a.MerkleRoot))
+	return root
+}
+
+// IsValid returns nil for an airdrop without the merkle root, which is the state of a chain
+// without an airdrop.
+func (a Airdrop) IsValid() error {
+	if a.MerkleRoot == "" {
+		return nil
+	}
+
+	root, err := hex.DecodeString(trimHexPrefix(a.MerkleRoot))
+	if err != nil || len(root) != 32 {
+		return fmt.Errorf("invalid merkle root")
+	}
+	if !(sdk.Coin{Denom: a.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+		return fmt.Errorf("invalid denom")
+	}
+	if a.Expiry < 0 {
+		return fmt.Errorf("invalid expiry")
+	}
+
+	return nil
+}
+
+func (a Airdrop) IsExpired(height int64) bool {
+	return a.Expiry > 0 && height >= a.Expiry
+}
+
+type Claim struct {
+	Index      uint64         `json:"index"`
+	EthAddress string         `json:"eth_address"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coin       `json:"amount"`
+}
+
+func NewClaim(index uint64, ethAddress string, recipient sdk.AccAddress, amount sdk.Coin) Claim {
+	return Claim{
+		Index:      index,
+		EthAddress: ethAddress,
+		Recipient:  recipient,
+		Amount:     amount,
+	}
+}
+
+func (c Claim) String() string {
+	return fmt.Sprintf(`Claim
+  Index:       %d
+  Eth Address: %s
+  Recipient:   %s
+  Amount:      %s`, c.Index, c.EthAddress, c.Recipient, c.Amount)
+}
+
+func (c Claim) IsValid() error {
+	if _, err := ParseEthAddress(c.EthAddress); err != nil {
+		return err
+	}
+	if c.Recipient == nil || c.Recipient.Empty() {
+		return fmt.Errorf("invalid recipient")
+	}
+	if !c.Amount.IsValid() || c.Amount.IsZero() {
+		return fmt.Errorf("invalid amount")
+	}
+
+	return nil
+}
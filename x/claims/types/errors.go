@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	Codespace = sdk.CodespaceType("claims")
+
+	errCodeUnknownMsgType   = 101
+	errCodeUnknownQueryType = 102
+	errCodeInvalidField     = 103
+	errCodeNoAirdrop        = 104
+	errCodeAirdropExpired   = 105
+	errCodeAlreadyClaimed   = 106
+	errCodeInvalidSignature = 107
+	errCodeInvalidProof     = 108
+
+	errMsgUnknownMsgType   = "Unknown message type: "
+	errMsgUnknownQueryType = "Invalid query type: "
+	errMsgInvalidField     = "Invalid field: "
+	errMsgNoAirdrop        = "No airdrop is set"
+	errMsgAirdropExpired   = "Airdrop has expired"
+	errMsgAlreadyClaimed   = "Index is already claimed"
+	errMsgInvalidSignature = "Signature is not signed by the Ethereum address"
+	errMsgInvalidProof     = "Proof does not match the merkle root"
+)
+
+func ErrorMarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeMarshal, hub.ErrMsgMarshal)
+}
+
+func ErrorUnmarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeUnmarshal, hub.ErrMsgUnmarshal)
+}
+
+func ErrorUnknownMsgType(msgType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownMsgType, errMsgUnknownMsgType+msgType)
+}
+
+func ErrorInvalidQueryType(queryType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownQueryType, errMsgUnknownQueryType+queryType)
+}
+
+func ErrorInvalidField(field string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidField, errMsgInvalidField+field)
+}
+
+func ErrorNoAirdrop() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNoAirdrop, errMsgNoAirdrop)
+}
+
+func ErrorAirdropExpired() sdk.Error {
+	return sdk.NewError(Codespace, errCodeAirdropExpired, errMsgAirdropExpired)
+}
+
+func ErrorAlreadyClaimed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeAlreadyClaimed, errMsgAlreadyClaimed)
+}
+
+func ErrorInvalidSignature() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidSignature, errMsgInvalidSignature)
+}
+
+func ErrorInvalidProof() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidProof, errMsgInvalidProof)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func trimHexPrefix(s string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+}
+
+func ParseEthAddress(s string) ([]byte, error) {
+	address, err := hex.DecodeString(trimHexPrefix(s))
+	if err != nil || len(address) != 20 {
+		return nil, fmt.Errorf("invalid eth address %s", s)
+	}
+
+	return address, nil
+}
+
+// ClaimSignBytes returns the hash the holder signs with the personal_sign method of the Ethereum
+// wallets to claim the coins to the recipient.
+func ClaimSignBytes(recipient sdk.AccAddress) []byte {
+	msg := fmt.Sprintf("Claim the Sentinel Hub airdrop to %s", recipient)
+	return Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(msg), msg)))
+}
+
+// RecoverEthAddress returns the Ethereum address which signed the hash, the signature is
+// r || s || v with v of 0, 1, 27 or 28.
+func RecoverEthAddress(hash, signature []byte) ([]byte, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid signature length")
+	}
+
+	v := signature[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, fmt.Errorf("invalid signature recovery id")
+	}
+
+	compact := append([]byte{27 + v}, signature[:64]...)
+	key, _, err := btcec.RecoverCompact(btcec.S256(), compact, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256(key.SerializeUncompressed()[1:])[12:], nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEthAddress(t *testing.T) {
+	_, err := ParseEthAddress("")
+	require.NotNil(t, err)
+	_, err = ParseEthAddress("0x00000000000000000000000000000000000000a")
+	require.NotNil(t, err)
+	_, err = ParseEthAddress("0x00000000000000000000000000000000000000zz")
+	require.NotNil(t, err)
+
+	address, err := ParseEthAddress("0x00000000000000000000000000000000000000aa")
+	require.Nil(t, err)
+	require.Equal(t, 20, len(address))
+
+	_address, err := ParseEthAddress("00000000000000000000000000000000000000AA")
+	require.Nil(t, err)
+	require.Equal(t, address, _address)
+}
+
+func TestRecoverEthAddress(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.Nil(t, err)
+
+	ethAddress := Keccak256(key.PubKey().SerializeUncompressed()[1:])[12:]
+	hash := ClaimSignBytes(sdk.AccAddress([]byte("address-1")))
+
+	compact, err := btcec.SignCompact(btcec.S256(), key, hash, false)
+	require.Nil(t, err)
+
+	signature := append(compact[1:], compact[0])
+
+	address, err := RecoverEthAddress(hash, signature)
+	require.Nil(t, err)
+	require.Equal(t, ethAddress, address)
+
+	signature[64] -= 27
+	address, err = RecoverEthAddress(hash, signature)
+	require.Nil(t, err)
+	require.Equal(t, ethAddress, address)
+
+	address, err = RecoverEthAddress(ClaimSignBytes(sdk.AccAddress([]byte("address-2"))), signature)
+	require.Nil(t, err)
+	require.NotEqual(t, ethAddress, address)
+
+	signature[64] = 2
+	_, err = RecoverEthAddress(hash, signature)
+	require.NotNil(t, err)
+
+	_, err = RecoverEthAddress(hash, signature[:64])
+	require.NotNil(t, err)
+}
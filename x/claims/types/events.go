@@ -0,0 +1,11 @@
+package types
+
+const (
+	EventTypeClaim         = "claim"
+	EventTypeExpireAirdrop = "expire_airdrop"
+
+	AttributeKeyIndex      = "index"
+	AttributeKeyEthAddress = "eth_address"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyAmount     = "amount"
+)
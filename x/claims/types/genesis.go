@@ -0,0 +1,17 @@
+package types
+
+type GenesisState struct {
+	Airdrop Airdrop `json:"airdrop"`
+	Claims  []Claim `json:"claims"`
+}
+
+func NewGenesisState(airdrop Airdrop, claims []Claim) GenesisState {
+	return GenesisState{
+		Airdrop: airdrop,
+		Claims:  claims,
+	}
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	ModuleName   = "claims"
+	StoreKey     = ModuleName
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
+)
+
+var (
+	AirdropKey     = []byte{0x00}
+	ClaimKeyPrefix = []byte{0x01}
+)
+
+func ClaimKey(index uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, index)
+
+	return append(ClaimKeyPrefix, bz...)
+}
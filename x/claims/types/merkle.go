@@ -0,0 +1,54 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/crypto/sha3"
+)
+
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		hasher.Write(b) // nolint:errcheck
+	}
+
+	return hasher.Sum(nil)
+}
+
+func uint256(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+// Leaf returns the leaf of the merkle tree of the snapshot for the index, the Ethereum address
+// and the amount, which is keccak256(abi.encodePacked(uint256 index, address account, uint256 amount)).
+func Leaf(index uint64, ethAddress []byte, amount sdk.Int) ([]byte, error) {
+	if len(ethAddress) != 20 {
+		return nil, fmt.Errorf("invalid eth address")
+	}
+	if !amount.IsPositive() || amount.BigInt().BitLen() > 256 {
+		return nil, fmt.Errorf("invalid amount")
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, index)
+
+	return Keccak256(uint256(bz), ethAddress, uint256(amount.BigInt().Bytes())), nil
+}
+
+// VerifyProof returns true if the proof hashes the leaf up to the root. The pairs of the nodes are
+// sorted before they are hashed, as by the MerkleProof library of OpenZeppelin.
+func VerifyProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, node := range proof {
+		if bytes.Compare(hash, node) <= 0 {
+			hash = Keccak256(hash, node)
+		} else {
+			hash = Keccak256(node, hash)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
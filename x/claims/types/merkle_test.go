@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaf(t *testing.T) {
+	ethAddress, _ := hex.DecodeString("00000000000000000000000000000000000000aa")
+
+	_, err := Leaf(0, ethAddress[:19], sdk.NewInt(1))
+	require.NotNil(t, err)
+	_, err = Leaf(0, ethAddress, sdk.ZeroInt())
+	require.NotNil(t, err)
+
+	leaf, err := Leaf(1, ethAddress, sdk.NewInt(2))
+	require.Nil(t, err)
+
+	packed, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001" +
+		"00000000000000000000000000000000000000aa" +
+		"0000000000000000000000000000000000000000000000000000000000000002")
+	require.Equal(t, Keccak256(packed), leaf)
+}
+
+func TestVerifyProof(t *testing.T) {
+	ethAddress, _ := hex.DecodeString("00000000000000000000000000000000000000aa")
+
+	var leaves [][]byte
+	for i := uint64(0); i < 3; i++ {
+		leaf, err := Leaf(i, ethAddress, sdk.NewInt(int64(i+1)))
+		require.Nil(t, err)
+		leaves = append(leaves, leaf)
+	}
+
+	hashPair := func(a, b []byte) []byte {
+		if hex.EncodeToString(a) <= hex.EncodeToString(b) {
+			return Keccak256(a, b)
+		}
+		return Keccak256(b, a)
+	}
+
+	node := hashPair(leaves[0], leaves[1])
+	root := hashPair(node, leaves[2])
+
+	require.True(t, VerifyProof(root, leaves[0], [][]byte{leaves[1], leaves[2]}))
+	require.True(t, VerifyProof(root, leaves[1], [][]byte{leaves[0], leaves[2]}))
+	require.True(t, VerifyProof(root, leaves[2], [][]byte{node}))
+	require.False(t, VerifyProof(root, leaves[2], [][]byte{leaves[0]}))
+	require.False(t, VerifyProof(root, leaves[0], [][]byte{leaves[2], leaves[1]}))
+	require.False(t, VerifyProof(root, leaves[0], nil))
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxProofLength is the maximum number of the nodes of a proof, enough for a snapshot of 2^32 holders.
+const MaxProofLength = 32
+
+var _ sdk.Msg = (*MsgClaim)(nil)
+
+// MsgClaim claims the amount of the holder of the Ethereum address at the index of the snapshot
+// to the signer, the signature of the holder binds the claim to the signer.
+type MsgClaim struct {
+	From       sdk.AccAddress `json:"from"`
+	Index      uint64         `json:"index"`
+	EthAddress string         `json:"eth_address"`
+	Amount     sdk.Int        `json:"amount"`
+	Proof      []string       `json:"proof"`
+	Signature  string         `json:"signature"`
+}
+
+func (msg MsgClaim) Type() string {
+	return "claim"
+}
+
+func (msg MsgClaim) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if _, err := ParseEthAddress(msg.EthAddress); err != nil {
+		return ErrorInvalidField("eth_address")
+	}
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return ErrorInvalidField("amount")
+	}
+	if len(msg.Proof) > MaxProofLength {
+		return ErrorInvalidField("proof")
+	}
+	if _, err := msg.ProofBytes(); err != nil {
+		return ErrorInvalidField("proof")
+	}
+	if signature, err := hex.DecodeString(trimHexPrefix(msg.Signature)); err != nil || len(signature) != 65 {
+		return ErrorInvalidField("signature")
+	}
+
+	return nil
+}
+
+func (msg MsgClaim) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgClaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgClaim) Route() string {
+	return RouterKey
+}
+
+func (msg MsgClaim) ProofBytes() ([][]byte, error) {
+	proof := make([][]byte, 0, len(msg.Proof))
+	for _, s := range msg.Proof {
+		node, err := hex.DecodeString(trimHexPrefix(s))
+		if err != nil || len(node) != 32 {
+			return nil, ErrorInvalidField("proof")
+		}
+
+		proof = append(proof, node)
+	}
+
+	return proof, nil
+}
+
+func (msg MsgClaim) SignatureBytes() []byte {
+	signature, _ := hex.DecodeString(trimHexPrefix(msg.Signature))
+	return signature
+}
+
+func NewMsgClaim(from sdk.AccAddress, index uint64, ethAddress string, amount sdk.Int,
+	proof []string, signature string) *MsgClaim {
+	return &MsgClaim{
+		From:       from,
+		Index:      index,
+		EthAddress: ethAddress,
+		Amount:     amount,
+		Proof:      proof,
+		Signature:  signature,
+	}
+}
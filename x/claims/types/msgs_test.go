@@ -0,0 +1,75 @@
+package types
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgClaim_ValidateBasic(t *testing.T) {
+	var (
+		from       = sdk.AccAddress([]byte("address-1"))
+		ethAddress = "0x00000000000000000000000000000000000000aa"
+		node       = strings.Repeat("ab", 32)
+		signature  = strings.Repeat("ab", 65)
+	)
+
+	proof := make([]string, MaxProofLength+1)
+	for i := range proof {
+		proof[i] = node
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgClaim
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgClaim(nil, 0, ethAddress, sdk.NewInt(1), []string{node}, signature),
+			ErrorInvalidField("from"),
+		}, {
+			"eth address is invalid",
+			NewMsgClaim(from, 0, "0xaa", sdk.NewInt(1), []string{node}, signature),
+			ErrorInvalidField("eth_address"),
+		}, {
+			"amount is nil",
+			NewMsgClaim(from, 0, ethAddress, sdk.Int{}, []string{node}, signature),
+			ErrorInvalidField("amount"),
+		}, {
+			"amount is zero",
+			NewMsgClaim(from, 0, ethAddress, sdk.ZeroInt(), []string{node}, signature),
+			ErrorInvalidField("amount"),
+		}, {
+			"proof is too long",
+			NewMsgClaim(from, 0, ethAddress, sdk.NewInt(1), proof, signature),
+			ErrorInvalidField("proof"),
+		}, {
+			"proof node is invalid",
+			NewMsgClaim(from, 0, ethAddress, sdk.NewInt(1), []string{"ab"}, signature),
+			ErrorInvalidField("proof"),
+		}, {
+			"signature is invalid",
+			NewMsgClaim(from, 0, ethAddress, sdk.NewInt(1), []string{node}, "ab"),
+			ErrorInvalidField("signature"),
+		}, {
+			"valid",
+			NewMsgClaim(from, 0, ethAddress, sdk.NewInt(1), []string{"0x" + node}, "0x"+signature),
+			nil,
+		}, {
+			"valid without proof",
+			NewMsgClaim(from, 0, ethAddress, sdk.NewInt(1), nil, signature),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
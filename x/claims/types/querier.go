@@ -0,0 +1,16 @@
+package types
+
+const (
+	QueryAirdrop = "airdrop"
+	QueryClaim   = "claim"
+)
+
+type QueryClaimParams struct {
+	Index uint64
+}
+
+func NewQueryClaimParams(index uint64) QueryClaimParams {
+	return QueryClaimParams{
+		Index: index,
+	}
+}
@@ -34,6 +34,10 @@ var (
 	NewQueryDepositOfAddressParams = types.NewQueryDepositOfAddressParams
 	NewKeeper                      = keeper.NewKeeper
 	NewQuerier                     = querier.NewQuerier
+	NewQueryAllDepositsParams      = types.NewQueryAllDepositsParams
+	NewQueryDepositsResponse       = types.NewQueryDepositsResponse
+	PrometheusMetrics              = keeper.PrometheusMetrics
+	NopMetrics                     = keeper.NopMetrics
 
 	// variable aliases
 	ModuleCdc        = types.ModuleCdc
@@ -45,4 +49,7 @@ type (
 	GenesisState               = types.GenesisState
 	QueryDepositOfAddressPrams = types.QueryDepositOfAddressPrams
 	Keeper                     = keeper.Keeper
+	QueryAllDepositsParams     = types.QueryAllDepositsParams
+	QueryDepositsResponse      = types.QueryDepositsResponse
+	Metrics                    = keeper.Metrics
 )
@@ -1,3 +1,26 @@
 package cli
 
-const flagAddress = "address"
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	flagAddress    = "address"
+	flagPageKey    = "page-key"
+	flagLimit      = "limit"
+	flagCountTotal = "count-total"
+)
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagPageKey, "", "Base64 encoded key to start the page from")
+	cmd.Flags().Uint64(flagLimit, hub.DefaultPageLimit, "Maximum number of items in the page")
+	cmd.Flags().Bool(flagCountTotal, false, "Count the total number of items")
+}
+
+func pageRequestFromFlags() (hub.PageRequest, error) {
+	return hub.NewPageRequestFromString(viper.GetString(flagPageKey),
+		viper.GetUint64(flagLimit), viper.GetBool(flagCountTotal))
+}
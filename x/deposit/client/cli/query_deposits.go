@@ -31,20 +31,27 @@ func QueryDepositsCmd(cdc *codec.Codec) *cobra.Command {
 				return nil
 			}
 
-			deposits, err := common.QueryAllDeposits(ctx)
+			page, err := pageRequestFromFlags()
 			if err != nil {
 				return err
 			}
 
-			for _, deposit := range deposits {
+			res, err := common.QueryAllDeposits(ctx, page)
+			if err != nil {
+				return err
+			}
+
+			for _, deposit := range res.Deposits {
 				fmt.Println(deposit)
 			}
 
+			fmt.Println(res.Pagination)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flagAddress, "", "Account address")
+	addPaginationFlags(cmd)
 
 	return client.GetCommands(cmd)[0]
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/deposit/types"
 )
 
@@ -39,20 +40,27 @@ func QueryDepositOfAddress(ctx context.CLIContext, s string) (*types.Deposit, er
 	return &d, nil
 }
 
-func QueryAllDeposits(ctx context.CLIContext) ([]types.Deposit, error) {
-	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDeposits)
-	res, _, err := ctx.QueryWithData(path, nil)
+func QueryAllDeposits(ctx context.CLIContext, page hub.PageRequest) (*types.QueryDepositsResponse, error) {
+	params := types.NewQueryAllDepositsParams(page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
-		return nil, fmt.Errorf("no deposits found")
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDeposits)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
 	}
 
-	var d []types.Deposit
-	if err = ctx.Codec.UnmarshalJSON(res, &d); err != nil {
+	var response types.QueryDepositsResponse
+	if err = ctx.Codec.UnmarshalJSON(res, &response); err != nil {
 		return nil, err
 	}
+	if len(response.Deposits) == 0 {
+		return nil, fmt.Errorf("no deposits found")
+	}
 
-	return d, nil
+	return &response, nil
 }
@@ -12,6 +12,11 @@ import (
 
 func getDepositOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
 
 		deposit, err := common.QueryDepositOfAddress(ctx, vars["address"])
@@ -26,12 +31,23 @@ func getDepositOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 
 func getAllDeposits(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		deposits, err := common.QueryAllDeposits(ctx)
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAllDeposits(ctx, page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, deposits)
+		rest.PostProcessResponse(w, ctx, res)
 	}
 }
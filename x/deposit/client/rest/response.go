@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// parseQueryHeight parses the height of the query, and pins the query to the last height committed by the
+// app when the request does not set one, so that every response is wrapped along with the height of its result.
+func parseQueryHeight(w http.ResponseWriter, ctx context.CLIContext, r *http.Request) (context.CLIContext, bool) {
+	ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+	if !ok || ctx.Height > 0 {
+		return ctx, ok
+	}
+
+	return withLatestHeight(w, ctx)
+}
+
+func withLatestHeight(w http.ResponseWriter, ctx context.CLIContext) (context.CLIContext, bool) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return ctx, false
+	}
+
+	info, err := node.ABCIInfo()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return ctx, false
+	}
+
+	return ctx.WithHeight(info.Response.LastBlockHeight), true
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/deposit/types"
 )
 
@@ -42,6 +44,18 @@ func (k Keeper) GetAllDeposits(ctx sdk.Context) (deposits []types.Deposit) {
 	return deposits
 }
 
+func (k Keeper) PaginateDeposits(ctx sdk.Context, page hub.PageRequest) (deposits []types.Deposit, res hub.PageResponse) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositKeyPrefix)
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &deposit)
+		deposits = append(deposits, deposit)
+	})
+
+	return deposits, res
+}
+
 func (k Keeper) Add(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) (err sdk.Error) {
 	if err := k.supply.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, coins); err != nil {
 		return err
@@ -61,6 +75,8 @@ func (k Keeper) Add(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) (e
 	}
 
 	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.LockedCoins, coins)
+
 	return nil
 }
 
@@ -80,6 +96,8 @@ func (k Keeper) Subtract(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coin
 	}
 
 	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.ReleasedCoins, coins)
+
 	return nil
 }
 
@@ -99,6 +117,29 @@ func (k Keeper) SendCoinsFromDepositToAccount(ctx sdk.Context, from, to sdk.AccA
 	}
 
 	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.ReleasedCoins, coins)
+
+	return nil
+}
+
+func (k Keeper) SendCoinsFromDepositToModule(ctx sdk.Context, from sdk.AccAddress, module string, coins sdk.Coins) sdk.Error {
+	deposit, found := k.GetDeposit(ctx, from)
+	if !found {
+		return types.ErrorDepositDoesNotExist()
+	}
+
+	deposit.Coins, _ = deposit.Coins.SafeSub(coins)
+	if deposit.Coins.IsAnyNegative() {
+		return types.ErrorInsufficientDepositFunds(deposit.Coins, coins)
+	}
+
+	if err := k.supply.SendCoinsFromModuleToModule(ctx, types.ModuleName, module, coins); err != nil {
+		return err
+	}
+
+	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.ReleasedCoins, coins)
+
 	return nil
 }
 
@@ -121,6 +162,55 @@ func (k Keeper) SendCoinsFromAccountToDeposit(ctx sdk.Context, from, to sdk.AccA
 	}
 
 	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.LockedCoins, coins)
+
+	return nil
+}
+
+func (k Keeper) SendCoinsFromModuleToDeposit(ctx sdk.Context, module string, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	if err := k.supply.SendCoinsFromModuleToModule(ctx, module, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	deposit, found := k.GetDeposit(ctx, to)
+	if !found {
+		deposit = types.Deposit{
+			Address: to,
+			Coins:   sdk.Coins{},
+		}
+	}
+
+	deposit.Coins = deposit.Coins.Add(coins)
+	k.SetDeposit(ctx, deposit)
+	addCoins(k.metrics.LockedCoins, coins)
+
+	return nil
+}
+
+func (k Keeper) SendCoinsFromDepositToDeposit(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	fromDeposit, found := k.GetDeposit(ctx, from)
+	if !found {
+		return types.ErrorDepositDoesNotExist()
+	}
+
+	fromDeposit.Coins, _ = fromDeposit.Coins.SafeSub(coins)
+	if fromDeposit.Coins.IsAnyNegative() {
+		return types.ErrorInsufficientDepositFunds(fromDeposit.Coins, coins)
+	}
+
+	k.SetDeposit(ctx, fromDeposit)
+
+	toDeposit, found := k.GetDeposit(ctx, to)
+	if !found {
+		toDeposit = types.Deposit{
+			Address: to,
+			Coins:   sdk.Coins{},
+		}
+	}
+
+	toDeposit.Coins = toDeposit.Coins.Add(coins)
+	k.SetDeposit(ctx, toDeposit)
+
 	return nil
 }
 
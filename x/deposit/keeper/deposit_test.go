@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sentinel-official/hub/x/deposit/types"
@@ -109,14 +111,14 @@ func TestKeeper_Add(t *testing.T) {
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
-	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	require.True(t, bk.GetCoins(ctx, types.TestAddress1).IsZero())
 
 	err = dk.Add(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	require.NotNil(t, err)
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}, deposit)
-	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	require.True(t, bk.GetCoins(ctx, types.TestAddress1).IsZero())
 
 	coinsPos2 := sdk.Coins{sdk.NewInt64Coin("stake", 10)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 10)})
 	depositPos2 := types.Deposit{Address: types.TestAddress1, Coins: coinsPos2}
@@ -140,7 +142,7 @@ func TestKeeper_Add(t *testing.T) {
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
 	require.Equal(t, depositPos3, deposit)
-	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress1))
+	require.True(t, bk.GetCoins(ctx, types.TestAddress1).IsZero())
 }
 
 func TestKeeper_Subtract(t *testing.T) {
@@ -349,3 +351,77 @@ func TestKeeper_ReceiveFromAccountToDeposit(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, coins)
 }
+
+func TestKeeper_SendCoinsFromDepositToDeposit(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+
+	err := dk.SendCoinsFromDepositToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.NotNil(t, err)
+
+	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
+	err = dk.SendCoinsFromDepositToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 20)})
+	require.NotNil(t, err)
+	_, found := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, false, found)
+
+	err = dk.SendCoinsFromDepositToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	require.Nil(t, err)
+	deposit, found := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 6)}, deposit.Coins)
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 4)}, deposit.Coins)
+
+	err = dk.SendCoinsFromDepositToDeposit(ctx, types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 6)})
+	require.Nil(t, err)
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.True(t, deposit.Coins.IsZero())
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, deposit.Coins)
+	require.True(t, bk.GetCoins(ctx, types.TestAddress2).IsZero())
+}
+
+func TestKeeper_SendCoinsFromDepositToModule(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	feeCollector := supply.NewModuleAddress(auth.FeeCollectorName)
+
+	err := dk.SendCoinsFromDepositToModule(ctx, types.TestAddress1, auth.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.NotNil(t, err)
+
+	_, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.Nil(t, err)
+	err = dk.SendCoinsFromAccountToDeposit(ctx, types.TestAddress1, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.Nil(t, err)
+
+	err = dk.SendCoinsFromDepositToModule(ctx, types.TestAddress1, auth.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("stake", 20)})
+	require.NotNil(t, err)
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, deposit.Coins)
+
+	err = dk.SendCoinsFromDepositToModule(ctx, types.TestAddress1, auth.FeeCollectorName, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	require.Nil(t, err)
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 6)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 4)}, bk.GetCoins(ctx, feeCollector))
+}
+
+func TestKeeper_SendCoinsFromModuleToDeposit(t *testing.T) {
+	ctx, dk, bk := CreateTestInput(t, false)
+	feeCollector := supply.NewModuleAddress(auth.FeeCollectorName)
+
+	err := dk.SendCoinsFromModuleToDeposit(ctx, auth.FeeCollectorName, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.NotNil(t, err)
+	_, found := dk.GetDeposit(ctx, types.TestAddress1)
+	require.False(t, found)
+
+	_, err = bk.AddCoins(ctx, feeCollector, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	require.Nil(t, err)
+
+	err = dk.SendCoinsFromModuleToDeposit(ctx, auth.FeeCollectorName, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	require.Nil(t, err)
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 4)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 6)}, bk.GetCoins(ctx, feeCollector))
+	require.True(t, bk.GetCoins(ctx, types.TestAddress1).IsZero())
+}
@@ -7,15 +7,17 @@ import (
 )
 
 type Keeper struct {
-	key    sdk.StoreKey
-	cdc    *codec.Codec
-	supply supply.Keeper
+	key     sdk.StoreKey
+	cdc     *codec.Codec
+	supply  supply.Keeper
+	metrics *Metrics
 }
 
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk supply.Keeper) Keeper {
 	return Keeper{
-		key:    key,
-		cdc:    cdc,
-		supply: sk,
+		key:     key,
+		cdc:     cdc,
+		supply:  sk,
+		metrics: NopMetrics(),
 	}
 }
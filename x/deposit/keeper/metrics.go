@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sentinel-official/hub/x/deposit/types"
+)
+
+// Metrics are the prometheus metrics of the deposit module, which are served on the
+// prometheus endpoint of the tendermint node when the instrumentation is enabled.
+type Metrics struct {
+	LockedCoins   metrics.Counter
+	ReleasedCoins metrics.Counter
+}
+
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		LockedCoins: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: types.ModuleName,
+			Name:      "locked_coins",
+			Help:      "Amount of the coins locked in the deposits.",
+		}, []string{"denom"}),
+		ReleasedCoins: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: types.ModuleName,
+			Name:      "released_coins",
+			Help:      "Amount of the coins released from the deposits.",
+		}, []string{"denom"}),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		LockedCoins:   discard.NewCounter(),
+		ReleasedCoins: discard.NewCounter(),
+	}
+}
+
+// WithMetrics returns the keeper reporting to the metrics, it has to be set before the
+// keeper is passed to the other keepers and the modules.
+func (k Keeper) WithMetrics(m *Metrics) Keeper {
+	k.metrics = m
+	return k
+}
+
+// addCoins adds the amounts of the coins to the counter, labeled by the denoms.
+func addCoins(counter metrics.Counter, coins sdk.Coins) {
+	for _, coin := range coins {
+		amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+		counter.With("denom", coin.Denom).Add(amount)
+	}
+}
@@ -38,7 +38,8 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, bank.Ke
 	blacklist := make(map[string]bool)
 	blacklist[depositAccount.String()] = true
 	accountPermissions := map[string][]string{
-		types.ModuleName: nil,
+		types.ModuleName:      nil,
+		auth.FeeCollectorName: nil,
 	}
 
 	cdc := MakeTestCodec()
@@ -27,10 +27,15 @@ func queryDepositOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keep
 	return res, nil
 }
 
-func queryAllDeposits(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
-	deposits := k.GetAllDeposits(ctx)
+func queryAllDeposits(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllDepositsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	deposits, page := k.PaginateDeposits(ctx, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(deposits)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryDepositsResponse(deposits, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/deposit/keeper"
 	"github.com/sentinel-official/hub/x/deposit/types"
 )
@@ -70,33 +71,66 @@ func Test_queryDepositOfAddress(t *testing.T) {
 func Test_queryAllDeposits(t *testing.T) {
 	ctx, dk, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDeposits),
+		Data: []byte{},
+	}
+
+	res, err := queryAllDeposits(ctx, req, dk)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(nil), res)
 
-	res, err := queryAllDeposits(ctx, dk)
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllDepositsParams(hub.NewPageRequest(nil, 0, true)))
+
+	res, err = queryAllDeposits(ctx, req, dk)
 	require.Nil(t, err)
-	require.Equal(t, []byte("null"), res)
 
-	var deposits []types.Deposit
-	cdc.MustUnmarshalJSON(res, &deposits)
-	require.NotEqual(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
+	var response types.QueryDepositsResponse
+	cdc.MustUnmarshalJSON(res, &response)
+	require.Len(t, response.Deposits, 0)
+	require.Equal(t, uint64(0), response.Pagination.Total)
 
 	dk.SetDeposit(ctx, types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}})
 
-	res, err = queryAllDeposits(ctx, dk)
+	res, err = queryAllDeposits(ctx, req, dk)
 	require.Nil(t, err)
-	require.NotEqual(t, []byte(nil), res)
 
-	cdc.MustUnmarshalJSON(res, &deposits)
-	require.Equal(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, deposits)
+	response = types.QueryDepositsResponse{}
+	cdc.MustUnmarshalJSON(res, &response)
+	require.Equal(t, []types.Deposit{{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}}, response.Deposits)
 
 	deposit := types.Deposit{types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}}
 	deposit.Address = types.TestAddress2
 	dk.SetDeposit(ctx, deposit)
 
-	res, err = queryAllDeposits(ctx, dk)
+	res, err = queryAllDeposits(ctx, req, dk)
 	require.Nil(t, err)
-	require.NotEqual(t, []byte(nil), res)
 
-	cdc.MustUnmarshalJSON(res, &deposits)
+	response = types.QueryDepositsResponse{}
+	cdc.MustUnmarshalJSON(res, &response)
+	require.Len(t, response.Deposits, 2)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+	require.Nil(t, response.Pagination.NextKey)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllDepositsParams(hub.NewPageRequest(nil, 1, false)))
+
+	res, err = queryAllDeposits(ctx, req, dk)
+	require.Nil(t, err)
+
+	response = types.QueryDepositsResponse{}
+	cdc.MustUnmarshalJSON(res, &response)
+	require.Len(t, response.Deposits, 1)
+	require.NotNil(t, response.Pagination.NextKey)
+
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAllDepositsParams(hub.NewPageRequest(response.Pagination.NextKey, 1, false)))
+
+	res, err = queryAllDeposits(ctx, req, dk)
 	require.Nil(t, err)
-	require.Len(t, deposits, 2)
+
+	first := response.Deposits[0]
+	response = types.QueryDepositsResponse{}
+	cdc.MustUnmarshalJSON(res, &response)
+	require.Len(t, response.Deposits, 1)
+	require.NotEqual(t, first.Address, response.Deposits[0].Address)
+	require.Nil(t, response.Pagination.NextKey)
 }
@@ -14,7 +14,7 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 		case types.QueryDepositOfAddress:
 			return queryDepositOfAddress(ctx, req, k)
 		case types.QueryAllDeposits:
-			return queryAllDeposits(ctx, k)
+			return queryAllDeposits(ctx, req, k)
 		default:
 			return nil, types.ErrorInvalidQueryType(path[0])
 		}
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
 )
 
 const (
@@ -18,3 +20,25 @@ func NewQueryDepositOfAddressParams(address sdk.AccAddress) QueryDepositOfAddres
 		Address: address,
 	}
 }
+
+type QueryAllDepositsParams struct {
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllDepositsParams(page hub.PageRequest) QueryAllDepositsParams {
+	return QueryAllDepositsParams{
+		Pagination: page,
+	}
+}
+
+type QueryDepositsResponse struct {
+	Deposits   []Deposit        `json:"deposits"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQueryDepositsResponse(deposits []Deposit, page hub.PageResponse) QueryDepositsResponse {
+	return QueryDepositsResponse{
+		Deposits:   deposits,
+		Pagination: page,
+	}
+}
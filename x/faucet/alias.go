@@ -0,0 +1,69 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/sentinel-official/hub/x/faucet/types/
+// ALIASGEN: github.com/sentinel-official/hub/x/faucet/keeper/
+// ALIASGEN: github.com/sentinel-official/hub/x/faucet/querier/
+package faucet
+
+import (
+	"github.com/sentinel-official/hub/x/faucet/keeper"
+	"github.com/sentinel-official/hub/x/faucet/querier"
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+const (
+	Codespace             = types.Codespace
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	RouterKey             = types.RouterKey
+	QuerierRoute          = types.QuerierRoute
+	QueryParams           = types.QueryParams
+	QueryWithdrawal       = types.QueryWithdrawal
+	EventTypeRequestCoins = types.EventTypeRequestCoins
+	AttributeKeyAddress   = types.AttributeKeyAddress
+	AttributeKeyAmount    = types.AttributeKeyAmount
+	DefaultParamspace     = keeper.DefaultParamspace
+)
+
+var (
+	// functions aliases
+	RegisterCodec            = types.RegisterCodec
+	ErrorMarshal             = types.ErrorMarshal
+	ErrorUnmarshal           = types.ErrorUnmarshal
+	ErrorUnknownMsgType      = types.ErrorUnknownMsgType
+	ErrorInvalidQueryType    = types.ErrorInvalidQueryType
+	ErrorInvalidField        = types.ErrorInvalidField
+	ErrorFaucetDisabled      = types.ErrorFaucetDisabled
+	ErrorLimitExceeded       = types.ErrorLimitExceeded
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	IsMainnetChainID         = types.IsMainnetChainID
+	WithdrawalKey            = types.WithdrawalKey
+	NewMsgRequestCoins       = types.NewMsgRequestCoins
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	NewQueryWithdrawalParams = types.NewQueryWithdrawalParams
+	NewWithdrawal            = types.NewWithdrawal
+	NewKeeper                = keeper.NewKeeper
+	ParamKeyTable            = keeper.ParamKeyTable
+	NewQuerier               = querier.NewQuerier
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	WithdrawalKeyPrefix  = types.WithdrawalKeyPrefix
+	MainnetChainIDPrefix = types.MainnetChainIDPrefix
+	DefaultLimit         = types.DefaultLimit
+	DefaultWindow        = types.DefaultWindow
+	KeyLimit             = types.KeyLimit
+	KeyWindow            = types.KeyWindow
+)
+
+type (
+	GenesisState          = types.GenesisState
+	MsgRequestCoins       = types.MsgRequestCoins
+	Params                = types.Params
+	QueryWithdrawalParams = types.QueryWithdrawalParams
+	Withdrawal            = types.Withdrawal
+	Keeper                = keeper.Keeper
+)
@@ -0,0 +1,44 @@
+package faucet
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+// NewAnteHandler wraps the given AnteHandler to let the new addresses request the test coins. The fees
+// of the transactions which only request the coins are waived, and the missing accounts of their signers
+// are created with the next account numbers, so that a new address signs with the next account number,
+// which is queried by the client, and the sequence zero.
+func NewAnteHandler(ante sdk.AnteHandler, ak auth.AccountKeeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok || !stdTx.Fee.Amount.IsZero() || !onlyRequestsCoins(stdTx.GetMsgs()) ||
+			types.IsMainnetChainID(ctx.ChainID()) {
+			return ante(ctx, tx, simulate)
+		}
+
+		for _, signer := range stdTx.GetSigners() {
+			if ak.GetAccount(ctx, signer) == nil {
+				ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, signer))
+			}
+		}
+
+		return ante(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+	}
+}
+
+func onlyRequestsCoins(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if _, ok := msg.(types.MsgRequestCoins); !ok {
+			return false
+		}
+	}
+
+	return true
+}
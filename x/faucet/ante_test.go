@@ -0,0 +1,55 @@
+package faucet
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/faucet/keeper"
+)
+
+func TestNewAnteHandler(t *testing.T) {
+	ctx, _, ak, _ := keeper.CreateTestInput(t, true)
+	ctx = ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))})
+
+	var waived bool
+	ante := NewAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		waived = ctx.MinGasPrices().IsZero()
+		return ctx, sdk.Result{}, false
+	}, ak)
+
+	msg := *NewMsgRequestCoins(testAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		return auth.NewStdTx(msgs, auth.NewStdFee(200000, fee), nil, "")
+	}
+
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, msg), false)
+	require.Equal(t, false, waived)
+	require.Nil(t, ak.GetAccount(ctx, testAddress1))
+
+	_, _, _ = ante(ctx.WithChainID(MainnetChainIDPrefix+"1"), newTx(nil, msg), false)
+	require.Equal(t, false, waived)
+	require.Nil(t, ak.GetAccount(ctx, testAddress1))
+
+	_, _, _ = ante(ctx, newTx(nil), false)
+	require.Equal(t, false, waived)
+
+	_, _, _ = ante(ctx, newTx(nil, msg), false)
+	require.Equal(t, true, waived)
+
+	account := ak.GetAccount(ctx, testAddress1)
+	require.NotNil(t, account)
+	require.Equal(t, uint64(0), account.GetAccountNumber())
+	require.Equal(t, uint64(0), account.GetSequence())
+
+	msg = *NewMsgRequestCoins(testAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	_, _, _ = ante(ctx, newTx(nil, msg), false)
+	require.Equal(t, true, waived)
+
+	account = ak.GetAccount(ctx, testAddress2)
+	require.NotNil(t, account)
+	require.Equal(t, uint64(1), account.GetAccountNumber())
+	require.Equal(t, uint64(0), account.GetSequence())
+}
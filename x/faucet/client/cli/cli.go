@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Querying commands for the faucet module",
+	}
+
+	cmd.AddCommand(client.GetCommands(
+		QueryParamsCmd(cdc),
+		QueryWithdrawalCmd(cdc),
+	)...)
+
+	return cmd
+}
+
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Faucet transactions subcommands",
+	}
+
+	cmd.AddCommand(client.PostCommands(
+		RequestCoinsTxCmd(cdc),
+	)...)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/faucet/client/common"
+)
+
+func QueryParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current parameters of the faucet module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := common.QueryParams(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(params)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryWithdrawalCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal [address]",
+		Short: "Query the coins requested by the address in the current window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			withdrawal, err := common.QueryWithdrawal(ctx, address)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(withdrawal)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/faucet/client/common"
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func RequestCoinsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-coins [amount]",
+		Short: "Request the test coins from the faucet",
+		Long: `Request the test coins from the faucet. The transaction of an address without an account is signed
+with the account number which the faucet assigns to the new account and the sequence zero, and is broadcast
+without the fees.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgRequestCoins(fromAddress, amount)
+			if _, err := auth.NewAccountRetriever(ctx).GetAccount(fromAddress); err == nil ||
+				ctx.GenerateOnly || ctx.Simulate {
+				return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+			}
+
+			number, err := common.QueryNextAccountNumber(ctx)
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := keys.GetPassphrase(ctx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txb.WithAccountNumber(number).WithSequence(0).
+				BuildAndSign(ctx.GetFromName(), passphrase, []sdk.Msg{msg})
+			if err != nil {
+				return err
+			}
+
+			res, err := ctx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintOutput(res)
+		},
+	}
+
+	return cmd
+}
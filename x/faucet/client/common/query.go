@@ -0,0 +1,68 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func QueryParams(ctx context.CLIContext) (*types.Params, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.Params
+	if err := ctx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// QueryNextAccountNumber returns the account number which the faucet assigns to the next new account.
+func QueryNextAccountNumber(ctx context.CLIContext) (uint64, error) {
+	res, _, err := ctx.QueryStore(auth.GlobalAccountNumberKey, auth.StoreKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var number uint64
+	if res != nil {
+		if err := ctx.Codec.UnmarshalBinaryLengthPrefixed(res, &number); err != nil {
+			return 0, err
+		}
+	}
+
+	return number, nil
+}
+
+func QueryWithdrawal(ctx context.CLIContext, address sdk.AccAddress) (*types.Withdrawal, error) {
+	params := types.NewQueryWithdrawalParams(address)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryWithdrawal)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no withdrawal found")
+	}
+
+	var withdrawal types.Withdrawal
+	if err := ctx.Codec.UnmarshalJSON(res, &withdrawal); err != nil {
+		return nil, err
+	}
+
+	return &withdrawal, nil
+}
@@ -0,0 +1,23 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(ctx, r)
+	registerQueryRoutes(ctx, r)
+}
+
+func registerTxRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/faucet/requests", requestCoinsHandlerFunc(ctx)).
+		Methods("POST")
+}
+
+func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/faucet/params", getParamsHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/faucet/withdrawals/{address}", getWithdrawalHandlerFunc(ctx)).
+		Methods("GET")
+}
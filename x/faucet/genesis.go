@@ -0,0 +1,42 @@
+package faucet
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, withdrawal := range data.Withdrawals {
+		k.SetWithdrawal(ctx, withdrawal)
+	}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetAllWithdrawals(ctx), k.GetParams(ctx))
+}
+
+func ValidateGenesis(data types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	withdrawalsMap := make(map[string]bool, len(data.Withdrawals))
+	for _, withdrawal := range data.Withdrawals {
+		if err := withdrawal.IsValid(); err != nil {
+			return err
+		}
+
+		if withdrawalsMap[withdrawal.Address.String()] {
+			return fmt.Errorf("duplicate address for the %s", withdrawal)
+		}
+
+		withdrawalsMap[withdrawal.Address.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package faucet
+
+import (
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/faucet/keeper"
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case types.MsgRequestCoins:
+			return handleRequestCoins(ctx, k, msg)
+		default:
+			return types.ErrorUnknownMsgType(reflect.TypeOf(msg).Name()).Result()
+		}
+	}
+}
+
+func handleRequestCoins(ctx sdk.Context, k keeper.Keeper, msg types.MsgRequestCoins) sdk.Result {
+	if types.IsMainnetChainID(ctx.ChainID()) {
+		return types.ErrorFaucetDisabled(ctx.ChainID()).Result()
+	}
+
+	withdrawal, found := k.GetWithdrawal(ctx, msg.From)
+	if !found || withdrawal.IsExpired(ctx.BlockTime(), k.Window(ctx)) {
+		withdrawal = types.NewWithdrawal(msg.From, sdk.Coins{}, ctx.BlockTime())
+	}
+
+	limit := k.Limit(ctx)
+	amount := withdrawal.Amount.Add(msg.Amount)
+	if !amount.IsAllLTE(limit) {
+		left, _ := limit.SafeSub(withdrawal.Amount)
+		return types.ErrorLimitExceeded(left).Result()
+	}
+
+	if err := k.SendCoins(ctx, msg.From, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	withdrawal.Amount = amount
+	k.SetWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRequestCoins,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.From.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,52 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/faucet/keeper"
+)
+
+var (
+	testAddress1 = sdk.AccAddress([]byte("address-1"))
+	testAddress2 = sdk.AccAddress([]byte("address-2"))
+)
+
+func Test_handleRequestCoins(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+	handler := NewHandler(k)
+
+	k.SetParams(ctx, NewParams(sdk.Coins{sdk.NewInt64Coin("stake", 100)}, time.Hour))
+
+	msg := NewMsgRequestCoins(testAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 60)})
+	res := handler(ctx.WithChainID(MainnetChainIDPrefix+"1"), *msg)
+	require.Equal(t, ErrorFaucetDisabled(MainnetChainIDPrefix+"1").Result(), res)
+
+	res = handler(ctx, *NewMsgRequestCoins(testAddress1, sdk.Coins{sdk.NewInt64Coin("coin", 60)}))
+	require.Equal(t, ErrorLimitExceeded(sdk.Coins{sdk.NewInt64Coin("stake", 100)}).Result(), res)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, bk.GetCoins(ctx, testAddress1))
+
+	res = handler(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), *msg)
+	require.Equal(t, ErrorLimitExceeded(sdk.Coins{sdk.NewInt64Coin("stake", 40)}).Result(), res)
+
+	res = handler(ctx, *NewMsgRequestCoins(testAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, testAddress2))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 120)}, bk.GetCoins(ctx, testAddress1))
+
+	withdrawal, found := k.GetWithdrawal(ctx, testAddress1)
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, withdrawal.Amount)
+	require.Equal(t, ctx.BlockTime(), withdrawal.Start)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+type Keeper struct {
+	key        sdk.StoreKey
+	cdc        *codec.Codec
+	paramStore params.Subspace
+	supply     supply.Keeper
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramStore params.Subspace, sk supply.Keeper) Keeper {
+	return Keeper{
+		key:        key,
+		cdc:        cdc,
+		paramStore: paramStore.WithKeyTable(ParamKeyTable()),
+		supply:     sk,
+	}
+}
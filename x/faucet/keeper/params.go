@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+const (
+	DefaultParamspace = types.ModuleName
+)
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+func (k Keeper) Limit(ctx sdk.Context) (res sdk.Coins) {
+	k.paramStore.Get(ctx, types.KeyLimit, &res)
+	return
+}
+
+func (k Keeper) Window(ctx sdk.Context) (res time.Duration) {
+	k.paramStore.Get(ctx, types.KeyWindow, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.Limit(ctx), k.Window(ctx))
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, auth.AccountKeeper, bank.Keeper) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	keyAccount := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyFaucet := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	mdb := db.NewMemDB()
+	ms := store.NewCommitMultiStore(mdb)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keyAccount, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keyFaucet, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, mdb)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	accountPermissions := map[string][]string{
+		types.ModuleName: {supply.Minter},
+	}
+
+	cdc := MakeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "chain-id"}, isCheckTx, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAccount, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, accountPermissions)
+	k := NewKeeper(cdc, keyFaucet, pk.Subspace(DefaultParamspace), sk)
+
+	sk.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
+	k.SetParams(ctx, types.DefaultParams())
+
+	return ctx, k, ak, bk
+}
+
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	return cdc
+}
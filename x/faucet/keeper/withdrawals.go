@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
+	key := types.WithdrawalKey(withdrawal.Address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(withdrawal)
+
+	store := ctx.KVStore(k.key)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetWithdrawal(ctx sdk.Context, address sdk.AccAddress) (withdrawal types.Withdrawal, found bool) {
+	store := ctx.KVStore(k.key)
+
+	key := types.WithdrawalKey(address)
+	value := store.Get(key)
+	if value == nil {
+		return withdrawal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &withdrawal)
+	return withdrawal, true
+}
+
+func (k Keeper) GetAllWithdrawals(ctx sdk.Context) (withdrawals []types.Withdrawal) {
+	store := ctx.KVStore(k.key)
+
+	iter := sdk.KVStorePrefixIterator(store, types.WithdrawalKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var withdrawal types.Withdrawal
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &withdrawal)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+
+	return withdrawals
+}
+
+// SendCoins mints the test coins to the module account and sends them to the address.
+func (k Keeper) SendCoins(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	if err := k.supply.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.supply.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins)
+}
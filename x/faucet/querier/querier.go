@@ -0,0 +1,52 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sentinel-official/hub/x/faucet/keeper"
+	"github.com/sentinel-official/hub/x/faucet/types"
+)
+
+func NewQuerier(k keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, k)
+		case types.QueryWithdrawal:
+			return queryWithdrawal(ctx, req, k)
+		default:
+			return nil, types.ErrorInvalidQueryType(path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(params)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryWithdrawal(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryWithdrawalParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	withdrawal, found := k.GetWithdrawal(ctx, params.Address)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(withdrawal)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	Codespace = sdk.CodespaceType("faucet")
+
+	errCodeUnknownMsgType   = 101
+	errCodeUnknownQueryType = 102
+	errCodeInvalidField     = 103
+	errCodeFaucetDisabled   = 104
+	errCodeLimitExceeded    = 105
+
+	errMsgUnknownMsgType   = "Unknown message type: "
+	errMsgUnknownQueryType = "Invalid query type: "
+	errMsgInvalidField     = "Invalid field: "
+	errMsgFaucetDisabled   = "Faucet is disabled on the chain "
+	errMsgLimitExceeded    = "Amount exceeds the limit of the window, left "
+)
+
+func ErrorMarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeMarshal, hub.ErrMsgMarshal)
+}
+
+func ErrorUnmarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeUnmarshal, hub.ErrMsgUnmarshal)
+}
+
+func ErrorUnknownMsgType(msgType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownMsgType, errMsgUnknownMsgType+msgType)
+}
+
+func ErrorInvalidQueryType(queryType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeUnknownQueryType, errMsgUnknownQueryType+queryType)
+}
+
+func ErrorInvalidField(field string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidField, errMsgInvalidField+field)
+}
+
+func ErrorFaucetDisabled(chainID string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeFaucetDisabled, errMsgFaucetDisabled+chainID)
+}
+
+func ErrorLimitExceeded(left sdk.Coins) sdk.Error {
+	return sdk.NewError(Codespace, errCodeLimitExceeded, errMsgLimitExceeded+left.String())
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	EventTypeRequestCoins = "request_coins"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyAmount  = "amount"
+)
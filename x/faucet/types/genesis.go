@@ -0,0 +1,17 @@
+package types
+
+type GenesisState struct {
+	Withdrawals []Withdrawal `json:"withdrawals"`
+	Params      Params       `json:"params"`
+}
+
+func NewGenesisState(withdrawals []Withdrawal, params Params) GenesisState {
+	return GenesisState{
+		Withdrawals: withdrawals,
+		Params:      params,
+	}
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil, DefaultParams())
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "faucet"
+	StoreKey     = ModuleName
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
+)
+
+var (
+	WithdrawalKeyPrefix = []byte{0x00}
+)
+
+// MainnetChainIDPrefix is the prefix of the chain IDs of the main network, the faucet never
+// hands out coins on these chains even if the module is enabled.
+var MainnetChainIDPrefix = "sentinelhub-"
+
+func IsMainnetChainID(chainID string) bool {
+	return strings.HasPrefix(chainID, MainnetChainIDPrefix)
+}
+
+func WithdrawalKey(address sdk.AccAddress) []byte {
+	return append(WithdrawalKeyPrefix, address.Bytes()...)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = (*MsgRequestCoins)(nil)
+
+// MsgRequestCoins requests the test coins from the faucet to the signer.
+type MsgRequestCoins struct {
+	From   sdk.AccAddress `json:"from"`
+	Amount sdk.Coins      `json:"amount"`
+}
+
+func (msg MsgRequestCoins) Type() string {
+	return "request_coins"
+}
+
+func (msg MsgRequestCoins) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Amount == nil || !msg.Amount.IsValid() {
+		return ErrorInvalidField("amount")
+	}
+
+	return nil
+}
+
+func (msg MsgRequestCoins) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgRequestCoins) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRequestCoins) Route() string {
+	return RouterKey
+}
+
+func NewMsgRequestCoins(from sdk.AccAddress, amount sdk.Coins) *MsgRequestCoins {
+	return &MsgRequestCoins{
+		From:   from,
+		Amount: amount,
+	}
+}
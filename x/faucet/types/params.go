@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+var (
+	DefaultLimit  = sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000)}
+	DefaultWindow = 24 * time.Hour
+)
+
+var (
+	KeyLimit  = []byte("Limit")
+	KeyWindow = []byte("Window")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params holds the coins an address can request from the faucet within each window.
+type Params struct {
+	Limit  sdk.Coins     `json:"limit"`
+	Window time.Duration `json:"window"`
+}
+
+func NewParams(limit sdk.Coins, window time.Duration) Params {
+	return Params{
+		Limit:  limit,
+		Window: window,
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params
+  Limit:  %s
+  Window: %s`, p.Limit, p.Window)
+}
+
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyLimit, Value: &p.Limit},
+		{Key: KeyWindow, Value: &p.Window},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		Limit:  DefaultLimit,
+		Window: DefaultWindow,
+	}
+}
+
+func (p Params) Validate() error {
+	if !p.Limit.IsValid() {
+		return fmt.Errorf("invalid limit")
+	}
+	if p.Window <= 0 {
+		return fmt.Errorf("invalid window")
+	}
+
+	return nil
+}
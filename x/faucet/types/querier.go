@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	QueryParams     = "params"
+	QueryWithdrawal = "withdrawal"
+)
+
+type QueryWithdrawalParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryWithdrawalParams(address sdk.AccAddress) QueryWithdrawalParams {
+	return QueryWithdrawalParams{
+		Address: address,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Withdrawal is the amount an address has requested from the faucet since the start of its window.
+type Withdrawal struct {
+	Address sdk.AccAddress `json:"address"`
+	Amount  sdk.Coins      `json:"amount"`
+	Start   time.Time      `json:"start"`
+}
+
+func NewWithdrawal(address sdk.AccAddress, amount sdk.Coins, start time.Time) Withdrawal {
+	return Withdrawal{
+		Address: address,
+		Amount:  amount,
+		Start:   start,
+	}
+}
+
+func (w Withdrawal) String() string {
+	return fmt.Sprintf(`Withdrawal
+  Address: %s
+  Amount:  %s
+  Start:   %s`, w.Address, w.Amount, w.Start)
+}
+
+func (w Withdrawal) IsValid() error {
+	if w.Address == nil || w.Address.Empty() {
+		return fmt.Errorf("invalid address")
+	}
+	if !w.Amount.IsValid() {
+		return fmt.Errorf("invalid amount")
+	}
+	if w.Start.IsZero() {
+		return fmt.Errorf("invalid start")
+	}
+
+	return nil
+}
+
+// IsExpired reports whether the window of the withdrawal has ended at the time.
+func (w Withdrawal) IsExpired(now time.Time, window time.Duration) bool {
+	return !now.Before(w.Start.Add(window))
+}
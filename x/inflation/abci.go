@@ -0,0 +1,10 @@
+package inflation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BeginBlock(ctx sdk.Context, k Keeper) {
+	k.BurnFees(ctx)
+	k.MintBlockProvision(ctx)
+}
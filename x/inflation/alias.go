@@ -0,0 +1,56 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/sentinel-official/hub/x/inflation/types/
+// ALIASGEN: github.com/sentinel-official/hub/x/inflation/keeper/
+// ALIASGEN: github.com/sentinel-official/hub/x/inflation/querier/
+package inflation
+
+import (
+	"github.com/sentinel-official/hub/x/inflation/keeper"
+	"github.com/sentinel-official/hub/x/inflation/querier"
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+const (
+	Codespace                    = types.Codespace
+	ModuleName                   = types.ModuleName
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryParams                  = types.QueryParams
+	EventTypeMint                = types.EventTypeMint
+	AttributeKeyBondedRatio      = types.AttributeKeyBondedRatio
+	AttributeKeyInflation        = types.AttributeKeyInflation
+	AttributeKeyAnnualProvisions = types.AttributeKeyAnnualProvisions
+	DefaultParamspace            = keeper.DefaultParamspace
+	EventTypeBurnFees            = types.EventTypeBurnFees
+)
+
+var (
+	// functions aliases
+	ErrorMarshal          = types.ErrorMarshal
+	ErrorInvalidQueryType = types.ErrorInvalidQueryType
+	NewGenesisState       = types.NewGenesisState
+	DefaultGenesisState   = types.DefaultGenesisState
+	NewParams             = types.NewParams
+	DefaultParams         = types.DefaultParams
+	NewPhase              = types.NewPhase
+	NewKeeper             = keeper.NewKeeper
+	ParamKeyTable         = keeper.ParamKeyTable
+	NewQuerier            = querier.NewQuerier
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	DefaultPhases      = types.DefaultPhases
+	KeyPhases          = types.KeyPhases
+	DefaultFeeBurnRate = types.DefaultFeeBurnRate
+	KeyFeeBurnRate     = types.KeyFeeBurnRate
+)
+
+type (
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	Phase        = types.Phase
+	Keeper       = keeper.Keeper
+	MintHooks    = types.MintHooks
+)
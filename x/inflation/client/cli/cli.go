@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Querying commands for the inflation module",
+	}
+
+	cmd.AddCommand(client.GetCommands(
+		QueryParamsCmd(cdc),
+	)...)
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func QueryParams(ctx context.CLIContext) (*types.Params, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.Params
+	if err := ctx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
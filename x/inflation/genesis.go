@@ -0,0 +1,19 @@
+package inflation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
+
+func ValidateGenesis(data types.GenesisState) error {
+	return data.Params.Validate()
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+// BurnFees burns the fee burn rate of the fees in the fee collector, it is called before the
+// provision of the block is minted so that only the fees of the previous block are burned.
+func (k Keeper) BurnFees(ctx sdk.Context) {
+	rate := k.FeeBurnRate(ctx)
+	if !rate.IsPositive() {
+		return
+	}
+
+	fees := k.supply.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins()
+
+	burned := sdk.Coins{}
+	for _, coin := range fees {
+		amount := rate.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			burned = burned.Add(sdk.Coins{sdk.NewCoin(coin.Denom, amount)})
+		}
+	}
+
+	if !burned.Empty() {
+		if err := k.supply.SendCoinsFromModuleToModule(ctx, auth.FeeCollectorName, types.ModuleName, burned); err != nil {
+			panic(err)
+		}
+		if err := k.supply.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnFees,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+	))
+}
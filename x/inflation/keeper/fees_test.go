@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func TestKeeper_BurnFees(t *testing.T) {
+	ctx, k, sk, bk := CreateTestInput(t, false)
+	feeCollector := supply.NewModuleAddress(auth.FeeCollectorName)
+	fees := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	sk.GetModuleAccount(ctx, auth.FeeCollectorName)
+	_, err := bk.AddCoins(ctx, feeCollector, fees)
+	require.Nil(t, err)
+	sk.SetSupply(ctx, supply.NewSupply(fees))
+
+	k.BurnFees(ctx)
+	require.Equal(t, fees, bk.GetCoins(ctx, feeCollector))
+	require.Len(t, ctx.EventManager().Events(), 0)
+
+	params := k.GetParams(ctx)
+	params.FeeBurnRate = sdk.NewDecWithPrec(25, 2)
+	k.SetParams(ctx, params)
+
+	k.BurnFees(ctx)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 75)}, bk.GetCoins(ctx, feeCollector))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 75)}, sk.GetSupply(ctx).GetTotal())
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, types.EventTypeBurnFees, events[len(events)-1].Type)
+	require.Equal(t, "25stake", string(events[len(events)-1].Attributes[0].Value))
+}
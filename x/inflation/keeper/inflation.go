@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+// NextInflationRate returns the inflation of the phase at the block height, the inflation
+// is adjusted to the bonded ratio as by the mint module before the first phase.
+func (k Keeper) NextInflationRate(ctx sdk.Context, minter mint.Minter, params mint.Params, bondedRatio sdk.Dec) sdk.Dec {
+	phase, found := k.GetParams(ctx).PhaseAt(ctx.BlockHeight())
+	if !found {
+		return minter.NextInflationRate(params, bondedRatio)
+	}
+
+	return phase.InflationAt(ctx.BlockHeight(), params.BlocksPerYear)
+}
+
+// MintBlockProvision mints the provision of the block to the fee collector with the inflation
+// of the emission curve, it replaces the begin blocker of the mint module.
+func (k Keeper) MintBlockProvision(ctx sdk.Context) {
+	minter := k.mint.GetMinter(ctx)
+	params := k.mint.GetParams(ctx)
+
+	totalStakingSupply := k.mint.StakingTokenSupply(ctx)
+	bondedRatio := k.mint.BondedRatio(ctx)
+	minter.Inflation = k.NextInflationRate(ctx, minter, params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	k.mint.SetMinter(ctx, minter)
+
+	mintedCoin := minter.BlockProvision(params)
+	mintedCoins := sdk.NewCoins(mintedCoin)
+
+	if err := k.mint.MintCoins(ctx, mintedCoins); err != nil {
+		panic(err)
+	}
+	if err := k.mint.AddCollectedFees(ctx, mintedCoins); err != nil {
+		panic(err)
+	}
+	if k.hooks != nil {
+		k.hooks.AfterMintBlockProvision(ctx, mintedCoins)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+		sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+		sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+	))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+type Keeper struct {
+	paramStore params.Subspace
+	mint       mint.Keeper
+	supply     supply.Keeper
+	hooks      types.MintHooks
+}
+
+func NewKeeper(paramStore params.Subspace, mk mint.Keeper, sk supply.Keeper) Keeper {
+	return Keeper{
+		paramStore: paramStore.WithKeyTable(ParamKeyTable()),
+		mint:       mk,
+		supply:     sk,
+	}
+}
+
+// WithHooks returns the keeper which calls the hooks after minting the provision of each block.
+func (k Keeper) WithHooks(h types.MintHooks) Keeper {
+	k.hooks = h
+	return k
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+const (
+	DefaultParamspace = types.ModuleName
+)
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+func (k Keeper) Phases(ctx sdk.Context) (res []types.Phase) {
+	k.paramStore.GetIfExists(ctx, types.KeyPhases, &res)
+	return
+}
+
+func (k Keeper) FeeBurnRate(ctx sdk.Context) sdk.Dec {
+	res := sdk.ZeroDec()
+	k.paramStore.GetIfExists(ctx, types.KeyFeeBurnRate, &res)
+	return res
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.Phases(ctx), k.FeeBurnRate(ctx))
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, supply.Keeper, bank.Keeper) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	keyAccount := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	mdb := db.NewMemDB()
+	ms := store.NewCommitMultiStore(mdb)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keyAccount, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, mdb)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	accountPermissions := map[string][]string{
+		auth.FeeCollectorName: nil,
+		mint.ModuleName:       {supply.Minter},
+		types.ModuleName:      {supply.Burner},
+	}
+
+	cdc := MakeTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "chain-id"}, isCheckTx, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAccount, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, accountPermissions)
+	mk := mint.NewKeeper(cdc, keyMint, pk.Subspace(mint.DefaultParamspace), nil, sk, auth.FeeCollectorName)
+	k := NewKeeper(pk.Subspace(DefaultParamspace), mk, sk)
+
+	sk.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
+	k.SetParams(ctx, types.DefaultParams())
+
+	return ctx, k, sk, bk
+}
+
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	return cdc
+}
@@ -0,0 +1,31 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sentinel-official/hub/x/inflation/keeper"
+	"github.com/sentinel-official/hub/x/inflation/types"
+)
+
+func NewQuerier(k keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, k)
+		default:
+			return nil, types.ErrorInvalidQueryType(path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(params)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
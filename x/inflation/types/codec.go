@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	ModuleCdc *codec.Codec
+)
+
+func init() {
+	ModuleCdc = codec.New()
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
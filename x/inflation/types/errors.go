@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	Codespace = sdk.CodespaceType("inflation")
+
+	errCodeInvalidQueryType = 101
+
+	errMsgInvalidQueryType = "invalid query type: %s"
+)
+
+func ErrorMarshal() sdk.Error {
+	return sdk.NewError(Codespace, hub.ErrCodeMarshal, hub.ErrMsgMarshal)
+}
+
+func ErrorInvalidQueryType(queryType string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidQueryType, fmt.Sprintf(errMsgInvalidQueryType, queryType))
+}
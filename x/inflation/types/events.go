@@ -0,0 +1,15 @@
+package types
+
+// The mint event has the type and the attributes of the event of the mint module,
+// so that the indexers keep working with the custom inflation.
+const (
+	EventTypeMint = "mint"
+
+	AttributeKeyBondedRatio      = "bonded_ratio"
+	AttributeKeyInflation        = "inflation"
+	AttributeKeyAnnualProvisions = "annual_provisions"
+)
+
+const (
+	EventTypeBurnFees = "burn_fees"
+)
@@ -0,0 +1,15 @@
+package types
+
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams())
+}
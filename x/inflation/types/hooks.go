@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintHooks are called after the provision of a block is minted to the fee collector,
+// before it is allocated by the distribution module.
+type MintHooks interface {
+	AfterMintBlockProvision(ctx sdk.Context, minted sdk.Coins)
+}
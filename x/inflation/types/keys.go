@@ -0,0 +1,7 @@
+package types
+
+const (
+	ModuleName   = "inflation"
+	RouterKey    = ModuleName
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+var (
+	DefaultPhases      []Phase
+	DefaultFeeBurnRate = sdk.ZeroDec()
+)
+
+var (
+	KeyPhases      = []byte("Phases")
+	KeyFeeBurnRate = []byte("FeeBurnRate")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params holds the phases of the emission curve in the order of the start heights. Before the
+// first phase the inflation is calculated by the mint module from the bonded ratio. The fee burn
+// rate is the part of the transaction fees which is burned instead of being distributed.
+type Params struct {
+	Phases      []Phase `json:"phases"`
+	FeeBurnRate sdk.Dec `json:"fee_burn_rate"`
+}
+
+func NewParams(phases []Phase, feeBurnRate sdk.Dec) Params {
+	return Params{
+		Phases:      phases,
+		FeeBurnRate: feeBurnRate,
+	}
+}
+
+func (p Params) String() string {
+	phases := make([]string, 0, len(p.Phases))
+	for _, phase := range p.Phases {
+		phases = append(phases, phase.String())
+	}
+
+	return fmt.Sprintf(`Params
+  Phases:        %s
+  Fee Burn Rate: %s`, strings.Join(phases, "\n"), p.FeeBurnRate)
+}
+
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyPhases, Value: &p.Phases},
+		{Key: KeyFeeBurnRate, Value: &p.FeeBurnRate},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		Phases:      DefaultPhases,
+		FeeBurnRate: DefaultFeeBurnRate,
+	}
+}
+
+func (p Params) Validate() error {
+	for i, phase := range p.Phases {
+		if err := phase.IsValid(); err != nil {
+			return fmt.Errorf("%s for the phase %d", err.Error(), i)
+		}
+		if i > 0 && phase.StartHeight <= p.Phases[i-1].StartHeight {
+			return fmt.Errorf("phase %d should start after the phase %d", i, i-1)
+		}
+	}
+
+	if p.FeeBurnRate.IsNil() || p.FeeBurnRate.IsNegative() || p.FeeBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid fee burn rate")
+	}
+
+	return nil
+}
+
+// PhaseAt returns the last phase which has started at the height.
+func (p Params) PhaseAt(height int64) (Phase, bool) {
+	for i := len(p.Phases) - 1; i >= 0; i-- {
+		if p.Phases[i].StartHeight <= height {
+			return p.Phases[i], true
+		}
+	}
+
+	return Phase{}, false
+}
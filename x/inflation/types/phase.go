@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Phase is a part of the emission curve which starts at the height with the inflation,
+// the inflation decays by the rate every year of blocks down to the min inflation.
+type Phase struct {
+	StartHeight  int64   `json:"start_height"`
+	Inflation    sdk.Dec `json:"inflation"`
+	DecayRate    sdk.Dec `json:"decay_rate"`
+	MinInflation sdk.Dec `json:"min_inflation"`
+}
+
+func NewPhase(startHeight int64, inflation, decayRate, minInflation sdk.Dec) Phase {
+	return Phase{
+		StartHeight:  startHeight,
+		Inflation:    inflation,
+		DecayRate:    decayRate,
+		MinInflation: minInflation,
+	}
+}
+
+func (p Phase) String() string {
+	return fmt.Sprintf(`Phase
+  Start Height:  %d
+  Inflation:     %s
+  Decay Rate:    %s
+  Min Inflation: %s`, p.StartHeight, p.Inflation, p.DecayRate, p.MinInflation)
+}
+
+func (p Phase) IsValid() error {
+	if p.StartHeight < 0 {
+		return fmt.Errorf("invalid start height")
+	}
+	if p.Inflation.IsNil() || p.Inflation.IsNegative() || p.Inflation.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid inflation")
+	}
+	if p.DecayRate.IsNil() || p.DecayRate.IsNegative() || p.DecayRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid decay rate")
+	}
+	if p.MinInflation.IsNil() || p.MinInflation.IsNegative() || p.MinInflation.GT(p.Inflation) {
+		return fmt.Errorf("invalid min inflation")
+	}
+
+	return nil
+}
+
+// InflationAt returns the inflation of the phase at the height, the height should not be
+// less than the start height of the phase.
+func (p Phase) InflationAt(height int64, blocksPerYear uint64) sdk.Dec {
+	if blocksPerYear == 0 {
+		return p.Inflation
+	}
+
+	years := uint64(height-p.StartHeight) / blocksPerYear
+	inflation := p.Inflation.Mul(power(sdk.OneDec().Sub(p.DecayRate), years))
+	if inflation.LT(p.MinInflation) {
+		return p.MinInflation
+	}
+
+	return inflation
+}
+
+// power returns the base raised to the exponent, the base is squared for every bit of the exponent
+// so that the number of the multiplications is logarithmic in the exponent.
+func power(base sdk.Dec, exponent uint64) sdk.Dec {
+	res := sdk.OneDec()
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			res = res.Mul(base)
+		}
+
+		base = base.Mul(base)
+	}
+
+	return res
+}
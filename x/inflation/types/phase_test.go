@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhase_IsValid(t *testing.T) {
+	phase := NewPhase(10, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2))
+	require.Nil(t, phase.IsValid())
+
+	invalid := phase
+	invalid.StartHeight = -1
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = phase
+	invalid.Inflation = sdk.NewDec(2)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = phase
+	invalid.DecayRate = sdk.NewDec(-1)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = phase
+	invalid.MinInflation = sdk.NewDecWithPrec(30, 2)
+	require.NotNil(t, invalid.IsValid())
+}
+
+func TestPhase_InflationAt(t *testing.T) {
+	phase := NewPhase(10, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(4, 2))
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), phase.InflationAt(10, 100))
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), phase.InflationAt(109, 100))
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), phase.InflationAt(110, 100))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), phase.InflationAt(210, 100))
+	require.Equal(t, sdk.NewDecWithPrec(4, 2), phase.InflationAt(310, 100))
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), phase.InflationAt(310, 0))
+}
+
+func TestParams_Validate(t *testing.T) {
+	phase1 := NewPhase(0, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2))
+	phase2 := NewPhase(100, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec(), sdk.ZeroDec())
+
+	require.Nil(t, DefaultParams().Validate())
+	require.Nil(t, NewParams([]Phase{phase1, phase2}, sdk.ZeroDec()).Validate())
+	require.NotNil(t, NewParams([]Phase{phase2, phase1}, sdk.ZeroDec()).Validate())
+	require.NotNil(t, NewParams([]Phase{phase1, phase1}, sdk.ZeroDec()).Validate())
+	require.Nil(t, NewParams(nil, sdk.OneDec()).Validate())
+	require.NotNil(t, NewParams(nil, sdk.NewDec(2)).Validate())
+	require.NotNil(t, NewParams(nil, sdk.Dec{}).Validate())
+}
+
+func TestParams_PhaseAt(t *testing.T) {
+	phase1 := NewPhase(10, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(5, 2))
+	phase2 := NewPhase(100, sdk.NewDecWithPrec(10, 2), sdk.ZeroDec(), sdk.ZeroDec())
+	params := NewParams([]Phase{phase1, phase2}, sdk.ZeroDec())
+
+	_, found := params.PhaseAt(9)
+	require.False(t, found)
+
+	phase, found := params.PhaseAt(10)
+	require.True(t, found)
+	require.Equal(t, phase1, phase)
+
+	phase, found = params.PhaseAt(1000)
+	require.True(t, found)
+	require.Equal(t, phase2, phase)
+}
+
+func TestPower(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     sdk.Dec
+		exponent uint64
+		want     sdk.Dec
+	}{
+		{"zero exponent", sdk.NewDecWithPrec(5, 1), 0, sdk.OneDec()},
+		{"zero base and zero exponent", sdk.ZeroDec(), 0, sdk.OneDec()},
+		{"zero base", sdk.ZeroDec(), 3, sdk.ZeroDec()},
+		{"one base and large exponent", sdk.OneDec(), 1 << 40, sdk.OneDec()},
+		{"one exponent", sdk.NewDecWithPrec(5, 1), 1, sdk.NewDecWithPrec(5, 1)},
+		{"odd exponent", sdk.NewDecWithPrec(5, 1), 3, sdk.NewDecWithPrec(125, 3)},
+		{"even exponent", sdk.NewDecWithPrec(9, 1), 2, sdk.NewDecWithPrec(81, 2)},
+		{"fractional base", sdk.NewDecWithPrec(9, 1), 5, sdk.NewDecWithPrec(59049, 5)},
+		{"integral base", sdk.NewDec(2), 10, sdk.NewDec(1024)},
+		{"integral base and odd exponent", sdk.NewDec(3), 13, sdk.NewDec(1594323)},
+		{"smallest precision", sdk.NewDecWithPrec(1, 1), 18, sdk.NewDecWithPrec(1, 18)},
+		{"below precision", sdk.NewDecWithPrec(1, 1), 19, sdk.ZeroDec()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := power(tc.base, tc.exponent)
+			require.True(t, tc.want.Equal(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
@@ -25,6 +25,9 @@ const (
 	StatusInactive                   = types.StatusInactive
 	StatusDeRegistered               = types.StatusDeRegistered
 	QueryNode                        = types.QueryNode
+	QueryNodeStats                   = types.QueryNodeStats
+	QueryTopNodes                    = types.QueryTopNodes
+	QueryNodeUptime                  = types.QueryNodeUptime
 	QueryNodesOfAddress              = types.QueryNodesOfAddress
 	QueryAllNodes                    = types.QueryAllNodes
 	QuerySubscription                = types.QuerySubscription
@@ -32,11 +35,127 @@ const (
 	QuerySubscriptionsOfAddress      = types.QuerySubscriptionsOfAddress
 	QueryAllSubscriptions            = types.QueryAllSubscriptions
 	QuerySessionsCountOfSubscription = types.QuerySessionsCountOfSubscription
+	QuerySessionIndexOfAddress       = types.QuerySessionIndexOfAddress
 	QuerySession                     = types.QuerySession
 	QuerySessionOfSubscription       = types.QuerySessionOfSubscription
 	QuerySessionsOfSubscription      = types.QuerySessionsOfSubscription
 	QueryAllSessions                 = types.QueryAllSessions
+	QueryActiveSessionsOfAddress     = types.QueryActiveSessionsOfAddress
 	DefaultParamspace                = keeper.DefaultParamspace
+	EventTypePruneNodeHistory        = types.EventTypePruneNodeHistory
+	AttributeKeyNodeID               = types.AttributeKeyNodeID
+	AttributeKeyCount                = types.AttributeKeyCount
+	MaxMetadataURILength             = types.MaxMetadataURILength
+	MetadataHashLength               = types.MetadataHashLength
+	QueryParams                      = types.QueryParams
+	MaxMinClientVersionLength        = types.MaxMinClientVersionLength
+	MaxMaintenanceBannerLength       = types.MaxMaintenanceBannerLength
+	MaxPruneGasRefund                = types.MaxPruneGasRefund
+	QueryAllowedAddressesOfNode      = types.QueryAllowedAddressesOfNode
+	QueryBlacklistedClientsOfNode    = types.QueryBlacklistedClientsOfNode
+	QuerySeatsOfSubscription         = types.QuerySeatsOfSubscription
+	MaxSubscriptionSeats             = types.MaxSubscriptionSeats
+	EventTypeSettleSession           = types.EventTypeSettleSession
+	AttributeKeySessionID            = types.AttributeKeySessionID
+	AttributeKeyAmount               = types.AttributeKeyAmount
+	AttributeKeyFinal                = types.AttributeKeyFinal
+	MaxPayoutRoutes                  = types.MaxPayoutRoutes
+	RankByBandwidth                  = types.RankByBandwidth
+	RankByEarnings                   = types.RankByEarnings
+	LatencyZoneAfrica                = types.LatencyZoneAfrica
+	LatencyZoneAntarctica            = types.LatencyZoneAntarctica
+	LatencyZoneAsia                  = types.LatencyZoneAsia
+	LatencyZoneEurope                = types.LatencyZoneEurope
+	LatencyZoneNorthAmerica          = types.LatencyZoneNorthAmerica
+	LatencyZoneOceania               = types.LatencyZoneOceania
+	LatencyZoneSouthAmerica          = types.LatencyZoneSouthAmerica
+	SortNodesByPriceAsc              = types.SortNodesByPriceAsc
+	SortNodesByPriceDesc             = types.SortNodesByPriceDesc
+	SortNodesByBandwidthAsc          = types.SortNodesByBandwidthAsc
+	SortNodesByBandwidthDesc         = types.SortNodesByBandwidthDesc
+	SortNodesByUptimeAsc             = types.SortNodesByUptimeAsc
+	SortNodesByUptimeDesc            = types.SortNodesByUptimeDesc
+	SortNodesByReputationAsc         = types.SortNodesByReputationAsc
+	SortNodesByReputationDesc        = types.SortNodesByReputationDesc
+	DefaultTopNodesWindow            = types.DefaultTopNodesWindow
+	DefaultTopNodesLimit             = types.DefaultTopNodesLimit
+	MaxTopNodesLimit                 = types.MaxTopNodesLimit
+	EventTypePayoutNode              = types.EventTypePayoutNode
+	AttributeKeyAddress              = types.AttributeKeyAddress
+	MaxQuoteLifetime                 = types.MaxQuoteLifetime
+	MaxSessionInfoUpdates            = types.MaxSessionInfoUpdates
+	EventTypeUpdateSessionInfo       = types.EventTypeUpdateSessionInfo
+	AttributeKeySubscriptionID       = types.AttributeKeySubscriptionID
+	AttributeKeySuccess              = types.AttributeKeySuccess
+	AttributeKeyCode                 = types.AttributeKeyCode
+	QueryProtocolFees                = types.QueryProtocolFees
+	AttributeKeyFee                  = types.AttributeKeyFee
+	EventTypeCompactEvents           = types.EventTypeCompactEvents
+	AttributeKeyVersion              = types.AttributeKeyVersion
+	AttributeKeyData                 = types.AttributeKeyData
+	QuerySubscriptionForecast        = types.QuerySubscriptionForecast
+	DefaultForecastBlocks            = types.DefaultForecastBlocks
+	PubKeyTypeEd25519                = types.PubKeyTypeEd25519
+	PubKeyTypeSecp256k1              = types.PubKeyTypeSecp256k1
+	EventTypeGCSubscription          = types.EventTypeGCSubscription
+	EventTypeEndSession              = types.EventTypeEndSession
+	AttributeKeyReason               = types.AttributeKeyReason
+	AttributeValueTimeout            = types.AttributeValueTimeout
+	EventTypeEndSubscription         = types.EventTypeEndSubscription
+	AttributeValueEndSubscription    = types.AttributeValueEndSubscription
+	ProposalTypeReleaseEscrow        = types.ProposalTypeReleaseEscrow
+	ProposalTypeJailNode             = types.ProposalTypeJailNode
+	EventTypeReleaseEscrow           = types.EventTypeReleaseEscrow
+	AttributeValueReleaseEscrow      = types.AttributeValueReleaseEscrow
+	AttributeValueExport             = types.AttributeValueExport
+	EventTypePauseSubscription       = types.EventTypePauseSubscription
+	EventTypeResumeSubscription      = types.EventTypeResumeSubscription
+	EventTypeTopUpSubscription       = types.EventTypeTopUpSubscription
+	EventTypeJailNode                = types.EventTypeJailNode
+	EventTypeUnjailNode              = types.EventTypeUnjailNode
+	EventTypeUpdateNodeStatus        = types.EventTypeUpdateNodeStatus
+	QueryDepositOfAddress            = types.QueryDepositOfAddress
+	QueryNetworkSummary              = types.QueryNetworkSummary
+	RevenueRoleNode                  = types.RevenueRoleNode
+	RevenueRoleProvider              = types.RevenueRoleProvider
+	RevenueRoleResolver              = types.RevenueRoleResolver
+	EventTypeSplitRevenue            = types.EventTypeSplitRevenue
+	AttributeKeyRole                 = types.AttributeKeyRole
+	QueryReferralEarnings            = types.QueryReferralEarnings
+	EventTypeReferralReward          = types.EventTypeReferralReward
+	QueryDiscountPlan                = types.QueryDiscountPlan
+	QueryNodeByMoniker               = types.QueryNodeByMoniker
+	NodeCategoryOpenVPN              = types.NodeCategoryOpenVPN
+	NodeCategoryWireGuard            = types.NodeCategoryWireGuard
+	NodeCategoryV2Ray                = types.NodeCategoryV2Ray
+	EventTypeLockDeposit             = types.EventTypeLockDeposit
+	EventTypeReleaseDeposit          = types.EventTypeReleaseDeposit
+	EventTypeSendDeposit             = types.EventTypeSendDeposit
+	AttributeKeyRecipient            = types.AttributeKeyRecipient
+	AttributeKeyStatus               = types.AttributeKeyStatus
+	QueryFeeAllowance                = types.QueryFeeAllowance
+	QueryAuthorizations              = types.QueryAuthorizations
+	MaxExecMsgs                      = types.MaxExecMsgs
+	QoSSummaryWindow                 = types.QoSSummaryWindow
+	MaxQoSLatency                    = types.MaxQoSLatency
+	QueryNodeQoS                     = types.QueryNodeQoS
+	EventTypeSubmitQoSReport         = types.EventTypeSubmitQoSReport
+	QueryNodeReputation              = types.QueryNodeReputation
+	MinRating                        = types.MinRating
+	MaxRating                        = types.MaxRating
+	EventTypeRateNode                = types.EventTypeRateNode
+	DisputePartyClient               = types.DisputePartyClient
+	DisputePartyNode                 = types.DisputePartyNode
+	DisputeStatusOpen                = types.DisputeStatusOpen
+	DisputeStatusUpheld              = types.DisputeStatusUpheld
+	DisputeStatusRejected            = types.DisputeStatusRejected
+	QueryDispute                     = types.QueryDispute
+	QueryAllDisputes                 = types.QueryAllDisputes
+	ProposalTypeResolveDispute       = types.ProposalTypeResolveDispute
+	EventTypeOpenDispute             = types.EventTypeOpenDispute
+	EventTypeResolveDispute          = types.EventTypeResolveDispute
+	AttributeValueEvidence           = types.AttributeValueEvidence
+	AttributeValueGovernance         = types.AttributeValueGovernance
 )
 
 var (
@@ -84,6 +203,7 @@ var (
 	NewQuerySubscriptionsOfNodePrams          = types.NewQuerySubscriptionsOfNodePrams
 	NewQuerySubscriptionsOfAddressParams      = types.NewQuerySubscriptionsOfAddressParams
 	NewQuerySessionsCountOfSubscriptionParams = types.NewQuerySessionsCountOfSubscriptionParams
+	NewQuerySessionIndexOfAddressParams       = types.NewQuerySessionIndexOfAddressParams
 	NewQuerySessionParams                     = types.NewQuerySessionParams
 	NewQuerySessionOfSubscriptionPrams        = types.NewQuerySessionOfSubscriptionPrams
 	NewQuerySessionsOfSubscriptionPrams       = types.NewQuerySessionsOfSubscriptionPrams
@@ -96,6 +216,195 @@ var (
 	RandomNode                                = keeper.RandomNode
 	RandomSubscription                        = keeper.RandomSubscription
 	RandomSession                             = keeper.RandomSession
+	NewMsgPruneNodeHistory                    = types.NewMsgPruneNodeHistory
+	ValidateMetadata                          = types.ValidateMetadata
+	NewNodeMetadata                           = types.NewNodeMetadata
+	ErrorAddressNotAllowed                    = types.ErrorAddressNotAllowed
+	AllowedAddressesOfNodeKey                 = types.AllowedAddressesOfNodeKey
+	AllowedAddressKey                         = types.AllowedAddressKey
+	NewAllowedAddress                         = types.NewAllowedAddress
+	NewMsgSetNodePrivate                      = types.NewMsgSetNodePrivate
+	NewMsgSetNodeLocation                     = types.NewMsgSetNodeLocation
+	NewNodeLocation                           = types.NewNodeLocation
+	IsValidLatencyZone                        = types.IsValidLatencyZone
+	NewMsgAddAllowedAddress                   = types.NewMsgAddAllowedAddress
+	NewMsgRemoveAllowedAddress                = types.NewMsgRemoveAllowedAddress
+	ErrorClientBlacklisted                    = types.ErrorClientBlacklisted
+	ErrorClientNotBlacklisted                 = types.ErrorClientNotBlacklisted
+	BlacklistedClientsOfNodeKey               = types.BlacklistedClientsOfNodeKey
+	BlacklistedClientKey                      = types.BlacklistedClientKey
+	NewBlacklistedClient                      = types.NewBlacklistedClient
+	NewMsgBlacklistClient                     = types.NewMsgBlacklistClient
+	NewMsgUnblacklistClient                   = types.NewMsgUnblacklistClient
+	ErrorInvalidSeat                          = types.ErrorInvalidSeat
+	ErrorSeatAlreadyAssigned                  = types.ErrorSeatAlreadyAssigned
+	SeatsOfSubscriptionKey                    = types.SeatsOfSubscriptionKey
+	SeatKey                                   = types.SeatKey
+	SeatIndexByAddressKey                     = types.SeatIndexByAddressKey
+	NewSeat                                   = types.NewSeat
+	NewMsgAssignSeat                          = types.NewMsgAssignSeat
+	NewMsgUnassignSeat                        = types.NewMsgUnassignSeat
+	NewQueryAllNodesParams                    = types.NewQueryAllNodesParams
+	NewQueryAllowedAddressesOfNodeParams      = types.NewQueryAllowedAddressesOfNodeParams
+	NewQueryBlacklistedClientsOfNodeParams    = types.NewQueryBlacklistedClientsOfNodeParams
+	NewQueryAllSubscriptionsParams            = types.NewQueryAllSubscriptionsParams
+	NewQuerySeatsOfSubscriptionParams         = types.NewQuerySeatsOfSubscriptionParams
+	NewQueryAllSessionsParams                 = types.NewQueryAllSessionsParams
+	NewQueryActiveSessionsOfAddressParams     = types.NewQueryActiveSessionsOfAddressParams
+	NewQueryNodesResponse                     = types.NewQueryNodesResponse
+	NewQueryAddressesResponse                 = types.NewQueryAddressesResponse
+	NewQuerySubscriptionsResponse             = types.NewQuerySubscriptionsResponse
+	NewQuerySeatsResponse                     = types.NewQuerySeatsResponse
+	NewQuerySessionsResponse                  = types.NewQuerySessionsResponse
+	NodeIDsOfAddressKey                       = types.NodeIDsOfAddressKey
+	SubscriptionIDsOfNodeKey                  = types.SubscriptionIDsOfNodeKey
+	SubscriptionIDsOfAddressKey               = types.SubscriptionIDsOfAddressKey
+	SessionIDsOfSubscriptionKey               = types.SessionIDsOfSubscriptionKey
+	NewPayoutRoute                            = types.NewPayoutRoute
+	ValidatePayoutRoutes                      = types.ValidatePayoutRoutes
+	NewMsgSetPayoutRoutes                     = types.NewMsgSetPayoutRoutes
+	NewPendingPayout                          = types.NewPendingPayout
+	PendingPayoutKey                          = types.PendingPayoutKey
+	NewQuote                                  = types.NewQuote
+	NewSignedQuote                            = types.NewSignedQuote
+	NewUsedQuote                              = types.NewUsedQuote
+	UsedQuoteKey                              = types.UsedQuoteKey
+	UsedQuotesByExpiryKey                     = types.UsedQuotesByExpiryKey
+	UsedQuoteByExpiryKey                      = types.UsedQuoteByExpiryKey
+	ErrorInvalidQuote                         = types.ErrorInvalidQuote
+	ErrorInvalidQuoteSignature                = types.ErrorInvalidQuoteSignature
+	ErrorQuoteExpired                         = types.ErrorQuoteExpired
+	ErrorQuoteAlreadyUsed                     = types.ErrorQuoteAlreadyUsed
+	NewSessionInfoUpdate                      = types.NewSessionInfoUpdate
+	NewMsgUpdateSessionsInfo                  = types.NewMsgUpdateSessionsInfo
+	FreeUpdatesCountsKey                      = types.FreeUpdatesCountsKey
+	FreeUpdatesCountKey                       = types.FreeUpdatesCountKey
+	ErrorStaleBandwidth                       = types.ErrorStaleBandwidth
+	BandwidthSignBytes                        = types.BandwidthSignBytes
+	ErrorInvalidClientSignature               = types.ErrorInvalidClientSignature
+	ErrorInvalidNodeSignature                 = types.ErrorInvalidNodeSignature
+	NewConsumptionRate                        = types.NewConsumptionRate
+	NewSubscriptionForecast                   = types.NewSubscriptionForecast
+	ConsumptionRateKey                        = types.ConsumptionRateKey
+	NewQuerySubscriptionForecastParams        = types.NewQuerySubscriptionForecastParams
+	PubKeyType                                = types.PubKeyType
+	VerifyBandwidthSignature                  = types.VerifyBandwidthSignature
+	RegisterInvariants                        = keeper.RegisterInvariants
+	SubscriptionReferencesInvariant           = keeper.SubscriptionReferencesInvariant
+	ErrorSubscriptionEnding                   = types.ErrorSubscriptionEnding
+	NewPendingSettlement                      = types.NewPendingSettlement
+	PendingSettlementKey                      = types.PendingSettlementKey
+	PendingSettlementsByHeightKey             = types.PendingSettlementsByHeightKey
+	PendingSettlementByHeightKey              = types.PendingSettlementByHeightKey
+	NewReleaseEscrowProposal                  = types.NewReleaseEscrowProposal
+	ErrorSubscriptionPaused                   = types.ErrorSubscriptionPaused
+	ErrorSubscriptionNotPaused                = types.ErrorSubscriptionNotPaused
+	NewMsgPauseSubscription                   = types.NewMsgPauseSubscription
+	NewMsgResumeSubscription                  = types.NewMsgResumeSubscription
+	NewMsgTopUpSubscription                   = types.NewMsgTopUpSubscription
+	NewNodeStats                              = types.NewNodeStats
+	NodeStatsKey                              = types.NodeStatsKey
+	NewQueryTopNodesParams                    = types.NewQueryTopNodesParams
+	NewNodeStatsSnapshot                      = types.NewNodeStatsSnapshot
+	NodeStatsSnapshotsKey                     = types.NodeStatsSnapshotsKey
+	NodeStatsSnapshotKey                      = types.NodeStatsSnapshotKey
+	NewNodeUptime                             = types.NewNodeUptime
+	NodeUptimeKey                             = types.NodeUptimeKey
+	NewQueryNodeUptimeResponse                = types.NewQueryNodeUptimeResponse
+	NewMsgUnjailNode                          = types.NewMsgUnjailNode
+	NewJailNodeProposal                       = types.NewJailNodeProposal
+	ErrorNodeJailed                           = types.ErrorNodeJailed
+	ErrorNodeNotJailed                        = types.ErrorNodeNotJailed
+	ErrorNodeJailCooldown                     = types.ErrorNodeJailCooldown
+	NewQueryDepositOfAddressParams            = types.NewQueryDepositOfAddressParams
+	NewNetworkSummary                         = types.NewNetworkSummary
+	NetworkSummaryInvariant                   = keeper.NetworkSummaryInvariant
+	PrometheusMetrics                         = keeper.PrometheusMetrics
+	NopMetrics                                = keeper.NopMetrics
+	NewFeeDenom                               = types.NewFeeDenom
+	ConvertMinGasPrices                       = types.ConvertMinGasPrices
+	HasFeeDenom                               = types.HasFeeDenom
+	ErrorInvalidFeeDenom                      = types.ErrorInvalidFeeDenom
+	NewRevenueSplit                           = types.NewRevenueSplit
+	ValidateRevenueSplits                     = types.ValidateRevenueSplits
+	NewMsgSetRevenueSplits                    = types.NewMsgSetRevenueSplits
+	RevenueSplitsInvariant                    = keeper.RevenueSplitsInvariant
+	EscrowInvariant                           = keeper.EscrowInvariant
+	NewReferralEarnings                       = types.NewReferralEarnings
+	ReferralEarningsKey                       = types.ReferralEarningsKey
+	NewQueryReferralEarningsParams            = types.NewQueryReferralEarningsParams
+	ErrorInvalidReferrer                      = types.ErrorInvalidReferrer
+	NewFreeTrial                              = types.NewFreeTrial
+	FreeTrialKey                              = types.FreeTrialKey
+	NewMsgSetNodeFreeTrial                    = types.NewMsgSetNodeFreeTrial
+	NewMsgStartFreeTrial                      = types.NewMsgStartFreeTrial
+	ErrorFreeTrialNotOffered                  = types.ErrorFreeTrialNotOffered
+	ErrorFreeTrialAlreadyUsed                 = types.ErrorFreeTrialAlreadyUsed
+	NewDiscountPlan                           = types.NewDiscountPlan
+	DiscountPlanKey                           = types.DiscountPlanKey
+	DiscountPlansByUntilKey                   = types.DiscountPlansByUntilKey
+	DiscountPlanByUntilKey                    = types.DiscountPlanByUntilKey
+	NewMsgSetDiscountPlan                     = types.NewMsgSetDiscountPlan
+	ErrorInvalidDiscountPlan                  = types.ErrorInvalidDiscountPlan
+	DeriveSessionID                           = types.DeriveSessionID
+	NodeIDByMonikerKey                        = types.NodeIDByMonikerKey
+	ErrorMonikerAlreadyTaken                  = types.ErrorMonikerAlreadyTaken
+	NewQueryNodeByMonikerParams               = types.NewQueryNodeByMonikerParams
+	NewNodeCategoryFromString                 = types.NewNodeCategoryFromString
+	IsValidNodeSortOrder                      = types.IsValidNodeSortOrder
+	ParseVersion                              = types.ParseVersion
+	CompareVersions                           = types.CompareVersions
+	IsVersionBelow                            = types.IsVersionBelow
+	NewNodeUnbonding                          = types.NewNodeUnbonding
+	NodeUnbondingKey                          = types.NodeUnbondingKey
+	NodeUnbondingsByHeightKey                 = types.NodeUnbondingsByHeightKey
+	NodeUnbondingByHeightKey                  = types.NodeUnbondingByHeightKey
+	NewMsgWithdrawNodeDeposit                 = types.NewMsgWithdrawNodeDeposit
+	ErrorInsufficientNodeDeposit              = types.ErrorInsufficientNodeDeposit
+	ErrorFeeAllowanceDoesNotExist             = types.ErrorFeeAllowanceDoesNotExist
+	NewFeeAllowance                           = types.NewFeeAllowance
+	FeeAllowanceKey                           = types.FeeAllowanceKey
+	NewQueryFeeAllowanceParams                = types.NewQueryFeeAllowanceParams
+	NewMsgGrantFeeAllowance                   = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance                  = types.NewMsgRevokeFeeAllowance
+	ErrorAuthorizationDoesNotExist            = types.ErrorAuthorizationDoesNotExist
+	IsAuthorizableMsgType                     = types.IsAuthorizableMsgType
+	NewAuthorization                          = types.NewAuthorization
+	AuthorizationsKey                         = types.AuthorizationsKey
+	AuthorizationKey                          = types.AuthorizationKey
+	NewQueryAuthorizationsParams              = types.NewQueryAuthorizationsParams
+	NewMsgGrantAuthorization                  = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization                 = types.NewMsgRevokeAuthorization
+	NewMsgExecAuthorized                      = types.NewMsgExecAuthorized
+	ErrorQoSAlreadyReported                   = types.ErrorQoSAlreadyReported
+	NewNodeQoS                                = types.NewNodeQoS
+	NodeQoSKey                                = types.NodeQoSKey
+	NewMsgSubmitQoSReport                     = types.NewMsgSubmitQoSReport
+	NewNodeReputation                         = types.NewNodeReputation
+	ReputationScore                           = types.ReputationScore
+	NodeReputationKey                         = types.NodeReputationKey
+	ErrorSubscriptionNotCompleted             = types.ErrorSubscriptionNotCompleted
+	ErrorNodeAlreadyRated                     = types.ErrorNodeAlreadyRated
+	NewMsgRateNode                            = types.NewMsgRateNode
+	NewDispute                                = types.NewDispute
+	IsValidDisputeStatus                      = types.IsValidDisputeStatus
+	DisputeKey                                = types.DisputeKey
+	DisputesByDeadlineKey                     = types.DisputesByDeadlineKey
+	DisputeByDeadlineKey                      = types.DisputeByDeadlineKey
+	OngoingSessionIndexesOfSubscriptionKey    = types.OngoingSessionIndexesOfSubscriptionKey
+	OngoingSessionIndexKey                    = types.OngoingSessionIndexKey
+	NewMsgOpenDispute                         = types.NewMsgOpenDispute
+	NewMsgSubmitDisputeEvidence               = types.NewMsgSubmitDisputeEvidence
+	NewResolveDisputeProposal                 = types.NewResolveDisputeProposal
+	NewQueryDisputeParams                     = types.NewQueryDisputeParams
+	NewQueryAllDisputesParams                 = types.NewQueryAllDisputesParams
+	NewQueryDisputesResponse                  = types.NewQueryDisputesResponse
+	ErrorDisputeDoesNotExist                  = types.ErrorDisputeDoesNotExist
+	ErrorDisputeAlreadyExists                 = types.ErrorDisputeAlreadyExists
+	ErrorDisputeWindowClosed                  = types.ErrorDisputeWindowClosed
+	ErrorInvalidDisputeStatus                 = types.ErrorInvalidDisputeStatus
+	ErrorInvalidDisputeAmount                 = types.ErrorInvalidDisputeAmount
+	ErrorInvalidEvidence                      = types.ErrorInvalidEvidence
 
 	// variable aliases
 	ModuleCdc                            = types.ModuleCdc
@@ -119,6 +428,100 @@ var (
 	KeyFreeNodesCount                    = types.KeyFreeNodesCount
 	KeyDeposit                           = types.KeyDeposit
 	KeySessionInactiveInterval           = types.KeySessionInactiveInterval
+	DefaultSessionPruningRetention       = types.DefaultSessionPruningRetention
+	DefaultPruneGasRefund                = types.DefaultPruneGasRefund
+	KeySessionPruningRetention           = types.KeySessionPruningRetention
+	KeyPruneGasRefund                    = types.KeyPruneGasRefund
+	DefaultMinClientVersion              = types.DefaultMinClientVersion
+	DefaultMaintenanceBanners            = types.DefaultMaintenanceBanners
+	KeyMinClientVersion                  = types.KeyMinClientVersion
+	KeyMaintenanceBanners                = types.KeyMaintenanceBanners
+	AllowedAddressKeyPrefix              = types.AllowedAddressKeyPrefix
+	BlacklistedClientKeyPrefix           = types.BlacklistedClientKeyPrefix
+	SeatKeyPrefix                        = types.SeatKeyPrefix
+	SeatIndexByAddressKeyPrefix          = types.SeatIndexByAddressKeyPrefix
+	DefaultSettlementInterval            = types.DefaultSettlementInterval
+	KeySettlementInterval                = types.KeySettlementInterval
+	DefaultSettlementEpoch               = types.DefaultSettlementEpoch
+	KeySettlementEpoch                   = types.KeySettlementEpoch
+	PayoutPoolAddress                    = types.PayoutPoolAddress
+	PendingPayoutKeyPrefix               = types.PendingPayoutKeyPrefix
+	UsedQuoteKeyPrefix                   = types.UsedQuoteKeyPrefix
+	UsedQuoteByExpiryKeyPrefix           = types.UsedQuoteByExpiryKeyPrefix
+	DefaultProtocolFeeRate               = types.DefaultProtocolFeeRate
+	KeyProtocolFeeRate                   = types.KeyProtocolFeeRate
+	ProtocolFeesKey                      = types.ProtocolFeesKey
+	DefaultFreeUpdatesPerBlock           = types.DefaultFreeUpdatesPerBlock
+	KeyFreeUpdatesPerBlock               = types.KeyFreeUpdatesPerBlock
+	FreeUpdatesCountKeyPrefix            = types.FreeUpdatesCountKeyPrefix
+	ConsumptionRateKeyPrefix             = types.ConsumptionRateKeyPrefix
+	ConsumptionRateSmoothing             = types.ConsumptionRateSmoothing
+	DefaultSubscriptionGCEpoch           = types.DefaultSubscriptionGCEpoch
+	KeySubscriptionGCEpoch               = types.KeySubscriptionGCEpoch
+	DefaultSubscriptionGCRetention       = types.DefaultSubscriptionGCRetention
+	KeySubscriptionGCRetention           = types.KeySubscriptionGCRetention
+	DefaultSettlementGracePeriod         = types.DefaultSettlementGracePeriod
+	KeySettlementGracePeriod             = types.KeySettlementGracePeriod
+	PendingSettlementKeyPrefix           = types.PendingSettlementKeyPrefix
+	PendingSettlementByHeightKeyPrefix   = types.PendingSettlementByHeightKeyPrefix
+	NodeStatsKeyPrefix                   = types.NodeStatsKeyPrefix
+	DefaultNodeStatsEpoch                = types.DefaultNodeStatsEpoch
+	KeyNodeStatsEpoch                    = types.KeyNodeStatsEpoch
+	DefaultNodeStatsRetention            = types.DefaultNodeStatsRetention
+	KeyNodeStatsRetention                = types.KeyNodeStatsRetention
+	NodeStatsSnapshotKeyPrefix           = types.NodeStatsSnapshotKeyPrefix
+	DefaultNodeInactiveInterval          = types.DefaultNodeInactiveInterval
+	KeyNodeInactiveInterval              = types.KeyNodeInactiveInterval
+	NodeUptimeKeyPrefix                  = types.NodeUptimeKeyPrefix
+	DefaultNodeJailCooldown              = types.DefaultNodeJailCooldown
+	KeyNodeJailCooldown                  = types.KeyNodeJailCooldown
+	NetworkSummaryKey                    = types.NetworkSummaryKey
+	DefaultFeeDenoms                     = types.DefaultFeeDenoms
+	KeyFeeDenoms                         = types.KeyFeeDenoms
+	DefaultReferralRate                  = types.DefaultReferralRate
+	KeyReferralRate                      = types.KeyReferralRate
+	ReferralEarningsKeyPrefix            = types.ReferralEarningsKeyPrefix
+	FreeTrialKeyPrefix                   = types.FreeTrialKeyPrefix
+	DiscountPlanKeyPrefix                = types.DiscountPlanKeyPrefix
+	DiscountPlanByUntilKeyPrefix         = types.DiscountPlanByUntilKeyPrefix
+	NodeIDByMonikerKeyPrefix             = types.NodeIDByMonikerKeyPrefix
+	NodeCategories                       = types.NodeCategories
+	LatencyZones                         = types.LatencyZones
+	NodeSortOrders                       = types.NodeSortOrders
+	DefaultMinNodeVersion                = types.DefaultMinNodeVersion
+	KeyMinNodeVersion                    = types.KeyMinNodeVersion
+	EnforcedMinNodeVersionKey            = types.EnforcedMinNodeVersionKey
+	NodeUnbondingKeyPrefix               = types.NodeUnbondingKeyPrefix
+	NodeUnbondingByHeightKeyPrefix       = types.NodeUnbondingByHeightKeyPrefix
+	KeyNodeUnbondingPeriod               = types.KeyNodeUnbondingPeriod
+	DefaultNodeUnbondingPeriod           = types.DefaultNodeUnbondingPeriod
+	NodeRewardPoolAddress                = types.NodeRewardPoolAddress
+	KeyNodeRewardRate                    = types.KeyNodeRewardRate
+	DefaultNodeRewardRate                = types.DefaultNodeRewardRate
+	FeeAllowanceKeyPrefix                = types.FeeAllowanceKeyPrefix
+	AuthorizationKeyPrefix               = types.AuthorizationKeyPrefix
+	AuthorizableMsgTypes                 = types.AuthorizableMsgTypes
+	NodeQoSKeyPrefix                     = types.NodeQoSKeyPrefix
+	NodeReputationKeyPrefix              = types.NodeReputationKeyPrefix
+	ReputationSessionsHalf               = types.ReputationSessionsHalf
+	ReputationUptimeWeight               = types.ReputationUptimeWeight
+	ReputationSessionsWeight             = types.ReputationSessionsWeight
+	DefaultReputationEpoch               = types.DefaultReputationEpoch
+	KeyReputationEpoch                   = types.KeyReputationEpoch
+	ReputationUnratedFactor              = types.ReputationUnratedFactor
+	ReputationRatingWeight               = types.ReputationRatingWeight
+	DisputePoolAddress                   = types.DisputePoolAddress
+	DisputeKeyPrefix                     = types.DisputeKeyPrefix
+	DisputeByDeadlineKeyPrefix           = types.DisputeByDeadlineKeyPrefix
+	DefaultDisputeWindow                 = types.DefaultDisputeWindow
+	KeyDisputeWindow                     = types.KeyDisputeWindow
+	DefaultDisputeTimeout                = types.DefaultDisputeTimeout
+	KeyDisputeTimeout                    = types.KeyDisputeTimeout
+	DefaultEscrowTimeout                 = types.DefaultEscrowTimeout
+	KeyEscrowTimeout                     = types.KeyEscrowTimeout
+	DefaultEscrowReleaseEpoch            = types.DefaultEscrowReleaseEpoch
+	KeyEscrowReleaseEpoch                = types.KeyEscrowReleaseEpoch
+	OngoingSessionIndexKeyPrefix         = types.OngoingSessionIndexKeyPrefix
 )
 
 type (
@@ -134,13 +537,109 @@ type (
 	QuerySubscriptionsOfNodePrams          = types.QuerySubscriptionsOfNodePrams
 	QuerySubscriptionsOfAddressParams      = types.QuerySubscriptionsOfAddressParams
 	QuerySessionsCountOfSubscriptionParams = types.QuerySessionsCountOfSubscriptionParams
+	QuerySessionIndexOfAddressParams       = types.QuerySessionIndexOfAddressParams
 	QuerySessionParams                     = types.QuerySessionParams
 	QuerySessionOfSubscriptionPrams        = types.QuerySessionOfSubscriptionPrams
 	QuerySessionsOfSubscriptionPrams       = types.QuerySessionsOfSubscriptionPrams
 	Session                                = types.Session
+	SessionIndex                           = types.SessionIndex
+	SessionsCount                          = types.SessionsCount
 	MsgUpdateSessionInfo                   = types.MsgUpdateSessionInfo
 	Subscription                           = types.Subscription
 	MsgStartSubscription                   = types.MsgStartSubscription
 	MsgEndSubscription                     = types.MsgEndSubscription
 	Keeper                                 = keeper.Keeper
+	MsgPruneNodeHistory                    = types.MsgPruneNodeHistory
+	NodeMetadata                           = types.NodeMetadata
+	AllowedAddress                         = types.AllowedAddress
+	MsgSetNodePrivate                      = types.MsgSetNodePrivate
+	MsgSetNodeLocation                     = types.MsgSetNodeLocation
+	NodeLocation                           = types.NodeLocation
+	MsgAddAllowedAddress                   = types.MsgAddAllowedAddress
+	MsgRemoveAllowedAddress                = types.MsgRemoveAllowedAddress
+	BlacklistedClient                      = types.BlacklistedClient
+	MsgBlacklistClient                     = types.MsgBlacklistClient
+	MsgUnblacklistClient                   = types.MsgUnblacklistClient
+	Seat                                   = types.Seat
+	MsgAssignSeat                          = types.MsgAssignSeat
+	MsgUnassignSeat                        = types.MsgUnassignSeat
+	QueryAllNodesParams                    = types.QueryAllNodesParams
+	QueryAllowedAddressesOfNodeParams      = types.QueryAllowedAddressesOfNodeParams
+	QueryBlacklistedClientsOfNodeParams    = types.QueryBlacklistedClientsOfNodeParams
+	QueryAllSubscriptionsParams            = types.QueryAllSubscriptionsParams
+	QuerySeatsOfSubscriptionParams         = types.QuerySeatsOfSubscriptionParams
+	QueryAllSessionsParams                 = types.QueryAllSessionsParams
+	QueryActiveSessionsOfAddressParams     = types.QueryActiveSessionsOfAddressParams
+	QueryNodesResponse                     = types.QueryNodesResponse
+	QueryAddressesResponse                 = types.QueryAddressesResponse
+	QuerySubscriptionsResponse             = types.QuerySubscriptionsResponse
+	QuerySeatsResponse                     = types.QuerySeatsResponse
+	QuerySessionsResponse                  = types.QuerySessionsResponse
+	PayoutRoute                            = types.PayoutRoute
+	MsgSetPayoutRoutes                     = types.MsgSetPayoutRoutes
+	PendingPayout                          = types.PendingPayout
+	Quote                                  = types.Quote
+	SignedQuote                            = types.SignedQuote
+	UsedQuote                              = types.UsedQuote
+	SessionInfoUpdate                      = types.SessionInfoUpdate
+	MsgUpdateSessionsInfo                  = types.MsgUpdateSessionsInfo
+	ConsumptionRate                        = types.ConsumptionRate
+	SubscriptionForecast                   = types.SubscriptionForecast
+	QuerySubscriptionForecastParams        = types.QuerySubscriptionForecastParams
+	PendingSettlement                      = types.PendingSettlement
+	ReleaseEscrowProposal                  = types.ReleaseEscrowProposal
+	MsgPauseSubscription                   = types.MsgPauseSubscription
+	MsgResumeSubscription                  = types.MsgResumeSubscription
+	MsgTopUpSubscription                   = types.MsgTopUpSubscription
+	NodeStats                              = types.NodeStats
+	NodeStatsSnapshot                      = types.NodeStatsSnapshot
+	QueryTopNodesParams                    = types.QueryTopNodesParams
+	NodeUptime                             = types.NodeUptime
+	QueryNodeUptimeResponse                = types.QueryNodeUptimeResponse
+	MsgUnjailNode                          = types.MsgUnjailNode
+	JailNodeProposal                       = types.JailNodeProposal
+	QueryDepositOfAddressParams            = types.QueryDepositOfAddressParams
+	NodeDeposit                            = types.NodeDeposit
+	SubscriptionDeposit                    = types.SubscriptionDeposit
+	DepositOfAddress                       = types.DepositOfAddress
+	NetworkSummary                         = types.NetworkSummary
+	Metrics                                = keeper.Metrics
+	StoreListener                          = keeper.StoreListener
+	FeeDenom                               = types.FeeDenom
+	RevenueSplit                           = types.RevenueSplit
+	RevenuePayout                          = types.RevenuePayout
+	MsgSetRevenueSplits                    = types.MsgSetRevenueSplits
+	ReferralEarnings                       = types.ReferralEarnings
+	QueryReferralEarningsParams            = types.QueryReferralEarningsParams
+	FreeTrial                              = types.FreeTrial
+	MsgSetNodeFreeTrial                    = types.MsgSetNodeFreeTrial
+	MsgStartFreeTrial                      = types.MsgStartFreeTrial
+	DiscountPlan                           = types.DiscountPlan
+	MsgSetDiscountPlan                     = types.MsgSetDiscountPlan
+	QueryNodeByMonikerParams               = types.QueryNodeByMonikerParams
+	NodeCategory                           = types.NodeCategory
+	NodeUnbonding                          = types.NodeUnbonding
+	MsgWithdrawNodeDeposit                 = types.MsgWithdrawNodeDeposit
+	Hooks                                  = keeper.Hooks
+	FeeAllowance                           = types.FeeAllowance
+	QueryFeeAllowanceParams                = types.QueryFeeAllowanceParams
+	MsgGrantFeeAllowance                   = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance                  = types.MsgRevokeFeeAllowance
+	Authorization                          = types.Authorization
+	QueryAuthorizationsParams              = types.QueryAuthorizationsParams
+	MsgGrantAuthorization                  = types.MsgGrantAuthorization
+	MsgRevokeAuthorization                 = types.MsgRevokeAuthorization
+	MsgExecAuthorized                      = types.MsgExecAuthorized
+	NodeQoS                                = types.NodeQoS
+	MsgSubmitQoSReport                     = types.MsgSubmitQoSReport
+	NodeReputation                         = types.NodeReputation
+	NodeRating                             = types.NodeRating
+	MsgRateNode                            = types.MsgRateNode
+	Dispute                                = types.Dispute
+	MsgOpenDispute                         = types.MsgOpenDispute
+	MsgSubmitDisputeEvidence               = types.MsgSubmitDisputeEvidence
+	ResolveDisputeProposal                 = types.ResolveDisputeProposal
+	QueryDisputeParams                     = types.QueryDisputeParams
+	QueryAllDisputesParams                 = types.QueryAllDisputesParams
+	QueryDisputesResponse                  = types.QueryDisputesResponse
 )
@@ -0,0 +1,209 @@
+package vpn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// NewAnteHandler wraps the given AnteHandler to waive the fees of the transactions which only
+// update the sessions of the registered nodes of their signer, so the frequent bandwidth updates
+// are not priced out. At most FreeUpdatesPerBlock updates of a node are free in a block.
+func NewAnteHandler(ante sdk.AnteHandler, k keeper.Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if ok && stdTx.Fee.Amount.IsZero() && waiveFees(ctx, k, stdTx.GetMsgs()) {
+			ctx = ctx.WithMinGasPrices(sdk.DecCoins{})
+		}
+
+		return ante(ctx, tx, simulate)
+	}
+}
+
+// waiveFees checks the types of the messages before reading the params, so that the other transactions,
+// such as the genesis transactions delivered before the genesis of the module, do not read them.
+func waiveFees(ctx sdk.Context, k keeper.Keeper, msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(types.MsgUpdateSessionInfo); !ok {
+			return false
+		}
+	}
+
+	limit := k.FreeUpdatesPerBlock(ctx)
+	if limit == 0 {
+		return false
+	}
+
+	var ids []hub.NodeID
+	counts := make(map[string]uint64)
+	for _, msg := range msgs {
+		msg := msg.(types.MsgUpdateSessionInfo)
+
+		subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
+		if !found {
+			return false
+		}
+
+		node, found := k.GetNode(ctx, subscription.NodeID)
+		if !found || node.Status != types.StatusRegistered || !node.Owner.Equals(msg.From) {
+			return false
+		}
+
+		if _, ok := counts[node.ID.String()]; !ok {
+			ids = append(ids, node.ID)
+			counts[node.ID.String()] = k.GetFreeUpdatesCount(ctx, node.ID)
+		}
+
+		counts[node.ID.String()]++
+		if counts[node.ID.String()] > limit {
+			return false
+		}
+	}
+
+	for _, id := range ids {
+		k.SetFreeUpdatesCount(ctx, id, counts[id.String()])
+	}
+
+	return true
+}
+
+// NewFeeDenomsAnteHandler wraps the given AnteHandler to reject the fees in the denoms other than
+// the staking denom and the fee denoms of the params, and to accept the fee denoms for the min gas
+// prices of the validator at their rates to the min gas price of the staking denom.
+func NewFeeDenomsAnteHandler(ante sdk.AnteHandler, k keeper.Keeper,
+	stakingDenom func(ctx sdk.Context) string) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return ante(ctx, tx, simulate)
+		}
+
+		denom := stakingDenom(ctx)
+		feeDenoms := k.FeeDenoms(ctx)
+		for _, coin := range stdTx.Fee.Amount {
+			if coin.Denom != denom && !types.HasFeeDenom(feeDenoms, coin.Denom) {
+				return ctx, types.ErrorInvalidFeeDenom(coin.Denom).Result(), true
+			}
+		}
+
+		if ctx.IsCheckTx() && len(feeDenoms) > 0 {
+			ctx = ctx.WithMinGasPrices(types.ConvertMinGasPrices(ctx.MinGasPrices(), denom, feeDenoms))
+		}
+
+		return ante(ctx, tx, simulate)
+	}
+}
+
+// NewFeeAllowanceAnteHandler wraps the given AnteHandler to let the owner of a node pay the fees of
+// the transactions of the subscriptions of its node. The fees are sent from the owner to the fee payer
+// within its fee allowance before the given AnteHandler deducts them, and are paid by the fee payer
+// itself if it has no allowance left. The allowance is spent only if the given AnteHandler accepts the
+// transaction, so the transactions with invalid signatures, sequences or fees do not drain it.
+func NewFeeAllowanceAnteHandler(ante sdk.AnteHandler, k keeper.Keeper, bk bank.Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok || stdTx.Fee.Amount.IsZero() {
+			return ante(ctx, tx, simulate)
+		}
+
+		signers := stdTx.GetSigners()
+		granter, found := feeGranter(ctx, k, stdTx.GetMsgs())
+		if !found || len(signers) == 0 || granter.Equals(signers[0]) {
+			return ante(ctx, tx, simulate)
+		}
+
+		payer := signers[0]
+		allowance, found := k.GetFeeAllowance(ctx, granter, payer)
+		if !found || allowance.IsExpired(ctx.BlockHeight()) {
+			return ante(ctx, tx, simulate)
+		}
+
+		limit, negative := allowance.SpendLimit.SafeSub(stdTx.Fee.Amount)
+		if negative {
+			return ante(ctx, tx, simulate)
+		}
+
+		cctx, write := ctx.CacheContext()
+		if err := bk.SendCoins(cctx, granter, payer, stdTx.Fee.Amount); err != nil {
+			return ante(ctx, tx, simulate)
+		}
+
+		if limit.IsZero() {
+			k.DeleteFeeAllowance(cctx, granter, payer)
+		} else {
+			allowance.SpendLimit = limit
+			k.SetFeeAllowance(cctx, allowance)
+		}
+
+		newCtx, res, abort := ante(cctx, tx, simulate)
+		if !abort && res.IsOK() {
+			write()
+		}
+
+		// The context of the given AnteHandler is on top of the cached store, which is discarded
+		if !newCtx.IsZero() {
+			newCtx = newCtx.WithMultiStore(ctx.MultiStore())
+		}
+
+		return newCtx, res, abort
+	}
+}
+
+func feeGranter(ctx sdk.Context, k keeper.Keeper, msgs []sdk.Msg) (granter sdk.AccAddress, found bool) {
+	for _, msg := range msgs {
+		var id hub.NodeID
+		switch msg := msg.(type) {
+		case types.MsgStartSubscription:
+			id = msg.NodeID
+		case types.MsgStartFreeTrial:
+			id = msg.NodeID
+		default:
+			subscriptionID, ok := subscriptionIDOf(msg)
+			if !ok {
+				return nil, false
+			}
+
+			subscription, found := k.GetSubscription(ctx, subscriptionID)
+			if !found {
+				return nil, false
+			}
+
+			id = subscription.NodeID
+		}
+
+		node, found := k.GetNode(ctx, id)
+		if !found || (granter != nil && !granter.Equals(node.Owner)) {
+			return nil, false
+		}
+
+		granter = node.Owner
+	}
+
+	return granter, granter != nil
+}
+
+func subscriptionIDOf(msg sdk.Msg) (hub.SubscriptionID, bool) {
+	switch msg := msg.(type) {
+	case types.MsgEndSubscription:
+		return msg.ID, true
+	case types.MsgAssignSeat:
+		return msg.ID, true
+	case types.MsgUnassignSeat:
+		return msg.ID, true
+	case types.MsgPauseSubscription:
+		return msg.ID, true
+	case types.MsgResumeSubscription:
+		return msg.ID, true
+	case types.MsgTopUpSubscription:
+		return msg.ID, true
+	default:
+		return nil, false
+	}
+}
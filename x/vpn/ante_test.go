@@ -0,0 +1,202 @@
+package vpn
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestNewAnteHandler(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, true)
+	ctx = ctx.WithBlockHeight(1).WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))})
+
+	var waived bool
+	ante := NewAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		waived = ctx.MinGasPrices().IsZero()
+		return ctx, sdk.Result{}, false
+	}, k)
+
+	params := k.GetParams(ctx)
+	params.FreeUpdatesPerBlock = 2
+	k.SetParams(ctx, params)
+
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	msg := *NewMsgUpdateSessionInfo(types.TestAddress1, types.TestSubscription.ID, types.TestBandwidthPos1,
+		types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		return auth.NewStdTx(msgs, auth.NewStdFee(200000, fee), nil, "")
+	}
+
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, msg), false)
+	require.Equal(t, false, waived)
+	_, _, _ = ante(ctx, newTx(nil, msg, *types.NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID)), false)
+	require.Equal(t, false, waived)
+
+	_msg := msg
+	_msg.From = types.TestAddress2
+	_, _, _ = ante(ctx, newTx(nil, _msg), false)
+	require.Equal(t, false, waived)
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx, node.ID))
+
+	_, _, _ = ante(ctx, newTx(nil, msg, msg, msg), false)
+	require.Equal(t, false, waived)
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx, node.ID))
+
+	_, _, _ = ante(ctx, newTx(nil, msg), false)
+	require.Equal(t, true, waived)
+	_, _, _ = ante(ctx, newTx(nil, msg), false)
+	require.Equal(t, true, waived)
+	require.Equal(t, uint64(2), k.GetFreeUpdatesCount(ctx, node.ID))
+
+	_, _, _ = ante(ctx, newTx(nil, msg), false)
+	require.Equal(t, false, waived)
+
+	EndBlock(ctx, k)
+	_, _, _ = ante(ctx.WithBlockHeight(2), newTx(nil, msg), false)
+	require.Equal(t, true, waived)
+
+	node.Status = types.StatusDeRegistered
+	k.SetNode(ctx, node)
+	_, _, _ = ante(ctx.WithBlockHeight(2), newTx(nil, msg), false)
+	require.Equal(t, false, waived)
+}
+
+func TestNewFeeDenomsAnteHandler(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, true)
+	ctx = ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))})
+
+	var prices sdk.DecCoins
+	ante := NewFeeDenomsAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		prices = ctx.MinGasPrices()
+		return ctx, sdk.Result{}, false
+	}, k, func(_ sdk.Context) string { return "stake" })
+
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		return auth.NewStdTx(nil, auth.NewStdFee(200000, fee), nil, "")
+	}
+
+	_, res, abort := ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}), false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, ctx.MinGasPrices(), prices)
+
+	_, res, abort = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("usent", 10)}), false)
+	require.True(t, abort)
+	require.Equal(t, types.ErrorInvalidFeeDenom("usent").Code(), res.Code)
+
+	params := k.GetParams(ctx)
+	params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("usent", sdk.NewDec(4))}
+	k.SetParams(ctx, params)
+
+	_, res, abort = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("usent", 10)}), false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewDecWithPrec(4, 2), prices.AmountOf("usent"))
+
+	_, res, abort = ante(ctx.WithIsCheckTx(false), newTx(sdk.Coins{sdk.NewInt64Coin("usent", 10)}), false)
+	require.False(t, abort)
+	require.True(t, prices.AmountOf("usent").IsZero())
+}
+
+func TestNewFeeAllowanceAnteHandler(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(1)
+
+	ante := NewFeeAllowanceAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		return ctx, sdk.Result{}, false
+	}, k, bk)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	msg := *NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID)
+	newTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		return auth.NewStdTx(msgs, auth.NewStdFee(200000, fee), nil, "")
+	}
+
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, msg), false)
+	require.Equal(t, true, bk.GetCoins(ctx, types.TestAddress2).IsZero())
+
+	k.SetFeeAllowance(ctx, NewFeeAllowance(types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, 0))
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, msg), false)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 90)}, bk.GetCoins(ctx, types.TestAddress1))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
+	allowance, found := k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 5)}, allowance.SpendLimit)
+
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, msg), false)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
+
+	_msg := *NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID)
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, msg, _msg,
+		*NewMsgUpdateSessionInfo(types.TestAddress1, types.TestSubscription.ID, types.TestBandwidthPos1,
+			types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)), false)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
+
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, msg, _msg), false)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, bk.GetCoins(ctx, types.TestAddress2))
+	_, found = k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.SetFeeAllowance(ctx, NewFeeAllowance(types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, 1))
+	_, _, _ = ante(ctx, newTx(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, msg), false)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, bk.GetCoins(ctx, types.TestAddress2))
+}
+
+func TestNewFeeAllowanceAnteHandler_InvalidSignature(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(1)
+
+	valid := false
+	ante := NewFeeAllowanceAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		if !valid {
+			return ctx, sdk.ErrUnauthorized("signature verification failed").Result(), true
+		}
+
+		return ctx, sdk.Result{}, false
+	}, k, bk)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	allowance := NewFeeAllowance(types.TestAddress1, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, 0)
+	k.SetFeeAllowance(ctx, allowance)
+
+	tx := auth.NewStdTx([]sdk.Msg{*NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID)},
+		auth.NewStdFee(200000, sdk.Coins{sdk.NewInt64Coin("stake", 10)}), nil, "")
+
+	for _, _ctx := range []sdk.Context{ctx.WithIsCheckTx(true), ctx.WithIsCheckTx(false)} {
+		_, res, abort := ante(_ctx, tx, false)
+		require.True(t, abort)
+		require.False(t, res.IsOK())
+
+		_allowance, found := k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
+		require.Equal(t, true, found)
+		require.Equal(t, allowance, _allowance)
+		require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress1))
+		require.Equal(t, true, bk.GetCoins(ctx, types.TestAddress2).IsZero())
+	}
+
+	valid = true
+	_, res, abort := ante(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+
+	_allowance, _ := k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 5)}, _allowance.SpendLimit)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, bk.GetCoins(ctx, types.TestAddress2))
+}
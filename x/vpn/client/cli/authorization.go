@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func GrantAuthorizationTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-authorization [grantee] [msg-type]",
+		Short: "Let the grantee execute the messages of the type on your behalf",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgGrantAuthorization(fromAddress, grantee, args[1], viper.GetInt64(flagExpiry))
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiry, 0, "Height from which the authorization can not be used, zero never expires")
+
+	return cmd
+}
+
+func RevokeAuthorizationTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-authorization [grantee] [msg-type]",
+		Short: "Stop the grantee from executing the messages of the type on your behalf",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgRevokeAuthorization(fromAddress, grantee, args[1])
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func ExecAuthorizedTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-authorized [tx-file]",
+		Short: "Execute the messages of the generated transaction of the granters on their behalf",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgExecAuthorized(fromAddress, stdTx.GetMsgs())
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
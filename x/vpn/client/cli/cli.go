@@ -14,11 +14,30 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(client.GetCommands(
 		QueryNodeCmd(cdc),
+		QueryNodeByMonikerCmd(cdc),
+		QueryNodeStatsCmd(cdc),
+		QueryNodeUptimeCmd(cdc),
+		QueryNodeQoSCmd(cdc),
+		QueryNodeReputationCmd(cdc),
+		QueryDiscountPlanCmd(cdc),
+		QueryTopNodesCmd(cdc),
 		QueryNodesCmd(cdc),
+		QueryAllowedAddressesCmd(cdc),
+		QueryBlacklistedClientsCmd(cdc),
 		QuerySubscriptionCmd(cdc),
 		QuerySubscriptionsCmd(cdc),
+		QuerySubscriptionForecastCmd(cdc),
+		QueryReferralEarningsCmd(cdc),
+		QueryFeeAllowanceCmd(cdc),
+		QueryAuthorizationsCmd(cdc),
+		QuerySeatsCmd(cdc),
 		QuerySessionCmd(cdc),
 		QuerySessionsCmd(cdc),
+		QueryDisputeCmd(cdc),
+		QueryDisputesCmd(cdc),
+		QueryProtocolFeesCmd(cdc),
+		QueryParamsCmd(cdc),
+		QueryNetworkSummaryCmd(cdc),
 	)...)
 
 	return cmd
@@ -48,6 +67,22 @@ func nodeTxCmd(cdc *codec.Codec) *cobra.Command {
 		RegisterNodeTxCmd(cdc),
 		UpdateNodeInfoTxCmd(cdc),
 		DeregisterNodeTxCmd(cdc),
+		PruneNodeHistoryTxCmd(cdc),
+		SetNodePrivateTxCmd(cdc),
+		SetNodeLocationTxCmd(cdc),
+		SetNodeFreeTrialTxCmd(cdc),
+		SetDiscountPlanTxCmd(cdc),
+		AddAllowedAddressTxCmd(cdc),
+		RemoveAllowedAddressTxCmd(cdc),
+		BlacklistClientTxCmd(cdc),
+		UnblacklistClientTxCmd(cdc),
+		SetPayoutRoutesTxCmd(cdc),
+		SetRevenueSplitsTxCmd(cdc),
+		UnjailNodeTxCmd(cdc),
+		WithdrawNodeDepositTxCmd(cdc),
+		GrantFeeAllowanceTxCmd(cdc),
+		RevokeFeeAllowanceTxCmd(cdc),
+		SignQuoteTxCmd(cdc),
 	)...)
 
 	return cmd
@@ -61,7 +96,18 @@ func subscriptionTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(client.PostCommands(
 		StartSubscriptionTxCmd(cdc),
+		StartFreeTrialTxCmd(cdc),
 		EndSubscriptionTxCmd(cdc),
+		PauseSubscriptionTxCmd(cdc),
+		ResumeSubscriptionTxCmd(cdc),
+		TopUpSubscriptionTxCmd(cdc),
+		SubmitQoSReportTxCmd(cdc),
+		RateNodeTxCmd(cdc),
+		AssignSeatTxCmd(cdc),
+		UnassignSeatTxCmd(cdc),
+		GrantAuthorizationTxCmd(cdc),
+		RevokeAuthorizationTxCmd(cdc),
+		ExecAuthorizedTxCmd(cdc),
 	)...)
 
 	return cmd
@@ -76,6 +122,9 @@ func sessionTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(client.PostCommands(
 		SignSessionBandwidthTxCmd(cdc),
 		UpdateSessionInfoTxCmd(cdc),
+		UpdateSessionsInfoTxCmd(cdc),
+		OpenDisputeTxCmd(cdc),
+		SubmitDisputeEvidenceTxCmd(cdc),
 	)...)
 
 	return cmd
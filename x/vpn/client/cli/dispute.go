@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func QueryDisputeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [session-id]",
+		Short: "Query the dispute of a session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			dispute, err := common.QueryDispute(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(dispute)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryDisputesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disputes",
+		Short: "Query disputes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryAllDisputes(ctx, strings.ToUpper(viper.GetString(flagStatus)), page)
+			if err != nil {
+				return err
+			}
+
+			for _, dispute := range res.Disputes {
+				fmt.Println(dispute)
+			}
+
+			fmt.Println(res.Pagination)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "Only the disputes of the status, one of OPEN, UPHELD and REJECTED")
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func OpenDisputeTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-dispute [subscription-id] [index] [amount]",
+		Short: "Dispute the settlement of an ended session of the subscription, as the client or the node owner",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewSubscriptionIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenDispute(ctx.GetFromAddress(), id, index, amount)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func SubmitDisputeEvidenceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dispute-evidence [session-id]",
+		Short: "Submit the bandwidth signed by the client as the evidence against the dispute of a session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewSessionIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			bandwidth, err := hub.NewBandwidthFromString(viper.GetString(flagUpload), viper.GetString(flagDownload))
+			if err != nil {
+				return err
+			}
+
+			var clientSignature auth.StdSignature
+			if err := cdc.UnmarshalJSON([]byte(viper.GetString(flagClientSign)), &clientSignature); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDisputeEvidence(ctx.GetFromAddress(), id, bandwidth, clientSignature)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagUpload, "0", "Upload in bytes")
+	cmd.Flags().String(flagDownload, "0", "Download in bytes")
+	cmd.Flags().String(flagClientSign, "", "Signature of the client")
+
+	_ = cmd.MarkFlagRequired(flagUpload)
+	_ = cmd.MarkFlagRequired(flagDownload)
+	_ = cmd.MarkFlagRequired(flagClientSign)
+
+	return cmd
+}
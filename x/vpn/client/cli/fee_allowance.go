@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func GrantFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-allowance [grantee] [spend-limit]",
+		Short: "Pay the fees of the transactions of the grantee on the subscriptions of your nodes up to the spend limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgGrantFeeAllowance(fromAddress, grantee, spendLimit, viper.GetInt64(flagExpiry))
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiry, 0, "Height from which the allowance can not be used, zero never expires")
+
+	return cmd
+}
+
+func RevokeFeeAllowanceTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-fee-allowance [grantee]",
+		Short: "Stop paying the fees of the transactions of the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgRevokeFeeAllowance(fromAddress, grantee)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
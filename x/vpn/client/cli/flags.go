@@ -1,5 +1,12 @@
 package cli
 
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
 const (
 	flagMoniker        = "moniker"
 	flagDeposit        = "deposit"
@@ -16,4 +23,48 @@ const (
 	flagClientSign     = "client-sign"
 	flagNodeOwnerSign  = "node-owner-sign"
 	flagSubscriptionID = "subscription-id"
+	flagMetadataURI    = "metadata-uri"
+	flagMetadataHash   = "metadata-hash"
+	flagClearMetadata  = "clear-metadata"
+	flagSeats          = "seats"
+	flagPageKey        = "page-key"
+	flagLimit          = "limit"
+	flagCountTotal     = "count-total"
+	flagClient         = "client"
+	flagPricePerGB     = "price-per-gb"
+	flagNonce          = "nonce"
+	flagExpiry         = "expiry"
+	flagQuote          = "quote"
+	flagBlocks         = "blocks"
+	flagKeyFile        = "key-file"
+	flagTitle          = "title"
+	flagDescription    = "description"
+	flagBy             = "by"
+	flagDenom          = "denom"
+	flagWindow         = "window"
+	flagActive         = "active"
+	flagProve          = "prove"
+	flagSessionsCount  = "sessions-count"
+	flagReferrer       = "referrer"
+	flagCategory       = "category"
+	flagSort           = "sort"
+	flagMinPrice       = "min-price"
+	flagMaxPrice       = "max-price"
+	flagCountry        = "country"
+	flagRegion         = "region"
+	flagLatencyZone    = "latency-zone"
+	flagLatency        = "latency"
+	flagStatus         = "status"
+	flagUpheld         = "upheld"
 )
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagPageKey, "", "Base64 encoded key to start the page from")
+	cmd.Flags().Uint64(flagLimit, hub.DefaultPageLimit, "Maximum number of items in the page")
+	cmd.Flags().Bool(flagCountTotal, false, "Count the total number of items")
+}
+
+func pageRequestFromFlags() (hub.PageRequest, error) {
+	return hub.NewPageRequestFromString(viper.GetString(flagPageKey),
+		viper.GetUint64(flagLimit), viper.GetBool(flagCountTotal))
+}
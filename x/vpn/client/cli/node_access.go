@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func SetNodePrivateTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-private [node-id] [true|false]",
+		Short: "Mark the node as private or public",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			private, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetNodePrivate(fromAddress, id, private)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func SetNodeLocationTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-location [node-id]",
+		Short: "Set the location of the node, the location is cleared if none of the flags is set",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			location := types.NewNodeLocation(viper.GetString(flagCountry),
+				viper.GetString(flagRegion), viper.GetString(flagLatencyZone))
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetNodeLocation(fromAddress, id, location)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagCountry, "", "ISO 3166-1 alpha-2 code of the country")
+	cmd.Flags().String(flagRegion, "", "ISO 3166-2 code of the region in the country")
+	cmd.Flags().String(flagLatencyZone, "", "Latency zone, one of AF, AN, AS, EU, NA, OC and SA")
+
+	return cmd
+}
+
+func SetNodeFreeTrialTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-free-trial [node-id] [bytes]",
+		Short: "Set the upload and the download each new client may use the node for free, zero disables the free trial",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetNodeFreeTrial(fromAddress, id, bytes)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func SetDiscountPlanTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-discount-plan [node-id] [rate] [until]",
+		Short: "Discount the prices of the node by the rate for the subscriptions started until the height, zero rate removes the discount plan",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			until, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetDiscountPlan(fromAddress, id, rate, until)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func AddAllowedAddressTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-allowed-address [node-id] [address]",
+		Short: "Allow the address to subscribe to the private node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgAddAllowedAddress(fromAddress, id, address)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func RemoveAllowedAddressTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-allowed-address [node-id] [address]",
+		Short: "Remove the address from the allow-list of the private node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgRemoveAllowedAddress(fromAddress, id, address)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func BlacklistClientTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklist-client [node-id] [client]",
+		Short: "Block the client from subscribing to the node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgBlacklistClient(fromAddress, id, client)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func UnblacklistClientTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblacklist-client [node-id] [client]",
+		Short: "Remove the client from the blacklist of the node",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgUnblacklistClient(fromAddress, id, client)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func SetPayoutRoutesTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-payout-routes [node-id] [denom:address,...]",
+		Short: "Set the per denom payout addresses of the node, no routes sends all the earnings to the owner",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			var routes []types.PayoutRoute
+			if len(args) > 1 {
+				routes, err = parsePayoutRoutes(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetPayoutRoutes(fromAddress, id, routes)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parsePayoutRoutes(s string) (routes []types.PayoutRoute, err error) {
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid payout route %s", item)
+		}
+
+		address, err := sdk.AccAddressFromBech32(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, types.NewPayoutRoute(parts[0], address))
+	}
+
+	return routes, nil
+}
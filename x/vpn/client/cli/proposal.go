@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func ReleaseEscrowProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrow [subscription-id] [recipient]",
+		Short: "Submit a proposal to release the stuck deposit of a subscription to the recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewSubscriptionIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReleaseEscrowProposal(viper.GetString(flagTitle),
+				viper.GetString(flagDescription), id, recipient)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, ctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "Title of the proposal")
+	cmd.Flags().String(flagDescription, "", "Description of the proposal")
+	cmd.Flags().String(flagDeposit, "", "Initial deposit of the proposal")
+
+	return cmd
+}
+
+func JailNodeProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail-node [node-id]",
+		Short: "Submit a proposal to jail a misbehaving node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewJailNodeProposal(viper.GetString(flagTitle),
+				viper.GetString(flagDescription), id)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, ctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "Title of the proposal")
+	cmd.Flags().String(flagDescription, "", "Description of the proposal")
+	cmd.Flags().String(flagDeposit, "", "Initial deposit of the proposal")
+
+	return cmd
+}
+
+func ResolveDisputeProposalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-dispute [session-id]",
+		Short: "Submit a proposal to resolve the open dispute of a session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewSessionIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResolveDisputeProposal(viper.GetString(flagTitle),
+				viper.GetString(flagDescription), id, viper.GetBool(flagUpheld))
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, ctx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "Title of the proposal")
+	cmd.Flags().String(flagDescription, "", "Description of the proposal")
+	cmd.Flags().String(flagDeposit, "", "Initial deposit of the proposal")
+	cmd.Flags().Bool(flagUpheld, false, "Uphold the claim of the disputing party")
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+func QueryDepositCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [address]",
+		Short: "Query the locked deposit of an address and the nodes and subscriptions which it backs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			deposit, err := common.QueryDepositOfAddress(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(deposit)
+			return nil
+		},
+	}
+
+	return client.GetCommands(cmd)[0]
+}
@@ -20,6 +20,21 @@ func QueryNodeCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
+			if viper.GetBool(flagProve) {
+				res, err := common.QueryNodeWithProof(ctx, args[0])
+				if err != nil {
+					return err
+				}
+
+				bytes, err := cdc.MarshalJSONIndent(res, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(bytes))
+				return nil
+			}
+
 			node, err := common.QueryNode(ctx, args[0])
 			if err != nil {
 				return err
@@ -30,6 +45,28 @@ func QueryNodeCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagProve, false, "Query the node along with the merkle proof of its record")
+	return cmd
+}
+
+func QueryNodeStatsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-stats",
+		Short: "Query the cumulative bandwidth, earnings and sessions count of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			stats, err := common.QueryNodeStats(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(stats)
+			return nil
+		},
+	}
+
 	return cmd
 }
 
@@ -40,28 +77,259 @@ func QueryNodesCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
+			var category types.NodeCategory
+			if s := viper.GetString(flagCategory); s != "" {
+				category, err = types.NewNodeCategoryFromString(s)
+				if err != nil {
+					return err
+				}
+			}
+
 			address := viper.GetString(flagAddress)
+			sort := viper.GetString(flagSort)
+			minPrice, maxPrice := viper.GetUint64(flagMinPrice), viper.GetUint64(flagMaxPrice)
+			location := types.NewNodeLocation(viper.GetString(flagCountry),
+				viper.GetString(flagRegion), viper.GetString(flagLatencyZone))
+			if address != "" && (sort != "" || minPrice > 0 || maxPrice > 0 || !location.IsEmpty()) {
+				return fmt.Errorf("the nodes of an address can not be sorted or filtered by the price or the location")
+			}
 
-			var nodes []types.Node
+			var res *types.QueryNodesResponse
 			if address != "" {
-				nodes, err = common.QueryNodesOfAddress(ctx, address)
+				res, err = common.QueryNodesOfAddress(ctx, address, category, page)
 			} else {
-				nodes, err = common.QueryAllNodes(ctx)
+				res, err = common.QueryAllNodes(ctx, category, location, sort, viper.GetString(flagDenom),
+					minPrice, maxPrice, page)
 			}
 
 			if err != nil {
 				return err
 			}
 
-			for _, node := range nodes {
+			for _, node := range res.Nodes {
 				fmt.Println(node)
 			}
 
+			fmt.Println(res.Pagination)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flagAddress, "", "Account address")
+	cmd.Flags().String(flagCategory, "", "Only the nodes of the category, one of OpenVPN, WireGuard and V2Ray")
+	cmd.Flags().String(flagSort, "", "Sort the nodes by price_asc, price_desc, bandwidth_asc, bandwidth_desc, "+
+		"uptime_asc, uptime_desc, reputation_asc or reputation_desc")
+	cmd.Flags().String(flagDenom, "", "Denom of the prices to sort and filter the nodes by, the deposit denom if empty")
+	cmd.Flags().Uint64(flagMinPrice, 0, "Only the nodes with a price per GB in the denom of at least the min price")
+	cmd.Flags().Uint64(flagMaxPrice, 0, "Only the nodes with a price per GB in the denom of at most the max price")
+	cmd.Flags().String(flagCountry, "", "Only the nodes in the country, an ISO 3166-1 alpha-2 code")
+	cmd.Flags().String(flagRegion, "", "Only the nodes in the region, an ISO 3166-2 code")
+	cmd.Flags().String(flagLatencyZone, "", "Only the nodes in the latency zone, one of AF, AN, AS, EU, NA, OC and SA")
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func QueryNodeUptimeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-uptime",
+		Short: "Query the uptime of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryNodeUptime(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(res.Uptime)
+			fmt.Println("Percentage:", res.Percentage)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryNodeQoSCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-qos",
+		Short: "Query the QoS summary of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			qos, err := common.QueryNodeQoS(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(qos)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryNodeReputationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-reputation",
+		Short: "Query the reputation of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			reputation, err := common.QueryNodeReputation(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(reputation)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryNodeByMonikerCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-by-moniker",
+		Short: "Query the node of a moniker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			node, err := common.QueryNodeByMoniker(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(node)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryDiscountPlanCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discount-plan",
+		Short: "Query the discount plan of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			plan, err := common.QueryDiscountPlan(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(plan)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryTopNodesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-nodes",
+		Short: "Query the nodes ranked by the bandwidth served or the earnings over a recent window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			stats, err := common.QueryTopNodes(ctx, viper.GetString(flagBy), viper.GetString(flagDenom),
+				viper.GetInt64(flagWindow), viper.GetUint64(flagLimit))
+			if err != nil {
+				return err
+			}
+
+			for _, s := range stats {
+				fmt.Println(s)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagBy, types.RankByBandwidth, "Rank the nodes by the bandwidth or the earnings")
+	cmd.Flags().String(flagDenom, "", "Denom of the earnings, defaults to the denom of the node deposit")
+	cmd.Flags().Int64(flagWindow, types.DefaultTopNodesWindow, "Number of recent blocks, 0 for all the blocks")
+	cmd.Flags().Uint64(flagLimit, types.DefaultTopNodesLimit, "Maximum number of nodes")
+
+	return cmd
+}
+
+func QueryAllowedAddressesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-addresses",
+		Short: "Query allowed addresses of a private node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryAllowedAddressesOfNode(ctx, args[0], page)
+			if err != nil {
+				return err
+			}
+
+			for _, address := range res.Addresses {
+				fmt.Println(address)
+			}
+
+			fmt.Println(res.Pagination)
+			return nil
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func QueryBlacklistedClientsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklisted-clients",
+		Short: "Query blacklisted clients of a node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryBlacklistedClientsOfNode(ctx, args[0], page)
+			if err != nil {
+				return err
+			}
+
+			for _, client := range res.Addresses {
+				fmt.Println(client)
+			}
+
+			fmt.Println(res.Pagination)
+			return nil
+		},
+	}
+
+	addPaginationFlags(cmd)
 
 	return cmd
 }
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+func QueryProtocolFeesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees",
+		Short: "Query total protocol fees sent to the community pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			fees, err := common.QueryProtocolFees(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(fees)
+			return nil
+		},
+	}
+
+	return cmd
+}
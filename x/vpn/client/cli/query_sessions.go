@@ -40,28 +40,42 @@ func QuerySessionsCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
 			id := viper.GetString(flagSubscriptionID)
+			address := viper.GetString(flagAddress)
+			if id != "" && address != "" {
+				return fmt.Errorf("only one of the subscription ID and the address can be set")
+			}
 
-			var sessions []types.Session
+			var res *types.QuerySessionsResponse
 			if id != "" {
-				sessions, err = common.QuerySessionsOfSubscription(ctx, id)
+				res, err = common.QuerySessionsOfSubscription(ctx, id, page)
+			} else if address != "" {
+				res, err = common.QueryActiveSessionsOfAddress(ctx, address, page)
 			} else {
-				sessions, err = common.QueryAllSessions(ctx)
+				res, err = common.QueryAllSessions(ctx, page)
 			}
 
 			if err != nil {
 				return err
 			}
 
-			for _, session := range sessions {
+			for _, session := range res.Sessions {
 				fmt.Println(session)
 			}
 
+			fmt.Println(res.Pagination)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
+	cmd.Flags().String(flagAddress, "", "Only the active sessions of the address, as the client or the node owner")
+	addPaginationFlags(cmd)
 
 	return cmd
 }
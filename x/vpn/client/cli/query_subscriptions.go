@@ -20,6 +20,21 @@ func QuerySubscriptionCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
+			if viper.GetBool(flagProve) {
+				res, err := common.QuerySubscriptionWithProof(ctx, args[0])
+				if err != nil {
+					return err
+				}
+
+				bytes, err := cdc.MarshalJSONIndent(res, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(bytes))
+				return nil
+			}
+
 			subscription, err := common.QuerySubscription(ctx, args[0])
 			if err != nil {
 				return err
@@ -30,6 +45,7 @@ func QuerySubscriptionCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagProve, false, "Query the subscription along with the merkle proof of its record")
 	return cmd
 }
 
@@ -40,32 +56,161 @@ func QuerySubscriptionsCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
 			id := viper.GetString(flagNodeID)
 			address := viper.GetString(flagAddress)
 
-			var subscriptions []types.Subscription
+			var res *types.QuerySubscriptionsResponse
 			if id != "" {
-				subscriptions, err = common.QuerySubscriptionsOfNode(ctx, id)
+				res, err = common.QuerySubscriptionsOfNode(ctx, id, viper.GetBool(flagActive), page)
 			} else if address != "" {
-				subscriptions, err = common.QuerySubscriptionsOfAddress(ctx, address)
+				res, err = common.QuerySubscriptionsOfAddress(ctx, address, page)
 			} else {
-				subscriptions, err = common.QueryAllSubscriptions(ctx)
+				res, err = common.QueryAllSubscriptions(ctx, page)
 			}
 
 			if err != nil {
 				return err
 			}
 
-			for _, subscription := range subscriptions {
+			for _, subscription := range res.Subscriptions {
 				fmt.Println(subscription)
 			}
 
+			fmt.Println(res.Pagination)
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flagNodeID, "", "Node ID")
+	cmd.Flags().Bool(flagActive, false, "Only the active subscriptions of the node")
 	cmd.Flags().String(flagAddress, "", "Account address")
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func QuerySubscriptionForecastCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscription-forecast",
+		Short: "Query the bandwidth depletion forecast of a subscription",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			forecast, err := common.QuerySubscriptionForecast(ctx, args[0], viper.GetInt64(flagBlocks))
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(forecast)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagBlocks, types.DefaultForecastBlocks, "Number of blocks the suggested top up covers")
+
+	return cmd
+}
+
+func QueryReferralEarningsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-earnings [address]",
+		Short: "Query the payments credited to an address as the referrer of subscriptions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			earnings, err := common.QueryReferralEarnings(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(earnings)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryFeeAllowanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-allowance [granter] [grantee]",
+		Short: "Query the fees of the grantee the owner of nodes pays",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			allowance, err := common.QueryFeeAllowance(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(allowance)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QueryAuthorizationsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Short: "Query the types of the messages the grantee may execute on behalf of the granter",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			authorizations, err := common.QueryAuthorizations(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			for _, authorization := range authorizations {
+				fmt.Println(authorization)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func QuerySeatsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seats",
+		Short: "Query seats of a subscription",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			page, err := pageRequestFromFlags()
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QuerySeatsOfSubscription(ctx, args[0], page)
+			if err != nil {
+				return err
+			}
+
+			for _, seat := range res.Seats {
+				fmt.Println(seat)
+			}
+
+			fmt.Println(res.Pagination)
+			return nil
+		},
+	}
+
+	addPaginationFlags(cmd)
 
 	return cmd
 }
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+func QueryNetworkSummaryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Query the counts of the nodes, the subscriptions, the sessions and the locked deposits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			summary, err := common.QueryNetworkSummary(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(summary)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// nolint:funlen
+func SignQuoteTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-quote",
+		Short: "Sign a price quote of the node for the client",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(viper.GetString(flagNodeID))
+			if err != nil {
+				return err
+			}
+
+			clientAddress, err := sdk.AccAddressFromBech32(viper.GetString(flagClient))
+			if err != nil {
+				return err
+			}
+
+			pricePerGB, err := sdk.ParseCoin(viper.GetString(flagPricePerGB))
+			if err != nil {
+				return err
+			}
+
+			capacity, err := hub.NewBandwidthFromString(viper.GetString(flagUpload), viper.GetString(flagDownload))
+			if err != nil {
+				return err
+			}
+
+			quote := types.NewQuote(id, clientAddress, pricePerGB, capacity,
+				viper.GetUint64(flagNonce), viper.GetInt64(flagExpiry))
+			if err := quote.IsValid(); err != nil {
+				return err
+			}
+
+			passphrase, err := keys.GetPassphrase(ctx.FromName)
+			if err != nil {
+				return err
+			}
+
+			kb, err := keys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+
+			data := quote.SignBytes(viper.GetString(client.FlagChainID))
+
+			sigBytes, pubKey, err := kb.Sign(ctx.FromName, passphrase, data)
+			if err != nil {
+				return err
+			}
+
+			signedQuote := types.NewSignedQuote(quote, auth.StdSignature{
+				PubKey:    pubKey,
+				Signature: sigBytes,
+			})
+
+			bytes, err := cdc.MarshalJSON(signedQuote)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(bytes))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNodeID, "", "Node ID")
+	cmd.Flags().String(flagClient, "", "Address of the client")
+	cmd.Flags().String(flagPricePerGB, "", "Price per GB")
+	cmd.Flags().String(flagUpload, "0", "Upload capacity in bytes")
+	cmd.Flags().String(flagDownload, "0", "Download capacity in bytes")
+	cmd.Flags().Uint64(flagNonce, 0, "Nonce of the quote, unique per node")
+	cmd.Flags().Int64(flagExpiry, 0, "Height after which the quote can not be used")
+
+	_ = cmd.MarkFlagRequired(flagNodeID)
+	_ = cmd.MarkFlagRequired(flagClient)
+	_ = cmd.MarkFlagRequired(flagPricePerGB)
+	_ = cmd.MarkFlagRequired(flagUpload)
+	_ = cmd.MarkFlagRequired(flagDownload)
+	_ = cmd.MarkFlagRequired(flagNonce)
+	_ = cmd.MarkFlagRequired(flagExpiry)
+
+	return cmd
+}
@@ -21,15 +21,22 @@ func RegisterNodeTxCmd(cdc *codec.Codec) *cobra.Command {
 			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
-			_type := viper.GetString(flagType)
+			_type, err := types.NewNodeCategoryFromString(viper.GetString(flagType))
+			if err != nil {
+				return err
+			}
+
 			version := viper.GetString(flagVersion)
 			moniker := viper.GetString(flagMoniker)
 			pricesPerGB := viper.GetString(flagPricesPerGB)
-			internetSpeed := hub.Bandwidth{
-				Upload:   sdk.NewInt(viper.GetInt64(flagUploadSpeed)),
-				Download: sdk.NewInt(viper.GetInt64(flagDownloadSpeed)),
+			internetSpeed, err := hub.NewBandwidthFromString(viper.GetString(flagUploadSpeed),
+				viper.GetString(flagDownloadSpeed))
+			if err != nil {
+				return err
 			}
 			encryption := viper.GetString(flagEncryption)
+			metadataURI := viper.GetString(flagMetadataURI)
+			metadataHash := viper.GetString(flagMetadataHash)
 
 			parsedPricesPerGB, err := sdk.ParseCoins(pricesPerGB)
 			if err != nil {
@@ -37,19 +44,21 @@ func RegisterNodeTxCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgRegisterNode(ctx.FromAddress, _type, version,
-				moniker, parsedPricesPerGB, internetSpeed, encryption)
+				moniker, parsedPricesPerGB, internetSpeed, encryption, metadataURI, metadataHash)
 
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
 
-	cmd.Flags().String(flagType, "", "VPN node type")
+	cmd.Flags().String(flagType, "", "VPN node type, one of OpenVPN, WireGuard and V2Ray")
 	cmd.Flags().String(flagVersion, "", "VPN node version")
 	cmd.Flags().String(flagMoniker, "", "Moniker")
 	cmd.Flags().String(flagPricesPerGB, "", "Prices per GB")
-	cmd.Flags().Int64(flagUploadSpeed, 0, "Internet upload speed in bytes/sec")
-	cmd.Flags().Int64(flagDownloadSpeed, 0, "Internet download speed in bytes/sec")
+	cmd.Flags().String(flagUploadSpeed, "0", "Internet upload speed in bytes/sec")
+	cmd.Flags().String(flagDownloadSpeed, "0", "Internet download speed in bytes/sec")
 	cmd.Flags().String(flagEncryption, "", "VPN encryption method")
+	cmd.Flags().String(flagMetadataURI, "", "URI of the off-chain node metadata")
+	cmd.Flags().String(flagMetadataHash, "", "Hex encoded SHA-256 hash of the off-chain node metadata")
 
 	_ = cmd.MarkFlagRequired(flagType)
 	_ = cmd.MarkFlagRequired(flagVersion)
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func SetRevenueSplitsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-revenue-splits [node-id] [role:address:share,...]",
+		Short: "Set the shares of the earnings of the node paid to the provider and the resolver, the rest is paid to the node",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewNodeIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			var splits []types.RevenueSplit
+			if len(args) > 1 {
+				splits, err = parseRevenueSplits(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSetRevenueSplits(fromAddress, id, splits)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parseRevenueSplits(s string) (splits []types.RevenueSplit, err error) {
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid revenue split %s", item)
+		}
+
+		address, err := sdk.AccAddressFromBech32(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := sdk.NewDecFromStr(parts[2])
+		if err != nil {
+			return nil, err
+		}
+
+		splits = append(splits, types.NewRevenueSplit(parts[0], address, share))
+	}
+
+	return splits, nil
+}
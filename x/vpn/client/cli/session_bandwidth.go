@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
 
 	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
@@ -25,43 +27,43 @@ func SignSessionBandwidthTxCmd(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 			_id := viper.GetString(flagSubscriptionID)
-			bandwidth := hub.Bandwidth{
-				Upload:   sdk.NewInt(viper.GetInt64(flagUpload)),
-				Download: sdk.NewInt(viper.GetInt64(flagDownload)),
-			}
-
-			scs, err := common.QuerySessionsCountOfSubscription(ctx, _id)
+			bandwidth, err := hub.NewBandwidthFromString(viper.GetString(flagUpload), viper.GetString(flagDownload))
 			if err != nil {
 				return err
 			}
 
-			id, err := hub.NewSubscriptionIDFromString(_id)
-			if err != nil {
-				return err
+			// The client and each of the seats sign with the index of their own session
+			index := viper.GetUint64(flagSessionsCount)
+			if !cmd.Flags().Changed(flagSessionsCount) {
+				address := ctx.GetFromAddress()
+				if s := viper.GetString(flagAddress); s != "" {
+					if address, err = sdk.AccAddressFromBech32(s); err != nil {
+						return err
+					}
+				}
+
+				if index, err = common.QuerySessionIndexOfAddress(ctx, _id, address); err != nil {
+					return err
+				}
 			}
 
-			data := hub.NewBandwidthSignatureData(id, scs, bandwidth).Bytes()
-
-			passphrase, err := keys.GetPassphrase(ctx.FromName)
+			id, err := hub.NewSubscriptionIDFromString(_id)
 			if err != nil {
 				return err
 			}
 
-			kb, err := keys.NewKeyBaseFromHomeFlag()
-			if err != nil {
-				return err
-			}
+			data := types.BandwidthSignBytes(id, index, bandwidth)
 
-			sigBytes, pubKey, err := kb.Sign(ctx.FromName, passphrase, data)
+			var stdSignature auth.StdSignature
+			if path := viper.GetString(flagKeyFile); path != "" {
+				stdSignature, err = signWithKeyFile(cdc, path, data)
+			} else {
+				stdSignature, err = signWithKeyBase(ctx.FromName, data)
+			}
 			if err != nil {
 				return err
 			}
 
-			stdSignature := auth.StdSignature{
-				PubKey:    pubKey,
-				Signature: sigBytes,
-			}
-
 			bytes, err := cdc.MarshalJSON(stdSignature)
 			if err != nil {
 				return err
@@ -73,8 +75,11 @@ func SignSessionBandwidthTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
-	cmd.Flags().Int64(flagUpload, 0, "Upload in in bytes")
-	cmd.Flags().Int64(flagDownload, 0, "Download in bytes")
+	cmd.Flags().String(flagUpload, "0", "Upload in bytes")
+	cmd.Flags().String(flagDownload, "0", "Download in bytes")
+	cmd.Flags().String(flagKeyFile, "", "File of the JSON encoded ed25519 or secp256k1 private key to sign with instead of the keybase")
+	cmd.Flags().Uint64(flagSessionsCount, 0, "Session index of the subscription to sign offline with instead of querying it")
+	cmd.Flags().String(flagAddress, "", "Address of the client or the seat of the session, the signer by default")
 
 	_ = cmd.MarkFlagRequired(flagSubscriptionID)
 	_ = cmd.MarkFlagRequired(flagUpload)
@@ -83,6 +88,43 @@ func SignSessionBandwidthTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func signWithKeyBase(name string, data []byte) (signature auth.StdSignature, err error) {
+	passphrase, err := keys.GetPassphrase(name)
+	if err != nil {
+		return signature, err
+	}
+
+	kb, err := keys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return signature, err
+	}
+
+	signature.Signature, signature.PubKey, err = kb.Sign(name, passphrase, data)
+	return signature, err
+}
+
+// signWithKeyFile signs the data with the private key of the file, which is how the
+// daemons that manage their own ed25519 keys sign the bandwidth.
+func signWithKeyFile(cdc *codec.Codec, path string, data []byte) (signature auth.StdSignature, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return signature, err
+	}
+
+	var privKey crypto.PrivKey
+	if err = cdc.UnmarshalJSON(bz, &privKey); err != nil {
+		return signature, err
+	}
+
+	signature.PubKey = privKey.PubKey()
+	if types.PubKeyType(signature.PubKey) == "" {
+		return signature, fmt.Errorf("unsupported key type %T", signature.PubKey)
+	}
+
+	signature.Signature, err = privKey.Sign(data)
+	return signature, err
+}
+
 func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-session-info",
@@ -95,9 +137,9 @@ func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			bandwidth := hub.Bandwidth{
-				Upload:   sdk.NewInt(viper.GetInt64(flagUpload)),
-				Download: sdk.NewInt(viper.GetInt64(flagDownload)),
+			bandwidth, err := hub.NewBandwidthFromString(viper.GetString(flagUpload), viper.GetString(flagDownload))
+			if err != nil {
+				return err
 			}
 			nodeOwnerSignatureStr := viper.GetString(flagNodeOwnerSign)
 			clientSignatureStr := viper.GetString(flagClientSign)
@@ -119,8 +161,8 @@ func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagSubscriptionID, "", "Subscription ID")
-	cmd.Flags().Int64(flagUpload, 0, "Upload in in bytes")
-	cmd.Flags().Int64(flagDownload, 0, "Download in bytes")
+	cmd.Flags().String(flagUpload, "0", "Upload in bytes")
+	cmd.Flags().String(flagDownload, "0", "Download in bytes")
 	cmd.Flags().String(flagNodeOwnerSign, "", "Signature of the node owner")
 	cmd.Flags().String(flagClientSign, "", "Signature of the client")
 
@@ -132,3 +174,30 @@ func UpdateSessionInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func UpdateSessionsInfoTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-sessions-info [updates-file]",
+		Short: "Update info of many sessions at once, the file contains a JSON list of the session updates",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var updates []types.SessionInfoUpdate
+			if err := cdc.UnmarshalJSON(bz, &updates); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSessionsInfo(ctx.GetFromAddress(), updates)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
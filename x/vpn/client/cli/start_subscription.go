@@ -33,18 +33,65 @@ func StartSubscriptionTxCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			var quote *types.SignedQuote
+			if s := viper.GetString(flagQuote); s != "" {
+				quote = &types.SignedQuote{}
+				if err := cdc.UnmarshalJSON([]byte(s), quote); err != nil {
+					return err
+				}
+			}
+
+			var referrer sdk.AccAddress
+			if s := viper.GetString(flagReferrer); s != "" {
+				referrer, err = sdk.AccAddressFromBech32(s)
+				if err != nil {
+					return err
+				}
+			}
+
+			seats := viper.GetUint64(flagSeats)
 			fromAddress := ctx.GetFromAddress()
 
-			msg := types.NewMsgStartSubscription(fromAddress, nodeID, parsedDeposit)
+			msg := types.NewMsgStartSubscription(fromAddress, nodeID, parsedDeposit, seats, quote, referrer)
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagNodeID, "", "Node ID")
 	cmd.Flags().String(flagDeposit, "", "Deposit")
+	cmd.Flags().Uint64(flagSeats, 0, "Number of seats to share the subscription with other addresses")
+	cmd.Flags().String(flagQuote, "", "Signed price quote of the node")
+	cmd.Flags().String(flagReferrer, "", "Address of the referrer to credit a share of the payments to")
 
 	_ = cmd.MarkFlagRequired(flagNodeID)
 	_ = cmd.MarkFlagRequired(flagDeposit)
 
 	return cmd
 }
+
+func StartFreeTrialTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-free-trial",
+		Short: "Start a subscription of the free trial of a node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			nodeID, err := hub.NewNodeIDFromString(viper.GetString(flagNodeID))
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgStartFreeTrial(fromAddress, nodeID)
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagNodeID, "", "Node ID")
+
+	_ = cmd.MarkFlagRequired(flagNodeID)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func SubmitQoSReportTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-qos [subscription-id]",
+		Short: "Report the quality of the last session of the subscription",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txb := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := hub.NewSubscriptionIDFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			speed, err := hub.NewBandwidthFromString(viper.GetString(flagUploadSpeed),
+				viper.GetString(flagDownloadSpeed))
+			if err != nil {
+				return err
+			}
+
+			fromAddress := ctx.GetFromAddress()
+
+			msg := types.NewMsgSubmitQoSReport(fromAddress, id, speed, viper.GetUint64(flagLatency))
+			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagUploadSpeed, "", "Average upload speed in bytes/sec")
+	cmd.Flags().String(flagDownloadSpeed, "", "Average download speed in bytes/sec")
+	cmd.Flags().Uint64(flagLatency, 0, "Average latency in milliseconds")
+
+	_ = cmd.MarkFlagRequired(flagUploadSpeed)
+	_ = cmd.MarkFlagRequired(flagDownloadSpeed)
+	_ = cmd.MarkFlagRequired(flagLatency)
+
+	return cmd
+}
@@ -26,15 +26,26 @@ func UpdateNodeInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			_type := viper.GetString(flagType)
+			var _type types.NodeCategory
+			if s := viper.GetString(flagType); s != "" {
+				_type, err = types.NewNodeCategoryFromString(s)
+				if err != nil {
+					return err
+				}
+			}
+
 			version := viper.GetString(flagVersion)
 			moniker := viper.GetString(flagMoniker)
 			pricesPerGB := viper.GetString(flagPricesPerGB)
-			internetSpeed := hub.Bandwidth{
-				Upload:   sdk.NewInt(viper.GetInt64(flagUploadSpeed)),
-				Download: sdk.NewInt(viper.GetInt64(flagDownloadSpeed)),
+			internetSpeed, err := hub.NewBandwidthFromString(viper.GetString(flagUploadSpeed),
+				viper.GetString(flagDownloadSpeed))
+			if err != nil {
+				return err
 			}
 			encryption := viper.GetString(flagEncryption)
+			metadataURI := viper.GetString(flagMetadataURI)
+			metadataHash := viper.GetString(flagMetadataHash)
+			clearMetadata := viper.GetBool(flagClearMetadata)
 
 			parsedPricesPerGB, err := sdk.ParseCoins(pricesPerGB)
 			if err != nil {
@@ -44,19 +55,22 @@ func UpdateNodeInfoTxCmd(cdc *codec.Codec) *cobra.Command {
 			fromAddress := ctx.GetFromAddress()
 
 			msg := types.NewMsgUpdateNodeInfo(fromAddress, nodeID,
-				_type, version, moniker, parsedPricesPerGB, internetSpeed, encryption)
+				_type, version, moniker, parsedPricesPerGB, internetSpeed, encryption, metadataURI, metadataHash, clearMetadata)
 			return utils.GenerateOrBroadcastMsgs(ctx, txb, []sdk.Msg{msg})
 		},
 	}
 
 	cmd.Flags().String(flagNodeID, "", "Node ID")
-	cmd.Flags().String(flagType, "", "VPN node type")
+	cmd.Flags().String(flagType, "", "VPN node type, one of OpenVPN, WireGuard and V2Ray")
 	cmd.Flags().String(flagVersion, "", "VPN node version")
 	cmd.Flags().String(flagMoniker, "", "Moniker")
 	cmd.Flags().String(flagPricesPerGB, "", "Prices per GB")
-	cmd.Flags().Int64(flagUploadSpeed, 0, "Internet upload speed in bytes/sec")
-	cmd.Flags().Int64(flagDownloadSpeed, 0, "Internet download speed in bytes/sec")
+	cmd.Flags().String(flagUploadSpeed, "0", "Internet upload speed in bytes/sec")
+	cmd.Flags().String(flagDownloadSpeed, "0", "Internet download speed in bytes/sec")
 	cmd.Flags().String(flagEncryption, "", "VPN encryption method")
+	cmd.Flags().String(flagMetadataURI, "", "URI of the off-chain node metadata")
+	cmd.Flags().String(flagMetadataHash, "", "Hex encoded SHA-256 hash of the off-chain node metadata")
+	cmd.Flags().Bool(flagClearMetadata, false, "Remove the off-chain node metadata")
 
 	_ = cmd.MarkFlagRequired(flagNodeID)
 
@@ -0,0 +1,145 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// NodeWithProof is a node along with the merkle proof of its record in the node store, which
+// can be verified against the app hash of the block after the height.
+type NodeWithProof struct {
+	Node   types.Node    `json:"node"`
+	Height int64         `json:"height"`
+	Store  string        `json:"store"`
+	Key    []byte        `json:"key"`
+	Proof  *merkle.Proof `json:"proof"`
+}
+
+type SubscriptionWithProof struct {
+	Subscription types.Subscription `json:"subscription"`
+	Height       int64              `json:"height"`
+	Store        string             `json:"store"`
+	Key          []byte             `json:"key"`
+	Proof        *merkle.Proof      `json:"proof"`
+}
+
+// queryStoreWithProof queries the raw value of the key with its proof, and verifies the proof
+// unless the node is trusted, as the proofs are not available for the custom queries.
+func queryStoreWithProof(ctx context.CLIContext, store string, key []byte) ([]byte, int64, *merkle.Proof, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height: ctx.Height,
+		Prove:  true,
+	}
+
+	result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", store), key, opts)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	res := result.Response
+	if !res.IsOK() {
+		return nil, 0, nil, errors.New(res.Log)
+	}
+	if res.Proof == nil {
+		return nil, 0, nil, errors.New("no proof returned by the node")
+	}
+
+	if !ctx.TrustNode {
+		if ctx.Verifier == nil {
+			return nil, 0, nil, errors.New("missing valid certifier to verify data from distrusted node")
+		}
+
+		// the app hash of the height is in the header of the next height
+		commit, err := ctx.Verify(res.Height + 1)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		kp := merkle.KeyPath{}
+		kp = kp.AppendKey([]byte(store), merkle.KeyEncodingURL)
+		kp = kp.AppendKey(res.Key, merkle.KeyEncodingURL)
+
+		prt := rootmulti.DefaultProofRuntime()
+		if res.Value == nil {
+			err = prt.VerifyAbsence(res.Proof, commit.Header.AppHash, kp.String())
+		} else {
+			err = prt.VerifyValue(res.Proof, commit.Header.AppHash, kp.String(), res.Value)
+		}
+		if err != nil {
+			return nil, 0, nil, err
+		}
+	}
+
+	return res.Value, res.Height, res.Proof, nil
+}
+
+func QueryNodeWithProof(ctx context.CLIContext, s string) (*NodeWithProof, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	key := types.NodeKey(id)
+	value, height, proof, err := queryStoreWithProof(ctx, types.StoreKeyNode, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var node types.Node
+	if err := ctx.Codec.UnmarshalBinaryLengthPrefixed(value, &node); err != nil {
+		return nil, err
+	}
+
+	return &NodeWithProof{
+		Node:   node,
+		Height: height,
+		Store:  types.StoreKeyNode,
+		Key:    key,
+		Proof:  proof,
+	}, nil
+}
+
+func QuerySubscriptionWithProof(ctx context.CLIContext, s string) (*SubscriptionWithProof, error) {
+	id, err := hub.NewSubscriptionIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	key := types.SubscriptionKey(id)
+	value, height, proof, err := queryStoreWithProof(ctx, types.StoreKeySubscription, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("no subscription found")
+	}
+
+	var subscription types.Subscription
+	if err := ctx.Codec.UnmarshalBinaryLengthPrefixed(value, &subscription); err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionWithProof{
+		Subscription: subscription,
+		Height:       height,
+		Store:        types.StoreKeySubscription,
+		Key:          key,
+		Proof:        proof,
+	}, nil
+}
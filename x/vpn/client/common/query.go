@@ -10,6 +10,51 @@ import (
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
+func QueryParams(ctx context.CLIContext) (*types.Params, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.Params
+	if err := ctx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+func QueryNetworkSummary(ctx context.CLIContext) (*types.NetworkSummary, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNetworkSummary)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary types.NetworkSummary
+	if err := ctx.Codec.UnmarshalJSON(res, &summary); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+func QueryProtocolFees(ctx context.CLIContext) (sdk.Coins, error) {
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProtocolFees)
+	res, _, err := ctx.QueryWithData(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fees sdk.Coins
+	if err := ctx.Codec.UnmarshalJSON(res, &fees); err != nil {
+		return nil, err
+	}
+
+	return fees, nil
+}
+
 func QueryNode(ctx context.CLIContext, s string) (*types.Node, error) {
 	id, err := hub.NewNodeIDFromString(s)
 	if err != nil {
@@ -39,13 +84,68 @@ func QueryNode(ctx context.CLIContext, s string) (*types.Node, error) {
 	return &node, nil
 }
 
-func QueryNodesOfAddress(ctx context.CLIContext, s string) ([]types.Node, error) {
+func QueryNodeByMoniker(ctx context.CLIContext, moniker string) (*types.Node, error) {
+	params := types.NewQueryNodeByMonikerParams(moniker)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeByMoniker)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var node types.Node
+	if err := ctx.Codec.UnmarshalJSON(res, &node); err != nil {
+		return nil, err
+	}
+
+	return &node, nil
+}
+
+func QueryNodeStats(ctx context.CLIContext, s string) (*types.NodeStats, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	params := types.NewQueryNodeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeStats)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var stats types.NodeStats
+	if err := ctx.Codec.UnmarshalJSON(res, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func QueryNodesOfAddress(ctx context.CLIContext, s string, category types.NodeCategory,
+	page hub.PageRequest) (*types.QueryNodesResponse, error) {
 	address, err := sdk.AccAddressFromBech32(s)
 	if err != nil {
 		return nil, err
 	}
 
-	params := types.NewQueryNodesOfAddressParams(address)
+	params := types.NewQueryNodesOfAddressParams(address, category, page)
 
 	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
@@ -57,34 +157,243 @@ func QueryNodesOfAddress(ctx context.CLIContext, s string) ([]types.Node, error)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
+
+	var response types.QueryNodesResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Nodes) == 0 {
+		return nil, fmt.Errorf("no nodes found")
+	}
+
+	return &response, nil
+}
+
+func QueryAllNodes(ctx context.CLIContext, category types.NodeCategory, location types.NodeLocation,
+	sort, denom string, minPrice, maxPrice uint64, page hub.PageRequest) (*types.QueryNodesResponse, error) {
+	params := types.NewQueryAllNodesParams(category, location, sort, denom, minPrice, maxPrice, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllNodes)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QueryNodesResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Nodes) == 0 {
 		return nil, fmt.Errorf("no nodes found")
 	}
 
-	var nodes []types.Node
-	if err := ctx.Codec.UnmarshalJSON(res, &nodes); err != nil {
+	return &response, nil
+}
+
+func QueryNodeQoS(ctx context.CLIContext, s string) (*types.NodeQoS, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	params := types.NewQueryNodeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
 		return nil, err
 	}
 
-	return nodes, nil
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeQoS)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var qos types.NodeQoS
+	if err := ctx.Codec.UnmarshalJSON(res, &qos); err != nil {
+		return nil, err
+	}
+
+	return &qos, nil
 }
 
-func QueryAllNodes(ctx context.CLIContext) ([]types.Node, error) {
-	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllNodes)
-	res, _, err := ctx.QueryWithData(path, nil)
+func QueryNodeReputation(ctx context.CLIContext, s string) (*types.NodeReputation, error) {
+	id, err := hub.NewNodeIDFromString(s)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
+	params := types.NewQueryNodeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeReputation)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var reputation types.NodeReputation
+	if err := ctx.Codec.UnmarshalJSON(res, &reputation); err != nil {
+		return nil, err
+	}
+
+	return &reputation, nil
+}
+
+func QueryNodeUptime(ctx context.CLIContext, s string) (*types.QueryNodeUptimeResponse, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	params := types.NewQueryNodeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeUptime)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no node found")
+	}
+
+	var response types.QueryNodeUptimeResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func QueryDiscountPlan(ctx context.CLIContext, s string) (*types.DiscountPlan, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	params := types.NewQueryNodeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDiscountPlan)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no discount plan found")
+	}
+
+	var plan types.DiscountPlan
+	if err := ctx.Codec.UnmarshalJSON(res, &plan); err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
+
+func QueryTopNodes(ctx context.CLIContext, by, denom string, window int64, limit uint64) ([]types.NodeStats, error) {
+	params := types.NewQueryTopNodesParams(by, denom, window, limit)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTopNodes)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []types.NodeStats
+	if err := ctx.Codec.UnmarshalJSON(res, &stats); err != nil {
+		return nil, err
+	}
+	if len(stats) == 0 {
 		return nil, fmt.Errorf("no nodes found")
 	}
 
-	var nodes []types.Node
-	if err := ctx.Codec.UnmarshalJSON(res, &nodes); err != nil {
+	return stats, nil
+}
+
+func QueryAllowedAddressesOfNode(ctx context.CLIContext, s string, page hub.PageRequest) (*types.QueryAddressesResponse, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryAllowedAddressesOfNodeParams(id, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllowedAddressesOfNode)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
 		return nil, err
 	}
 
-	return nodes, nil
+	var response types.QueryAddressesResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Addresses) == 0 {
+		return nil, fmt.Errorf("no allowed addresses found")
+	}
+
+	return &response, nil
+}
+
+func QueryBlacklistedClientsOfNode(ctx context.CLIContext, s string, page hub.PageRequest) (*types.QueryAddressesResponse, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryBlacklistedClientsOfNodeParams(id, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlacklistedClientsOfNode)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QueryAddressesResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Addresses) == 0 {
+		return nil, fmt.Errorf("no blacklisted clients found")
+	}
+
+	return &response, nil
 }
 
 func QuerySubscription(ctx context.CLIContext, s string) (*types.Subscription, error) {
@@ -116,81 +425,243 @@ func QuerySubscription(ctx context.CLIContext, s string) (*types.Subscription, e
 	return &subscription, nil
 }
 
-func QuerySubscriptionsOfNode(ctx context.CLIContext, s string) ([]types.Subscription, error) {
-	id, err := hub.NewNodeIDFromString(s)
+func QuerySubscriptionForecast(ctx context.CLIContext, s string, blocks int64) (*types.SubscriptionForecast, error) {
+	id, err := hub.NewSubscriptionIDFromString(s)
 	if err != nil {
 		return nil, err
 	}
-	params := types.NewQuerySubscriptionsOfNodePrams(id)
+	params := types.NewQuerySubscriptionForecastParams(id, blocks)
 
 	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
 		return nil, err
 	}
 
-	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfNode)
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionForecast)
 	res, _, err := ctx.QueryWithData(path, bytes)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
-		return nil, fmt.Errorf("no subscriptions found")
+	if res == nil {
+		return nil, fmt.Errorf("no subscription found")
 	}
 
-	var subscriptions []types.Subscription
-	if err := ctx.Codec.UnmarshalJSON(res, &subscriptions); err != nil {
+	var forecast types.SubscriptionForecast
+	if err := ctx.Codec.UnmarshalJSON(res, &forecast); err != nil {
 		return nil, err
 	}
 
-	return subscriptions, nil
+	return &forecast, nil
 }
 
-func QuerySubscriptionsOfAddress(ctx context.CLIContext, s string) ([]types.Subscription, error) {
+func QueryReferralEarnings(ctx context.CLIContext, s string) (*types.ReferralEarnings, error) {
 	address, err := sdk.AccAddressFromBech32(s)
 	if err != nil {
 		return nil, err
 	}
 
-	params := types.NewQuerySubscriptionsOfAddressParams(address)
+	params := types.NewQueryReferralEarningsParams(address)
 
 	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
 		return nil, err
 	}
 
-	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfAddress)
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReferralEarnings)
 	res, _, err := ctx.QueryWithData(path, bytes)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
-		return nil, fmt.Errorf("no subscriptions found")
+
+	var earnings types.ReferralEarnings
+	if err := ctx.Codec.UnmarshalJSON(res, &earnings); err != nil {
+		return nil, err
+	}
+
+	return &earnings, nil
+}
+
+func QueryFeeAllowance(ctx context.CLIContext, granter, grantee string) (*types.FeeAllowance, error) {
+	granterAddress, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return nil, err
 	}
 
-	var subscriptions []types.Subscription
-	if err := ctx.Codec.UnmarshalJSON(res, &subscriptions); err != nil {
+	granteeAddress, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
 		return nil, err
 	}
 
-	return subscriptions, nil
+	params := types.NewQueryFeeAllowanceParams(granterAddress, granteeAddress)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowance)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no fee allowance found")
+	}
+
+	var allowance types.FeeAllowance
+	if err := ctx.Codec.UnmarshalJSON(res, &allowance); err != nil {
+		return nil, err
+	}
+
+	return &allowance, nil
 }
 
-func QueryAllSubscriptions(ctx context.CLIContext) ([]types.Subscription, error) {
-	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllSubscriptions)
-	res, _, err := ctx.QueryWithData(path, nil)
+func QueryAuthorizations(ctx context.CLIContext, granter, grantee string) ([]types.Authorization, error) {
+	granterAddress, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddress, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryAuthorizationsParams(granterAddress, granteeAddress)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var authorizations []types.Authorization
+	if err := ctx.Codec.UnmarshalJSON(res, &authorizations); err != nil {
+		return nil, err
+	}
+
+	return authorizations, nil
+}
+
+func QuerySeatsOfSubscription(ctx context.CLIContext, s string, page hub.PageRequest) (*types.QuerySeatsResponse, error) {
+	id, err := hub.NewSubscriptionIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQuerySeatsOfSubscriptionParams(id, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySeatsOfSubscription)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QuerySeatsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Seats) == 0 {
+		return nil, fmt.Errorf("no seats found")
+	}
+
+	return &response, nil
+}
+
+func QuerySubscriptionsOfNode(ctx context.CLIContext, s string, active bool,
+	page hub.PageRequest) (*types.QuerySubscriptionsResponse, error) {
+	id, err := hub.NewNodeIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQuerySubscriptionsOfNodePrams(id, active, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfNode)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QuerySubscriptionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Subscriptions) == 0 {
 		return nil, fmt.Errorf("no subscriptions found")
 	}
 
-	var subscriptions []types.Subscription
-	if err := ctx.Codec.UnmarshalJSON(res, &subscriptions); err != nil {
+	return &response, nil
+}
+
+func QuerySubscriptionsOfAddress(ctx context.CLIContext, s string, page hub.PageRequest) (*types.QuerySubscriptionsResponse, error) {
+	address, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQuerySubscriptionsOfAddressParams(address, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
 		return nil, err
 	}
 
-	return subscriptions, nil
+	var response types.QuerySubscriptionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Subscriptions) == 0 {
+		return nil, fmt.Errorf("no subscriptions found")
+	}
+
+	return &response, nil
+}
+
+func QueryAllSubscriptions(ctx context.CLIContext, page hub.PageRequest) (*types.QuerySubscriptionsResponse, error) {
+	params := types.NewQueryAllSubscriptionsParams(page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllSubscriptions)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QuerySubscriptionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Subscriptions) == 0 {
+		return nil, fmt.Errorf("no subscriptions found")
+	}
+
+	return &response, nil
 }
 
 func QuerySessionsCountOfSubscription(ctx context.CLIContext, s string) (uint64, error) {
@@ -222,6 +693,35 @@ func QuerySessionsCountOfSubscription(ctx context.CLIContext, s string) (uint64,
 	return count, nil
 }
 
+func QuerySessionIndexOfAddress(ctx context.CLIContext, s string, address sdk.AccAddress) (uint64, error) {
+	id, err := hub.NewSubscriptionIDFromString(s)
+	if err != nil {
+		return 0, err
+	}
+	params := types.NewQuerySessionIndexOfAddressParams(id, address)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return 0, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySessionIndexOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, fmt.Errorf("no session index found")
+	}
+
+	var index uint64
+	if err := ctx.Codec.UnmarshalJSON(res, &index); err != nil {
+		return 0, err
+	}
+
+	return index, nil
+}
+
 func QuerySession(ctx context.CLIContext, s string) (*types.Session, error) {
 	id, err := hub.NewSessionIDFromString(s)
 	if err != nil {
@@ -281,12 +781,13 @@ func QuerySessionOfSubscription(ctx context.CLIContext, s string, index uint64)
 	return &session, nil
 }
 
-func QuerySessionsOfSubscription(ctx context.CLIContext, s string) ([]types.Session, error) {
+func QuerySessionsOfSubscription(ctx context.CLIContext, s string, page hub.PageRequest) (*types.QuerySessionsResponse, error) {
 	id, err := hub.NewSubscriptionIDFromString(s)
 	if err != nil {
 		return nil, err
 	}
-	params := types.NewQuerySessionsOfSubscriptionPrams(id)
+
+	params := types.NewQuerySessionsOfSubscriptionPrams(id, page)
 
 	bytes, err := ctx.Codec.MarshalJSON(params)
 	if err != nil {
@@ -298,32 +799,152 @@ func QuerySessionsOfSubscription(ctx context.CLIContext, s string) ([]types.Sess
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
+
+	var response types.QuerySessionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Sessions) == 0 {
 		return nil, fmt.Errorf("no sessions found")
 	}
 
-	var sessions []types.Session
-	if err := ctx.Codec.UnmarshalJSON(res, &sessions); err != nil {
+	return &response, nil
+}
+
+func QueryActiveSessionsOfAddress(ctx context.CLIContext, s string,
+	page hub.PageRequest) (*types.QuerySessionsResponse, error) {
+	address, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryActiveSessionsOfAddressParams(address, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
 		return nil, err
 	}
 
-	return sessions, nil
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryActiveSessionsOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QuerySessionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Sessions) == 0 {
+		return nil, fmt.Errorf("no active sessions found")
+	}
+
+	return &response, nil
 }
 
-func QueryAllSessions(ctx context.CLIContext) ([]types.Session, error) {
+func QueryAllSessions(ctx context.CLIContext, page hub.PageRequest) (*types.QuerySessionsResponse, error) {
+	params := types.NewQueryAllSessionsParams(page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllSessions)
-	res, _, err := ctx.QueryWithData(path, nil)
+	res, _, err := ctx.QueryWithData(path, bytes)
 	if err != nil {
 		return nil, err
 	}
-	if string(res) == "[]" || string(res) == "null" {
+
+	var response types.QuerySessionsResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Sessions) == 0 {
 		return nil, fmt.Errorf("no sessions found")
 	}
 
-	var sessions []types.Session
-	if err := ctx.Codec.UnmarshalJSON(res, &sessions); err != nil {
+	return &response, nil
+}
+
+func QueryDispute(ctx context.CLIContext, s string) (*types.Dispute, error) {
+	id, err := hub.NewSessionIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryDisputeParams(id)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDispute)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("no dispute found")
+	}
+
+	var dispute types.Dispute
+	if err := ctx.Codec.UnmarshalJSON(res, &dispute); err != nil {
+		return nil, err
+	}
+
+	return &dispute, nil
+}
+
+func QueryAllDisputes(ctx context.CLIContext, status string, page hub.PageRequest) (*types.QueryDisputesResponse, error) {
+	params := types.NewQueryAllDisputesParams(status, page)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDisputes)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var response types.QueryDisputesResponse
+	if err := ctx.Codec.UnmarshalJSON(res, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Disputes) == 0 {
+		return nil, fmt.Errorf("no disputes found")
+	}
+
+	return &response, nil
+}
+
+func QueryDepositOfAddress(ctx context.CLIContext, s string) (*types.DepositOfAddress, error) {
+	address, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := types.NewQueryDepositOfAddressParams(address)
+
+	bytes, err := ctx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDepositOfAddress)
+	res, _, err := ctx.QueryWithData(path, bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var deposit types.DepositOfAddress
+	if err := ctx.Codec.UnmarshalJSON(res, &deposit); err != nil {
 		return nil, err
 	}
 
-	return sessions, nil
+	return &deposit, nil
 }
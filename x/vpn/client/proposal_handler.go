@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/sentinel-official/hub/x/vpn/client/cli"
+	"github.com/sentinel-official/hub/x/vpn/client/rest"
+)
+
+var (
+	ReleaseEscrowProposalHandler = govclient.NewProposalHandler(cli.ReleaseEscrowProposalTxCmd,
+		rest.ReleaseEscrowProposalRESTHandler)
+	JailNodeProposalHandler = govclient.NewProposalHandler(cli.JailNodeProposalTxCmd,
+		rest.JailNodeProposalRESTHandler)
+	ResolveDisputeProposalHandler = govclient.NewProposalHandler(cli.ResolveDisputeProposalTxCmd,
+		rest.ResolveDisputeProposalRESTHandler)
+)
@@ -0,0 +1,300 @@
+package rest
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tm "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+const (
+	eventsSubscriber = "sentinel-hubcli-vpn-events"
+	eventsCapacity   = 100
+	eventsKeepAlive  = 15 * time.Second
+)
+
+// streamedEventTypes are the types of the vpn events which are pushed to the clients of the event stream,
+// the changes of the node statuses, the updates of the sessions, the settlements and the disputes.
+var streamedEventTypes = map[string]bool{
+	types.EventTypeUpdateNodeStatus:  true,
+	types.EventTypeJailNode:          true,
+	types.EventTypeUnjailNode:        true,
+	types.EventTypeUpdateSessionInfo: true,
+	types.EventTypeEndSession:        true,
+	types.EventTypeSettleSession:     true,
+	types.EventTypePayoutNode:        true,
+	types.EventTypeEndSubscription:   true,
+	types.EventTypeReleaseEscrow:     true,
+	types.EventTypeOpenDispute:       true,
+	types.EventTypeResolveDispute:    true,
+}
+
+// streamedEventAttributes are the attributes which the clients can filter the events by.
+var streamedEventAttributes = []string{
+	types.AttributeKeyNodeID,
+	types.AttributeKeySubscriptionID,
+	types.AttributeKeySessionID,
+	types.AttributeKeyAddress,
+}
+
+type event struct {
+	Height     int64             `json:"height"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+type eventFilter struct {
+	types      map[string]bool
+	attributes map[string]string
+}
+
+func newEventFilter(r *http.Request) (eventFilter, error) {
+	filter := eventFilter{
+		types:      streamedEventTypes,
+		attributes: make(map[string]string),
+	}
+
+	if s := r.URL.Query().Get("types"); s != "" {
+		filter.types = make(map[string]bool)
+		for _, _type := range strings.Split(s, ",") {
+			if !streamedEventTypes[_type] {
+				return filter, fmt.Errorf("invalid event type %s", _type)
+			}
+
+			filter.types[_type] = true
+		}
+	}
+
+	for _, key := range streamedEventAttributes {
+		if value := r.URL.Query().Get(key); value != "" {
+			filter.attributes[key] = value
+		}
+	}
+
+	return filter, nil
+}
+
+func (f eventFilter) match(e event) bool {
+	if !f.types[e.Type] {
+		return false
+	}
+
+	for key, value := range f.attributes {
+		if e.Attributes[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventBroker subscribes to the transactions and the blocks of the node once, when the first client
+// connects, and fans the vpn events out to all the connected clients. The clients which do not keep up
+// with the events are disconnected, so that they can query the state again and reconnect.
+type eventBroker struct {
+	ctx context.CLIContext
+
+	mtx     sync.Mutex
+	client  *rpcclient.HTTP
+	clients map[chan event]bool
+}
+
+func newEventBroker(ctx context.CLIContext) *eventBroker {
+	return &eventBroker{
+		ctx:     ctx,
+		clients: make(map[chan event]bool),
+	}
+}
+
+func (b *eventBroker) subscribe() (chan event, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.client == nil {
+		if err := b.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan event, eventsCapacity)
+	b.clients[ch] = true
+
+	return ch, nil
+}
+
+func (b *eventBroker) unsubscribe(ch chan event) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.clients[ch] {
+		delete(b.clients, ch)
+		close(ch)
+	}
+}
+
+func (b *eventBroker) start() error {
+	client := rpcclient.NewHTTP(b.ctx.NodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return err
+	}
+
+	txs, err := client.Subscribe(gocontext.Background(), eventsSubscriber, tm.EventQueryTx.String(), eventsCapacity)
+	if err != nil {
+		_ = client.Stop()
+		return err
+	}
+
+	blocks, err := client.Subscribe(gocontext.Background(), eventsSubscriber, tm.EventQueryNewBlock.String(), eventsCapacity)
+	if err != nil {
+		_ = client.Stop()
+		return err
+	}
+
+	b.client = client
+	go b.run(txs, blocks)
+
+	return nil
+}
+
+// stop closes the subscriptions of the node and disconnects all the clients, the next client
+// which connects subscribes to the node again.
+func (b *eventBroker) stop() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	_ = b.client.Stop()
+	b.client = nil
+
+	for ch := range b.clients {
+		delete(b.clients, ch)
+		close(ch)
+	}
+}
+
+func (b *eventBroker) run(txs, blocks <-chan ctypes.ResultEvent) {
+	defer b.stop()
+
+	for {
+		select {
+		case res, ok := <-txs:
+			if !ok {
+				return
+			}
+
+			if data, ok := res.Data.(tm.EventDataTx); ok && data.Result.IsOK() {
+				b.broadcast(data.Height, data.Result.Events)
+			}
+		case res, ok := <-blocks:
+			if !ok {
+				return
+			}
+
+			if data, ok := res.Data.(tm.EventDataNewBlock); ok {
+				b.broadcast(data.Block.Height, data.ResultEndBlock.Events)
+			}
+		}
+	}
+}
+
+func (b *eventBroker) broadcast(height int64, events []abci.Event) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, e := range events {
+		if !streamedEventTypes[e.Type] {
+			continue
+		}
+
+		_e := event{
+			Height:     height,
+			Type:       e.Type,
+			Attributes: make(map[string]string, len(e.Attributes)),
+		}
+		for _, attribute := range e.Attributes {
+			_e.Attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		for ch := range b.clients {
+			select {
+			case ch <- _e:
+			default:
+				delete(b.clients, ch)
+				close(ch)
+			}
+		}
+	}
+}
+
+// streamEventsHandlerFunc pushes the vpn events to the client as server-sent events, the event stream ends
+// when the client disconnects or does not keep up with the events. The write timeout of the rest server
+// also ends the stream, the clients are expected to reconnect.
+func streamEventsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	broker := newEventBroker(ctx)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, "streaming is not supported")
+			return
+		}
+
+		filter, err := newEventFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ch, err := broker.subscribe()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer broker.unsubscribe(ch)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(eventsKeepAlive)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			case e, ok := <-ch:
+				if !ok {
+					return
+				}
+				if !filter.match(e) {
+					continue
+				}
+
+				bz, err := json.Marshal(e)
+				if err != nil {
+					return
+				}
+
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, bz)
+			}
+
+			flusher.Flush()
+		}
+	}
+}
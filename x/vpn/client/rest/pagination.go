@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// parsePageRequest parses the page of the query parameters, selected either by the key of the previous
+// page or by the page number starting from 1, with the limit of the entries of a page.
+func parsePageRequest(r *http.Request) (hub.PageRequest, error) {
+	var (
+		number     uint64
+		limit      uint64
+		countTotal bool
+		err        error
+	)
+
+	query := r.URL.Query()
+	if s := query.Get("page"); s != "" {
+		number, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return hub.PageRequest{}, err
+		}
+		if number == 0 {
+			return hub.PageRequest{}, errors.New("page must be positive")
+		}
+		if query.Get("key") != "" {
+			return hub.PageRequest{}, errors.New("page and key can not be used together")
+		}
+	}
+	if s := query.Get("limit"); s != "" {
+		limit, err = strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return hub.PageRequest{}, err
+		}
+	}
+	if s := query.Get("count_total"); s != "" {
+		countTotal, err = strconv.ParseBool(s)
+		if err != nil {
+			return hub.PageRequest{}, err
+		}
+	}
+
+	page, err := hub.NewPageRequestFromString(query.Get("key"), limit, countTotal)
+	if err != nil {
+		return hub.PageRequest{}, err
+	}
+	if number > 0 {
+		page.Offset = (number - 1) * page.GetLimit()
+	}
+
+	return page, nil
+}
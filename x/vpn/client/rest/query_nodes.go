@@ -2,17 +2,58 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 func getNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var prove bool
+		if s := r.URL.Query().Get("prove"); s != "" {
+			var err error
+			if prove, err = strconv.ParseBool(s); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if prove {
+			res, err := common.QueryNodeWithProof(ctx, vars["id"])
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			rest.PostProcessResponse(w, ctx.WithHeight(res.Height), res)
+			return
+		}
+
+		// The proofs of the latest height can not be verified until the next block is committed,
+		// so only the queries without the proofs are pinned to it.
+		if ctx.Height == 0 {
+			if ctx, ok = withLatestHeight(w, ctx); !ok {
+				return
+			}
+		}
 
 		node, err := common.QueryNode(ctx, vars["id"])
 		if err != nil {
@@ -24,28 +65,361 @@ func getNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func getNodeStatsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		stats, err := common.QueryNodeStats(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, stats)
+	}
+}
+
+func getNodeMetadataHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		node, err := common.QueryNode(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if node.MetadataURI == "" {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "no metadata found")
+			return
+		}
+
+		metadata := types.NewNodeMetadata(node.MetadataURI, node.MetadataHash)
+		rest.PostProcessResponse(w, ctx, metadata)
+	}
+}
+
+func getAllowedAddressesOfNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAllowedAddressesOfNode(ctx, vars["id"], page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getBlacklistedClientsOfNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryBlacklistedClientsOfNode(ctx, vars["id"], page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
 func getNodesOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		nodes, err := common.QueryNodesOfAddress(ctx, vars["address"])
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		category, err := parseNodeCategory(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryNodesOfAddress(ctx, vars["address"], category, page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, nodes)
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getNodeUptimeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		uptime, err := common.QueryNodeUptime(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, uptime)
+	}
+}
+
+func getNodeQoSHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		qos, err := common.QueryNodeQoS(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, qos)
+	}
+}
+
+func getNodeReputationHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		reputation, err := common.QueryNodeReputation(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, reputation)
+	}
+}
+
+func getNodeByMonikerHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+
+		node, err := common.QueryNodeByMoniker(ctx, vars["moniker"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, node)
+	}
+}
+
+func getDiscountPlanHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		plan, err := common.QueryDiscountPlan(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, plan)
+	}
+}
+
+func getTopNodesHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		query := r.URL.Query()
+
+		by := query.Get("by")
+		if by == "" {
+			by = types.RankByBandwidth
+		}
+
+		window := types.DefaultTopNodesWindow
+		if s := query.Get("window"); s != "" {
+			var err error
+			if window, err = strconv.ParseInt(s, 10, 64); err != nil || window < 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid window")
+				return
+			}
+		}
+
+		limit := types.DefaultTopNodesLimit
+		if s := query.Get("limit"); s != "" {
+			var err error
+			if limit, err = strconv.ParseUint(s, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid limit")
+				return
+			}
+		}
+
+		stats, err := common.QueryTopNodes(ctx, by, query.Get("denom"), window, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, stats)
 	}
 }
 
 func getAllNodesHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		nodes, err := common.QueryAllNodes(ctx)
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		category, err := parseNodeCategory(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		query := r.URL.Query()
+		if !types.IsValidNodeSortOrder(query.Get("sort")) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid sort")
+			return
+		}
+
+		var minPrice, maxPrice uint64
+		if s := query.Get("min_price"); s != "" {
+			if minPrice, err = strconv.ParseUint(s, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid min_price")
+				return
+			}
+		}
+		if s := query.Get("max_price"); s != "" {
+			if maxPrice, err = strconv.ParseUint(s, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid max_price")
+				return
+			}
+		}
+
+		location := types.NewNodeLocation(query.Get("country"), query.Get("region"), query.Get("latency_zone"))
+
+		res, err := common.QueryAllNodes(ctx, category, location, query.Get("sort"), query.Get("denom"),
+			minPrice, maxPrice, page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, nodes)
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+// parseNodeCategory returns the category of the query parameter, or an empty one if it is not set.
+func parseNodeCategory(r *http.Request) (types.NodeCategory, error) {
+	s := r.URL.Query().Get("category")
+	if s == "" {
+		return "", nil
 	}
+
+	return types.NewNodeCategoryFromString(s)
 }
@@ -2,17 +2,30 @@ package rest
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 func getSessionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := hub.NewSessionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
 		session, err := common.QuerySession(ctx, vars["id"])
 		if err != nil {
@@ -26,26 +39,133 @@ func getSessionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 
 func getSessionsOfSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewSubscriptionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QuerySessionsOfSubscription(ctx, vars["id"], page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getActiveSessionsOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		sessions, err := common.QuerySessionsOfSubscription(ctx, vars["id"])
+		res, err := common.QueryActiveSessionsOfAddress(ctx, vars["address"], page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, sessions)
+		rest.PostProcessResponse(w, ctx, res)
 	}
 }
 
 func getAllSessionsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		sessions, err := common.QueryAllSessions(ctx)
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAllSessions(ctx, page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getDisputeOfSessionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewSessionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		dispute, err := common.QueryDispute(ctx, vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, dispute)
+	}
+}
+
+func getAllDisputesHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		status := strings.ToUpper(r.URL.Query().Get("status"))
+		if status != "" && !types.IsValidDisputeStatus(status) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid dispute status")
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAllDisputes(ctx, status, page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, sessions)
+		rest.PostProcessResponse(w, ctx, res)
 	}
 }
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/sentinel-official/hub/x/vpn/client/common"
+)
+
+type status struct {
+	ChainID            string    `json:"chain_id"`
+	LatestBlockHeight  int64     `json:"latest_block_height"`
+	LatestBlockTime    time.Time `json:"latest_block_time"`
+	ParamsHash         string    `json:"params_hash"`
+	MinClientVersion   string    `json:"min_client_version"`
+	MinNodeVersion     string    `json:"min_node_version"`
+	MaintenanceBanners []string  `json:"maintenance_banners"`
+}
+
+func getStatusHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		node, err := ctx.GetNode()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		result, err := node.Status()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		params, err := common.QueryParams(ctx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		bytes, err := ctx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		hash := sha256.Sum256(bytes)
+		rest.PostProcessResponse(w, ctx, status{
+			ChainID:            result.NodeInfo.Network,
+			LatestBlockHeight:  result.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:    result.SyncInfo.LatestBlockTime,
+			ParamsHash:         hex.EncodeToString(hash[:]),
+			MinClientVersion:   params.MinClientVersion,
+			MinNodeVersion:     params.MinNodeVersion,
+			MaintenanceBanners: params.MaintenanceBanners,
+		})
+	}
+}
@@ -2,17 +2,58 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/client/common"
+	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 func getSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := hub.NewSubscriptionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var prove bool
+		if s := r.URL.Query().Get("prove"); s != "" {
+			var err error
+			if prove, err = strconv.ParseBool(s); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if prove {
+			res, err := common.QuerySubscriptionWithProof(ctx, vars["id"])
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			rest.PostProcessResponse(w, ctx.WithHeight(res.Height), res)
+			return
+		}
+
+		// The proofs of the latest height can not be verified until the next block is committed,
+		// so only the queries without the proofs are pinned to it.
+		if ctx.Height == 0 {
+			if ctx, ok = withLatestHeight(w, ctx); !ok {
+				return
+			}
+		}
 
 		subscription, err := common.QuerySubscription(ctx, vars["id"])
 		if err != nil {
@@ -24,42 +65,230 @@ func getSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func getSubscriptionForecastHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewSubscriptionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		blocks := types.DefaultForecastBlocks
+		if s := r.URL.Query().Get("blocks"); s != "" {
+			var err error
+			if blocks, err = strconv.ParseInt(s, 10, 64); err != nil || blocks <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid blocks")
+				return
+			}
+		}
+
+		forecast, err := common.QuerySubscriptionForecast(ctx, vars["id"], blocks)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, forecast)
+	}
+}
+
+func getSeatsOfSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := hub.NewSubscriptionIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QuerySeatsOfSubscription(ctx, vars["id"], page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
 func getSubscriptionsOfNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := hub.NewNodeIDFromString(vars["id"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var active bool
+		if s := r.URL.Query().Get("active"); s != "" {
+			active, err = strconv.ParseBool(s)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
 
-		subscriptions, err := common.QuerySubscriptionsOfNode(ctx, vars["id"])
+		res, err := common.QuerySubscriptionsOfNode(ctx, vars["id"], active, page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, subscriptions)
+		rest.PostProcessResponse(w, ctx, res)
 	}
 }
 
 func getSubscriptionsOfAddressHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
 		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		subscriptions, err := common.QuerySubscriptionsOfAddress(ctx, vars["address"])
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QuerySubscriptionsOfAddress(ctx, vars["address"], page)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, subscriptions)
+		rest.PostProcessResponse(w, ctx, res)
 	}
 }
 
 func getAllSubscriptionsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		subscriptions, err := common.QueryAllSubscriptions(ctx)
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		page, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAllSubscriptions(ctx, page)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getReferralEarningsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		earnings, err := common.QueryReferralEarnings(ctx, vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, earnings)
+	}
+}
+
+func getFeeAllowanceHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if _, err := sdk.AccAddressFromBech32(vars["grantee"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		allowance, err := common.QueryFeeAllowance(ctx, vars["address"], vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, ctx, allowance)
+	}
+}
+
+func getAuthorizationsHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := parseQueryHeight(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		if _, err := sdk.AccAddressFromBech32(vars["address"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if _, err := sdk.AccAddressFromBech32(vars["grantee"]); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authorizations, err := common.QueryAuthorizations(ctx, vars["address"], vars["grantee"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		rest.PostProcessResponse(w, ctx, subscriptions)
+		rest.PostProcessResponse(w, ctx, authorizations)
 	}
 }
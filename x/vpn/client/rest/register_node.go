@@ -20,6 +20,8 @@ type msgRegisterNode struct {
 	PricesPerGB   string        `json:"prices_per_gb"`
 	InternetSpeed hub.Bandwidth `json:"internet_speed"`
 	Encryption    string        `json:"encryption"`
+	MetadataURI   string        `json:"metadata_uri"`
+	MetadataHash  string        `json:"metadata_hash"`
 }
 
 func registerNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -47,8 +49,14 @@ func registerNodeHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgRegisterNode(fromAddress, req.Type, req.Version,
-			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption)
+		_type, err := types.NewNodeCategoryFromString(req.Type)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRegisterNode(fromAddress, _type, req.Version,
+			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption, req.MetadataURI, req.MetadataHash)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
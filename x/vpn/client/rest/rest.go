@@ -17,22 +17,111 @@ func registerTxRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("DELETE")
 	r.HandleFunc("/nodes/{id}/info", updateNodeInfoHandlerFunc(ctx)).
 		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/history", pruneNodeHistoryHandlerFunc(ctx)).
+		Methods("DELETE")
+	r.HandleFunc("/nodes/{id}/private", setNodePrivateHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/location", setNodeLocationHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/free_trial", setNodeFreeTrialHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/free_trial", startFreeTrialHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/discount_plan", setDiscountPlanHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/allowed_addresses", addAllowedAddressHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/allowed_addresses/{address}", removeAllowedAddressHandlerFunc(ctx)).
+		Methods("DELETE")
+	r.HandleFunc("/nodes/{id}/payout_routes", setPayoutRoutesHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/revenue_splits", setRevenueSplitsHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/nodes/{id}/unjail", unjailNodeHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/deposit/withdraw", withdrawNodeDepositHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/blacklisted_clients", blacklistClientHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/nodes/{id}/blacklisted_clients/{client}", unblacklistClientHandlerFunc(ctx)).
+		Methods("DELETE")
 	r.HandleFunc("/nodes/{id}/subscriptions", startSubscriptionHandlerFunc(ctx)).
 		Methods("POST")
+	r.HandleFunc("/fee_allowances", grantFeeAllowanceHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/fee_allowances/{grantee}", revokeFeeAllowanceHandlerFunc(ctx)).
+		Methods("DELETE")
+	r.HandleFunc("/authorizations", grantAuthorizationHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/authorizations/{grantee}/{msg_type}", revokeAuthorizationHandlerFunc(ctx)).
+		Methods("DELETE")
+	r.HandleFunc("/authorizations/exec", execAuthorizedHandlerFunc(ctx)).
+		Methods("POST")
 
 	r.HandleFunc("/subscriptions/{id}", endSubscriptionHandlerFunc(ctx)).
 		Methods("DELETE")
+	r.HandleFunc("/subscriptions/{id}/pause", pauseSubscriptionHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/resume", resumeSubscriptionHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/deposit", topUpSubscriptionHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/qos", submitQoSReportHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/rating", rateNodeHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/seats", assignSeatHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/subscriptions/{id}/seats/{index}", unassignSeatHandlerFunc(ctx)).
+		Methods("DELETE")
 	r.HandleFunc("/subscriptions/{id}/sessions/bandwidth/sign", signSessionBandwidthHandlerFunc(ctx)).
 		Methods("POST")
 	r.HandleFunc("/subscriptions/{id}/sessions", updateSessionInfoHandlerFunc(ctx)).
 		Methods("PUT")
+	r.HandleFunc("/sessions", updateSessionsInfoHandlerFunc(ctx)).
+		Methods("PUT")
+	r.HandleFunc("/subscriptions/{id}/sessions/{index}/dispute", openDisputeHandlerFunc(ctx)).
+		Methods("POST")
+	r.HandleFunc("/sessions/{id}/dispute/evidence", submitDisputeEvidenceHandlerFunc(ctx)).
+		Methods("POST")
 }
 
 func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/vpn/status", getStatusHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/vpn/protocol_fees", getProtocolFeesHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/vpn/params", getParamsHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/vpn/summary", getNetworkSummaryHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/vpn/events", streamEventsHandlerFunc(ctx)).
+		Methods("GET")
+
 	r.HandleFunc("/nodes", getAllNodesHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/nodes/top", getTopNodesHandlerFunc(ctx)).
+		Methods("GET")
 	r.HandleFunc("/nodes/{id}", getNodeHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/nodes/monikers/{moniker}", getNodeByMonikerHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/metadata", getNodeMetadataHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/stats", getNodeStatsHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/uptime", getNodeUptimeHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/qos", getNodeQoSHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/reputation", getNodeReputationHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/discount_plan", getDiscountPlanHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/allowed_addresses", getAllowedAddressesOfNodeHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/nodes/{id}/blacklisted_clients", getBlacklistedClientsOfNodeHandlerFunc(ctx)).
+		Methods("GET")
 	r.HandleFunc("/nodes/{id}/subscriptions", getSubscriptionsOfNodeHandlerFunc(ctx)).
 		Methods("GET")
 
@@ -42,14 +131,30 @@ func registerQueryRoutes(ctx context.CLIContext, r *mux.Router) {
 		Methods("GET")
 	r.HandleFunc("/subscriptions/{id}/sessions", getSessionsOfSubscriptionHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/subscriptions/{id}/seats", getSeatsOfSubscriptionHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/subscriptions/{id}/forecast", getSubscriptionForecastHandlerFunc(ctx)).
+		Methods("GET")
 
 	r.HandleFunc("/sessions", getAllSessionsHandlerFunc(ctx)).
 		Methods("GET")
 	r.HandleFunc("/sessions/{id}", getSessionHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/sessions/{id}/dispute", getDisputeOfSessionHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/disputes", getAllDisputesHandlerFunc(ctx)).
+		Methods("GET")
 
 	r.HandleFunc("/accounts/{address}/subscriptions", getSubscriptionsOfAddressHandlerFunc(ctx)).
 		Methods("GET")
 	r.HandleFunc("/accounts/{address}/nodes", getNodesOfAddressHandlerFunc(ctx)).
 		Methods("GET")
+	r.HandleFunc("/accounts/{address}/sessions/active", getActiveSessionsOfAddressHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/accounts/{address}/referral_earnings", getReferralEarningsHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/accounts/{address}/fee_allowances/{grantee}", getFeeAllowanceHandlerFunc(ctx)).
+		Methods("GET")
+	r.HandleFunc("/accounts/{address}/authorizations/{grantee}", getAuthorizationsHandlerFunc(ctx)).
+		Methods("GET")
 }
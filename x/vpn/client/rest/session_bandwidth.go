@@ -16,10 +16,14 @@ import (
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
+// msgSignSessionBandwidth signs the bandwidth of the session of the address, which is the signer unless
+// it is given. The session index is queried from the chain unless it is given for signing offline.
 type msgSignSessionBandwidth struct {
-	From      string        `json:"from"`
-	Password  string        `json:"password"`
-	Bandwidth hub.Bandwidth `json:"bandwidth"`
+	From          string        `json:"from"`
+	Password      string        `json:"password"`
+	Bandwidth     hub.Bandwidth `json:"bandwidth"`
+	Address       string        `json:"address,omitempty"`
+	SessionsCount *uint64       `json:"sessions_count,omitempty"`
 }
 
 func signSessionBandwidthHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -31,18 +35,11 @@ func signSessionBandwidthHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		scs, err := common.QuerySessionsCountOfSubscription(ctx, vars["id"])
-		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-
 		id, err := hub.NewSubscriptionIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		data := hub.NewBandwidthSignatureData(id, scs, req.Bandwidth).Bytes()
 
 		kb, err := keys.NewKeyBaseFromHomeFlag()
 		if err != nil {
@@ -50,6 +47,33 @@ func signSessionBandwidthHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		var index uint64
+		if req.SessionsCount != nil {
+			index = *req.SessionsCount
+		} else {
+			var address sdk.AccAddress
+			if req.Address == "" {
+				info, err := kb.Get(req.From)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+
+				address = info.GetAddress()
+			} else if address, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			index, err = common.QuerySessionIndexOfAddress(ctx, vars["id"], address)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+
+		data := types.BandwidthSignBytes(id, index, req.Bandwidth)
+
 		sigBytes, pubKey, err := kb.Sign(req.From, req.Password, data)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -113,3 +137,37 @@ func updateSessionInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+type msgUpdateSessionsInfo struct {
+	BaseReq rest.BaseReq              `json:"base_req"`
+	Updates []types.SessionInfoUpdate `json:"updates"`
+}
+
+func updateSessionsInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgUpdateSessionsInfo
+
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUpdateSessionsInfo(fromAddress, req.Updates)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
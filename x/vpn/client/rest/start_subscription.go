@@ -14,8 +14,11 @@ import (
 )
 
 type msgStartSubscription struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Deposit string       `json:"deposit"`
+	BaseReq  rest.BaseReq       `json:"base_req"`
+	Deposit  string             `json:"deposit"`
+	Seats    uint64             `json:"seats"`
+	Quote    *types.SignedQuote `json:"quote"`
+	Referrer string             `json:"referrer"`
 }
 
 func startSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -43,13 +46,62 @@ func startSubscriptionHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		var referrer sdk.AccAddress
+		if req.Referrer != "" {
+			referrer, err = sdk.AccAddressFromBech32(req.Referrer)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		vars := mux.Vars(r)
 		id, err := hub.NewNodeIDFromString(vars["id"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgStartSubscription(fromAddress, id, deposit)
+		msg := types.NewMsgStartSubscription(fromAddress, id, deposit, req.Seats, req.Quote, referrer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type msgStartFreeTrial struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func startFreeTrialHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req msgStartFreeTrial
+
+		if !rest.ReadRESTReq(w, r, ctx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		id, err := hub.NewNodeIDFromString(vars["id"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgStartFreeTrial(fromAddress, id)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -21,6 +21,9 @@ type msgUpdateNode struct {
 	Encryption    string        `json:"encryption"`
 	Type          string        `json:"type"`
 	Version       string        `json:"version"`
+	MetadataURI   string        `json:"metadata_uri"`
+	MetadataHash  string        `json:"metadata_hash"`
+	ClearMetadata bool          `json:"clear_metadata"`
 }
 
 func updateNodeInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
@@ -54,8 +57,18 @@ func updateNodeInfoHandlerFunc(ctx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgUpdateNodeInfo(fromAddress, id, req.Type, req.Version,
-			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption)
+
+		var _type types.NodeCategory
+		if req.Type != "" {
+			_type, err = types.NewNodeCategoryFromString(req.Type)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgUpdateNodeInfo(fromAddress, id, _type, req.Version,
+			req.Moniker, pricesPerGB, req.InternetSpeed, req.Encryption, req.MetadataURI, req.MetadataHash, req.ClearMetadata)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
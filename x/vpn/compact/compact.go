@@ -0,0 +1,269 @@
+// Package compact implements a versioned binary encoding of the settlement events which
+// the vpn module emits at the end of each block, so that indexers can ingest them without
+// decoding the full block results.
+package compact
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+const (
+	Version byte = 1
+)
+
+var (
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	ErrTruncated          = errors.New("truncated data")
+	ErrTrailingBytes      = errors.New("trailing bytes")
+)
+
+type SettleSession struct {
+	SessionID hub.SessionID `json:"session_id"`
+	Amount    sdk.Coin      `json:"amount"`
+	Fee       sdk.Coin      `json:"fee"`
+	Final     bool          `json:"final"`
+}
+
+type PayoutNode struct {
+	NodeID  hub.NodeID     `json:"node_id"`
+	Address sdk.AccAddress `json:"address"`
+	Amount  sdk.Coins      `json:"amount"`
+}
+
+type Block struct {
+	SettleSessions []SettleSession `json:"settle_sessions"`
+	PayoutNodes    []PayoutNode    `json:"payout_nodes"`
+}
+
+func (b Block) Empty() bool {
+	return len(b.SettleSessions) == 0 && len(b.PayoutNodes) == 0
+}
+
+// FromEvents collects the settle session and payout node events from the given events.
+func FromEvents(events sdk.Events) (block Block, err error) {
+	for _, event := range events {
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		switch event.Type {
+		case types.EventTypeSettleSession:
+			var settle SettleSession
+			if settle.SessionID, err = hub.NewSessionIDFromString(attributes[types.AttributeKeySessionID]); err != nil {
+				return block, err
+			}
+			if settle.Amount, err = sdk.ParseCoin(attributes[types.AttributeKeyAmount]); err != nil {
+				return block, err
+			}
+			if settle.Fee, err = sdk.ParseCoin(attributes[types.AttributeKeyFee]); err != nil {
+				return block, err
+			}
+			if settle.Final, err = strconv.ParseBool(attributes[types.AttributeKeyFinal]); err != nil {
+				return block, err
+			}
+
+			block.SettleSessions = append(block.SettleSessions, settle)
+		case types.EventTypePayoutNode:
+			var payout PayoutNode
+			if payout.NodeID, err = hub.NewNodeIDFromString(attributes[types.AttributeKeyNodeID]); err != nil {
+				return block, err
+			}
+			if payout.Address, err = sdk.AccAddressFromBech32(attributes[types.AttributeKeyAddress]); err != nil {
+				return block, err
+			}
+			if payout.Amount, err = sdk.ParseCoins(attributes[types.AttributeKeyAmount]); err != nil {
+				return block, err
+			}
+
+			block.PayoutNodes = append(block.PayoutNodes, payout)
+		}
+	}
+
+	return block, nil
+}
+
+// Encode returns the compact binary encoding of the block. All the integers are unsigned
+// varints, byte slices are length prefixed and the coin amounts are big endian.
+func Encode(block Block) []byte {
+	var e encoder
+	e.bz = append(e.bz, Version)
+
+	e.uvarint(uint64(len(block.SettleSessions)))
+	for _, settle := range block.SettleSessions {
+		e.uvarint(settle.SessionID.Uint64())
+		e.coin(settle.Amount)
+		e.coin(settle.Fee)
+		e.bool(settle.Final)
+	}
+
+	e.uvarint(uint64(len(block.PayoutNodes)))
+	for _, payout := range block.PayoutNodes {
+		e.uvarint(payout.NodeID.Uint64())
+		e.bytes(payout.Address)
+		e.uvarint(uint64(len(payout.Amount)))
+		for _, coin := range payout.Amount {
+			e.coin(coin)
+		}
+	}
+
+	return e.bz
+}
+
+func EncodeToString(block Block) string {
+	return base64.StdEncoding.EncodeToString(Encode(block))
+}
+
+// Decode parses the compact binary encoding of a block.
+func Decode(bz []byte) (block Block, err error) {
+	if len(bz) == 0 {
+		return block, ErrTruncated
+	}
+	if bz[0] != Version {
+		return block, fmt.Errorf("%s: %d", ErrUnsupportedVersion, bz[0])
+	}
+
+	d := decoder{bz: bz[1:]}
+
+	count := d.uvarint()
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		settle := SettleSession{
+			SessionID: hub.NewSessionID(d.uvarint()),
+			Amount:    d.coin(),
+			Fee:       d.coin(),
+			Final:     d.bool(),
+		}
+
+		block.SettleSessions = append(block.SettleSessions, settle)
+	}
+
+	count = d.uvarint()
+	for i := uint64(0); i < count && d.err == nil; i++ {
+		payout := PayoutNode{
+			NodeID:  hub.NewNodeID(d.uvarint()),
+			Address: sdk.AccAddress(d.bytes()),
+		}
+
+		n := d.uvarint()
+		for j := uint64(0); j < n && d.err == nil; j++ {
+			payout.Amount = append(payout.Amount, d.coin())
+		}
+
+		block.PayoutNodes = append(block.PayoutNodes, payout)
+	}
+
+	if d.err != nil {
+		return Block{}, d.err
+	}
+	if len(d.bz) > 0 {
+		return Block{}, ErrTrailingBytes
+	}
+
+	return block, nil
+}
+
+func DecodeString(s string) (Block, error) {
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return Block{}, err
+	}
+
+	return Decode(bz)
+}
+
+type encoder struct {
+	bz []byte
+}
+
+func (e *encoder) uvarint(i uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], i)
+	e.bz = append(e.bz, buf[:n]...)
+}
+
+func (e *encoder) bytes(bz []byte) {
+	e.uvarint(uint64(len(bz)))
+	e.bz = append(e.bz, bz...)
+}
+
+func (e *encoder) bool(b bool) {
+	if b {
+		e.bz = append(e.bz, 1)
+	} else {
+		e.bz = append(e.bz, 0)
+	}
+}
+
+func (e *encoder) coin(coin sdk.Coin) {
+	e.bytes([]byte(coin.Denom))
+	e.bytes(coin.Amount.BigInt().Bytes())
+}
+
+type decoder struct {
+	bz  []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	i, n := binary.Uvarint(d.bz)
+	if n <= 0 {
+		d.err = ErrTruncated
+		return 0
+	}
+
+	d.bz = d.bz[n:]
+	return i
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.bz)) < n {
+		d.err = ErrTruncated
+		return nil
+	}
+
+	bz := d.bz[:n]
+	d.bz = d.bz[n:]
+	return bz
+}
+
+func (d *decoder) bool() bool {
+	if d.err != nil {
+		return false
+	}
+	if len(d.bz) == 0 {
+		d.err = ErrTruncated
+		return false
+	}
+
+	b := d.bz[0]
+	d.bz = d.bz[1:]
+	return b == 1
+}
+
+func (d *decoder) coin() sdk.Coin {
+	denom := string(d.bytes())
+	amount := new(big.Int).SetBytes(d.bytes())
+	if d.err != nil {
+		return sdk.Coin{}
+	}
+
+	return sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)}
+}
@@ -0,0 +1,114 @@
+package compact
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+var (
+	testBlock = Block{
+		SettleSessions: []SettleSession{
+			{
+				SessionID: hub.NewSessionID(0),
+				Amount:    sdk.NewInt64Coin("stake", 100),
+				Fee:       sdk.NewInt64Coin("stake", 0),
+				Final:     false,
+			},
+			{
+				SessionID: hub.NewSessionID(300),
+				Amount:    sdk.NewCoin("stake", sdk.NewIntWithDecimal(1, 30)),
+				Fee:       sdk.NewInt64Coin("stake", 10),
+				Final:     true,
+			},
+		},
+		PayoutNodes: []PayoutNode{
+			{
+				NodeID:  hub.NewNodeID(1),
+				Address: types.TestAddress1,
+				Amount:  sdk.Coins{sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 90)},
+			},
+		},
+	}
+)
+
+func TestEncodeDecode(t *testing.T) {
+	bz := Encode(testBlock)
+	require.Equal(t, Version, bz[0])
+
+	block, err := Decode(bz)
+	require.Nil(t, err)
+	require.Equal(t, testBlock, block)
+
+	block, err = DecodeString(EncodeToString(testBlock))
+	require.Nil(t, err)
+	require.Equal(t, testBlock, block)
+
+	block, err = Decode(Encode(Block{}))
+	require.Nil(t, err)
+	require.True(t, block.Empty())
+}
+
+func TestDecode(t *testing.T) {
+	bz := Encode(testBlock)
+
+	_, err := Decode(nil)
+	require.Equal(t, ErrTruncated, err)
+	_, err = Decode([]byte{Version + 1})
+	require.NotNil(t, err)
+
+	for i := 1; i < len(bz); i++ {
+		_, err = Decode(bz[:i])
+		require.Equal(t, ErrTruncated, err)
+	}
+
+	_, err = Decode(append(bz, 0))
+	require.Equal(t, ErrTrailingBytes, err)
+
+	_, err = DecodeString("invalid")
+	require.NotNil(t, err)
+}
+
+func TestFromEvents(t *testing.T) {
+	events := sdk.Events{
+		sdk.NewEvent(types.EventTypePruneNodeHistory,
+			sdk.NewAttribute(types.AttributeKeyNodeID, hub.NewNodeID(1).String()),
+		),
+		sdk.NewEvent(types.EventTypeSettleSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, hub.NewSessionID(0).String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, "100stake"),
+			sdk.NewAttribute(types.AttributeKeyFee, "0stake"),
+			sdk.NewAttribute(types.AttributeKeyFinal, "false"),
+		),
+		sdk.NewEvent(types.EventTypeSettleSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, hub.NewSessionID(300).String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, "1000000000000000000000000000000stake"),
+			sdk.NewAttribute(types.AttributeKeyFee, "10stake"),
+			sdk.NewAttribute(types.AttributeKeyFinal, "true"),
+		),
+		sdk.NewEvent(types.EventTypePayoutNode,
+			sdk.NewAttribute(types.AttributeKeyNodeID, hub.NewNodeID(1).String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, types.TestAddress1.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, "5atom,90stake"),
+		),
+	}
+
+	block, err := FromEvents(events)
+	require.Nil(t, err)
+	require.Equal(t, testBlock, block)
+
+	block, err = FromEvents(events[:1])
+	require.Nil(t, err)
+	require.True(t, block.Empty())
+
+	_, err = FromEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeSettleSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, hub.NewSessionID(0).String()),
+		),
+	})
+	require.NotNil(t, err)
+}
@@ -2,6 +2,7 @@ package vpn
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,11 +17,30 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 		nca := k.GetNodesCountOfAddress(ctx, node.Owner)
 		k.SetNodeIDByAddress(ctx, node.Owner, nca, node.ID)
+		if node.Moniker != "" && node.Status != types.StatusDeRegistered {
+			k.SetNodeIDByMoniker(ctx, node.Moniker, node.ID)
+		}
 
 		k.SetNodesCount(ctx, k.GetNodesCount(ctx)+1)
 		k.SetNodesCountOfAddress(ctx, node.Owner, nca+1)
 	}
 
+	for _, allowed := range data.AllowedAddresses {
+		k.SetAllowedAddress(ctx, allowed)
+	}
+
+	for _, blacklisted := range data.BlacklistedClients {
+		k.SetBlacklistedClient(ctx, blacklisted)
+	}
+
+	for _, trial := range data.FreeTrials {
+		k.SetFreeTrial(ctx, trial)
+	}
+
+	for _, plan := range data.DiscountPlans {
+		k.SetDiscountPlan(ctx, plan)
+	}
+
 	for _, subscription := range data.Subscriptions {
 		k.SetSubscription(ctx, subscription)
 
@@ -30,29 +50,159 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		sca := k.GetSubscriptionsCountOfAddress(ctx, subscription.Client)
 		k.SetSubscriptionIDByAddress(ctx, subscription.Client, sca, subscription.ID)
 
-		k.SetSubscriptionsCount(ctx, k.GetSubscriptionsCount(ctx)+1)
+		// The collected subscriptions leave gaps in the IDs, which must not be reused
+		if sc := subscription.ID.Uint64() + 1; sc > k.GetSubscriptionsCount(ctx) {
+			k.SetSubscriptionsCount(ctx, sc)
+		}
 		k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, scn+1)
 		k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, sca+1)
 	}
 
+	for _, seat := range data.Seats {
+		k.SetSeat(ctx, seat)
+		k.SetSeatIndexByAddress(ctx, seat.SubscriptionID, seat.Address, seat.Index)
+	}
+
+	// The genesis states exported before the session indexes are indexed in the order of the sessions
+	indexed := len(data.SessionIndexes) > 0 || len(data.SessionsCounts) > 0
 	for _, session := range data.Sessions {
 		k.SetSession(ctx, session)
+		k.SetSessionsCount(ctx, k.GetSessionsCount(ctx)+1)
 
-		scs := k.GetSessionsCountOfSubscription(ctx, session.SubscriptionID)
-		k.SetSessionIDBySubscriptionID(ctx, session.SubscriptionID, scs, session.ID)
+		if !indexed {
+			scs := k.GetSessionsCountOfSubscription(ctx, session.SubscriptionID)
+			k.SetSessionIDBySubscriptionID(ctx, session.SubscriptionID, scs, session.ID)
+			k.SetSessionsCountOfSubscription(ctx, session.SubscriptionID, scs+1)
+		}
+	}
 
-		k.SetSessionsCount(ctx, k.GetSessionsCount(ctx)+1)
-		k.SetSessionsCountOfSubscription(ctx, session.SubscriptionID, scs+1)
+	for _, index := range data.SessionIndexes {
+		k.SetSessionIDBySubscriptionID(ctx, index.SubscriptionID, index.Index, index.SessionID)
+		if !index.Address.Empty() {
+			k.SetOngoingSessionIndex(ctx, index)
+		}
+	}
+
+	for _, count := range data.SessionsCounts {
+		k.SetSessionsCountOfSubscription(ctx, count.SubscriptionID, count.Count)
+	}
+
+	for _, payout := range data.PendingPayouts {
+		k.SetPendingPayout(ctx, payout)
+	}
+
+	for _, quote := range data.UsedQuotes {
+		k.SetUsedQuote(ctx, quote)
+	}
+
+	for _, rate := range data.ConsumptionRates {
+		k.SetConsumptionRate(ctx, rate)
+	}
+
+	for _, settlement := range data.PendingSettlements {
+		k.SetPendingSettlement(ctx, settlement)
+	}
+
+	for _, dispute := range data.Disputes {
+		k.SetDispute(ctx, dispute)
+	}
+
+	for _, stats := range data.NodeStats {
+		k.SetNodeStats(ctx, stats)
+	}
+
+	for _, snapshot := range data.NodeStatsSnapshots {
+		k.SetNodeStatsSnapshot(ctx, snapshot)
+	}
+
+	for _, uptime := range data.NodeUptimes {
+		k.SetNodeUptime(ctx, uptime)
+	}
+
+	for _, qos := range data.NodeQoS {
+		k.SetNodeQoS(ctx, qos)
+	}
+
+	for _, reputation := range data.NodeReputations {
+		k.SetNodeReputation(ctx, reputation)
+	}
+
+	for _, unbonding := range data.NodeUnbondings {
+		k.SetNodeUnbonding(ctx, unbonding)
+	}
+
+	if !data.ProtocolFees.Empty() {
+		k.SetProtocolFees(ctx, data.ProtocolFees)
+	}
+
+	for _, earnings := range data.ReferralEarnings {
+		k.SetReferralEarnings(ctx, earnings)
+	}
+
+	for _, allowance := range data.FeeAllowances {
+		k.SetFeeAllowance(ctx, allowance)
+	}
+
+	for _, authorization := range data.Authorizations {
+		k.SetAuthorization(ctx, authorization)
 	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	nodes := k.GetAllNodes(ctx)
+	allowedAddresses := k.GetAllAllowedAddresses(ctx)
+	blacklistedClients := k.GetAllBlacklistedClients(ctx)
+	freeTrials := k.GetAllFreeTrials(ctx)
+	discountPlans := k.GetAllDiscountPlans(ctx)
 	subscriptions := k.GetAllSubscriptions(ctx)
+	seats := k.GetAllSeats(ctx)
 	sessions := k.GetAllSessions(ctx)
+	pendingPayouts := k.GetAllPendingPayouts(ctx)
+	usedQuotes := k.GetAllUsedQuotes(ctx)
+	consumptionRates := k.GetAllConsumptionRates(ctx)
+	pendingSettlements := k.GetAllPendingSettlements(ctx)
+	disputes := k.GetAllDisputes(ctx)
+	nodeStats := k.GetAllNodeStats(ctx)
+	nodeStatsSnapshots := k.GetAllNodeStatsSnapshots(ctx)
+	nodeUptimes := k.GetAllNodeUptimes(ctx)
+	nodeQoS := k.GetAllNodeQoS(ctx)
+	nodeReputations := k.GetAllNodeReputations(ctx)
+	nodeUnbondings := k.GetAllNodeUnbondings(ctx)
+	protocolFees := k.GetProtocolFees(ctx)
+	referralEarnings := k.GetAllReferralEarnings(ctx)
+	feeAllowances := k.GetAllFeeAllowances(ctx)
+	authorizations := k.GetAllAuthorizations(ctx)
+
+	var (
+		sessionIndexes []types.SessionIndex
+		sessionsCounts []types.SessionsCount
+	)
+	for _, subscription := range subscriptions {
+		scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+		if scs > 0 {
+			sessionsCounts = append(sessionsCounts, types.SessionsCount{SubscriptionID: subscription.ID, Count: scs})
+		}
 
-	return types.NewGenesisState(nodes, subscriptions, sessions, params)
+		// The ongoing sessions keep the addresses of the client and the seats holding them
+		addresses := make(map[uint64]sdk.AccAddress)
+		for _, ongoing := range k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID) {
+			addresses[ongoing.Index] = ongoing.Address
+		}
+
+		next := k.GetNextSessionIndexOfSubscription(ctx, subscription.ID)
+		for i := uint64(0); i < next; i++ {
+			if id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, i); found {
+				sessionIndexes = append(sessionIndexes, types.SessionIndex{
+					SubscriptionID: subscription.ID, Index: i, SessionID: id, Address: addresses[i]})
+			}
+		}
+	}
+
+	return types.NewGenesisState(nodes, allowedAddresses, blacklistedClients, freeTrials, discountPlans, subscriptions,
+		seats, sessions, sessionIndexes, sessionsCounts, pendingPayouts, usedQuotes, consumptionRates,
+		pendingSettlements, disputes, nodeStats, nodeStatsSnapshots, nodeUptimes, nodeQoS, nodeReputations,
+		nodeUnbondings, protocolFees, referralEarnings, feeAllowances, authorizations, params)
 }
 
 func ValidateGenesis(data types.GenesisState) error {
@@ -73,20 +223,46 @@ func ValidateGenesis(data types.GenesisState) error {
 		sessionsMap[session.ID.Uint64()] = true
 	}
 
-	subscriptionsMap := make(map[uint64]bool, len(data.Subscriptions))
+	subscriptionsMap := make(map[uint64]types.Subscription, len(data.Subscriptions))
 	for _, subscription := range data.Subscriptions {
 		if err := subscription.IsValid(); err != nil {
 			return fmt.Errorf("%s for the %s", err.Error(), subscription)
 		}
 
-		if subscriptionsMap[subscription.ID.Uint64()] {
+		if _, ok := subscriptionsMap[subscription.ID.Uint64()]; ok {
 			return fmt.Errorf("duplicate id for the %s", subscription)
 		}
 
-		subscriptionsMap[subscription.ID.Uint64()] = true
+		subscriptionsMap[subscription.ID.Uint64()] = subscription
+	}
+
+	for _, session := range data.Sessions {
+		subscription, ok := subscriptionsMap[session.SubscriptionID.Uint64()]
+		if !ok {
+			return fmt.Errorf("missing subscription %s for the %s", session.SubscriptionID, session)
+		}
+		if session.Paid.Denom != subscription.PricePerGB.Denom {
+			return fmt.Errorf("invalid paid for the %s", session)
+		}
+	}
+
+	for _, seat := range data.Seats {
+		subscription, ok := subscriptionsMap[seat.SubscriptionID.Uint64()]
+		if !ok || seat.Index >= subscription.Seats {
+			return fmt.Errorf("invalid subscription for the %s", seat)
+		}
+		if seat.Address == nil || seat.Address.Empty() {
+			return fmt.Errorf("invalid address for the %s", seat)
+		}
+	}
+
+	indexesMap, err := validateSessionIndexes(data, subscriptionsMap)
+	if err != nil {
+		return err
 	}
 
 	nodeIDsMap := make(map[uint64]bool, len(data.Nodes))
+	monikersMap := make(map[string]bool, len(data.Nodes))
 	for _, node := range data.Nodes {
 		if err := node.IsValid(); err != nil {
 			return fmt.Errorf("%s for the %s", err.Error(), node)
@@ -101,7 +277,486 @@ func ValidateGenesis(data types.GenesisState) error {
 		}
 
 		nodeIDsMap[node.ID.Uint64()] = true
+
+		if node.Moniker != "" && node.Status != types.StatusDeRegistered {
+			moniker := strings.ToLower(node.Moniker)
+			if monikersMap[moniker] {
+				return fmt.Errorf("duplicate moniker for the %s", node)
+			}
+
+			monikersMap[moniker] = true
+		}
+	}
+
+	for _, subscription := range data.Subscriptions {
+		if !nodeIDsMap[subscription.NodeID.Uint64()] {
+			return fmt.Errorf("missing node %s for the %s", subscription.NodeID, subscription)
+		}
+	}
+
+	for _, allowed := range data.AllowedAddresses {
+		if !nodeIDsMap[allowed.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the allowed address %s", allowed.Address)
+		}
+		if allowed.Address == nil || allowed.Address.Empty() {
+			return fmt.Errorf("invalid allowed address for the node %s", allowed.NodeID)
+		}
+	}
+
+	for _, blacklisted := range data.BlacklistedClients {
+		if !nodeIDsMap[blacklisted.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the blacklisted client %s", blacklisted.Client)
+		}
+		if blacklisted.Client == nil || blacklisted.Client.Empty() {
+			return fmt.Errorf("invalid blacklisted client for the node %s", blacklisted.NodeID)
+		}
+	}
+
+	for _, trial := range data.FreeTrials {
+		if !nodeIDsMap[trial.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the free trial of %s", trial.Address)
+		}
+		if trial.Address == nil || trial.Address.Empty() {
+			return fmt.Errorf("invalid free trial address for the node %s", trial.NodeID)
+		}
+	}
+
+	plansMap := make(map[uint64]bool, len(data.DiscountPlans))
+	for _, plan := range data.DiscountPlans {
+		if !nodeIDsMap[plan.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the discount plan %s", plan)
+		}
+		if err := plan.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), plan)
+		}
+
+		if plansMap[plan.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the discount plan %s", plan)
+		}
+		plansMap[plan.NodeID.Uint64()] = true
+	}
+
+	payoutsMap := make(map[uint64]bool, len(data.PendingPayouts))
+	for _, payout := range data.PendingPayouts {
+		if !nodeIDsMap[payout.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the pending payout %s", payout)
+		}
+		if payout.Coins.Empty() || !payout.Coins.IsValid() {
+			return fmt.Errorf("invalid coins for the pending payout %s", payout)
+		}
+
+		if payoutsMap[payout.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the pending payout %s", payout)
+		}
+
+		payoutsMap[payout.NodeID.Uint64()] = true
+	}
+
+	for _, quote := range data.UsedQuotes {
+		if !nodeIDsMap[quote.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the used quote %d", quote.Nonce)
+		}
+		if quote.Expiry <= 0 {
+			return fmt.Errorf("invalid expiry for the used quote %d", quote.Nonce)
+		}
+	}
+
+	for _, rate := range data.ConsumptionRates {
+		if _, ok := subscriptionsMap[rate.SubscriptionID.Uint64()]; !ok {
+			return fmt.Errorf("invalid subscription id for the %s", rate)
+		}
+		if rate.Rate.IsNil() || rate.Rate.IsNegative() {
+			return fmt.Errorf("invalid rate for the %s", rate)
+		}
+	}
+
+	settlementsMap := make(map[uint64]bool, len(data.PendingSettlements))
+	for _, settlement := range data.PendingSettlements {
+		subscription, ok := subscriptionsMap[settlement.SubscriptionID.Uint64()]
+		if !ok || subscription.Status != types.StatusActive {
+			return fmt.Errorf("invalid subscription id for the %s", settlement)
+		}
+		if settlement.Height <= 0 {
+			return fmt.Errorf("invalid height for the %s", settlement)
+		}
+
+		if settlementsMap[settlement.SubscriptionID.Uint64()] {
+			return fmt.Errorf("duplicate subscription id for the %s", settlement)
+		}
+
+		settlementsMap[settlement.SubscriptionID.Uint64()] = true
+	}
+
+	disputesMap := make(map[uint64]bool, len(data.Disputes))
+	for _, dispute := range data.Disputes {
+		if !sessionsMap[dispute.SessionID.Uint64()] {
+			return fmt.Errorf("invalid session id for the %s", dispute)
+		}
+		if index, ok := indexesMap[dispute.SessionID.Uint64()]; ok && index != dispute.Index {
+			return fmt.Errorf("invalid index for the %s", dispute)
+		}
+		if err := dispute.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), dispute)
+		}
+
+		if disputesMap[dispute.SessionID.Uint64()] {
+			return fmt.Errorf("duplicate session id for the %s", dispute)
+		}
+
+		disputesMap[dispute.SessionID.Uint64()] = true
+	}
+
+	statsMap := make(map[uint64]bool, len(data.NodeStats))
+	for _, stats := range data.NodeStats {
+		if !nodeIDsMap[stats.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the %s", stats)
+		}
+		if stats.Bandwidth.IsValid() != nil || !stats.Earnings.IsValid() {
+			return fmt.Errorf("invalid counters for the %s", stats)
+		}
+
+		if statsMap[stats.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the %s", stats)
+		}
+
+		statsMap[stats.NodeID.Uint64()] = true
+	}
+
+	snapshotsMap := make(map[string]bool, len(data.NodeStatsSnapshots))
+	for _, snapshot := range data.NodeStatsSnapshots {
+		stats := snapshot.Stats
+		if !nodeIDsMap[stats.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the node stats snapshot %s", snapshot)
+		}
+		if snapshot.Height <= 0 {
+			return fmt.Errorf("invalid height for the node stats snapshot %s", snapshot)
+		}
+		if stats.Bandwidth.IsValid() != nil || !stats.Earnings.IsValid() {
+			return fmt.Errorf("invalid counters for the node stats snapshot %s", snapshot)
+		}
+
+		if snapshotsMap[snapshot.String()] {
+			return fmt.Errorf("duplicate node stats snapshot %s", snapshot)
+		}
+
+		snapshotsMap[snapshot.String()] = true
+	}
+
+	uptimesMap := make(map[uint64]bool, len(data.NodeUptimes))
+	for _, uptime := range data.NodeUptimes {
+		if !nodeIDsMap[uptime.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the %s", uptime)
+		}
+		if uptime.Since < 0 || uptime.LastHeartbeat < uptime.Since || uptime.OnlineBlocks < 0 ||
+			(uptime.Until > 0 && uptime.Until < uptime.LastHeartbeat) {
+			return fmt.Errorf("invalid heights for the %s", uptime)
+		}
+
+		if uptimesMap[uptime.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the %s", uptime)
+		}
+
+		uptimesMap[uptime.NodeID.Uint64()] = true
+	}
+
+	qosMap := make(map[uint64]bool, len(data.NodeQoS))
+	for _, qos := range data.NodeQoS {
+		if !nodeIDsMap[qos.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the %s", qos)
+		}
+		if err := qos.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), qos)
+		}
+
+		if qosMap[qos.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the %s", qos)
+		}
+
+		qosMap[qos.NodeID.Uint64()] = true
+	}
+
+	reputationsMap := make(map[uint64]bool, len(data.NodeReputations))
+	for _, reputation := range data.NodeReputations {
+		if !nodeIDsMap[reputation.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the %s", reputation)
+		}
+		if err := reputation.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), reputation)
+		}
+
+		if reputationsMap[reputation.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the %s", reputation)
+		}
+
+		reputationsMap[reputation.NodeID.Uint64()] = true
+	}
+
+	unbondingsMap := make(map[uint64]bool, len(data.NodeUnbondings))
+	for _, unbonding := range data.NodeUnbondings {
+		if !nodeIDsMap[unbonding.NodeID.Uint64()] {
+			return fmt.Errorf("invalid node id for the %s", unbonding)
+		}
+		if err := unbonding.IsValid(); err != nil {
+			return fmt.Errorf("%s for the %s", err.Error(), unbonding)
+		}
+
+		if unbondingsMap[unbonding.NodeID.Uint64()] {
+			return fmt.Errorf("duplicate node id for the %s", unbonding)
+		}
+
+		unbondingsMap[unbonding.NodeID.Uint64()] = true
+	}
+
+	if !data.ProtocolFees.IsValid() {
+		return fmt.Errorf("invalid protocol fees %s", data.ProtocolFees)
+	}
+
+	referralEarningsMap := make(map[string]bool, len(data.ReferralEarnings))
+	for _, earnings := range data.ReferralEarnings {
+		if earnings.Address == nil || earnings.Address.Empty() || !earnings.Earnings.IsValid() {
+			return fmt.Errorf("invalid %s", earnings)
+		}
+
+		if referralEarningsMap[earnings.Address.String()] {
+			return fmt.Errorf("duplicate address for the %s", earnings)
+		}
+
+		referralEarningsMap[earnings.Address.String()] = true
+	}
+
+	feeAllowancesMap := make(map[string]bool, len(data.FeeAllowances))
+	for _, allowance := range data.FeeAllowances {
+		if err := allowance.IsValid(); err != nil {
+			return err
+		}
+
+		key := allowance.Granter.String() + allowance.Grantee.String()
+		if feeAllowancesMap[key] {
+			return fmt.Errorf("duplicate granter and grantee for the %s", allowance)
+		}
+
+		feeAllowancesMap[key] = true
+	}
+
+	authorizationsMap := make(map[string]bool, len(data.Authorizations))
+	for _, authorization := range data.Authorizations {
+		if err := authorization.IsValid(); err != nil {
+			return err
+		}
+
+		key := authorization.Granter.String() + authorization.Grantee.String() + authorization.MsgType
+		if authorizationsMap[key] {
+			return fmt.Errorf("duplicate granter, grantee and msg type for the %s", authorization)
+		}
+
+		authorizationsMap[key] = true
 	}
 
 	return nil
 }
+
+// PrepForZeroHeightGenesis prepares the vpn state for an export from which the chain restarts at
+// height zero. The in-flight sessions are closed and the pending settlements and payouts are paid
+// out, so no funds are left in flight, and the heights of the state are reset to zero.
+func PrepForZeroHeightGenesis(ctx sdk.Context, k Keeper) {
+	height := ctx.BlockHeight()
+
+	for _, session := range k.GetAllSessions(ctx) {
+		if session.Status != types.StatusActive {
+			continue
+		}
+
+		subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
+		k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
+
+		endSession(ctx, k, session, subscription, types.AttributeValueExport)
+	}
+
+	for _, settlement := range k.GetAllPendingSettlements(ctx) {
+		finalizeSettlement(ctx, k, settlement)
+	}
+
+	payPendingPayouts(ctx, k)
+
+	for _, node := range k.GetAllNodes(ctx) {
+		node.StatusModifiedAt = 0
+		if node.JailedUntil > height {
+			node.JailedUntil -= height
+		} else {
+			node.JailedUntil = 0
+		}
+
+		k.SetNode(ctx, node)
+	}
+
+	for _, subscription := range k.GetAllSubscriptions(ctx) {
+		subscription.StatusModifiedAt = 0
+		k.SetSubscription(ctx, subscription)
+	}
+
+	for _, session := range k.GetAllSessions(ctx) {
+		session.StatusModifiedAt = 0
+		k.SetSession(ctx, session)
+	}
+
+	for _, quote := range k.GetAllUsedQuotes(ctx) {
+		k.DeleteUsedQuote(ctx, quote)
+		if quote.Expiry > height {
+			quote.Expiry -= height
+			k.SetUsedQuote(ctx, quote)
+		}
+	}
+
+	for _, allowance := range k.GetAllFeeAllowances(ctx) {
+		if allowance.Expiry == 0 {
+			continue
+		}
+
+		k.DeleteFeeAllowance(ctx, allowance.Granter, allowance.Grantee)
+		if allowance.Expiry > height {
+			allowance.Expiry -= height
+			k.SetFeeAllowance(ctx, allowance)
+		}
+	}
+
+	for _, authorization := range k.GetAllAuthorizations(ctx) {
+		if authorization.Expiry == 0 {
+			continue
+		}
+
+		k.DeleteAuthorization(ctx, authorization.Granter, authorization.Grantee, authorization.MsgType)
+		if authorization.Expiry > height {
+			authorization.Expiry -= height
+			k.SetAuthorization(ctx, authorization)
+		}
+	}
+
+	for _, unbonding := range k.GetAllNodeUnbondings(ctx) {
+		if unbonding.CompletesAt <= height {
+			completeNodeUnbonding(ctx, k, unbonding)
+			continue
+		}
+
+		k.DeleteNodeUnbonding(ctx, unbonding)
+		unbonding.CompletesAt -= height
+		k.SetNodeUnbonding(ctx, unbonding)
+	}
+
+	// The open disputes stay frozen, their deadlines are moved to the heights of the new chain.
+	for _, dispute := range k.GetAllDisputes(ctx) {
+		if dispute.IsOpen() && dispute.Deadline <= height {
+			resolveDispute(ctx, k, dispute, dispute.Party == types.DisputePartyClient, types.AttributeValueTimeout)
+			dispute, _ = k.GetDispute(ctx, dispute.SessionID)
+		}
+
+		k.DeleteDispute(ctx, dispute)
+		if dispute.IsOpen() {
+			dispute.Deadline -= height
+		}
+		dispute.StatusModifiedAt = 0
+		k.SetDispute(ctx, dispute)
+	}
+
+	for _, rate := range k.GetAllConsumptionRates(ctx) {
+		rate.Height = 0
+		k.SetConsumptionRate(ctx, rate)
+	}
+
+	for _, qos := range k.GetAllNodeQoS(ctx) {
+		qos.UpdatedAt = 0
+		k.SetNodeQoS(ctx, qos)
+	}
+
+	for _, reputation := range k.GetAllNodeReputations(ctx) {
+		reputation.UpdatedAt = 0
+		k.SetNodeReputation(ctx, reputation)
+	}
+
+	// The uptimes and the snapshots of the node stats are measured in the heights of the exported
+	// chain, the uptime tracking of the registered nodes starts again from their next heartbeat.
+	for _, uptime := range k.GetAllNodeUptimes(ctx) {
+		if uptime.Until > 0 {
+			k.DeleteNodeUptime(ctx, uptime.NodeID)
+			continue
+		}
+
+		k.SetNodeUptime(ctx, types.NewNodeUptime(uptime.NodeID, 0))
+	}
+
+	k.DeleteNodeStatsSnapshots(ctx, height+1)
+	k.DeleteFreeUpdatesCounts(ctx, height)
+}
+
+// validateSessionIndexes checks that every session is indexed once, and that the ongoing session is at the
+// index of the sessions count of its subscription and the ended ones below it. The genesis states exported
+// before the session indexes have neither the indexes nor the counts. It returns the index of every session
+// by the session id.
+func validateSessionIndexes(data types.GenesisState,
+	subscriptionsMap map[uint64]types.Subscription) (map[uint64]uint64, error) {
+	indexesMap := make(map[uint64]uint64, len(data.SessionIndexes))
+	if len(data.SessionIndexes) == 0 && len(data.SessionsCounts) == 0 {
+		return indexesMap, nil
+	}
+
+	countsMap := make(map[uint64]uint64, len(data.SessionsCounts))
+	for _, count := range data.SessionsCounts {
+		if _, ok := subscriptionsMap[count.SubscriptionID.Uint64()]; !ok {
+			return nil, fmt.Errorf("missing subscription %s for the %s", count.SubscriptionID, count)
+		}
+
+		if _, ok := countsMap[count.SubscriptionID.Uint64()]; ok {
+			return nil, fmt.Errorf("duplicate subscription id for the %s", count)
+		}
+
+		countsMap[count.SubscriptionID.Uint64()] = count.Count
+	}
+
+	sessionsMap := make(map[uint64]types.Session, len(data.Sessions))
+	for _, session := range data.Sessions {
+		sessionsMap[session.ID.Uint64()] = session
+	}
+
+	keysMap := make(map[string]bool, len(data.SessionIndexes))
+	addressesMap := make(map[string]bool)
+	for _, index := range data.SessionIndexes {
+		session, ok := sessionsMap[index.SessionID.Uint64()]
+		if !ok || !session.SubscriptionID.IsEqual(index.SubscriptionID) {
+			return nil, fmt.Errorf("invalid session id for the %s", index)
+		}
+
+		// The ended sessions are below the count, the ongoing ones may be past it when the seats hold
+		// sessions at the same time
+		count := countsMap[index.SubscriptionID.Uint64()]
+		if session.Status != types.StatusActive && index.Index >= count {
+			return nil, fmt.Errorf("invalid index for the %s", index)
+		}
+		if (session.Status == types.StatusActive) != (index.Address != nil && !index.Address.Empty()) {
+			return nil, fmt.Errorf("invalid address for the %s", index)
+		}
+
+		key := fmt.Sprintf("%d/%d", index.SubscriptionID.Uint64(), index.Index)
+		if _, ok := indexesMap[index.SessionID.Uint64()]; ok || keysMap[key] {
+			return nil, fmt.Errorf("duplicate index for the %s", index)
+		}
+
+		keysMap[key] = true
+		indexesMap[index.SessionID.Uint64()] = index.Index
+
+		if session.Status == types.StatusActive {
+			key = fmt.Sprintf("%d/%s", index.SubscriptionID.Uint64(), index.Address)
+			if addressesMap[key] {
+				return nil, fmt.Errorf("duplicate address for the %s", index)
+			}
+
+			addressesMap[key] = true
+		}
+	}
+
+	for _, session := range data.Sessions {
+		if _, ok := indexesMap[session.ID.Uint64()]; !ok {
+			return nil, fmt.Errorf("missing index for the %s", session)
+		}
+	}
+
+	return indexesMap, nil
+}
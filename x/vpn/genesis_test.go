@@ -0,0 +1,314 @@
+package vpn
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestPrepForZeroHeightGenesis(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 200))
+	require.Nil(t, err)
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.StatusModifiedAt = 5
+	node.Jailed = true
+	node.JailedUntil = 30
+	k.SetNode(ctx, node)
+	k.SetNodeUptime(ctx, types.NewNodeUptime(node.ID, 5))
+	k.SetNodeUptime(ctx, types.NodeUptime{NodeID: hub.NewNodeID(1), Since: 1, Until: 10})
+	k.SnapshotNodeStats(ctx, 10)
+
+	subscription := types.TestSubscription
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 200)
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	subscription.StatusModifiedAt = 5
+	k.SetSubscription(ctx, subscription)
+
+	session := types.TestSession
+	session.Bandwidth = types.TestBandwidthPos1
+	session.StatusModifiedAt = 15
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 15, session.ID)
+
+	k.SetUsedQuote(ctx, types.NewUsedQuote(node.ID, 1, 25))
+
+	PrepForZeroHeightGenesis(ctx, k)
+
+	require.Len(t, k.GetActiveSessionIDs(ctx, 15), 0)
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, types.StatusInactive, session.Status)
+	require.Equal(t, int64(0), session.StatusModifiedAt)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, int64(0), subscription.StatusModifiedAt)
+
+	require.Len(t, k.GetAllPendingPayouts(ctx), 0)
+	require.False(t, bk.GetCoins(ctx, node.Owner).IsZero())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, int64(0), node.StatusModifiedAt)
+	require.Equal(t, true, node.Jailed)
+	require.Equal(t, int64(10), node.JailedUntil)
+
+	require.Equal(t, []types.NodeUptime{types.NewNodeUptime(node.ID, 0)}, k.GetAllNodeUptimes(ctx))
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 0)
+	require.Equal(t, []types.UsedQuote{types.NewUsedQuote(node.ID, 1, 5)}, k.GetAllUsedQuotes(ctx))
+}
+
+func Test_validateSessionIndexes(t *testing.T) {
+	session := types.TestSession
+	session.ID = hub.NewSessionID(2)
+	session.Status = StatusInactive
+
+	state := types.DefaultGenesisState()
+	state.Subscriptions = []types.Subscription{types.TestSubscription}
+	state.Sessions = []types.Session{session}
+	subscriptionsMap := map[uint64]types.Subscription{types.TestSubscription.ID.Uint64(): types.TestSubscription}
+	_, err := validateSessionIndexes(state, subscriptionsMap)
+	require.Nil(t, err)
+
+	state.SessionsCounts = []types.SessionsCount{{SubscriptionID: types.TestSubscription.ID, Count: 3}}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	index := types.SessionIndex{SubscriptionID: types.TestSubscription.ID, Index: 2, SessionID: session.ID}
+	state.SessionIndexes = []types.SessionIndex{index}
+	indexesMap, err := validateSessionIndexes(state, subscriptionsMap)
+	require.Nil(t, err)
+	require.Equal(t, map[uint64]uint64{session.ID.Uint64(): 2}, indexesMap)
+
+	state.SessionsCounts = append(state.SessionsCounts, state.SessionsCounts[0])
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	state.SessionsCounts = []types.SessionsCount{{SubscriptionID: hub.NewSubscriptionID(1), Count: 3}}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	state.SessionsCounts = []types.SessionsCount{{SubscriptionID: types.TestSubscription.ID, Count: 3}}
+	state.SessionIndexes = append(state.SessionIndexes, index)
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	index.Index = 3
+	state.SessionIndexes = []types.SessionIndex{index}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	state.Sessions[0].Status = StatusActive
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	index.Address = types.TestAddress1
+	state.SessionIndexes = []types.SessionIndex{index}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.Nil(t, err)
+
+	_session := session
+	_session.ID = hub.NewSessionID(3)
+	_session.Status = StatusActive
+	state.Sessions = append(state.Sessions, _session)
+	state.SessionIndexes = append(state.SessionIndexes,
+		types.SessionIndex{SubscriptionID: index.SubscriptionID, Index: 4, SessionID: _session.ID, Address: index.Address})
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	state.SessionIndexes[1].Address = types.TestAddress2
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.Nil(t, err)
+
+	state.Sessions = state.Sessions[:1]
+	state.Sessions[0].Status = StatusInactive
+	index.Index = 2
+	state.SessionIndexes = []types.SessionIndex{index}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+
+	index.SubscriptionID = hub.NewSubscriptionID(1)
+	state.SessionIndexes = []types.SessionIndex{index}
+	_, err = validateSessionIndexes(state, subscriptionsMap)
+	require.NotNil(t, err)
+}
+
+func TestExportGenesis_SessionIndexes(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	k.SetNode(ctx, types.TestNode)
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	subscription.StatusModifiedAt = 5
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionIDByNodeID(ctx, types.TestNode.ID, 0, subscription.ID)
+	k.SetSubscriptionsCountOfNode(ctx, types.TestNode.ID, 1)
+
+	var sessions []types.Session
+	for i := uint64(0); i < 3; i++ {
+		session := types.TestSession
+		session.ID = hub.NewSessionID(i)
+		session.Status = StatusInactive
+		session.StatusModifiedAt = 5 + 10*int64(i/2)
+		sessions = append(sessions, session)
+
+		k.SetSession(ctx, session)
+		k.SetSessionIDBySubscriptionID(ctx, subscription.ID, i, session.ID)
+	}
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 3)
+
+	dispute := types.NewDispute(sessions[2], 2, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 10), 1, 100)
+	k.SetDispute(ctx, dispute)
+
+	require.Equal(t, uint64(2), pruneSessionsOfNode(ctx, k, types.TestNode.ID, 10))
+
+	state := ExportGenesis(ctx, k)
+	require.Nil(t, ValidateGenesis(state))
+	require.Equal(t, []types.Session{sessions[2]}, state.Sessions)
+
+	ctx, k, _, _ = keeper.CreateTestInput(t, false)
+	InitGenesis(ctx, k, state)
+
+	require.Equal(t, uint64(3), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+	_, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, 0)
+	require.Equal(t, false, found)
+
+	dispute, found = k.GetDispute(ctx, sessions[2].ID)
+	require.Equal(t, true, found)
+
+	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, dispute.Index)
+	require.Equal(t, true, found)
+	require.Equal(t, sessions[2].ID, id)
+}
+
+func TestExportGenesis_OngoingSessions(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+
+	var indexes []types.SessionIndex
+	for i, address := range []sdk.AccAddress{types.TestAddress2, types.TestAddress1} {
+		session := types.TestSession
+		session.ID = hub.NewSessionID(uint64(i))
+		k.SetSession(ctx, session)
+
+		index := types.SessionIndex{SubscriptionID: session.SubscriptionID, Index: uint64(i + 1),
+			SessionID: session.ID, Address: address}
+		k.SetSessionIDBySubscriptionID(ctx, index.SubscriptionID, index.Index, index.SessionID)
+		k.SetOngoingSessionIndex(ctx, index)
+		indexes = append(indexes, index)
+	}
+
+	state := ExportGenesis(ctx, k)
+	require.Nil(t, ValidateGenesis(state))
+	require.Equal(t, indexes, state.SessionIndexes)
+
+	ctx, k, _, _ = keeper.CreateTestInput(t, false)
+	InitGenesis(ctx, k, state)
+
+	for _, index := range indexes {
+		ongoing, found := k.GetOngoingSessionIndex(ctx, index.SubscriptionID, index.Address)
+		require.Equal(t, true, found)
+		require.Equal(t, index, ongoing)
+	}
+	require.Equal(t, uint64(3), k.GetNextSessionIndexOfSubscription(ctx, types.TestSubscription.ID))
+}
+
+func TestInitGenesis_ParamsMissingFromGenesis(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	// The genesis states exported before a param was added have no value for it, the param is set to
+	// its zero value so that the getters of the keeper can read it.
+	var state types.GenesisState
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON([]byte(`{"params":{}}`), &state))
+
+	InitGenesis(ctx, k, state)
+	require.NotPanics(t, func() { k.GetParams(ctx) })
+	require.Equal(t, "", k.MinNodeVersion(ctx))
+	require.Equal(t, int64(0), k.NodeUnbondingPeriod(ctx))
+	require.True(t, k.NodeRewardRate(ctx).IsZero())
+	require.Equal(t, int64(0), k.ReputationEpoch(ctx))
+	require.Equal(t, int64(0), k.DisputeWindow(ctx))
+	require.Equal(t, int64(0), k.DisputeTimeout(ctx))
+	require.Equal(t, int64(0), k.EscrowTimeout(ctx))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	state := types.DefaultGenesisState()
+	require.Nil(t, ValidateGenesis(state))
+
+	state.Subscriptions = []types.Subscription{types.TestSubscription}
+	require.NotNil(t, ValidateGenesis(state))
+
+	state.Nodes = []types.Node{types.TestNode}
+	require.Nil(t, ValidateGenesis(state))
+
+	session := types.TestSession
+	session.SubscriptionID = hub.NewSubscriptionID(1)
+	state.Sessions = []types.Session{session}
+	require.NotNil(t, ValidateGenesis(state))
+
+	session.SubscriptionID = types.TestSubscription.ID
+	session.Status = StatusRegistered
+	state.Sessions = []types.Session{session}
+	require.NotNil(t, ValidateGenesis(state))
+
+	session.Status = StatusInactive
+	state.Sessions = []types.Session{session}
+	require.Nil(t, ValidateGenesis(state))
+
+	qos := types.NewNodeQoS(types.TestNode.ID)
+	state.NodeQoS = []types.NodeQoS{qos}
+	require.NotNil(t, ValidateGenesis(state))
+
+	qos = qos.Add(types.TestBandwidthPos1, 50, 10)
+	state.NodeQoS = []types.NodeQoS{qos}
+	require.Nil(t, ValidateGenesis(state))
+
+	state.NodeQoS = append(state.NodeQoS, qos)
+	require.NotNil(t, ValidateGenesis(state))
+
+	state.NodeQoS = nil
+	dispute := types.NewDispute(session, 0, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 10), 1, 100)
+	state.Disputes = []types.Dispute{dispute}
+	require.Nil(t, ValidateGenesis(state))
+
+	state.Disputes = append(state.Disputes, dispute)
+	require.NotNil(t, ValidateGenesis(state))
+
+	dispute.SessionID = hub.NewSessionID(1)
+	state.Disputes = []types.Dispute{dispute}
+	require.NotNil(t, ValidateGenesis(state))
+
+	state.Disputes = nil
+	state.SessionsCounts = []types.SessionsCount{{SubscriptionID: types.TestSubscription.ID, Count: 3}}
+	index := types.SessionIndex{SubscriptionID: types.TestSubscription.ID, Index: 2, SessionID: session.ID}
+	state.SessionIndexes = []types.SessionIndex{index}
+	require.Nil(t, ValidateGenesis(state))
+
+	dispute.SessionID = session.ID
+	state.Disputes = []types.Dispute{dispute}
+	require.NotNil(t, ValidateGenesis(state))
+
+	dispute.Index = 2
+	state.Disputes = []types.Dispute{dispute}
+	require.Nil(t, ValidateGenesis(state))
+
+	state.SessionIndexes, state.SessionsCounts, state.Disputes = nil, nil, nil
+	state.Nodes = append(state.Nodes, types.TestNode)
+	require.NotNil(t, ValidateGenesis(state))
+}
@@ -2,11 +2,18 @@ package vpn
 
 import (
 	"bytes"
+	"fmt"
+	"math/big"
 	"reflect"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/compact"
 	"github.com/sentinel-official/hub/x/vpn/keeper"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -20,12 +27,70 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleUpdateNodeInfo(ctx, k, msg)
 		case types.MsgDeregisterNode:
 			return handleDeregisterNode(ctx, k, msg)
+		case types.MsgPruneNodeHistory:
+			return handlePruneNodeHistory(ctx, k, msg)
+		case types.MsgSetNodePrivate:
+			return handleSetNodePrivate(ctx, k, msg)
+		case types.MsgSetNodeLocation:
+			return handleSetNodeLocation(ctx, k, msg)
+		case types.MsgAddAllowedAddress:
+			return handleAddAllowedAddress(ctx, k, msg)
+		case types.MsgRemoveAllowedAddress:
+			return handleRemoveAllowedAddress(ctx, k, msg)
+		case types.MsgBlacklistClient:
+			return handleBlacklistClient(ctx, k, msg)
+		case types.MsgUnblacklistClient:
+			return handleUnblacklistClient(ctx, k, msg)
+		case types.MsgSetPayoutRoutes:
+			return handleSetPayoutRoutes(ctx, k, msg)
+		case types.MsgSetRevenueSplits:
+			return handleSetRevenueSplits(ctx, k, msg)
+		case types.MsgSetNodeFreeTrial:
+			return handleSetNodeFreeTrial(ctx, k, msg)
+		case types.MsgSetDiscountPlan:
+			return handleSetDiscountPlan(ctx, k, msg)
+		case types.MsgUnjailNode:
+			return handleUnjailNode(ctx, k, msg)
+		case types.MsgWithdrawNodeDeposit:
+			return handleWithdrawNodeDeposit(ctx, k, msg)
+		case types.MsgGrantFeeAllowance:
+			return handleGrantFeeAllowance(ctx, k, msg)
+		case types.MsgRevokeFeeAllowance:
+			return handleRevokeFeeAllowance(ctx, k, msg)
+		case types.MsgGrantAuthorization:
+			return handleGrantAuthorization(ctx, k, msg)
+		case types.MsgRevokeAuthorization:
+			return handleRevokeAuthorization(ctx, k, msg)
+		case types.MsgExecAuthorized:
+			return handleExecAuthorized(ctx, k, msg)
 		case types.MsgStartSubscription:
 			return handleStartSubscription(ctx, k, msg)
+		case types.MsgStartFreeTrial:
+			return handleStartFreeTrial(ctx, k, msg)
 		case types.MsgEndSubscription:
 			return handleEndSubscription(ctx, k, msg)
+		case types.MsgAssignSeat:
+			return handleAssignSeat(ctx, k, msg)
+		case types.MsgUnassignSeat:
+			return handleUnassignSeat(ctx, k, msg)
+		case types.MsgPauseSubscription:
+			return handlePauseSubscription(ctx, k, msg)
+		case types.MsgResumeSubscription:
+			return handleResumeSubscription(ctx, k, msg)
+		case types.MsgTopUpSubscription:
+			return handleTopUpSubscription(ctx, k, msg)
+		case types.MsgSubmitQoSReport:
+			return handleSubmitQoSReport(ctx, k, msg)
+		case types.MsgRateNode:
+			return handleRateNode(ctx, k, msg)
 		case types.MsgUpdateSessionInfo:
 			return handleUpdateSessionInfo(ctx, k, msg)
+		case types.MsgUpdateSessionsInfo:
+			return handleUpdateSessionsInfo(ctx, k, msg)
+		case types.MsgOpenDispute:
+			return handleOpenDispute(ctx, k, msg)
+		case types.MsgSubmitDisputeEvidence:
+			return handleSubmitDisputeEvidence(ctx, k, msg)
 		default:
 			return types.ErrorUnknownMsgType(reflect.TypeOf(msg).Name()).Result()
 		}
@@ -33,7 +98,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func EndBlock(ctx sdk.Context, k keeper.Keeper) {
+	defer func(start time.Time) {
+		k.Metrics().EndBlockDuration.Observe(time.Since(start).Seconds())
+	}(time.Now())
+
 	height := ctx.BlockHeight()
+
+	// The sessions which are not updated for the inactive interval time out, and they are
+	// settled at the last bandwidth signed by both the client and the node.
 	_height := height - k.SessionInactiveInterval(ctx)
 
 	ids := k.GetActiveSessionIDs(ctx, _height)
@@ -41,34 +113,503 @@ func EndBlock(ctx sdk.Context, k keeper.Keeper) {
 		session, _ := k.GetSession(ctx, id.(hub.SessionID))
 		subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
 
-		bandwidth := session.Bandwidth.CeilTo(hub.GB.Quo(subscription.PricePerGB.Amount))
-		amount := bandwidth.Sum().Mul(subscription.PricePerGB.Amount).Quo(hub.GB)
-		pay := sdk.NewCoin(subscription.PricePerGB.Denom, amount)
+		endSession(ctx, k, session, subscription, types.AttributeValueTimeout)
+	}
+
+	k.DeleteActiveSessionIDs(ctx, _height)
 
-		if !pay.IsZero() {
-			node, _ := k.GetNode(ctx, subscription.NodeID)
+	settlements := k.GetPendingSettlementsByHeight(ctx, height)
+	for _, settlement := range settlements {
+		finalizeSettlement(ctx, k, settlement)
+	}
 
-			if err := k.SendDeposit(ctx, subscription.Client, node.Owner, pay); err != nil {
-				panic(err)
+	// The disputes which are not resolved by their deadline are resolved in favour of the client.
+	disputes := k.GetDisputesByDeadline(ctx, height)
+	for _, dispute := range disputes {
+		resolveDispute(ctx, k, dispute, dispute.Party == types.DisputePartyClient, types.AttributeValueTimeout)
+	}
+
+	interval := k.SettlementInterval(ctx)
+	if interval > 0 && height%interval == 0 {
+		streamSettlement(ctx, k, _height+1, height)
+	}
+
+	epoch := k.SettlementEpoch(ctx)
+	if epoch == 0 || height%epoch == 0 {
+		payPendingPayouts(ctx, k)
+	}
+
+	quotes := k.GetUsedQuotesByExpiry(ctx, height)
+	for _, quote := range quotes {
+		k.DeleteUsedQuote(ctx, quote)
+	}
+
+	plans := k.GetDiscountPlansByUntil(ctx, height)
+	for _, plan := range plans {
+		k.DeleteDiscountPlan(ctx, plan)
+	}
+
+	gcEpoch := k.SubscriptionGCEpoch(ctx)
+	if gcEpoch > 0 && height%gcEpoch == 0 {
+		gcSubscriptions(ctx, k, height-k.SubscriptionGCRetention(ctx))
+	}
+
+	// The stranded escrows are looked for once in an epoch, so that they are released within an epoch
+	// after the escrow timeout.
+	escrowEpoch := k.EscrowReleaseEpoch(ctx)
+	if timeout := k.EscrowTimeout(ctx); timeout > 0 && escrowEpoch > 0 && height%escrowEpoch == 0 {
+		releaseStrandedEscrows(ctx, k, height-timeout)
+	}
+
+	statsEpoch := k.NodeStatsEpoch(ctx)
+	if statsEpoch > 0 && height%statsEpoch == 0 {
+		distributeNodeRewards(ctx, k, height-statsEpoch)
+		k.SnapshotNodeStats(ctx, height)
+		k.DeleteNodeStatsSnapshots(ctx, height-k.NodeStatsRetention(ctx))
+	}
+
+	reputationEpoch := k.ReputationEpoch(ctx)
+	if reputationEpoch > 0 && height%reputationEpoch == 0 {
+		k.UpdateNodeReputations(ctx)
+	}
+
+	// The statuses of all the nodes are updated only when the minimum node version changes,
+	// the nodes registered or updated afterwards are checked by their handlers.
+	if min := k.MinNodeVersion(ctx); min != k.GetEnforcedMinNodeVersion(ctx) {
+		for _, node := range k.GetAllNodes(ctx) {
+			if _node := enforceMinNodeVersion(ctx, node, min); _node.Status != node.Status {
+				k.SetNode(ctx, _node)
+				emitNodeStatusEvent(ctx, _node)
 			}
 		}
 
-		session.Status = types.StatusInactive
-		session.StatusModifiedAt = height
-		k.SetSession(ctx, session)
+		k.SetEnforcedMinNodeVersion(ctx, min)
+	}
 
-		subscription.RemainingDeposit = subscription.RemainingDeposit.Sub(pay)
-		subscription.RemainingBandwidth = subscription.RemainingBandwidth.Sub(bandwidth)
+	unbondings := k.GetNodeUnbondingsByHeight(ctx, height)
+	for _, unbonding := range unbondings {
+		completeNodeUnbonding(ctx, k, unbonding)
+	}
+
+	k.DeleteFreeUpdatesCounts(ctx, height)
+	emitCompactEvents(ctx)
+}
+
+// completeNodeUnbonding releases the deposit of the de-registered node to its owner.
+func completeNodeUnbonding(ctx sdk.Context, k keeper.Keeper, unbonding types.NodeUnbonding) {
+	if err := k.SubtractDeposit(ctx, unbonding.Address, unbonding.Deposit); err != nil {
+		panic(err)
+	}
+
+	k.DeleteNodeUnbonding(ctx, unbonding)
+	emitDepositEvent(ctx, types.EventTypeReleaseDeposit, unbonding.Address, sdk.Coins{unbonding.Deposit},
+		sdk.NewAttribute(types.AttributeKeyNodeID, unbonding.NodeID.String()))
+}
+
+// emitDepositEvent emits a movement of the coins of the deposit of the address, the attributes refer
+// to the node or the subscription which the deposit backs and to the recipient of the coins.
+func emitDepositEvent(ctx sdk.Context, _type string, address sdk.AccAddress, coins sdk.Coins,
+	attributes ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(_type, append([]sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+	}, attributes...)...))
+}
+
+func emitNodeStatusEvent(ctx sdk.Context, node types.Node) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateNodeStatus,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, node.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyStatus, node.Status),
+	))
+}
+
+// enforceMinNodeVersion marks a registered node which runs a version below the minimum version as
+// inactive, and an inactive node which is not below it anymore as registered again.
+func enforceMinNodeVersion(ctx sdk.Context, node types.Node, min string) types.Node {
+	status := node.Status
+	switch {
+	case node.Status == types.StatusRegistered && types.IsVersionBelow(node.Version, min):
+		status = types.StatusInactive
+	case node.Status == types.StatusInactive && !types.IsVersionBelow(node.Version, min):
+		status = types.StatusRegistered
+	}
+
+	if status != node.Status {
+		node.Status = status
+		node.StatusModifiedAt = ctx.BlockHeight()
+	}
+
+	return node
+}
+
+// endSession settles the session at the last signed bandwidth and closes it, the remaining deposit
+// and bandwidth of the subscription are reduced by the settled amount.
+func endSession(ctx sdk.Context, k keeper.Keeper, session types.Session,
+	subscription types.Subscription, reason string) types.Subscription {
+	bandwidth, amount := session.Bandwidth, sdk.ZeroInt()
+	if !subscription.Trial {
+		bandwidth = bandwidth.CeilTo(hub.GB.Quo(subscription.PricePerGB.Amount))
+		amount = bandwidth.Cost(subscription.PricePerGB.Amount)
+	}
+
+	pay := settleSession(ctx, k, &session, &subscription, amount, true)
+
+	session.Status = types.StatusInactive
+	session.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSession(ctx, session)
+
+	subscription.RemainingDeposit = subscription.RemainingDeposit.Sub(pay)
+	subscription.RemainingBandwidth = subscription.RemainingBandwidth.SaturatingSub(bandwidth)
+	k.SetSubscription(ctx, subscription)
+
+	k.EndOngoingSession(ctx, subscription.ID, session.ID)
+
+	k.AddNodeStats(ctx, subscription.NodeID, session.Bandwidth, nil, 1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEndSession,
+		sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+
+	return subscription
+}
+
+// finalizeSettlement ends the subscription after its grace period, the ongoing sessions are
+// closed at the last bandwidth submitted by the node and the remaining deposit is refunded.
+func finalizeSettlement(ctx sdk.Context, k keeper.Keeper, settlement types.PendingSettlement) {
+	subscription, _ := k.GetSubscription(ctx, settlement.SubscriptionID)
+
+	for _, ongoing := range k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID) {
+		session, _ := k.GetSession(ctx, ongoing.SessionID)
+		k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
+
+		subscription = endSession(ctx, k, session, subscription, types.AttributeValueEndSubscription)
+	}
+
+	// The remaining deposit is zero when the sessions used it all up, and the zero coins can not be subtracted
+	if !subscription.Trial && subscription.RemainingDeposit.IsPositive() {
+		if err := k.SubtractDeposit(ctx, subscription.Client, subscription.RemainingDeposit); err != nil {
+			panic(err)
+		}
+
+		emitDepositEvent(ctx, types.EventTypeReleaseDeposit, subscription.Client, sdk.Coins{subscription.RemainingDeposit},
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()))
+	}
+
+	subscription.Status = types.StatusInactive
+	subscription.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSubscription(ctx, subscription)
+	k.DeletePendingSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEndSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, subscription.RemainingDeposit.String()),
+	))
+}
+
+// releaseStrandedEscrows ends the active subscriptions of which the escrow is stranded since before the
+// height, and refunds their remaining deposits to the clients.
+func releaseStrandedEscrows(ctx sdk.Context, k keeper.Keeper, height int64) {
+	var subscriptions []types.Subscription
+	k.IterateSubscriptions(ctx, func(_ int64, subscription types.Subscription) bool {
+		if k.IsEscrowStranded(ctx, subscription, height) {
+			subscriptions = append(subscriptions, subscription)
+		}
+
+		return false
+	})
+
+	for _, subscription := range subscriptions {
+		if err := k.SubtractDeposit(ctx, subscription.Client, subscription.RemainingDeposit); err != nil {
+			panic(err)
+		}
+
+		emitDepositEvent(ctx, types.EventTypeReleaseDeposit, subscription.Client, sdk.Coins{subscription.RemainingDeposit},
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()))
+
+		subscription.Status = types.StatusInactive
+		subscription.StatusModifiedAt = ctx.BlockHeight()
 		k.SetSubscription(ctx, subscription)
 
-		scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-		k.SetSessionsCountOfSubscription(ctx, subscription.ID, scs+1)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeReleaseEscrow,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, subscription.Client.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, subscription.RemainingDeposit.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueTimeout),
+		))
 	}
+}
 
-	k.DeleteActiveSessionIDs(ctx, _height)
+// gcSubscriptions removes the inert subscriptions which ended before the height. Their deposits
+// are already refunded when they ended.
+func gcSubscriptions(ctx sdk.Context, k keeper.Keeper, height int64) {
+	var subscriptions []types.Subscription
+	k.IterateSubscriptions(ctx, func(_ int64, subscription types.Subscription) bool {
+		if subscription.StatusModifiedAt < height && k.IsSubscriptionInert(ctx, subscription) {
+			subscriptions = append(subscriptions, subscription)
+		}
+
+		return false
+	})
+
+	for _, subscription := range subscriptions {
+		k.RemoveSubscription(ctx, subscription)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeGCSubscription,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyNodeID, subscription.NodeID.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, subscription.Client.String()),
+		))
+	}
+}
+
+// emitCompactEvents emits the settlements of the block once more in the compact binary
+// encoding, which can be decoded with the compact package.
+func emitCompactEvents(ctx sdk.Context) {
+	block, err := compact.FromEvents(ctx.EventManager().Events())
+	if err != nil {
+		panic(err)
+	}
+	if block.Empty() {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCompactEvents,
+		sdk.NewAttribute(types.AttributeKeyVersion, strconv.Itoa(int(compact.Version))),
+		sdk.NewAttribute(types.AttributeKeyData, compact.EncodeToString(block)),
+	))
+}
+
+// distributeNodeRewards adds the node reward pool to the pending payouts of the registered nodes in
+// proportion to the bandwidth served since the snapshot at the height. The remainders of the
+// division stay in the pool for the next epoch.
+func distributeNodeRewards(ctx sdk.Context, k keeper.Keeper, height int64) {
+	pool := k.GetNodeRewardPool(ctx)
+	if pool.Empty() {
+		return
+	}
+
+	var (
+		ids        []hub.NodeID
+		bandwidths []sdk.Int
+		total      = sdk.ZeroInt()
+	)
+
+	for _, stats := range k.GetAllNodeStats(ctx) {
+		node, found := k.GetNode(ctx, stats.NodeID)
+		if !found || node.Status != types.StatusRegistered || node.Jailed {
+			continue
+		}
+
+		if snapshot, found := k.GetNodeStatsSnapshot(ctx, height, stats.NodeID); found {
+			stats = stats.Sub(snapshot.Stats)
+		}
+
+		bandwidth := stats.Bandwidth.Sum()
+		if !bandwidth.IsPositive() {
+			continue
+		}
+
+		ids = append(ids, node.ID)
+		bandwidths = append(bandwidths, bandwidth)
+		total = total.Add(bandwidth)
+	}
+
+	for i, id := range ids {
+		for _, coin := range pool {
+			reward := sdk.NewCoin(coin.Denom, coin.Amount.Mul(bandwidths[i]).Quo(total))
+			if !reward.IsPositive() {
+				continue
+			}
+
+			if err := k.AddPendingPayout(ctx, types.NodeRewardPoolAddress, id, reward); err != nil {
+				panic(err)
+			}
+
+			emitDepositEvent(ctx, types.EventTypeSendDeposit, types.NodeRewardPoolAddress, sdk.Coins{reward},
+				sdk.NewAttribute(types.AttributeKeyNodeID, id.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, types.PayoutPoolAddress.String()))
+		}
+	}
+}
+
+// payPendingPayouts sends the settled amounts of the epoch to the nodes and the recipients of
+// their revenue splits, batched into a single transfer per address of each node.
+func payPendingPayouts(ctx sdk.Context, k keeper.Keeper) {
+	payouts := k.GetAllPendingPayouts(ctx)
+	for _, payout := range payouts {
+		node, _ := k.GetNode(ctx, payout.NodeID)
+
+		var addresses []sdk.AccAddress
+		amounts := make(map[string]sdk.Coins)
+		for _, coin := range payout.Coins {
+			for _, _payout := range node.SplitRevenue(coin) {
+				address := _payout.Address
+				if _, ok := amounts[address.String()]; !ok {
+					addresses = append(addresses, address)
+				}
+
+				amounts[address.String()] = amounts[address.String()].Add(sdk.Coins{_payout.Coin})
+			}
+		}
+
+		for _, address := range addresses {
+			amount := amounts[address.String()]
+			if err := k.SendPendingPayout(ctx, address, amount); err != nil {
+				panic(err)
+			}
+
+			emitDepositEvent(ctx, types.EventTypeSendDeposit, types.PayoutPoolAddress, amount,
+				sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, address.String()))
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypePayoutNode,
+				sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			))
+		}
+
+		k.DeletePendingPayout(ctx, payout.NodeID)
+	}
+}
+
+// streamSettlement pays the nodes for the bandwidth consumed so far by the sessions
+// which are active between the given heights, rounding the amount down. The rest is paid
+// once the session becomes inactive.
+func streamSettlement(ctx sdk.Context, k keeper.Keeper, from, to int64) {
+	for height := from; height <= to; height++ {
+		ids := k.GetActiveSessionIDs(ctx, height)
+		for _, id := range ids {
+			session, _ := k.GetSession(ctx, id.(hub.SessionID))
+			subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
+
+			amount := session.Bandwidth.Cost(subscription.PricePerGB.Amount)
+
+			pay := settleSession(ctx, k, &session, &subscription, amount, false)
+			if pay.IsZero() {
+				continue
+			}
+
+			k.SetSession(ctx, session)
+
+			subscription.RemainingDeposit = subscription.RemainingDeposit.Sub(pay)
+			k.SetSubscription(ctx, subscription)
+		}
+	}
+}
+
+// settleSession sends the part of the amount which is not paid yet to the payout address of the node,
+// or adds it to the pending payout of the node when the settlement epochs are enabled. The protocol
+// fee is deducted from it and sent to the community pool.
+func settleSession(ctx sdk.Context, k keeper.Keeper, session *types.Session,
+	subscription *types.Subscription, amount sdk.Int, final bool) sdk.Coin {
+	pay := sdk.NewCoin(subscription.PricePerGB.Denom, sdk.ZeroInt())
+	if amount.GT(session.Paid.Amount) {
+		pay.Amount = amount.Sub(session.Paid.Amount)
+	}
+
+	if !pay.IsZero() {
+		fee := sdk.NewCoin(pay.Denom, k.ProtocolFeeRate(ctx).MulInt(pay.Amount).TruncateInt())
+		if !fee.IsZero() {
+			if err := k.FundCommunityPool(ctx, subscription.Client, fee); err != nil {
+				panic(err)
+			}
+
+			emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{fee},
+				sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, supply.NewModuleAddress(distribution.ModuleName).String()))
+		}
+
+		earning := pay.Sub(fee)
+		if subscription.Referrer != nil {
+			reward := sdk.NewCoin(pay.Denom, k.ReferralRate(ctx).MulInt(pay.Amount).TruncateInt())
+			if !reward.IsZero() {
+				if err := k.SendDeposit(ctx, subscription.Client, subscription.Referrer, reward); err != nil {
+					panic(err)
+				}
+
+				emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{reward},
+					sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, subscription.Referrer.String()))
+
+				k.AddReferralEarnings(ctx, subscription.Referrer, sdk.Coins{reward})
+				earning = earning.Sub(reward)
+
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeReferralReward,
+					sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyAddress, subscription.Referrer.String()),
+					sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+				))
+			}
+		}
+
+		if !earning.IsZero() {
+			k.AddNodeStats(ctx, subscription.NodeID, hub.NewBandwidthFromInt64(0, 0), sdk.Coins{earning}, 0)
+
+			if k.SettlementEpoch(ctx) > 0 {
+				if err := k.AddPendingPayout(ctx, subscription.Client, subscription.NodeID, earning); err != nil {
+					panic(err)
+				}
+
+				emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{earning},
+					sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, types.PayoutPoolAddress.String()))
+			} else {
+				node, _ := k.GetNode(ctx, subscription.NodeID)
+
+				for _, payout := range node.SplitRevenue(earning) {
+					if err := k.SendDeposit(ctx, subscription.Client, payout.Address, payout.Coin); err != nil {
+						panic(err)
+					}
+
+					emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{payout.Coin},
+						sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+						sdk.NewAttribute(types.AttributeKeyRecipient, payout.Address.String()))
+
+					ctx.EventManager().EmitEvent(sdk.NewEvent(
+						types.EventTypeSplitRevenue,
+						sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+						sdk.NewAttribute(types.AttributeKeyRole, payout.Role),
+						sdk.NewAttribute(types.AttributeKeyAddress, payout.Address.String()),
+						sdk.NewAttribute(types.AttributeKeyAmount, payout.Coin.String()),
+					))
+				}
+			}
+		}
+
+		session.Paid = session.Paid.Add(pay)
+		k.ObserveSettlement(pay)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettleSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, pay.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFinal, strconv.FormatBool(final)),
+		))
+	}
+
+	return pay
 }
 
 func handleRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterNode) sdk.Result {
+	if msg.Moniker != "" {
+		if _, found := k.GetNodeIDByMoniker(ctx, msg.Moniker); found {
+			return types.ErrorMonikerAlreadyTaken().Result()
+		}
+	}
+
 	nc := k.GetNodesCount(ctx)
 	node := types.Node{
 		ID:               hub.NewNodeID(nc),
@@ -80,9 +621,12 @@ func handleRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterN
 		PricesPerGB:      msg.PricesPerGB,
 		InternetSpeed:    msg.InternetSpeed,
 		Encryption:       msg.Encryption,
+		MetadataURI:      msg.MetadataURI,
+		MetadataHash:     msg.MetadataHash,
 		Status:           types.StatusRegistered,
 		StatusModifiedAt: ctx.BlockHeight(),
 	}
+	node = enforceMinNodeVersion(ctx, node, k.MinNodeVersion(ctx))
 
 	nca := k.GetNodesCountOfAddress(ctx, node.Owner)
 	if nca >= k.FreeNodesCount(ctx) {
@@ -91,14 +635,22 @@ func handleRegisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterN
 		if err := k.AddDeposit(ctx, node.Owner, node.Deposit); err != nil {
 			return err.Result()
 		}
+
+		emitDepositEvent(ctx, types.EventTypeLockDeposit, node.Owner, sdk.Coins{node.Deposit},
+			sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()))
 	}
 
 	k.SetNode(ctx, node)
 	k.SetNodeIDByAddress(ctx, node.Owner, nca, node.ID)
+	k.SetNodeUptime(ctx, types.NewNodeUptime(node.ID, ctx.BlockHeight()))
+	if node.Moniker != "" {
+		k.SetNodeIDByMoniker(ctx, node.Moniker, node.ID)
+	}
 
 	k.SetNodesCount(ctx, nc+1)
 	k.SetNodesCountOfAddress(ctx, node.Owner, nca+1)
 
+	emitNodeStatusEvent(ctx, node)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
@@ -114,6 +666,17 @@ func handleUpdateNodeInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateN
 		return types.ErrorInvalidNodeStatus().Result()
 	}
 
+	if msg.Moniker != "" {
+		if id, found := k.GetNodeIDByMoniker(ctx, msg.Moniker); found && !id.IsEqual(node.ID) {
+			return types.ErrorMonikerAlreadyTaken().Result()
+		}
+
+		if node.Moniker != "" {
+			k.DeleteNodeIDByMoniker(ctx, node.Moniker)
+		}
+		k.SetNodeIDByMoniker(ctx, msg.Moniker, node.ID)
+	}
+
 	_node := types.Node{
 		Type:          msg.T,
 		Version:       msg.Version,
@@ -121,10 +684,22 @@ func handleUpdateNodeInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateN
 		PricesPerGB:   msg.PricesPerGB,
 		InternetSpeed: msg.InternetSpeed,
 		Encryption:    msg.Encryption,
+		MetadataURI:   msg.MetadataURI,
+		MetadataHash:  msg.MetadataHash,
 	}
+	status := node.Status
 	node = node.UpdateInfo(_node)
+	if msg.ClearMetadata {
+		node.MetadataURI, node.MetadataHash = "", ""
+	}
+	node = enforceMinNodeVersion(ctx, node, k.MinNodeVersion(ctx))
 
 	k.SetNode(ctx, node)
+	k.AddNodeHeartbeat(ctx, node.ID)
+
+	if node.Status != status {
+		emitNodeStatusEvent(ctx, node)
+	}
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
@@ -141,9 +716,19 @@ func handleDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregis
 		return types.ErrorInvalidNodeStatus().Result()
 	}
 
+	// The deposit stays locked for the unbonding period, so that the node can still be
+	// penalized for the misbehavior which is found after the de-registration.
 	if node.Deposit.IsPositive() {
-		if err := k.SubtractDeposit(ctx, node.Owner, node.Deposit); err != nil {
-			return err.Result()
+		if period := k.NodeUnbondingPeriod(ctx); period > 0 {
+			k.SetNodeUnbonding(ctx, types.NewNodeUnbonding(node.ID, node.Owner, node.Deposit,
+				ctx.BlockHeight()+period))
+		} else {
+			if err := k.SubtractDeposit(ctx, node.Owner, node.Deposit); err != nil {
+				return err.Result()
+			}
+
+			emitDepositEvent(ctx, types.EventTypeReleaseDeposit, node.Owner, sdk.Coins{node.Deposit},
+				sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()))
 		}
 	}
 
@@ -152,142 +737,1251 @@ func handleDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregis
 
 	k.SetNode(ctx, node)
 
+	// The moniker of a de-registered node can be taken by another node.
+	if node.Moniker != "" {
+		k.DeleteNodeIDByMoniker(ctx, node.Moniker)
+	}
+
+	if uptime, found := k.GetNodeUptime(ctx, node.ID); found {
+		k.SetNodeUptime(ctx, uptime.Stop(ctx.BlockHeight(), k.NodeInactiveInterval(ctx)))
+	}
+
+	emitNodeStatusEvent(ctx, node)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
-func handleStartSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgStartSubscription) sdk.Result {
-	node, found := k.GetNode(ctx, msg.NodeID)
+func handlePruneNodeHistory(ctx sdk.Context, k keeper.Keeper, msg types.MsgPruneNodeHistory) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
 	if !found {
 		return types.ErrorNodeDoesNotExist().Result()
 	}
-	if node.Status != types.StatusRegistered {
-		return types.ErrorInvalidNodeStatus().Result()
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
 	}
 
-	if err := k.AddDeposit(ctx, msg.From, msg.Deposit); err != nil {
-		return err.Result()
+	gasMeter := ctx.GasMeter()
+	pruneGasMeter := sdk.NewInfiniteGasMeter()
+	if gasMeter.Limit() > 0 {
+		pruneGasMeter = sdk.NewGasMeter(gasMeter.Limit() - gasMeter.GasConsumedToLimit())
 	}
 
-	bandwidth, err := node.DepositToBandwidth(msg.Deposit)
-	if err != nil {
-		return err.Result()
+	height := ctx.BlockHeight() - k.SessionPruningRetention(ctx)
+	count := pruneSessionsOfNode(ctx.WithGasMeter(pruneGasMeter), k, node.ID, height)
+
+	// The refund is capped at the gas consumed by the pruning, the gas is divided by the refund
+	// instead of the count being multiplied, which can overflow.
+	gas, refund := pruneGasMeter.GasConsumed(), k.PruneGasRefund(ctx)
+	if refund == 0 || count <= gas/refund {
+		gasMeter.ConsumeGas(gas-count*refund, "prune node history")
 	}
 
-	pricePerGB := node.FindPricePerGB(msg.Deposit.Denom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePruneNodeHistory,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", count)),
+	))
 
-	sc := k.GetSubscriptionsCount(ctx)
-	subscription := types.Subscription{
-		ID:                 hub.NewSubscriptionID(sc),
-		NodeID:             node.ID,
-		Client:             msg.From,
-		PricePerGB:         pricePerGB,
-		TotalDeposit:       msg.Deposit,
-		RemainingDeposit:   msg.Deposit,
-		RemainingBandwidth: bandwidth,
-		Status:             types.StatusActive,
-		StatusModifiedAt:   ctx.BlockHeight(),
-	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-	k.SetSubscription(ctx, subscription)
-	k.SetSubscriptionsCount(ctx, sc+1)
+func pruneSessionsOfNode(ctx sdk.Context, k keeper.Keeper, id hub.NodeID, height int64) (count uint64) {
+	subscriptions := k.GetSubscriptionsOfNode(ctx, id)
+	for _, subscription := range subscriptions {
+		if subscription.Status != types.StatusInactive || subscription.StatusModifiedAt >= height {
+			continue
+		}
 
-	nsc := k.GetSubscriptionsCountOfNode(ctx, node.ID)
-	k.SetSubscriptionIDByNodeID(ctx, node.ID, nsc, subscription.ID)
-	k.SetSubscriptionsCountOfNode(ctx, node.ID, nsc+1)
+		scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+		for i := uint64(0); i < scs; i++ {
+			_id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, i)
+			if !found {
+				continue
+			}
 
-	sca := k.GetSubscriptionsCountOfAddress(ctx, subscription.Client)
-	k.SetSubscriptionIDByAddress(ctx, subscription.Client, sca, subscription.ID)
-	k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, sca+1)
+			session, _ := k.GetSession(ctx, _id)
+			if session.Status != types.StatusInactive || session.StatusModifiedAt >= height {
+				continue
+			}
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+			if dispute, found := k.GetDispute(ctx, session.ID); found {
+				if dispute.IsOpen() {
+					continue
+				}
+
+				k.DeleteDispute(ctx, dispute)
+			}
+
+			k.DeleteSession(ctx, session.ID)
+			k.DeleteSessionIDBySubscriptionID(ctx, subscription.ID, i)
+			count++
+		}
+	}
+
+	return count
 }
 
-func handleEndSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgEndSubscription) sdk.Result {
-	subscription, found := k.GetSubscription(ctx, msg.ID)
+func handleSetNodePrivate(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetNodePrivate) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
 	if !found {
-		return types.ErrorSubscriptionDoesNotExist().Result()
+		return types.ErrorNodeDoesNotExist().Result()
 	}
-	if !msg.From.Equals(subscription.Client) {
+	if !msg.From.Equals(node.Owner) {
 		return types.ErrorUnauthorized().Result()
 	}
-	if subscription.Status != types.StatusActive {
-		return types.ErrorInvalidSubscriptionStatus().Result()
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
 	}
 
-	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	node.Private = msg.Private
+	k.SetNode(ctx, node)
 
-	_, found = k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs)
-	if found {
-		return types.ErrorSessionAlreadyExists().Result()
-	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-	if err := k.SubtractDeposit(ctx, subscription.Client, subscription.RemainingDeposit); err != nil {
-		return err.Result()
+func handleSetNodeLocation(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetNodeLocation) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
 	}
 
-	subscription.Status = types.StatusInactive
-	subscription.StatusModifiedAt = ctx.BlockHeight()
-
-	k.SetSubscription(ctx, subscription)
+	node.Location = msg.Location
+	k.SetNode(ctx, node)
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
-func handleUpdateSessionInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateSessionInfo) sdk.Result {
-	subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
+func handleSetNodeFreeTrial(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetNodeFreeTrial) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+
+	node.FreeTrialBytes = msg.Bytes
+	k.SetNode(ctx, node)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleSetDiscountPlan replaces the discount plan of the node, the subscriptions which are already
+// started keep their prices.
+func handleSetDiscountPlan(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetDiscountPlan) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if msg.Rate.IsPositive() && msg.Until <= ctx.BlockHeight() {
+		return types.ErrorInvalidDiscountPlan().Result()
+	}
+
+	if plan, found := k.GetDiscountPlan(ctx, node.ID); found {
+		k.DeleteDiscountPlan(ctx, plan)
+	}
+	if msg.Rate.IsPositive() {
+		k.SetDiscountPlan(ctx, types.NewDiscountPlan(node.ID, msg.Rate, msg.Until))
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleUnjailNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjailNode) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if !node.Jailed {
+		return types.ErrorNodeNotJailed().Result()
+	}
+	if ctx.BlockHeight() < node.JailedUntil {
+		return types.ErrorNodeJailCooldown().Result()
+	}
+
+	node.Jailed = false
+	node.JailedUntil = 0
+	k.SetNode(ctx, node)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnjailNode,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleWithdrawNodeDeposit(ctx sdk.Context, k keeper.Keeper, msg types.MsgWithdrawNodeDeposit) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if msg.Amount.Denom != node.Deposit.Denom {
+		return types.ErrorInvalidDeposit().Result()
+	}
+	if k.WithdrawableNodeDeposit(ctx, node).IsLT(msg.Amount) {
+		return types.ErrorInsufficientNodeDeposit().Result()
+	}
+
+	if err := k.SubtractDeposit(ctx, node.Owner, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	node.Deposit = node.Deposit.Sub(msg.Amount)
+	k.SetNode(ctx, node)
+
+	emitDepositEvent(ctx, types.EventTypeReleaseDeposit, node.Owner, sdk.Coins{msg.Amount},
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleGrantFeeAllowance(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrantFeeAllowance) sdk.Result {
+	if k.GetNodesCountOfAddress(ctx, msg.From) == 0 {
+		return types.ErrorUnauthorized().Result()
+	}
+	if msg.Expiry > 0 && msg.Expiry <= ctx.BlockHeight() {
+		return types.ErrorInvalidField("expiry").Result()
+	}
+
+	k.SetFeeAllowance(ctx, types.NewFeeAllowance(msg.From, msg.Grantee, msg.SpendLimit, msg.Expiry))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleRevokeFeeAllowance(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeFeeAllowance) sdk.Result {
+	if _, found := k.GetFeeAllowance(ctx, msg.From, msg.Grantee); !found {
+		return types.ErrorFeeAllowanceDoesNotExist().Result()
+	}
+
+	k.DeleteFeeAllowance(ctx, msg.From, msg.Grantee)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleGrantAuthorization(ctx sdk.Context, k keeper.Keeper, msg types.MsgGrantAuthorization) sdk.Result {
+	if msg.Expiry > 0 && msg.Expiry <= ctx.BlockHeight() {
+		return types.ErrorInvalidField("expiry").Result()
+	}
+
+	k.SetAuthorization(ctx, types.NewAuthorization(msg.From, msg.Grantee, msg.MsgType, msg.Expiry))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleRevokeAuthorization(ctx sdk.Context, k keeper.Keeper, msg types.MsgRevokeAuthorization) sdk.Result {
+	if _, found := k.GetAuthorization(ctx, msg.From, msg.Grantee, msg.MsgType); !found {
+		return types.ErrorAuthorizationDoesNotExist().Result()
+	}
+
+	k.DeleteAuthorization(ctx, msg.From, msg.Grantee, msg.MsgType)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleExecAuthorized runs the messages through the handler as if they were signed by their
+// granters, the whole message fails if any of them fails. Every signer of a message other than
+// the grantee must have an unexpired grant, and the nested executions are not allowed.
+func handleExecAuthorized(ctx sdk.Context, k keeper.Keeper, msg types.MsgExecAuthorized) sdk.Result {
+	for _, _msg := range msg.Msgs {
+		switch _msg.(type) {
+		case types.MsgExecAuthorized, *types.MsgExecAuthorized:
+			return types.ErrorInvalidField("msgs").Result()
+		}
+
+		for _, granter := range _msg.GetSigners() {
+			if granter.Equals(msg.From) {
+				continue
+			}
+
+			authorization, found := k.GetAuthorization(ctx, granter, msg.From, _msg.Type())
+			if !found || authorization.IsExpired(ctx.BlockHeight()) {
+				return types.ErrorUnauthorized().Result()
+			}
+		}
+	}
+
+	handler := NewHandler(k)
+	for _, _msg := range msg.Msgs {
+		if res := handler(ctx, _msg); !res.IsOK() {
+			return res
+		}
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleAddAllowedAddress(ctx sdk.Context, k keeper.Keeper, msg types.MsgAddAllowedAddress) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+
+	k.SetAllowedAddress(ctx, types.NewAllowedAddress(node.ID, msg.Address))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleRemoveAllowedAddress(ctx sdk.Context, k keeper.Keeper, msg types.MsgRemoveAllowedAddress) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if !k.HasAllowedAddress(ctx, node.ID, msg.Address) {
+		return types.ErrorAddressNotAllowed().Result()
+	}
+
+	k.DeleteAllowedAddress(ctx, node.ID, msg.Address)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleBlacklistClient(ctx sdk.Context, k keeper.Keeper, msg types.MsgBlacklistClient) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if node.Status == types.StatusDeRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if k.HasBlacklistedClient(ctx, node.ID, msg.Client) {
+		return types.ErrorClientBlacklisted().Result()
+	}
+
+	k.SetBlacklistedClient(ctx, types.NewBlacklistedClient(node.ID, msg.Client))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleUnblacklistClient(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnblacklistClient) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if !k.HasBlacklistedClient(ctx, node.ID, msg.Client) {
+		return types.ErrorClientNotBlacklisted().Result()
+	}
+
+	k.DeleteBlacklistedClient(ctx, node.ID, msg.Client)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleSetPayoutRoutes(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetPayoutRoutes) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+
+	node.PayoutRoutes = msg.Routes
+	k.SetNode(ctx, node)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleSetRevenueSplits(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetRevenueSplits) sdk.Result {
+	node, found := k.GetNode(ctx, msg.ID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+
+	node.RevenueSplits = msg.Splits
+	k.SetNode(ctx, node)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleStartSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgStartSubscription) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status != types.StatusRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if k.HasBlacklistedClient(ctx, node.ID, msg.From) {
+		return types.ErrorClientBlacklisted().Result()
+	}
+	if node.Private && !msg.From.Equals(node.Owner) &&
+		!k.HasAllowedAddress(ctx, node.ID, msg.From) {
+		return types.ErrorAddressNotAllowed().Result()
+	}
+	if msg.Referrer != nil && (msg.Referrer.Equals(msg.From) || msg.Referrer.Equals(node.Owner)) {
+		return types.ErrorInvalidReferrer().Result()
+	}
+
+	var (
+		bandwidth  hub.Bandwidth
+		pricePerGB sdk.Coin
+		err        sdk.Error
+	)
+
+	if msg.Quote != nil {
+		quote := msg.Quote.Quote
+		if ctx.BlockHeight() > quote.Expiry {
+			return types.ErrorQuoteExpired().Result()
+		}
+		if quote.Expiry-ctx.BlockHeight() > types.MaxQuoteLifetime {
+			return types.ErrorInvalidQuote().Result()
+		}
+		if k.HasUsedQuote(ctx, node.ID, quote.Nonce) {
+			return types.ErrorQuoteAlreadyUsed().Result()
+		}
+		if !bytes.Equal(msg.Quote.Signature.PubKey.Address(), node.Owner.Bytes()) {
+			return types.ErrorUnauthorized().Result()
+		}
+		if !msg.Quote.Signature.VerifyBytes(quote.SignBytes(ctx.ChainID()), msg.Quote.Signature.Signature) {
+			return types.ErrorInvalidQuoteSignature().Result()
+		}
+
+		bandwidth, err = quote.DepositToBandwidth(msg.Deposit)
+		if err != nil {
+			return err.Result()
+		}
+		if quote.Capacity.AnyLT(bandwidth) {
+			return types.ErrorInvalidQuote().Result()
+		}
+
+		pricePerGB = quote.PricePerGB
+	} else {
+		bandwidth, err = node.DepositToBandwidth(msg.Deposit)
+		if err != nil {
+			return err.Result()
+		}
+
+		pricePerGB = node.FindPricePerGB(msg.Deposit.Denom)
+
+		// The discounted price is kept by the subscription, so the settlements of its sessions
+		// are also at the discount.
+		if plan, found := k.GetDiscountPlan(ctx, node.ID); found && plan.IsActive(ctx.BlockHeight()) {
+			pricePerGB = plan.Apply(pricePerGB)
+
+			bandwidth = hub.NewBandwidthFromCost(msg.Deposit.Amount, pricePerGB.Amount)
+		}
+	}
+
+	if err := k.AddDeposit(ctx, msg.From, msg.Deposit); err != nil {
+		return err.Result()
+	}
+
+	if msg.Quote != nil {
+		quote := msg.Quote.Quote
+		k.SetUsedQuote(ctx, types.NewUsedQuote(node.ID, quote.Nonce, quote.Expiry))
+	}
+
+	subscription := addSubscription(ctx, k, types.Subscription{
+		NodeID:             node.ID,
+		Client:             msg.From,
+		Referrer:           msg.Referrer,
+		PricePerGB:         pricePerGB,
+		TotalDeposit:       msg.Deposit,
+		RemainingDeposit:   msg.Deposit,
+		RemainingBandwidth: bandwidth,
+		Seats:              msg.Seats,
+		Status:             types.StatusActive,
+		StatusModifiedAt:   ctx.BlockHeight(),
+	})
+
+	emitDepositEvent(ctx, types.EventTypeLockDeposit, subscription.Client, sdk.Coins{msg.Deposit},
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleStartFreeTrial starts a subscription of the free trial bandwidth of the node, which is
+// priced at zero and is not backed by a deposit. Every address can use the free trial of a node once.
+func handleStartFreeTrial(ctx sdk.Context, k keeper.Keeper, msg types.MsgStartFreeTrial) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+	if node.Status != types.StatusRegistered {
+		return types.ErrorInvalidNodeStatus().Result()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed().Result()
+	}
+	if node.FreeTrialBytes == 0 {
+		return types.ErrorFreeTrialNotOffered().Result()
+	}
+	if k.HasBlacklistedClient(ctx, node.ID, msg.From) {
+		return types.ErrorClientBlacklisted().Result()
+	}
+	if node.Private && !msg.From.Equals(node.Owner) &&
+		!k.HasAllowedAddress(ctx, node.ID, msg.From) {
+		return types.ErrorAddressNotAllowed().Result()
+	}
+	if k.HasFreeTrial(ctx, node.ID, msg.From) {
+		return types.ErrorFreeTrialAlreadyUsed().Result()
+	}
+
+	free := sdk.NewInt64Coin(k.Deposit(ctx).Denom, 0)
+	bytes := sdk.NewIntFromBigInt(new(big.Int).SetUint64(node.FreeTrialBytes))
+
+	k.SetFreeTrial(ctx, types.NewFreeTrial(node.ID, msg.From))
+	addSubscription(ctx, k, types.Subscription{
+		NodeID:             node.ID,
+		Client:             msg.From,
+		PricePerGB:         free,
+		TotalDeposit:       free,
+		RemainingDeposit:   free,
+		RemainingBandwidth: hub.NewBandwidth(bytes, bytes),
+		Trial:              true,
+		Status:             types.StatusActive,
+		StatusModifiedAt:   ctx.BlockHeight(),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// addSubscription stores the subscription under the next ID, along with the indexes of its
+// node and its client.
+func addSubscription(ctx sdk.Context, k keeper.Keeper, subscription types.Subscription) types.Subscription {
+	sc := k.GetSubscriptionsCount(ctx)
+	subscription.ID = hub.NewSubscriptionID(sc)
+
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionsCount(ctx, sc+1)
+
+	nsc := k.GetSubscriptionsCountOfNode(ctx, subscription.NodeID)
+	k.SetSubscriptionIDByNodeID(ctx, subscription.NodeID, nsc, subscription.ID)
+	k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, nsc+1)
+
+	sca := k.GetSubscriptionsCountOfAddress(ctx, subscription.Client)
+	k.SetSubscriptionIDByAddress(ctx, subscription.Client, sca, subscription.ID)
+	k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, sca+1)
+
+	return subscription
+}
+
+func handleEndSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgEndSubscription) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status != types.StatusActive {
+		return types.ErrorInvalidSubscriptionStatus().Result()
+	}
+	if _, found = k.GetPendingSettlement(ctx, subscription.ID); found {
+		return types.ErrorSubscriptionEnding().Result()
+	}
+
+	// The node can submit the final bandwidth of the ongoing session until the end of the grace period
+	if grace := k.SettlementGracePeriod(ctx); grace > 0 {
+		k.SetPendingSettlement(ctx, types.NewPendingSettlement(subscription.ID, ctx.BlockHeight()+grace))
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	}
+
+	if len(k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID)) > 0 {
+		return types.ErrorSessionAlreadyExists().Result()
+	}
+
+	if !subscription.Trial && subscription.RemainingDeposit.IsPositive() {
+		if err := k.SubtractDeposit(ctx, subscription.Client, subscription.RemainingDeposit); err != nil {
+			return err.Result()
+		}
+
+		emitDepositEvent(ctx, types.EventTypeReleaseDeposit, subscription.Client, sdk.Coins{subscription.RemainingDeposit},
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()))
+	}
+
+	subscription.Status = types.StatusInactive
+	subscription.StatusModifiedAt = ctx.BlockHeight()
+
+	k.SetSubscription(ctx, subscription)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleAssignSeat(ctx sdk.Context, k keeper.Keeper, msg types.MsgAssignSeat) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status != types.StatusActive {
+		return types.ErrorInvalidSubscriptionStatus().Result()
+	}
+	if msg.Index >= subscription.Seats {
+		return types.ErrorInvalidSeat().Result()
+	}
+
+	if _, found = k.GetSeatIndexByAddress(ctx, subscription.ID, msg.Address); found {
+		return types.ErrorSeatAlreadyAssigned().Result()
+	}
+
+	seat, found := k.GetSeat(ctx, subscription.ID, msg.Index)
+	if found {
+		k.DeleteSeatIndexByAddress(ctx, subscription.ID, seat.Address)
+	}
+
+	seat = types.NewSeat(subscription.ID, msg.Index, msg.Address)
+	k.SetSeat(ctx, seat)
+	k.SetSeatIndexByAddress(ctx, subscription.ID, seat.Address, seat.Index)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleUnassignSeat(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnassignSeat) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+
+	seat, found := k.GetSeat(ctx, subscription.ID, msg.Index)
+	if !found {
+		return types.ErrorInvalidSeat().Result()
+	}
+
+	k.DeleteSeat(ctx, subscription.ID, seat.Index)
+	k.DeleteSeatIndexByAddress(ctx, subscription.ID, seat.Address)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handlePauseSubscription stops the new sessions of the subscription until it is resumed,
+// the ongoing session is not affected.
+func handlePauseSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgPauseSubscription) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
 	if !found {
 		return types.ErrorSubscriptionDoesNotExist().Result()
 	}
-	if subscription.Status == types.StatusInactive {
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status != types.StatusActive {
 		return types.ErrorInvalidSubscriptionStatus().Result()
 	}
-	if !bytes.Equal(msg.ClientSignature.PubKey.Address(), subscription.Client.Bytes()) {
+	if subscription.Paused {
+		return types.ErrorSubscriptionPaused().Result()
+	}
+
+	subscription.Paused = true
+	k.SetSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePauseSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleResumeSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgResumeSubscription) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
 		return types.ErrorUnauthorized().Result()
 	}
+	if subscription.Status != types.StatusActive {
+		return types.ErrorInvalidSubscriptionStatus().Result()
+	}
+	if !subscription.Paused {
+		return types.ErrorSubscriptionNotPaused().Result()
+	}
 
-	node, _ := k.GetNode(ctx, subscription.NodeID)
-	if !bytes.Equal(msg.NodeOwnerSignature.PubKey.Address(), node.Owner.Bytes()) {
+	subscription.Paused = false
+	k.SetSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResumeSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleTopUpSubscription(ctx sdk.Context, k keeper.Keeper, msg types.MsgTopUpSubscription) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status != types.StatusActive {
+		return types.ErrorInvalidSubscriptionStatus().Result()
+	}
+	if _, found = k.GetPendingSettlement(ctx, subscription.ID); found {
+		return types.ErrorSubscriptionEnding().Result()
+	}
+
+	bandwidth, err := subscription.DepositToBandwidth(msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := k.AddDeposit(ctx, msg.From, msg.Deposit); err != nil {
+		return err.Result()
+	}
+
+	subscription.TotalDeposit = subscription.TotalDeposit.Add(msg.Deposit)
+	subscription.RemainingDeposit = subscription.RemainingDeposit.Add(msg.Deposit)
+	subscription.RemainingBandwidth = subscription.RemainingBandwidth.Add(bandwidth)
+	k.SetSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTopUpSubscription,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Deposit.String()),
+	))
+	emitDepositEvent(ctx, types.EventTypeLockDeposit, subscription.Client, sdk.Coins{msg.Deposit},
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleSubmitQoSReport weighs the report of the client into the QoS summary of the node of the subscription,
+// a subscription reports once for each of its sessions.
+func handleSubmitQoSReport(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitQoSReport) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
 		return types.ErrorUnauthorized().Result()
 	}
 
 	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	data := hub.NewBandwidthSignatureData(subscription.ID, scs, msg.Bandwidth).Bytes()
-	if !msg.NodeOwnerSignature.VerifyBytes(data, msg.NodeOwnerSignature.Signature) {
-		return types.ErrorInvalidBandwidthSignature().Result()
+	if scs <= subscription.QoSReportedSessions {
+		return types.ErrorQoSAlreadyReported().Result()
+	}
+
+	subscription.QoSReportedSessions = scs
+	k.SetSubscription(ctx, subscription)
+
+	k.AddNodeQoS(ctx, subscription.NodeID, msg.Speed, msg.Latency)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitQoSReport,
+		sdk.NewAttribute(types.AttributeKeyNodeID, subscription.NodeID.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleRateNode adds the rating of the client to the node of the subscription, a subscription is completed
+// once it is inactive after at least one session.
+func handleRateNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgRateNode) sdk.Result {
+	subscription, found := k.GetSubscription(ctx, msg.ID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if !msg.From.Equals(subscription.Client) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if subscription.Status != types.StatusInactive || k.GetSessionsCountOfSubscription(ctx, subscription.ID) == 0 {
+		return types.ErrorSubscriptionNotCompleted().Result()
+	}
+	if subscription.Rated {
+		return types.ErrorNodeAlreadyRated().Result()
+	}
+
+	node, found := k.GetNode(ctx, subscription.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist().Result()
+	}
+
+	subscription.Rated = true
+	k.SetSubscription(ctx, subscription)
+
+	node.Rating = node.Rating.Add(msg.Rating)
+	k.SetNode(ctx, node)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRateNode,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// updateSessionInfo returns the ID of the session of the update, which is created for the first
+// update of the client or the seat of the subscription.
+func updateSessionInfo(ctx sdk.Context, k keeper.Keeper,
+	msg types.MsgUpdateSessionInfo) (hub.SessionID, sdk.Error) {
+	subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
+	if !found {
+		return nil, types.ErrorSubscriptionDoesNotExist()
+	}
+	if subscription.Status == types.StatusInactive {
+		return nil, types.ErrorInvalidSubscriptionStatus()
+	}
+
+	address := sdk.AccAddress(msg.ClientSignature.PubKey.Address())
+	if !address.Equals(subscription.Client) {
+		if _, found = k.GetSeatIndexByAddress(ctx, subscription.ID, address); !found {
+			return nil, types.ErrorUnauthorized()
+		}
+	}
+
+	node, _ := k.GetNode(ctx, subscription.NodeID)
+	if !bytes.Equal(msg.NodeOwnerSignature.PubKey.Address(), node.Owner.Bytes()) {
+		return nil, types.ErrorUnauthorized()
+	}
+
+	// The client and each of the seats hold a session of their own, a new one is at the next index.
+	ongoing, found := k.GetOngoingSessionIndex(ctx, subscription.ID, address)
+	if !found {
+		ongoing = types.SessionIndex{
+			SubscriptionID: subscription.ID,
+			Index:          k.GetNextSessionIndexOfSubscription(ctx, subscription.ID),
+			Address:        address,
+		}
 	}
-	if !msg.ClientSignature.VerifyBytes(data, msg.ClientSignature.Signature) {
-		return types.ErrorInvalidBandwidthSignature().Result()
+
+	data := types.BandwidthSignBytes(subscription.ID, ongoing.Index, msg.Bandwidth)
+	if !types.VerifyBandwidthSignature(msg.NodeOwnerSignature, data) {
+		return nil, types.ErrorInvalidNodeSignature()
+	}
+	if !types.VerifyBandwidthSignature(msg.ClientSignature, data) {
+		return nil, types.ErrorInvalidClientSignature()
 	}
 
 	if subscription.RemainingBandwidth.AnyLT(msg.Bandwidth) {
-		return types.ErrorInvalidBandwidth().Result()
+		return nil, types.ErrorInvalidBandwidth()
 	}
 
 	var session types.Session
 
-	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs)
 	if !found {
+		if node.Jailed {
+			return nil, types.ErrorNodeJailed()
+		}
+		if k.HasBlacklistedClient(ctx, node.ID, subscription.Client) {
+			return nil, types.ErrorClientBlacklisted()
+		}
+		if _, found = k.GetPendingSettlement(ctx, subscription.ID); found {
+			return nil, types.ErrorSubscriptionEnding()
+		}
+		if subscription.Paused {
+			return nil, types.ErrorSubscriptionPaused()
+		}
+
 		sc := k.GetSessionsCount(ctx)
 		session = types.Session{
-			ID:             hub.NewSessionID(sc),
+			ID:             k.DeriveSessionID(ctx, subscription.ID, ongoing.Index),
 			SubscriptionID: subscription.ID,
 			Bandwidth:      hub.NewBandwidthFromInt64(0, 0),
+			Paid:           sdk.NewInt64Coin(subscription.PricePerGB.Denom, 0),
 		}
+		ongoing.SessionID = session.ID
 
 		k.SetSessionsCount(ctx, sc+1)
-		k.SetSessionIDBySubscriptionID(ctx, subscription.ID, scs, session.ID)
+		k.SetSessionIDBySubscriptionID(ctx, subscription.ID, ongoing.Index, session.ID)
+		k.SetOngoingSessionIndex(ctx, ongoing)
 	} else {
-		session, _ = k.GetSession(ctx, id)
+		session, _ = k.GetSession(ctx, ongoing.SessionID)
+
+		// The bandwidth is cumulative, so an update which does not increase it is stale or replayed.
+		if msg.Bandwidth.AnyLT(session.Bandwidth) || msg.Bandwidth.AllEqual(session.Bandwidth) {
+			return nil, types.ErrorStaleBandwidth()
+		}
 	}
 
 	k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
 	k.AddSessionIDToActiveList(ctx, ctx.BlockHeight(), session.ID)
 
+	k.UpdateConsumptionRate(ctx, subscription, msg.Bandwidth.Sum().Sub(session.Bandwidth.Sum()))
+
+	// The bandwidth signed by the node owner is a heartbeat of the node.
+	if node.Status == types.StatusRegistered {
+		k.AddNodeHeartbeat(ctx, node.ID)
+	}
+
 	session.Bandwidth = msg.Bandwidth
 	session.Status = types.StatusActive
 	session.StatusModifiedAt = ctx.BlockHeight()
 
 	k.SetSession(ctx, session)
 
+	return session.ID, nil
+}
+
+func handleUpdateSessionInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateSessionInfo) sdk.Result {
+	id, err := updateSessionInfo(ctx, k, msg)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateSessionInfo,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, msg.SubscriptionID.String()),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(true)),
+		sdk.NewAttribute(types.AttributeKeyCode, strconv.FormatUint(uint64(sdk.CodeOK), 10)),
+		sdk.NewAttribute(types.AttributeKeySessionID, id.String()),
+	))
+
+	return sdk.Result{Data: []byte(id.String()), Events: ctx.EventManager().Events()}
+}
+
+// handleUpdateSessionsInfo applies every update independently, an update which fails
+// does not revert the others. The result of each update is reported in the events.
+func handleUpdateSessionsInfo(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateSessionsInfo) sdk.Result {
+	for _, update := range msg.Updates {
+		cctx, write := ctx.CacheContext()
+
+		code := sdk.CodeOK
+		id, err := updateSessionInfo(cctx, k, *types.NewMsgUpdateSessionInfo(msg.From, update.SubscriptionID,
+			update.Bandwidth, update.NodeOwnerSignature, update.ClientSignature))
+		if err != nil {
+			code = err.Code()
+		} else {
+			write()
+		}
+
+		event := sdk.NewEvent(
+			types.EventTypeUpdateSessionInfo,
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, update.SubscriptionID.String()),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+			sdk.NewAttribute(types.AttributeKeyCode, strconv.FormatUint(uint64(code), 10)),
+		)
+		if err == nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeySessionID, id.String()))
+		}
+
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleOpenDispute freezes the disputed amount of the ended session until the dispute is resolved. The claim
+// of the client is backed by the deposit of the node, and the claim of the node by the remaining deposit of the
+// subscription, which must also cover the bandwidth of the ongoing session.
+func handleOpenDispute(ctx sdk.Context, k keeper.Keeper, msg types.MsgOpenDispute) sdk.Result {
+	window := k.DisputeWindow(ctx)
+	if window == 0 {
+		return types.ErrorDisputeWindowClosed().Result()
+	}
+
+	subscription, found := k.GetSubscription(ctx, msg.SubscriptionID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist().Result()
+	}
+	if subscription.Trial {
+		return types.ErrorInvalidSubscriptionStatus().Result()
+	}
+
+	id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, msg.Index)
+	if !found {
+		return types.ErrorInvalidSessionStatus().Result()
+	}
+
+	session, _ := k.GetSession(ctx, id)
+	if session.Status != types.StatusInactive {
+		return types.ErrorInvalidSessionStatus().Result()
+	}
+	if ctx.BlockHeight() > session.StatusModifiedAt+window {
+		return types.ErrorDisputeWindowClosed().Result()
+	}
+	if _, found = k.GetDispute(ctx, session.ID); found {
+		return types.ErrorDisputeAlreadyExists().Result()
+	}
+	if msg.Amount.Denom != session.Paid.Denom {
+		return types.ErrorInvalidDisputeAmount().Result()
+	}
+
+	node, _ := k.GetNode(ctx, subscription.NodeID)
+
+	var party string
+	switch {
+	case msg.From.Equals(subscription.Client):
+		if session.Paid.IsLT(msg.Amount) {
+			return types.ErrorInvalidDisputeAmount().Result()
+		}
+		if err := freezeNodeDeposit(ctx, k, node, msg.Amount); err != nil {
+			return err.Result()
+		}
+
+		party = types.DisputePartyClient
+	case msg.From.Equals(node.Owner):
+		bandwidth, err := subscription.DepositToBandwidth(msg.Amount)
+		if err != nil {
+			return err.Result()
+		}
+
+		required := bandwidth
+		for _, ongoing := range k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID) {
+			_session, _ := k.GetSession(ctx, ongoing.SessionID)
+			required = required.Add(_session.Bandwidth)
+		}
+
+		if subscription.Status != types.StatusActive || subscription.RemainingDeposit.IsLT(msg.Amount) ||
+			subscription.RemainingBandwidth.AnyLT(required) {
+			return types.ErrorInvalidDisputeAmount().Result()
+		}
+
+		if err := k.FreezeDisputedDeposit(ctx, subscription.Client, msg.Amount); err != nil {
+			return err.Result()
+		}
+
+		subscription.RemainingDeposit = subscription.RemainingDeposit.Sub(msg.Amount)
+		subscription.RemainingBandwidth = subscription.RemainingBandwidth.Sub(bandwidth)
+		k.SetSubscription(ctx, subscription)
+
+		emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{msg.Amount},
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, types.DisputePoolAddress.String()))
+
+		party = types.DisputePartyNode
+	default:
+		return types.ErrorUnauthorized().Result()
+	}
+
+	dispute := types.NewDispute(session, msg.Index, msg.From, party, msg.Amount,
+		ctx.BlockHeight(), ctx.BlockHeight()+k.DisputeTimeout(ctx))
+	k.SetDispute(ctx, dispute)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOpenDispute,
+		sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.From.String()),
+		sdk.NewAttribute(types.AttributeKeyRole, party),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// freezeNodeDeposit moves the coin from the deposit of the node, or from the unbonding deposit of the
+// de-registered node, to the dispute pool.
+func freezeNodeDeposit(ctx sdk.Context, k keeper.Keeper, node types.Node, coin sdk.Coin) sdk.Error {
+	if node.Status != types.StatusDeRegistered {
+		if node.Deposit.Denom != coin.Denom || node.Deposit.IsLT(coin) {
+			return types.ErrorInsufficientNodeDeposit()
+		}
+		if err := k.FreezeDisputedDeposit(ctx, node.Owner, coin); err != nil {
+			return err
+		}
+
+		node.Deposit = node.Deposit.Sub(coin)
+		k.SetNode(ctx, node)
+	} else {
+		unbonding, found := k.GetNodeUnbonding(ctx, node.ID)
+		if !found || unbonding.Deposit.Denom != coin.Denom || unbonding.Deposit.IsLT(coin) {
+			return types.ErrorInsufficientNodeDeposit()
+		}
+		if err := k.FreezeDisputedDeposit(ctx, node.Owner, coin); err != nil {
+			return err
+		}
+
+		k.DeleteNodeUnbonding(ctx, unbonding)
+		if unbonding.Deposit = unbonding.Deposit.Sub(coin); unbonding.Deposit.IsPositive() {
+			k.SetNodeUnbonding(ctx, unbonding)
+		}
+	}
+
+	emitDepositEvent(ctx, types.EventTypeSendDeposit, node.Owner, sdk.Coins{coin},
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, types.DisputePoolAddress.String()))
+
+	return nil
+}
+
+// handleSubmitDisputeEvidence resolves the open dispute of the session by the bandwidth of the session which
+// the client signed. The claim of the client is rejected if the bandwidth costs at least the paid amount, and
+// the claim of the node is upheld if it costs at least the paid amount plus the disputed amount.
+func handleSubmitDisputeEvidence(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitDisputeEvidence) sdk.Result {
+	dispute, found := k.GetDispute(ctx, msg.SessionID)
+	if !found {
+		return types.ErrorDisputeDoesNotExist().Result()
+	}
+	if !dispute.IsOpen() {
+		return types.ErrorInvalidDisputeStatus().Result()
+	}
+
+	subscription, _ := k.GetSubscription(ctx, dispute.SubscriptionID)
+	node, _ := k.GetNode(ctx, subscription.NodeID)
+	if !msg.From.Equals(node.Owner) {
+		return types.ErrorUnauthorized().Result()
+	}
+	if !bytes.Equal(msg.ClientSignature.PubKey.Address(), subscription.Client.Bytes()) {
+		address := sdk.AccAddress(msg.ClientSignature.PubKey.Address())
+		if _, found = k.GetSeatIndexByAddress(ctx, subscription.ID, address); !found {
+			return types.ErrorUnauthorized().Result()
+		}
+	}
+
+	data := types.BandwidthSignBytes(subscription.ID, dispute.Index, msg.Bandwidth)
+	if !types.VerifyBandwidthSignature(msg.ClientSignature, data) {
+		return types.ErrorInvalidClientSignature().Result()
+	}
+
+	session, _ := k.GetSession(ctx, dispute.SessionID)
+	bandwidth := msg.Bandwidth.CeilTo(hub.GB.Quo(subscription.PricePerGB.Amount))
+
+	upheld, proven := dispute.Party == types.DisputePartyNode, session.Paid.Amount
+	if upheld {
+		proven = proven.Add(dispute.Amount.Amount)
+	}
+	if bandwidth.Cost(subscription.PricePerGB.Amount).LT(proven) {
+		return types.ErrorInvalidEvidence().Result()
+	}
+
+	resolveDispute(ctx, k, dispute, upheld, types.AttributeValueEvidence)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// resolveDispute releases the frozen amount of the dispute. The client is refunded the amount of its upheld
+// claim, the node is settled the amount of its upheld claim as a part of the session, and the amount of the
+// rejected claim is returned to where it was frozen from.
+func resolveDispute(ctx sdk.Context, k keeper.Keeper, dispute types.Dispute, upheld bool, reason string) {
+	subscription, _ := k.GetSubscription(ctx, dispute.SubscriptionID)
+
+	switch {
+	case dispute.Party == types.DisputePartyClient && upheld:
+		if err := k.SendDisputedDeposit(ctx, subscription.Client, dispute.Amount); err != nil {
+			panic(err)
+		}
+
+		emitDepositEvent(ctx, types.EventTypeSendDeposit, types.DisputePoolAddress, sdk.Coins{dispute.Amount},
+			sdk.NewAttribute(types.AttributeKeySessionID, dispute.SessionID.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, subscription.Client.String()))
+	case dispute.Party == types.DisputePartyClient:
+		returnNodeDeposit(ctx, k, subscription.NodeID, dispute)
+	case upheld:
+		if err := k.ReturnDisputedDeposit(ctx, subscription.Client, dispute.Amount); err != nil {
+			panic(err)
+		}
+
+		session, _ := k.GetSession(ctx, dispute.SessionID)
+		settleSession(ctx, k, &session, &subscription, session.Paid.Amount.Add(dispute.Amount.Amount), true)
+		k.SetSession(ctx, session)
+	default:
+		// The remaining deposit of the ended subscription is already refunded, so the amount is refunded too.
+		if subscription.Status == types.StatusActive {
+			if err := k.ReturnDisputedDeposit(ctx, subscription.Client, dispute.Amount); err != nil {
+				panic(err)
+			}
+
+			bandwidth, _ := subscription.DepositToBandwidth(dispute.Amount)
+			subscription.RemainingDeposit = subscription.RemainingDeposit.Add(dispute.Amount)
+			subscription.RemainingBandwidth = subscription.RemainingBandwidth.Add(bandwidth)
+		} else {
+			if err := k.SendDisputedDeposit(ctx, subscription.Client, dispute.Amount); err != nil {
+				panic(err)
+			}
+
+			subscription.TotalDeposit = subscription.TotalDeposit.Sub(dispute.Amount)
+		}
+
+		k.SetSubscription(ctx, subscription)
+		emitDepositEvent(ctx, types.EventTypeSendDeposit, types.DisputePoolAddress, sdk.Coins{dispute.Amount},
+			sdk.NewAttribute(types.AttributeKeySessionID, dispute.SessionID.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, subscription.Client.String()))
+	}
+
+	k.DeleteDispute(ctx, dispute)
+
+	dispute.Status = types.DisputeStatusRejected
+	if upheld {
+		dispute.Status = types.DisputeStatusUpheld
+	}
+	dispute.StatusModifiedAt = ctx.BlockHeight()
+	k.SetDispute(ctx, dispute)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResolveDispute,
+		sdk.NewAttribute(types.AttributeKeySessionID, dispute.SessionID.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, dispute.SubscriptionID.String()),
+		sdk.NewAttribute(types.AttributeKeyStatus, dispute.Status),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyAmount, dispute.Amount.String()),
+	))
+}
+
+// returnNodeDeposit returns the frozen amount of the rejected claim of the client to the deposit of the node,
+// or to the unbonding deposit of the de-registered node. It is sent to the owner if the unbonding is complete.
+func returnNodeDeposit(ctx sdk.Context, k keeper.Keeper, id hub.NodeID, dispute types.Dispute) {
+	node, _ := k.GetNode(ctx, id)
+
+	unbonding, found := k.GetNodeUnbonding(ctx, id)
+	switch {
+	case node.Status != types.StatusDeRegistered:
+		if err := k.ReturnDisputedDeposit(ctx, node.Owner, dispute.Amount); err != nil {
+			panic(err)
+		}
+
+		node.Deposit = node.Deposit.Add(dispute.Amount)
+		k.SetNode(ctx, node)
+	case found:
+		if err := k.ReturnDisputedDeposit(ctx, node.Owner, dispute.Amount); err != nil {
+			panic(err)
+		}
+
+		unbonding.Deposit = unbonding.Deposit.Add(dispute.Amount)
+		k.SetNodeUnbonding(ctx, unbonding)
+	default:
+		if err := k.SendDisputedDeposit(ctx, node.Owner, dispute.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	emitDepositEvent(ctx, types.EventTypeSendDeposit, types.DisputePoolAddress, sdk.Coins{dispute.Amount},
+		sdk.NewAttribute(types.AttributeKeySessionID, dispute.SessionID.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, node.Owner.String()))
+}
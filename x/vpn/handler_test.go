@@ -1,12 +1,17 @@
 package vpn
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/compact"
 	"github.com/sentinel-official/hub/x/vpn/keeper"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -23,9 +28,11 @@ func Test_handleRegisterNode(t *testing.T) {
 	handler := NewHandler(k)
 	node := types.TestNode
 
-	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
+	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
 	res := handler(ctx, *msg)
 	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeUpdateNodeStatus, res.Events[0].Type)
+	require.Equal(t, types.StatusRegistered, string(res.Events[0].Attributes[2].Value))
 
 	node, found := k.GetNode(ctx, hub.NewNodeID(0))
 	require.Equal(t, true, found)
@@ -44,7 +51,7 @@ func Test_handleRegisterNode(t *testing.T) {
 
 	k.SetNodesCount(ctx, DefaultFreeNodesCount)
 	k.SetNodesCountOfAddress(ctx, types.TestAddress1, DefaultFreeNodesCount)
-	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
+	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, "moniker_1", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
@@ -65,7 +72,7 @@ func Test_handleRegisterNode(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 
-	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
+	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, "moniker_1", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 
@@ -97,7 +104,7 @@ func Test_handleRegisterNode(t *testing.T) {
 	coins = bk.GetCoins(ctx, types.TestAddress1)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), coins)
 
-	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption)
+	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, "moniker_2", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 
@@ -123,6 +130,52 @@ func Test_handleRegisterNode(t *testing.T) {
 	require.Equal(t, id, node.ID)
 }
 
+func Test_handleRegisterNodeWithTakenMoniker(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+
+	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, "moniker", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
+	res := handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	id, found := k.GetNodeIDByMoniker(ctx, "MONIKER")
+	require.Equal(t, true, found)
+	require.Equal(t, hub.NewNodeID(0), id)
+
+	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, "Moniker", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorMonikerAlreadyTaken().Code(), res.Code)
+
+	msg = NewMsgRegisterNode(node.Owner, node.Type, node.Version, "other_moniker", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	update := NewMsgUpdateNodeInfo(node.Owner, hub.NewNodeID(1), "", "", "Moniker", nil, hub.Bandwidth{}, "", "", "", false)
+	res = handler(ctx, *update)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorMonikerAlreadyTaken().Code(), res.Code)
+
+	deregister := NewMsgDeregisterNode(node.Owner, hub.NewNodeID(0))
+	res = handler(ctx, *deregister)
+	require.True(t, res.IsOK())
+
+	_, found = k.GetNodeIDByMoniker(ctx, "moniker")
+	require.Equal(t, false, found)
+
+	res = handler(ctx, *update)
+	require.True(t, res.IsOK())
+
+	id, found = k.GetNodeIDByMoniker(ctx, "moniker")
+	require.Equal(t, true, found)
+	require.Equal(t, hub.NewNodeID(1), id)
+
+	_, found = k.GetNodeIDByMoniker(ctx, "other_moniker")
+	require.Equal(t, false, found)
+}
+
 func Test_handleUpdateNodeInfo(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 
@@ -135,23 +188,23 @@ func Test_handleUpdateNodeInfo(t *testing.T) {
 	node = types.TestNode
 	node.Status = StatusDeRegistered
 	k.SetNode(ctx, node)
-	msg := NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg := NewMsgUpdateNodeInfo(node.Owner, node.ID, types.NodeCategoryV2Ray, "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", "", "", false)
 	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	msg = NewMsgUpdateNodeInfo(types.TestAddress2, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg = NewMsgUpdateNodeInfo(types.TestAddress2, node.ID, types.NodeCategoryV2Ray, "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", "", "", false)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
 	node.Status = StatusInactive
 	k.SetNode(ctx, node)
-	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "new_node_type", "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption")
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, types.NodeCategoryV2Ray, "new_version", "new_moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "new_encryption", "", "", false)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 
 	node, found = k.GetNode(ctx, node.ID)
 	require.Equal(t, true, found)
-	require.Equal(t, "new_node_type", node.Type)
+	require.Equal(t, types.NodeCategoryV2Ray, node.Type)
 	require.Equal(t, "new_version", node.Version)
 	require.Equal(t, "new_moniker", node.Moniker)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, node.PricesPerGB)
@@ -159,17 +212,80 @@ func Test_handleUpdateNodeInfo(t *testing.T) {
 
 	node.Status = StatusRegistered
 	k.SetNode(ctx, node)
-	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "encryption")
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, types.NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, types.TestBandwidthPos1, "encryption", "", "", false)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 
 	node, found = k.GetNode(ctx, node.ID)
 	require.Equal(t, true, found)
-	require.Equal(t, "node_type", node.Type)
+	require.Equal(t, types.NodeCategoryWireGuard, node.Type)
 	require.Equal(t, "version", node.Version)
 	require.Equal(t, "moniker", node.Moniker)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, node.PricesPerGB)
 	require.Equal(t, "encryption", node.Encryption)
+
+	metadataURI, metadataHash := "https://example.com/node.json", strings.Repeat("a", 64)
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "", "", "", nil, hub.Bandwidth{}, "", metadataURI, metadataHash, false)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "", "", "", nil, hub.Bandwidth{}, "", "", "", false)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, metadataURI, node.MetadataURI)
+	require.Equal(t, metadataHash, node.MetadataHash)
+
+	msg = NewMsgUpdateNodeInfo(node.Owner, node.ID, "", "", "", nil, hub.Bandwidth{}, "", "", "", true)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, "", node.MetadataURI)
+	require.Equal(t, "", node.MetadataHash)
+	require.Equal(t, "moniker", node.Moniker)
+}
+
+func Test_handleNodeUptime(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(100)
+
+	params := k.GetParams(ctx)
+	params.NodeInactiveInterval = 10
+	k.SetParams(ctx, params)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+
+	msg := NewMsgRegisterNode(node.Owner, node.Type, node.Version, node.Moniker, node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
+	res := handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	uptime, found := k.GetNodeUptime(ctx, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewNodeUptime(hub.NewNodeID(0), 100), uptime)
+
+	_msg := NewMsgUpdateNodeInfo(node.Owner, hub.NewNodeID(0), "", "", "", nil, hub.NewBandwidthFromInt64(0, 0), "", "", "", false)
+	res = handler(ctx.WithBlockHeight(105), *_msg)
+	require.True(t, res.IsOK())
+
+	uptime, _ = k.GetNodeUptime(ctx, hub.NewNodeID(0))
+	require.Equal(t, int64(5), uptime.OnlineBlocks)
+	require.Equal(t, int64(105), uptime.LastHeartbeat)
+
+	__msg := NewMsgDeregisterNode(node.Owner, hub.NewNodeID(0))
+	res = handler(ctx.WithBlockHeight(130), *__msg)
+	require.True(t, res.IsOK())
+
+	uptime, _ = k.GetNodeUptime(ctx, hub.NewNodeID(0))
+	require.Equal(t, int64(15), uptime.OnlineBlocks)
+	require.Equal(t, int64(130), uptime.Until)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), uptime.Percentage(200, 10))
+
+	k.AddNodeHeartbeat(ctx.WithBlockHeight(140), hub.NewNodeID(0))
+	_uptime, _ := k.GetNodeUptime(ctx, hub.NewNodeID(0))
+	require.Equal(t, uptime, _uptime)
 }
 
 func Test_handleDeregisterNode(t *testing.T) {
@@ -306,430 +422,2469 @@ func Test_handleDeregisterNode(t *testing.T) {
 
 	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
 	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), deposit.Coins)
 
 	coins = bk.GetCoins(ctx, node.Owner)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	require.Equal(t, sdk.Coins(nil), coins)
 
 	node, found = k.GetNode(ctx, node.ID)
 	require.Equal(t, true, found)
 	require.Equal(t, StatusDeRegistered, node.Status)
-}
 
-func Test_handleStartSubscription(t *testing.T) {
-	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	unbonding, found := k.GetNodeUnbonding(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, NewNodeUnbonding(node.ID, node.Owner, sdk.NewInt64Coin("stake", 100), DefaultNodeUnbondingPeriod), unbonding)
 
-	node, found := k.GetNode(ctx, hub.NewNodeID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Node{}, node)
+	EndBlock(ctx.WithBlockHeight(DefaultNodeUnbondingPeriod-1), k)
 
-	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), deposit.Coins)
+
+	EndBlock(ctx.WithBlockHeight(DefaultNodeUnbondingPeriod), k)
+
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+
+	coins = bk.GetCoins(ctx, node.Owner)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	_, found = k.GetNodeUnbonding(ctx, node.ID)
 	require.Equal(t, false, found)
-	require.Equal(t, types.Subscription{}, subscription)
+}
+
+func Test_handlePruneNodeHistory(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 
 	handler := NewHandler(k)
-	msg := NewMsgStartSubscription(types.TestAddress2, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
-	res := handler(ctx, *msg)
-	require.False(t, res.IsOK())
 
-	node = types.TestNode
-	node.Status = StatusDeRegistered
-	k.SetNode(ctx, node)
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100))
-	res = handler(ctx, *msg)
+	msg := NewMsgPruneNodeHistory(types.TestNode.Owner, types.TestNode.ID)
+	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	deposit, found := dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, false, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	session := types.TestSession
+	session.Status = StatusInactive
 
-	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Subscription{}, subscription)
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionIDByNodeID(ctx, types.TestNode.ID, 0, subscription.ID)
+	k.SetSubscriptionsCountOfNode(ctx, types.TestNode.ID, 1)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, session.ID)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
 
-	node.Status = StatusRegistered
-	k.SetNode(ctx, node)
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgPruneNodeHistory(types.TestAddress2, types.TestNode.ID)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, false, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	ctx = ctx.WithBlockHeight(k.SessionPruningRetention(ctx))
+	msg = NewMsgPruneNodeHistory(types.TestNode.Owner, types.TestNode.ID)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Subscription{}, subscription)
+	_, found := k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
 
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("invalid", 100))
+	ctx = ctx.WithBlockHeight(k.SessionPruningRetention(ctx) + 1)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
 
-	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
+	_, found = k.GetSession(ctx, session.ID)
 	require.Equal(t, false, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
-
-	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	_, found = k.GetSessionIDBySubscriptionID(ctx, subscription.ID, 0)
 	require.Equal(t, false, found)
-	require.Equal(t, types.Subscription{}, subscription)
+	require.Equal(t, uint64(1), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+	require.Equal(t, []types.Session{}, k.GetSessionsOfSubscription(ctx, subscription.ID))
+}
 
-	coins, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
-	require.Nil(t, err)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+func Test_handlePruneNodeHistoryGasRefund(t *testing.T) {
+	prune := func(refund uint64) uint64 {
+		ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+		params := k.GetParams(ctx)
+		params.PruneGasRefund = refund
+		k.SetParams(ctx, params)
+
+		subscription := types.TestSubscription
+		subscription.Status = StatusInactive
+
+		k.SetNode(ctx, types.TestNode)
+		k.SetSubscription(ctx, subscription)
+		k.SetSubscriptionIDByNodeID(ctx, types.TestNode.ID, 0, subscription.ID)
+		k.SetSubscriptionsCountOfNode(ctx, types.TestNode.ID, 1)
+		for i := uint64(0); i < 2; i++ {
+			session := types.TestSession
+			session.ID = hub.NewSessionID(i)
+			session.Status = StatusInactive
+			k.SetSession(ctx, session)
+			k.SetSessionIDBySubscriptionID(ctx, subscription.ID, i, session.ID)
+		}
+		k.SetSessionsCountOfSubscription(ctx, subscription.ID, 2)
+
+		ctx = ctx.WithBlockHeight(k.SessionPruningRetention(ctx) + 1).WithGasMeter(sdk.NewGasMeter(1000000))
+		res := NewHandler(k)(ctx, *NewMsgPruneNodeHistory(types.TestNode.Owner, types.TestNode.ID))
+		require.True(t, res.IsOK())
+		require.Len(t, k.GetAllSessions(ctx), 0)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	gas := prune(0)
+	require.True(t, gas > prune(types.MaxPruneGasRefund))
+	require.True(t, gas > prune(1<<63))
+}
 
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100))
-	res = handler(ctx, *msg)
-	require.True(t, res.IsOK())
+func Test_handleSetPayoutRoutes(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
 
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins(nil), coins)
+	handler := NewHandler(k)
+	payout := sdk.AccAddress([]byte("payout-address"))
+	routes := []types.PayoutRoute{types.NewPayoutRoute("stake", payout)}
 
-	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, true, found)
-	require.Equal(t, sdk.NewInt(100), deposit.Coins.AmountOf("stake"))
+	msg := NewMsgSetPayoutRoutes(types.TestNode.Owner, types.TestNode.ID, routes)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestAddress2, subscription.Client)
-	require.Equal(t, hub.NewNodeID(0), subscription.NodeID)
+	k.SetNode(ctx, types.TestNode)
 
-	count := k.GetSubscriptionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	msg = NewMsgSetPayoutRoutes(types.TestAddress2, types.TestNode.ID, routes)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
 
-	id, found := k.GetSubscriptionIDByAddress(ctx, types.TestAddress2, 0)
-	require.Equal(t, true, found)
-	require.Equal(t, id, subscription.ID)
+	msg = NewMsgSetPayoutRoutes(types.TestNode.Owner, types.TestNode.ID, routes)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
 
-	id, found = k.GetSubscriptionIDByNodeID(ctx, node.ID, 0)
-	require.Equal(t, true, found)
-	require.Equal(t, id, subscription.ID)
+	node, _ := k.GetNode(ctx, types.TestNode.ID)
+	require.Equal(t, routes, node.PayoutRoutes)
 
-	count = k.GetSubscriptionsCountOfAddress(ctx, types.TestAddress2)
-	require.Equal(t, uint64(1), count)
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
 
-	subscriptions := k.GetSubscriptionsOfNode(ctx, node.ID)
-	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	k.SetSubscription(ctx, types.TestSubscription)
 
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100))
-	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	session := types.TestSession
+	session.Bandwidth = types.TestBandwidthPos1
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 0, session.ID)
 
-	coins, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
-	require.Nil(t, err)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), coins)
+	EndBlock(ctx.WithBlockHeight(k.SessionInactiveInterval(ctx)), k)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, payout))
+	require.True(t, bk.GetCoins(ctx, types.TestNode.Owner).IsZero())
 
-	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100))
+	msg = NewMsgSetPayoutRoutes(types.TestNode.Owner, types.TestNode.ID, nil)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
 
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	node, _ = k.GetNode(ctx, types.TestNode.ID)
+	require.Equal(t, 0, len(node.PayoutRoutes))
+}
 
-	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, deposit.Coins)
+func Test_handleSetNodeLocation(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 
-	count = k.GetSubscriptionsCountOfAddress(ctx, types.TestAddress2)
-	require.Equal(t, uint64(2), count)
+	handler := NewHandler(k)
+	location := NewNodeLocation("US", "US-CA", LatencyZoneNorthAmerica)
 
-	subscriptionID := hub.NewSubscriptionID(count - 1)
-	subscription, found = k.GetSubscription(ctx, subscriptionID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestAddress2, subscription.Client)
-	require.Equal(t, hub.NewNodeID(0), subscription.NodeID)
+	res := handler(ctx, *NewMsgSetNodeLocation(types.TestNode.Owner, types.TestNode.ID, location))
+	require.False(t, res.IsOK())
 
-	count = k.GetSubscriptionsCount(ctx)
-	require.Equal(t, uint64(2), count)
+	node := types.TestNode
+	k.SetNode(ctx, node)
 
-	id, found = k.GetSubscriptionIDByAddress(ctx, types.TestAddress2, 1)
-	require.Equal(t, true, found)
-	require.Equal(t, id, subscriptionID)
+	res = handler(ctx, *NewMsgSetNodeLocation(node.Owner, node.ID, location))
+	require.False(t, res.IsOK())
 
-	id, found = k.GetSubscriptionIDByNodeID(ctx, node.ID, 1)
-	require.Equal(t, true, found)
-	require.Equal(t, id, subscriptionID)
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
 
-	subscriptions = k.GetSubscriptionsOfNode(ctx, node.ID)
-	require.Len(t, subscriptions, 2)
-	require.Equal(t, types.TestSubscription, subscriptions[0])
-	require.Equal(t, subscription, subscriptions[1])
-}
+	res = handler(ctx, *NewMsgSetNodeLocation(types.TestAddress2, node.ID, location))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgSetNodeLocation(node.Owner, node.ID, location))
+	require.True(t, res.IsOK())
 
-func Test_handleEndSubscription(t *testing.T) {
-	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, location, node.Location)
 
-	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Subscription{}, subscription)
+	res = handler(ctx, *NewMsgSetNodeLocation(node.Owner, node.ID, NodeLocation{}))
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.True(t, node.Location.IsEmpty())
+}
+
+func Test_handleStartSubscriptionOfPrivateNode(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
 
 	handler := NewHandler(k)
 
-	subscription = types.TestSubscription
-	subscription.Status = StatusInactive
-	k.SetSubscription(ctx, subscription)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
 
-	msg := NewMsgEndSubscription(types.TestAddress1, subscription.ID)
-	res := handler(ctx, *msg)
+	res := handler(ctx, *NewMsgSetNodePrivate(types.TestAddress2, node.ID, true))
 	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgSetNodePrivate(node.Owner, node.ID, true))
+	require.True(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, node.Private)
 
-	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+
+	msg := NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
+	res = handler(ctx, *NewMsgAddAllowedAddress(types.TestAddress2, node.ID, types.TestAddress2))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgAddAllowedAddress(node.Owner, node.ID, types.TestAddress2))
+	require.True(t, res.IsOK())
 
-	subscription.Status = StatusActive
-	k.SetSubscription(ctx, subscription)
-	msg = NewMsgEndSubscription(types.TestAddress1, hub.NewSubscriptionID(0))
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
+	res = handler(ctx, *NewMsgRemoveAllowedAddress(node.Owner, node.ID, types.TestAddress2))
+	require.True(t, res.IsOK())
+	res = handler(ctx, *NewMsgRemoveAllowedAddress(node.Owner, node.ID, types.TestAddress2))
+	require.False(t, res.IsOK())
 
-	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
+	res = handler(ctx, *NewMsgSetNodePrivate(node.Owner, node.ID, false))
+	require.True(t, res.IsOK())
 
-	coins, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
-	require.Nil(t, err)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+}
 
-	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
-	require.Nil(t, err)
+func Test_handleStartSubscriptionOfBlacklistedClient(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
 
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Nil(t, err)
-	require.Equal(t, sdk.Coins(nil), coins)
+	handler := NewHandler(k)
 
-	deposit, found := dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
 
-	subscription.Status = StatusInactive
-	k.SetSubscription(ctx, subscription)
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
 
-	msg = NewMsgEndSubscription(types.TestAddress1, subscription.ID)
-	res = handler(ctx, *msg)
+	res := handler(ctx, *NewMsgBlacklistClient(types.TestAddress2, node.ID, types.TestAddress2))
 	require.False(t, res.IsOK())
-
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
-
-	msg = NewMsgEndSubscription(types.TestAddress2, hub.NewSubscriptionID(0))
-	res = handler(ctx, *msg)
+	res = handler(ctx, *NewMsgBlacklistClient(node.Owner, node.ID, types.TestAddress2))
+	require.True(t, res.IsOK())
+	res = handler(ctx, *NewMsgBlacklistClient(node.Owner, node.ID, types.TestAddress2))
 	require.False(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
-
-	subscription.Status = StatusActive
-	k.SetSubscription(ctx, subscription)
-	msg = NewMsgEndSubscription(types.TestAddress1, hub.NewSubscriptionID(0))
+	msg := NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
+	res = handler(ctx, *NewMsgUnblacklistClient(node.Owner, node.ID, types.TestAddress2))
+	require.True(t, res.IsOK())
+	res = handler(ctx, *NewMsgUnblacklistClient(node.Owner, node.ID, types.TestAddress2))
+	require.False(t, res.IsOK())
 
-	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
+}
 
-	subscription, found = k.GetSubscription(ctx, subscription.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, StatusInactive, subscription.Status)
+func Test_handleStartSubscriptionOfJailedNode(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
 
-	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
-	require.Equal(t, true, found)
-	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+	handler := NewHandler(k)
 
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	params := k.GetParams(ctx)
+	params.NodeJailCooldown = 100
+	k.SetParams(ctx, params)
 
-	coins = bk.GetCoins(ctx, types.TestAddress2)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node = k.JailNode(ctx.WithBlockHeight(10), node)
+	require.Equal(t, int64(110), node.JailedUntil)
 
-	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
 	require.Nil(t, err)
 
-	coins, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
-	require.Nil(t, err)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+	msg := NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
+	res := handler(ctx, *msg)
+	require.Equal(t, types.ErrorNodeJailed().Code(), res.Code)
 
-	k.SetSubscription(ctx, types.TestSubscription)
-	k.SetSession(ctx, types.TestSession)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+	res = handler(ctx.WithBlockHeight(110), *NewMsgUnjailNode(types.TestAddress2, node.ID))
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+	res = handler(ctx.WithBlockHeight(109), *NewMsgUnjailNode(node.Owner, node.ID))
+	require.Equal(t, types.ErrorNodeJailCooldown().Code(), res.Code)
+	res = handler(ctx.WithBlockHeight(110), *NewMsgUnjailNode(node.Owner, node.ID))
+	require.True(t, res.IsOK())
+	res = handler(ctx.WithBlockHeight(110), *NewMsgUnjailNode(node.Owner, node.ID))
+	require.Equal(t, types.ErrorNodeNotJailed().Code(), res.Code)
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, false, node.Jailed)
+	require.Equal(t, int64(0), node.JailedUntil)
 
-	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
 	res = handler(ctx, *msg)
 	require.True(t, res.IsOK())
+}
 
-	k.SetSubscription(ctx, types.TestSubscription)
-	k.SetSession(ctx, types.TestSession)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
-	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 1, hub.NewSessionID(0))
+func Test_handleWithdrawNodeDeposit(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 300)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 300)))
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.Deposit = sdk.NewInt64Coin("stake", 300)
+	k.SetNode(ctx, node)
+
+	msg := NewMsgWithdrawNodeDeposit(node.Owner, node.ID, sdk.NewInt64Coin("stake", 150))
+	res := handler(ctx, *NewMsgWithdrawNodeDeposit(types.TestAddress2, node.ID, msg.Amount))
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+	res = handler(ctx, *NewMsgWithdrawNodeDeposit(node.Owner, node.ID, sdk.NewInt64Coin("sent", 150)))
+	require.Equal(t, types.ErrorInvalidDeposit().Code(), res.Code)
+	res = handler(ctx, *NewMsgWithdrawNodeDeposit(node.Owner, node.ID, sdk.NewInt64Coin("stake", 201)))
+	require.Equal(t, types.ErrorInsufficientNodeDeposit().Code(), res.Code)
 
-	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusRegistered, node.Status)
+	require.Equal(t, sdk.NewInt64Coin("stake", 150), node.Deposit)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 150)}, bk.GetCoins(ctx, node.Owner))
+
+	deposit, _ := dk.GetDeposit(ctx, node.Owner)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 150)}, deposit.Coins)
+
+	res = handler(ctx, *NewMsgWithdrawNodeDeposit(node.Owner, node.ID, sdk.NewInt64Coin("stake", 51)))
+	require.Equal(t, types.ErrorInsufficientNodeDeposit().Code(), res.Code)
+
+	node = k.JailNode(ctx, node)
+	res = handler(ctx, *NewMsgWithdrawNodeDeposit(node.Owner, node.ID, sdk.NewInt64Coin("stake", 50)))
+	require.Equal(t, types.ErrorNodeJailed().Code(), res.Code)
 }
 
-func Test_handleUpdateSessionInfo(t *testing.T) {
+func Test_handleGrantAndRevokeFeeAllowance(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	handler := NewHandler(k)
+	spendLimit := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	res := handler(ctx, *NewMsgGrantFeeAllowance(types.TestAddress1, types.TestAddress2, spendLimit, 0))
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
 
-	session, found := k.GetSession(ctx, hub.NewSessionID(0))
+	k.SetNode(ctx, types.TestNode)
+	k.SetNodesCountOfAddress(ctx, types.TestAddress1, 1)
+
+	res = handler(ctx, *NewMsgGrantFeeAllowance(types.TestAddress1, types.TestAddress2, spendLimit, 10))
+	require.Equal(t, types.ErrorInvalidField("expiry").Code(), res.Code)
+	res = handler(ctx, *NewMsgGrantFeeAllowance(types.TestAddress1, types.TestAddress2, spendLimit, 20))
+	require.True(t, res.IsOK())
+
+	allowance, found := k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, NewFeeAllowance(types.TestAddress1, types.TestAddress2, spendLimit, 20), allowance)
+
+	res = handler(ctx, *NewMsgRevokeFeeAllowance(types.TestAddress2, types.TestAddress1))
+	require.Equal(t, types.ErrorFeeAllowanceDoesNotExist().Code(), res.Code)
+	res = handler(ctx, *NewMsgRevokeFeeAllowance(types.TestAddress1, types.TestAddress2))
+	require.True(t, res.IsOK())
+
+	_, found = k.GetFeeAllowance(ctx, types.TestAddress1, types.TestAddress2)
 	require.Equal(t, false, found)
-	require.Equal(t, types.Session{}, session)
+}
+
+func Test_handleUpdateSessionInfoOfJailedNode(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 
 	handler := NewHandler(k)
-	msg := NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSubscriptionID(1), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
-	res := handler(ctx, *msg)
-	require.False(t, res.IsOK())
+
+	node := types.TestNode
+	node.Jailed = true
+	node.JailedUntil = 100
+	k.SetNode(ctx, node)
 
 	subscription := types.TestSubscription
-	subscription.Status = StatusInactive
+	subscription.Status = StatusActive
 	k.SetSubscription(ctx, subscription)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+
+	msg := NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res := handler(ctx, *msg)
+	require.Equal(t, types.ErrorNodeJailed().Code(), res.Code)
+	require.Equal(t, uint64(0), k.GetSessionsCount(ctx))
+
+	node.Jailed = false
+	node.JailedUntil = 0
+	k.SetNode(ctx, node)
 
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
+	require.Equal(t, uint64(1), k.GetSessionsCount(ctx))
+}
 
-	session, found = k.GetSession(ctx, hub.NewSessionID(0))
-	require.Equal(t, false, found)
-	require.Equal(t, types.Session{}, session)
+func Test_handleStartSubscription(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
 
-	count := k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(0), count)
+	node, found := k.GetNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Node{}, node)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(0), count)
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Subscription{}, subscription)
 
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
-	res = handler(ctx, *msg)
+	handler := NewHandler(k)
+	msg := NewMsgStartSubscription(types.TestAddress2, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil)
+	res := handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	node := types.TestNode
+	node = types.TestNode
+	node.Status = StatusDeRegistered
 	k.SetNode(ctx, node)
-	subscription.Status = StatusActive
-	k.SetSubscription(ctx, subscription)
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestNodeOwnerStdSignaturePos1)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestClientStdSignaturePos1)
-	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	deposit, found := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 
-	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos2)
-	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Subscription{}, subscription)
 
-	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
-	require.True(t, res.IsOK())
+	require.False(t, res.IsOK())
 
-	id, _ := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, k.GetSessionsCountOfSubscription(ctx, subscription.ID))
-	session, found = k.GetSession(ctx, id)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Subscription{}, subscription)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos1)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("invalid", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, false, found)
+	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Subscription{}, subscription)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	coins, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
 
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos2)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
 
-	session, found = k.GetSession(ctx, session.ID)
+	coins = bk.GetCoins(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins(nil), coins)
+
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	require.Equal(t, sdk.NewInt(100), deposit.Coins.AmountOf("stake"))
 
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	subscription, found = k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestAddress2, subscription.Client)
+	require.Equal(t, hub.NewNodeID(0), subscription.NodeID)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	count := k.GetSubscriptionsCount(ctx)
 	require.Equal(t, uint64(1), count)
 
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
-	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	id, found := k.GetSubscriptionIDByAddress(ctx, types.TestAddress2, 0)
+	require.Equal(t, true, found)
+	require.Equal(t, id, subscription.ID)
 
-	session, found = k.GetSession(ctx, session.ID)
+	id, found = k.GetSubscriptionIDByNodeID(ctx, node.ID, 0)
 	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	require.Equal(t, id, subscription.ID)
 
-	count = k.GetSessionsCount(ctx)
+	count = k.GetSubscriptionsCountOfAddress(ctx, types.TestAddress2)
 	require.Equal(t, uint64(1), count)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	subscriptions := k.GetSubscriptionsOfNode(ctx, node.ID)
+	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
 
-	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthNeg, types.TestNodeOwnerStdSignatureNeg, types.TestClientStdSignatureNeg)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
 	require.False(t, res.IsOK())
 
-	session, found = k.GetSession(ctx, session.ID)
-	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
-
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
-
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	coins, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}))
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}.Add(sdk.Coins{sdk.NewInt64Coin("stake", 100)}), coins)
 
-	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthZero, types.TestNodeOwnerStdSignatureZero, types.TestClientStdSignatureZero)
+	msg = NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	res = handler(ctx, *msg)
-	require.False(t, res.IsOK())
+	require.True(t, res.IsOK())
 
-	session, found = k.GetSession(ctx, session.ID)
+	coins = bk.GetCoins(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
 	require.Equal(t, true, found)
-	require.Equal(t, types.TestSession, session)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, deposit.Coins)
 
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	count = k.GetSubscriptionsCountOfAddress(ctx, types.TestAddress2)
+	require.Equal(t, uint64(2), count)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
-	require.Equal(t, uint64(1), count)
+	subscriptionID := hub.NewSubscriptionID(count - 1)
+	subscription, found = k.GetSubscription(ctx, subscriptionID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestAddress2, subscription.Client)
+	require.Equal(t, hub.NewNodeID(0), subscription.NodeID)
 
-	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
-	res = handler(ctx, *msg)
-	require.True(t, res.IsOK())
+	count = k.GetSubscriptionsCount(ctx)
+	require.Equal(t, uint64(2), count)
 
-	session, found = k.GetSession(ctx, hub.NewSessionID(0))
+	id, found = k.GetSubscriptionIDByAddress(ctx, types.TestAddress2, 1)
 	require.Equal(t, true, found)
-	require.Equal(t, types.TestBandwidthPos1, session.Bandwidth)
-
-	count = k.GetSessionsCount(ctx)
-	require.Equal(t, uint64(1), count)
+	require.Equal(t, id, subscriptionID)
 
-	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	id, found = k.GetSubscriptionIDByNodeID(ctx, node.ID, 1)
+	require.Equal(t, true, found)
+	require.Equal(t, id, subscriptionID)
+
+	subscriptions = k.GetSubscriptionsOfNode(ctx, node.ID)
+	require.Len(t, subscriptions, 2)
+	require.Equal(t, types.TestSubscription, subscriptions[0])
+	require.Equal(t, subscription, subscriptions[1])
+}
+
+func Test_handleEndSubscription(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 0
+	k.SetParams(ctx, params)
+
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, types.Subscription{}, subscription)
+
+	handler := NewHandler(k)
+
+	subscription = types.TestSubscription
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	msg := NewMsgEndSubscription(types.TestAddress1, subscription.ID)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
+	msg = NewMsgEndSubscription(types.TestAddress1, hub.NewSubscriptionID(0))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	coins, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	coins = bk.GetCoins(ctx, types.TestAddress2)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins(nil), coins)
+
+	deposit, found := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	msg = NewMsgEndSubscription(types.TestAddress1, subscription.ID)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	msg = NewMsgEndSubscription(types.TestAddress2, hub.NewSubscriptionID(0))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
+	msg = NewMsgEndSubscription(types.TestAddress1, hub.NewSubscriptionID(0))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+
+	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	subscription, found = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, StatusInactive, subscription.Status)
+
+	deposit, found = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins(nil), deposit.Coins)
+
+	coins = bk.GetCoins(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	coins = bk.GetCoins(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	coins, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, coins)
+
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, types.TestSession)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+
+	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, types.TestSession)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 1, hub.NewSessionID(0))
+
+	msg = NewMsgEndSubscription(types.TestAddress2, subscription.ID)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+}
+
+func Test_handleExecAuthorized(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 0
+	k.SetParams(ctx, params)
+
+	handler := NewHandler(k)
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100)))
+
+	msg := NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{*NewMsgEndSubscription(types.TestAddress2, subscription.ID)})
+	res := handler(ctx, *msg)
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgGrantAuthorization(types.TestAddress2, types.TestAddress1, MsgEndSubscription{}.Type(), 10))
+	require.Equal(t, types.ErrorInvalidField("expiry").Code(), res.Code)
+	res = handler(ctx, *NewMsgGrantAuthorization(types.TestAddress2, types.TestAddress1, MsgEndSubscription{}.Type(), 11))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx.WithBlockHeight(11), *msg)
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+
+	res = handler(ctx, *NewMsgRevokeAuthorization(types.TestAddress2, types.TestAddress1, MsgStartSubscription{}.Type()))
+	require.Equal(t, types.ErrorAuthorizationDoesNotExist().Code(), res.Code)
+	res = handler(ctx, *NewMsgRevokeAuthorization(types.TestAddress2, types.TestAddress1, MsgEndSubscription{}.Type()))
+	require.True(t, res.IsOK())
+	require.Equal(t, []types.Authorization(nil), k.GetAuthorizations(ctx, types.TestAddress2, types.TestAddress1))
+}
+
+// msgMultiSigners wraps a message with the signers other than its own.
+type msgMultiSigners struct {
+	MsgEndSubscription
+	Signers []sdk.AccAddress
+}
+
+func (msg msgMultiSigners) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+func Test_handleExecAuthorizedMultipleSigners(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	handler := NewHandler(k)
+	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	res := handler(ctx, *NewMsgGrantAuthorization(types.TestAddress2, types.TestAddress1, MsgEndSubscription{}.Type(), 20))
+	require.True(t, res.IsOK())
+
+	_msg := msgMultiSigners{
+		MsgEndSubscription: *NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID),
+		Signers:            []sdk.AccAddress{types.TestAddress2, address},
+	}
+	msg := NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{_msg})
+	res = handler(ctx, *msg)
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgGrantAuthorization(address, types.TestAddress1, MsgEndSubscription{}.Type(), 15))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx.WithBlockHeight(15), *msg)
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.Equal(t, types.ErrorUnknownMsgType("").Code(), res.Code)
+
+	_msg.Signers = []sdk.AccAddress{types.TestAddress2, types.TestAddress1}
+	res = handler(ctx.WithBlockHeight(15), *NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{_msg}))
+	require.Equal(t, types.ErrorUnknownMsgType("").Code(), res.Code)
+}
+
+func Test_handleExecAuthorizedNested(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	handler := NewHandler(k)
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100)))
+
+	res := handler(ctx, *NewMsgGrantAuthorization(types.TestAddress2, types.TestAddress1, MsgEndSubscription{}.Type(), 20))
+	require.True(t, res.IsOK())
+
+	inner := NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{*NewMsgEndSubscription(types.TestAddress2, subscription.ID)})
+
+	res = handler(ctx, *NewMsgExecAuthorized(types.TestAddress2, []sdk.Msg{*inner}))
+	require.Equal(t, types.ErrorInvalidField("msgs").Code(), res.Code)
+	res = handler(ctx, *NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{*inner}))
+	require.Equal(t, types.ErrorInvalidField("msgs").Code(), res.Code)
+	res = handler(ctx, *NewMsgExecAuthorized(types.TestAddress1, []sdk.Msg{inner}))
+	require.Equal(t, types.ErrorInvalidField("msgs").Code(), res.Code)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusActive, subscription.Status)
+}
+
+func Test_handleEndSubscriptionGracePeriod(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 10
+	k.SetParams(ctx, params)
+
+	handler := NewHandler(k)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Bandwidth = hub.NewBandwidthFromInt64(250000000, 250000000)
+	session.StatusModifiedAt = 2
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	k.AddSessionIDToActiveList(ctx, 2, session.ID)
+
+	msg := NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID)
+	res := handler(ctx.WithBlockHeight(5), *msg)
+	require.True(t, res.IsOK())
+
+	settlement, found := k.GetPendingSettlement(ctx, types.TestSubscription.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewPendingSettlement(types.TestSubscription.ID, 15), settlement)
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, StatusActive, subscription.Status)
+
+	res = handler(ctx.WithBlockHeight(6), *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionEnding().Code(), res.Code)
+
+	EndBlock(ctx.WithBlockHeight(14), k)
+	_, found = k.GetPendingSettlement(ctx, types.TestSubscription.ID)
+	require.Equal(t, true, found)
+
+	cctx := ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	EndBlock(cctx, k)
+
+	_, found = k.GetPendingSettlement(ctx, types.TestSubscription.ID)
+	require.Equal(t, false, found)
+	require.Nil(t, k.GetPendingSettlementsByHeight(ctx, 15))
+	require.Len(t, k.GetActiveSessionIDs(ctx, 2), 0)
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, StatusInactive, session.Status)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), session.Paid)
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.Equal(t, int64(15), subscription.StatusModifiedAt)
+	require.Equal(t, uint64(1), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, bk.GetCoins(ctx, types.TestNode.Owner))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, bk.GetCoins(ctx, types.TestAddress2))
+
+	stats, found := k.GetNodeStats(ctx, types.TestNode.ID)
+	require.Equal(t, true, found)
+	require.True(t, stats.Bandwidth.AllEqual(hub.NewBandwidthFromInt64(250000000, 250000000)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, stats.Earnings)
+	require.Equal(t, uint64(1), stats.SessionsCount)
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.True(t, deposit.Coins.IsZero())
+
+	events := cctx.EventManager().Events()
+	end := eventsOfType(events, types.EventTypeEndSession)
+	require.Len(t, end, 1)
+	require.Equal(t, types.AttributeValueEndSubscription, string(end[0].Attributes[2].Value))
+	end = eventsOfType(events, types.EventTypeEndSubscription)
+	require.Len(t, end, 1)
+	require.Equal(t, "50stake", string(end[0].Attributes[1].Value))
+
+	release := eventsOfType(events, types.EventTypeReleaseDeposit)
+	require.Len(t, release, 1)
+	require.Equal(t, types.TestAddress2.String(), string(release[0].Attributes[0].Value))
+	require.Equal(t, "50stake", string(release[0].Attributes[1].Value))
+	require.Equal(t, types.TestSubscription.ID.String(), string(release[0].Attributes[2].Value))
+
+	send := eventsOfType(events, types.EventTypeSendDeposit)
+	require.Len(t, send, 1)
+	require.Equal(t, "50stake", string(send[0].Attributes[1].Value))
+	require.Equal(t, types.TestNode.Owner.String(), string(send[0].Attributes[3].Value))
+}
+
+func Test_handleEndSubscriptionGracePeriodUsedUp(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 10
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Bandwidth = types.TestBandwidthPos1
+	session.StatusModifiedAt = 2
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	k.AddSessionIDToActiveList(ctx, 2, session.ID)
+
+	res := NewHandler(k)(ctx.WithBlockHeight(5), *NewMsgEndSubscription(types.TestAddress2, types.TestSubscription.ID))
+	require.True(t, res.IsOK())
+
+	cctx := ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { EndBlock(cctx, k) })
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.True(t, subscription.RemainingDeposit.IsZero())
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), session.Paid)
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestNode.Owner))
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.True(t, deposit.Coins.IsZero())
+	require.Len(t, eventsOfType(cctx.EventManager().Events(), types.EventTypeReleaseDeposit), 0)
+}
+
+// eventsOfType returns the events of the type in the order of their emission.
+func eventsOfType(events sdk.Events, _type string) (_events sdk.Events) {
+	for _, event := range events {
+		if event.Type == _type {
+			_events = append(_events, event)
+		}
+	}
+
+	return _events
+}
+
+func Test_handleAssignSeat(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, node.ID, sdk.NewInt64Coin("stake", 100), 2, nil, nil))
+	require.True(t, res.IsOK())
+
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(2), subscription.Seats)
+
+	res = handler(ctx, *NewMsgAssignSeat(types.TestAddress1, subscription.ID, 0, types.TestAddress1))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgAssignSeat(subscription.Client, subscription.ID, 2, types.TestAddress1))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgAssignSeat(subscription.Client, subscription.ID, 1, types.TestAddress1))
+	require.True(t, res.IsOK())
+	res = handler(ctx, *NewMsgAssignSeat(subscription.Client, subscription.ID, 0, types.TestAddress1))
+	require.False(t, res.IsOK())
+
+	seat, found := k.GetSeat(ctx, subscription.ID, 1)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewSeat(subscription.ID, 1, types.TestAddress1), seat)
+
+	res = handler(ctx, *NewMsgAssignSeat(subscription.Client, subscription.ID, 1, types.TestAddress2))
+	require.True(t, res.IsOK())
+
+	_, found = k.GetSeatIndexByAddress(ctx, subscription.ID, types.TestAddress1)
+	require.Equal(t, false, found)
+	i, found := k.GetSeatIndexByAddress(ctx, subscription.ID, types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(1), i)
+
+	res = handler(ctx, *NewMsgUnassignSeat(subscription.Client, subscription.ID, 0))
+	require.False(t, res.IsOK())
+	res = handler(ctx, *NewMsgUnassignSeat(subscription.Client, subscription.ID, 1))
+	require.True(t, res.IsOK())
+
+	require.Equal(t, []types.Seat(nil), k.GetSeatsOfSubscription(ctx, subscription.ID))
+	_, found = k.GetSeatIndexByAddress(ctx, subscription.ID, types.TestAddress2)
+	require.Equal(t, false, found)
+}
+
+func Test_handleUpdateSessionInfoSeats(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100)))
+
+	k.SetNode(ctx, types.TestNode)
+
+	subscription := types.TestSubscription
+	subscription.Seats = 2
+	k.SetSubscription(ctx, subscription)
+
+	keys := []crypto.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	addresses := make([]sdk.AccAddress, 0, len(keys))
+	for i, key := range keys {
+		address := sdk.AccAddress(key.PubKey().Address())
+		k.SetSeat(ctx, types.NewSeat(subscription.ID, uint64(i), address))
+		k.SetSeatIndexByAddress(ctx, subscription.ID, address, uint64(i))
+		addresses = append(addresses, address)
+	}
+
+	update := func(height int64, key crypto.PrivKey, index uint64, bandwidth hub.Bandwidth) sdk.Result {
+		data := types.BandwidthSignBytes(subscription.ID, index, bandwidth)
+		nodeOwnerSignature, _ := types.TestPrivKey1.Sign(data)
+		clientSignature, _ := key.Sign(data)
+
+		return handler(ctx.WithBlockHeight(height), *NewMsgUpdateSessionInfo(types.TestAddress1, subscription.ID, bandwidth,
+			auth.StdSignature{PubKey: types.TestPubkey1, Signature: nodeOwnerSignature},
+			auth.StdSignature{PubKey: key.PubKey(), Signature: clientSignature}))
+	}
+
+	// The second seat starts its session at the next index while the session of the first one is ongoing
+	bandwidth := hub.NewBandwidthFromInt64(100000000, 100000000)
+	require.True(t, update(1, keys[0], 0, bandwidth).IsOK())
+	require.False(t, update(2, keys[1], 0, bandwidth).IsOK())
+	require.True(t, update(2, keys[1], 1, bandwidth).IsOK())
+	require.True(t, update(3, keys[0], 0, bandwidth.Add(bandwidth)).IsOK())
+
+	require.Len(t, k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID), 2)
+	require.Equal(t, uint64(0), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+
+	first, found := k.GetOngoingSessionIndex(ctx, subscription.ID, addresses[0])
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(0), first.Index)
+	second, found := k.GetOngoingSessionIndex(ctx, subscription.ID, addresses[1])
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(1), second.Index)
+
+	session, _ := k.GetSession(ctx, first.SessionID)
+	require.Equal(t, StatusActive, session.Status)
+	require.True(t, session.Bandwidth.AllEqual(bandwidth.Add(bandwidth)))
+	session, _ = k.GetSession(ctx, second.SessionID)
+	require.Equal(t, StatusActive, session.Status)
+	require.True(t, session.Bandwidth.AllEqual(bandwidth))
+
+	// The session of the second seat ends first, and its next session follows the ongoing one of the first seat
+	interval := k.SessionInactiveInterval(ctx)
+	EndBlock(ctx.WithBlockHeight(2+interval), k)
+
+	session, _ = k.GetSession(ctx, second.SessionID)
+	require.Equal(t, StatusInactive, session.Status)
+	_, found = k.GetOngoingSessionIndex(ctx, subscription.ID, addresses[1])
+	require.Equal(t, false, found)
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+
+	require.False(t, update(2+interval, keys[1], 1, bandwidth).IsOK())
+	require.True(t, update(2+interval, keys[1], 2, bandwidth).IsOK())
+
+	EndBlock(ctx.WithBlockHeight(3+interval), k)
+
+	session, _ = k.GetSession(ctx, first.SessionID)
+	require.Equal(t, StatusInactive, session.Status)
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+
+	require.True(t, update(4+interval, keys[0], 3, bandwidth).IsOK())
+	require.Len(t, k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID), 2)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 40), subscription.RemainingDeposit)
+}
+
+func Test_handlePauseSubscription(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	msg := NewMsgPauseSubscription(types.TestAddress2, types.TestSubscription.ID)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+
+	res = handler(ctx, *NewMsgPauseSubscription(types.TestAddress1, types.TestSubscription.ID))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypePauseSubscription, res.Events[0].Type)
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, true, subscription.Paused)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionPaused().Code(), res.Code)
+
+	update := NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *update)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionPaused().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgResumeSubscription(types.TestAddress2, types.TestSubscription.ID))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *update)
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *update)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorStaleBandwidth().Code(), res.Code)
+}
+
+func Test_handleResumeSubscription(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	msg := NewMsgResumeSubscription(types.TestAddress2, types.TestSubscription.ID)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionNotPaused().Code(), res.Code)
+
+	subscription := types.TestSubscription
+	subscription.Paused = true
+	k.SetSubscription(ctx, subscription)
+
+	res = handler(ctx, *NewMsgResumeSubscription(types.TestAddress1, types.TestSubscription.ID))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeResumeSubscription, res.Events[0].Type)
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, false, subscription.Paused)
+}
+
+func Test_handleTopUpSubscription(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	msg := NewMsgTopUpSubscription(types.TestAddress2, types.TestSubscription.ID, sdk.NewInt64Coin("stake", 100))
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	res = handler(ctx, *NewMsgTopUpSubscription(types.TestAddress1, types.TestSubscription.ID, sdk.NewInt64Coin("stake", 100)))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgTopUpSubscription(types.TestAddress2, types.TestSubscription.ID, sdk.NewInt64Coin("sent", 100)))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Len(t, eventsOfType(res.Events, types.EventTypeTopUpSubscription), 1)
+	lock := eventsOfType(res.Events, types.EventTypeLockDeposit)
+	require.Len(t, lock, 1)
+	require.Equal(t, "100stake", string(lock[0].Attributes[1].Value))
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 200), subscription.TotalDeposit)
+	require.Equal(t, sdk.NewInt64Coin("stake", 200), subscription.RemainingDeposit)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestBandwidthPos2))
+	require.Nil(t, subscription.IsValid())
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	require.True(t, bk.GetCoins(ctx, types.TestAddress2).IsZero())
+
+	k.SetPendingSettlement(ctx, types.NewPendingSettlement(types.TestSubscription.ID, 10))
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionEnding().Code(), res.Code)
+}
+
+func Test_handleSubmitQoSReport(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	speed := hub.NewBandwidthFromInt64(100, 200)
+	msg := NewMsgSubmitQoSReport(types.TestAddress2, types.TestSubscription.ID, speed, 50)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionDoesNotExist().Code(), res.Code)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	res = handler(ctx, *NewMsgSubmitQoSReport(types.TestAddress1, types.TestSubscription.ID, speed, 50))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorQoSAlreadyReported().Code(), res.Code)
+
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeSubmitQoSReport, res.Events[0].Type)
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, uint64(1), subscription.QoSReportedSessions)
+
+	qos, found := k.GetNodeQoS(ctx, types.TestNode.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), qos.Reports)
+	require.Equal(t, speed, qos.Speed)
+	require.Equal(t, sdk.NewInt(50), qos.Latency)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorQoSAlreadyReported().Code(), res.Code)
+
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
+
+	res = handler(ctx, *NewMsgSubmitQoSReport(types.TestAddress2, types.TestSubscription.ID,
+		hub.NewBandwidthFromInt64(300, 400), 150))
+	require.True(t, res.IsOK())
+
+	qos, _ = k.GetNodeQoS(ctx, types.TestNode.ID)
+	require.Equal(t, uint64(2), qos.Reports)
+	require.Equal(t, hub.NewBandwidthFromInt64(200, 300), qos.Speed)
+	require.Equal(t, sdk.NewInt(100), qos.Latency)
+}
+
+func Test_handleRateNode(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewHandler(k)
+
+	msg := NewMsgRateNode(types.TestAddress2, types.TestSubscription.ID, 4)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionDoesNotExist().Code(), res.Code)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	res = handler(ctx, *NewMsgRateNode(types.TestAddress1, types.TestSubscription.ID, 4))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionNotCompleted().Code(), res.Code)
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionNotCompleted().Code(), res.Code)
+
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, types.EventTypeRateNode, res.Events[0].Type)
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, true, subscription.Rated)
+
+	node, _ := k.GetNode(ctx, types.TestNode.ID)
+	require.Equal(t, types.NodeRating{Count: 1, Total: 4}, node.Rating)
+
+	res = handler(ctx, *NewMsgRateNode(types.TestAddress2, types.TestSubscription.ID, 5))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorNodeAlreadyRated().Code(), res.Code)
+
+	node, _ = k.GetNode(ctx, types.TestNode.ID)
+	require.Equal(t, types.NodeRating{Count: 1, Total: 4}, node.Rating)
+}
+
+func Test_handleUpdateSessionInfo(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	sessionID := types.DeriveSessionID(types.TestSubscription.ID, 1, 0)
+	want := types.TestSession
+	want.ID = sessionID
+
+	session, found := k.GetSession(ctx, sessionID)
+	require.Equal(t, false, found)
+	require.Equal(t, types.Session{}, session)
+
+	handler := NewHandler(k)
+	msg := NewMsgUpdateSessionInfo(types.TestAddress2, hub.NewSubscriptionID(1), types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	subscription := types.TestSubscription
+	subscription.Status = StatusInactive
+	k.SetSubscription(ctx, subscription)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
+
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	session, found = k.GetSession(ctx, sessionID)
+	require.Equal(t, false, found)
+	require.Equal(t, types.Session{}, session)
+
+	count := k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(0), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(0), count)
+
+	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	node := types.TestNode
+	k.SetNode(ctx, node)
+	subscription.Status = StatusActive
+	k.SetSubscription(ctx, subscription)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 0)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestNodeOwnerStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos2)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	msg = NewMsgUpdateSessionInfo(types.TestAddress2, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, []byte(sessionID.String()), res.Data)
+
+	id, _ := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, k.GetSessionsCountOfSubscription(ctx, subscription.ID))
+	session, found = k.GetSession(ctx, id)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidNodeSignature().Code(), res.Code)
+
+	session, found = k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos2)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidClientSignature().Code(), res.Code)
+
+	session, found = k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	session, found = k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	msg = NewMsgUpdateSessionInfo(subscription.Client, subscription.ID, types.TestBandwidthNeg, types.TestNodeOwnerStdSignatureNeg, types.TestClientStdSignatureNeg)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	session, found = k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthZero, types.TestNodeOwnerStdSignatureZero, types.TestClientStdSignatureZero)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+
+	session, found = k.GetSession(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	count = k.GetSessionsCount(ctx)
+	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthPos1, types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1)
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorStaleBandwidth().Code(), res.Code)
+
+	session, found = k.GetSession(ctx, sessionID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetSubscription(ctx, subscription)
+	msg = NewMsgUpdateSessionInfo(node.Owner, subscription.ID, types.TestBandwidthPos2, types.TestNodeOwnerStdSignaturePos2, types.TestClientStdSignaturePos2)
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	session, found = k.GetSession(ctx, sessionID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestBandwidthPos2, session.Bandwidth)
+
+	rate, found := k.GetConsumptionRate(ctx, subscription.ID)
+	require.Equal(t, true, found)
+	require.True(t, rate.Rate.IsPositive())
+
+	count = k.GetSessionsCount(ctx)
 	require.Equal(t, uint64(1), count)
+
+	count = k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	require.Equal(t, uint64(1), count)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorStaleBandwidth().Code(), res.Code)
+}
+
+func Test_EndBlockStreamSettlement(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementInterval = 2
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Bandwidth = hub.NewBandwidthFromInt64(250000000, 250000000)
+	session.StatusModifiedAt = 2
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 2, session.ID)
+
+	EndBlock(ctx.WithBlockHeight(3), k)
+	require.True(t, bk.GetCoins(ctx, types.TestNode.Owner).IsZero())
+
+	EndBlock(ctx.WithBlockHeight(4), k)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, bk.GetCoins(ctx, types.TestNode.Owner))
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), session.Paid)
+	require.Equal(t, StatusActive, session.Status)
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), subscription.RemainingDeposit)
+
+	EndBlock(ctx.WithBlockHeight(6), k)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, bk.GetCoins(ctx, types.TestNode.Owner))
+
+	session.Bandwidth = types.TestBandwidthPos1
+	k.SetSession(ctx, session)
+
+	cctx := ctx.WithBlockHeight(2 + k.SessionInactiveInterval(ctx)).WithEventManager(sdk.NewEventManager())
+	EndBlock(cctx, k)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestNode.Owner))
+
+	events := eventsOfType(cctx.EventManager().Events(), types.EventTypeEndSession)
+	require.Len(t, events, 1)
+	require.Equal(t, session.ID.String(), string(events[0].Attributes[0].Value))
+	require.Equal(t, types.AttributeValueTimeout, string(events[0].Attributes[2].Value))
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), session.Paid)
+	require.Equal(t, StatusInactive, session.Status)
+
+	subscription, _ = k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.True(t, subscription.RemainingDeposit.IsZero())
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestBandwidthZero))
+}
+
+func Test_EndBlockSettlementEpoch(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementEpoch = 10
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 200))
+	require.Nil(t, err)
+
+	subscription := types.TestSubscription
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 200)
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, subscription)
+
+	session1 := types.TestSession
+	session1.Bandwidth = hub.NewBandwidthFromInt64(250000000, 250000000)
+	k.SetSession(ctx, session1)
+	k.AddSessionIDToActiveList(ctx, 0, session1.ID)
+
+	session2 := types.TestSession
+	session2.ID = hub.NewSessionID(1)
+	session2.Bandwidth = types.TestBandwidthPos1
+	k.SetSession(ctx, session2)
+	k.AddSessionIDToActiveList(ctx, 1, session2.ID)
+
+	inactive := k.SessionInactiveInterval(ctx)
+	EndBlock(ctx.WithBlockHeight(inactive), k)
+	EndBlock(ctx.WithBlockHeight(inactive+1), k)
+	require.True(t, bk.GetCoins(ctx, types.TestNode.Owner).IsZero())
+
+	payout, found := k.GetPendingPayout(ctx, types.TestNode.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 150)}, payout.Coins)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlock(ctx.WithBlockHeight(30), k)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 150)}, bk.GetCoins(ctx, types.TestNode.Owner))
+
+	_, found = k.GetPendingPayout(ctx, types.TestNode.ID)
+	require.Equal(t, false, found)
+
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePayoutNode {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+}
+
+func Test_EndBlockProtocolFee(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	subscription := types.TestSubscription
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 100)
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, subscription)
+
+	session := types.TestSession
+	session.Bandwidth = types.TestBandwidthPos1
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 0, session.ID)
+
+	EndBlock(ctx.WithBlockHeight(k.SessionInactiveInterval(ctx)), k)
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), session.Paid)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 90)}, bk.GetCoins(ctx, types.TestNode.Owner))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, k.GetProtocolFees(ctx))
+
+	var data string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCompactEvents {
+			data = string(event.Attributes[1].Value)
+		}
+	}
+
+	block, decErr := compact.DecodeString(data)
+	require.Nil(t, decErr)
+	require.Equal(t, []compact.SettleSession{{
+		SessionID: session.ID,
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		Fee:       sdk.NewInt64Coin("stake", 10),
+		Final:     true,
+	}}, block.SettleSessions)
+	require.Equal(t, 0, len(block.PayoutNodes))
+}
+
+func Test_EndBlockReferralReward(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.ProtocolFeeRate = sdk.NewDecWithPrec(1, 1)
+	params.ReferralRate = sdk.NewDecWithPrec(5, 2)
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	referrer := sdk.AccAddress([]byte("referrer-address"))
+	subscription := types.TestSubscription
+	subscription.Referrer = referrer
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 100)
+	subscription.RemainingBandwidth = types.TestBandwidthPos2
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, subscription)
+
+	session := types.TestSession
+	session.Bandwidth = types.TestBandwidthPos1
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 0, session.ID)
+
+	EndBlock(ctx.WithBlockHeight(k.SessionInactiveInterval(ctx)), k)
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), session.Paid)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 85)}, bk.GetCoins(ctx, types.TestNode.Owner))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 5)}, bk.GetCoins(ctx, referrer))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, k.GetProtocolFees(ctx))
+	require.Equal(t, types.NewReferralEarnings(referrer, sdk.Coins{sdk.NewInt64Coin("stake", 5)}),
+		k.GetReferralEarnings(ctx, referrer))
+}
+
+func Test_handleStartSubscriptionWithReferrer(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 100), 0, nil, types.TestNode.Owner))
+	require.Equal(t, types.ErrorInvalidReferrer().Result(), res)
+
+	referrer := sdk.AccAddress([]byte("referrer-address"))
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 100), 0, nil, referrer))
+	require.True(t, res.IsOK())
+
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, referrer, subscription.Referrer)
+}
+
+func Test_handleStartFreeTrial(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 0
+	k.SetParams(ctx, params)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	res := handler(ctx, *NewMsgStartFreeTrial(types.TestAddress2, types.TestNode.ID))
+	require.Equal(t, types.ErrorFreeTrialNotOffered().Result(), res)
+
+	res = handler(ctx, *NewMsgSetNodeFreeTrial(types.TestAddress2, types.TestNode.ID, 1000))
+	require.Equal(t, types.ErrorUnauthorized().Result(), res)
+
+	res = handler(ctx, *NewMsgSetNodeFreeTrial(types.TestNode.Owner, types.TestNode.ID, 1000))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgStartFreeTrial(types.TestAddress2, types.TestNode.ID))
+	require.True(t, res.IsOK())
+	require.Equal(t, true, k.HasFreeTrial(ctx, types.TestNode.ID, types.TestAddress2))
+
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, true, subscription.Trial)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(hub.NewBandwidthFromInt64(1000, 1000)))
+	require.Nil(t, subscription.IsValid())
+
+	res = handler(ctx, *NewMsgStartFreeTrial(types.TestAddress2, types.TestNode.ID))
+	require.Equal(t, types.ErrorFreeTrialAlreadyUsed().Result(), res)
+
+	session := types.TestSession
+	session.Bandwidth = hub.NewBandwidthFromInt64(400, 500)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, session.ID)
+	k.AddSessionIDToActiveList(ctx, 0, session.ID)
+
+	EndBlock(ctx.WithBlockHeight(k.SessionInactiveInterval(ctx)), k)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(hub.NewBandwidthFromInt64(600, 500)))
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), subscription.RemainingDeposit)
+
+	res = handler(ctx, *NewMsgEndSubscription(types.TestAddress2, subscription.ID))
+	require.True(t, res.IsOK())
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+}
+
+func Test_handleStartSubscriptionWithQuote(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+
+	sign := func(quote types.Quote) *types.SignedQuote {
+		signature, _ := types.TestPrivKey1.Sign(quote.SignBytes(ctx.ChainID()))
+		return types.NewSignedQuote(quote, auth.StdSignature{PubKey: types.TestPubkey1, Signature: signature})
+	}
+
+	quote := types.NewQuote(types.TestNode.ID, types.TestAddress2, sdk.NewInt64Coin("stake", 50),
+		types.TestBandwidthPos1, 1, 20)
+
+	expired := quote
+	expired.Expiry = 9
+	res := handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0, sign(expired), nil))
+	require.False(t, res.IsOK())
+
+	long := quote
+	long.Expiry = 11 + types.MaxQuoteLifetime
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0, sign(long), nil))
+	require.False(t, res.IsOK())
+
+	signature, _ := types.TestPrivKey1.Sign(quote.SignBytes("other-chain-id"))
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0,
+		types.NewSignedQuote(quote, auth.StdSignature{PubKey: types.TestPubkey1, Signature: signature}), nil))
+	require.False(t, res.IsOK())
+
+	signature, _ = types.TestPrivKey2.Sign(quote.SignBytes(ctx.ChainID()))
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0,
+		types.NewSignedQuote(quote, auth.StdSignature{PubKey: types.TestPubkey2, Signature: signature}), nil))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 100), 0, sign(quote), nil))
+	require.False(t, res.IsOK())
+	require.False(t, k.HasUsedQuote(ctx, types.TestNode.ID, quote.Nonce))
+
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0, sign(quote), nil))
+	require.True(t, res.IsOK())
+	require.True(t, k.HasUsedQuote(ctx, types.TestNode.ID, quote.Nonce))
+
+	subscription, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), subscription.PricePerGB)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestBandwidthPos1))
+
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, types.TestNode.ID,
+		sdk.NewInt64Coin("stake", 50), 0, sign(quote), nil))
+	require.False(t, res.IsOK())
+
+	EndBlock(ctx.WithBlockHeight(quote.Expiry), k)
+	require.False(t, k.HasUsedQuote(ctx, types.TestNode.ID, quote.Nonce))
+	require.Equal(t, 0, len(k.GetAllUsedQuotes(ctx)))
+}
+
+func Test_handleSetDiscountPlan(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	handler := NewHandler(k)
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+
+	res := handler(ctx, *NewMsgSetDiscountPlan(types.TestAddress2, node.ID, sdk.NewDecWithPrec(5, 1), 20))
+	require.Equal(t, types.ErrorUnauthorized().Result(), res)
+
+	res = handler(ctx, *NewMsgSetDiscountPlan(node.Owner, node.ID, sdk.NewDecWithPrec(5, 1), 10))
+	require.Equal(t, types.ErrorInvalidDiscountPlan().Result(), res)
+
+	res = handler(ctx, *NewMsgSetDiscountPlan(node.Owner, node.ID, sdk.NewDecWithPrec(5, 1), 15))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgSetDiscountPlan(node.Owner, node.ID, sdk.NewDecWithPrec(5, 1), 20))
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(k.GetDiscountPlansByUntil(ctx, 15)))
+
+	plan, found := k.GetDiscountPlan(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewDiscountPlan(node.ID, sdk.NewDecWithPrec(5, 1), 20), plan)
+
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, node.ID,
+		sdk.NewInt64Coin("stake", 50), 0, nil, nil))
+	require.True(t, res.IsOK())
+
+	subscription, _ := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), subscription.PricePerGB)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestBandwidthPos1))
+
+	EndBlock(ctx.WithBlockHeight(20), k)
+	_, found = k.GetDiscountPlan(ctx, node.ID)
+	require.Equal(t, false, found)
+	require.Equal(t, 0, len(k.GetAllDiscountPlans(ctx)))
+
+	res = handler(ctx, *NewMsgStartSubscription(types.TestAddress2, node.ID,
+		sdk.NewInt64Coin("stake", 100), 0, nil, nil))
+	require.True(t, res.IsOK())
+
+	subscription, _ = k.GetSubscription(ctx, hub.NewSubscriptionID(1))
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), subscription.PricePerGB)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestBandwidthPos1))
+
+	res = handler(ctx, *NewMsgSetDiscountPlan(node.Owner, node.ID, sdk.NewDecWithPrec(5, 1), 20))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgSetDiscountPlan(node.Owner, node.ID, sdk.ZeroDec(), 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(k.GetAllDiscountPlans(ctx)))
+	require.Equal(t, 0, len(k.GetDiscountPlansByUntil(ctx, 20)))
+}
+
+func Test_handleUpdateSessionsInfo(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
+
+	msg := NewMsgUpdateSessionsInfo(types.TestAddress1, []types.SessionInfoUpdate{
+		types.NewSessionInfoUpdate(hub.NewSubscriptionID(1), types.TestBandwidthPos1,
+			types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1),
+		types.NewSessionInfoUpdate(types.TestSubscription.ID, types.TestBandwidthPos2,
+			types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1),
+		types.NewSessionInfoUpdate(types.TestSubscription.ID, types.TestBandwidthPos1,
+			types.TestNodeOwnerStdSignaturePos1, types.TestClientStdSignaturePos1),
+	})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res := handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	want := types.TestSession
+	want.ID = types.DeriveSessionID(types.TestSubscription.ID, 1, 0)
+
+	session, found := k.GetSession(ctx, want.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, want, session)
+	require.Equal(t, uint64(1), k.GetSessionsCount(ctx))
+
+	var results, ids []string
+	for _, event := range res.Events {
+		if event.Type != types.EventTypeUpdateSessionInfo {
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeySuccess {
+				results = append(results, string(attribute.Value))
+			}
+			if string(attribute.Key) == types.AttributeKeySessionID {
+				ids = append(ids, string(attribute.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"false", "false", "true"}, results)
+	require.Equal(t, []string{want.ID.String()}, ids)
+}
+
+func Test_EndBlockGCSubscriptions(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SubscriptionGCEpoch = 10
+	params.SubscriptionGCRetention = 100
+	k.SetParams(ctx, params)
+
+	var subscriptions []types.Subscription
+	for i, status := range []string{types.StatusInactive, types.StatusInactive, types.StatusActive, types.StatusInactive} {
+		subscription := types.TestSubscription
+		subscription.ID = hub.NewSubscriptionID(uint64(i))
+		subscription.Status = status
+		subscription.StatusModifiedAt = 5
+		subscriptions = append(subscriptions, subscription)
+
+		k.SetSubscription(ctx, subscription)
+		k.SetSubscriptionIDByNodeID(ctx, subscription.NodeID, uint64(i), subscription.ID)
+		k.SetSubscriptionIDByAddress(ctx, subscription.Client, uint64(i), subscription.ID)
+	}
+
+	k.SetSubscriptionsCount(ctx, 4)
+	k.SetSubscriptionsCountOfNode(ctx, types.TestSubscription.NodeID, 4)
+	k.SetSubscriptionsCountOfAddress(ctx, types.TestSubscription.Client, 4)
+	k.SetSessionsCountOfSubscription(ctx, subscriptions[1].ID, 1)
+
+	subscriptions[3].StatusModifiedAt = 50
+	k.SetSubscription(ctx, subscriptions[3])
+
+	EndBlock(ctx.WithBlockHeight(105), k)
+	require.Len(t, k.GetAllSubscriptions(ctx), 4)
+
+	cctx := ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	EndBlock(cctx, k)
+
+	_, found := k.GetSubscription(ctx, subscriptions[0].ID)
+	require.Equal(t, false, found)
+	_, found = k.GetSubscriptionIDByNodeID(ctx, types.TestSubscription.NodeID, 0)
+	require.Equal(t, false, found)
+	_, found = k.GetSubscriptionIDByAddress(ctx, types.TestSubscription.Client, 0)
+	require.Equal(t, false, found)
+	require.Equal(t, subscriptions[1:], k.GetSubscriptionsOfNode(ctx, types.TestSubscription.NodeID))
+	require.Equal(t, subscriptions[1:], k.GetSubscriptionsOfAddress(ctx, types.TestSubscription.Client))
+	require.Equal(t, uint64(4), k.GetSubscriptionsCount(ctx))
+	require.Equal(t, uint64(4), k.GetSubscriptionsCountOfNode(ctx, types.TestSubscription.NodeID))
+
+	events := cctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeGCSubscription, events[0].Type)
+	require.Equal(t, subscriptions[0].ID.String(), string(events[0].Attributes[0].Value))
+
+	_, broken := keeper.SubscriptionReferencesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	EndBlock(ctx.WithBlockHeight(160), k)
+	_, found = k.GetSubscription(ctx, subscriptions[3].ID)
+	require.Equal(t, false, found)
+	require.Equal(t, subscriptions[1:3], k.GetAllSubscriptions(ctx))
+}
+
+func Test_EndBlockReleaseStrandedEscrows(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.SubscriptionGCEpoch = 0
+	params.EscrowReleaseEpoch = 10
+	params.EscrowTimeout = 0
+	k.SetParams(ctx, params)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 200)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 200)))
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	subscription := types.TestSubscription
+	subscription.ID = hub.NewSubscriptionID(1)
+	k.SetSubscription(ctx, subscription)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, hub.NewSessionID(0))
+	k.SetOngoingSessionIndex(ctx, types.SessionIndex{SubscriptionID: subscription.ID, Index: 0,
+		SessionID: hub.NewSessionID(0), Address: subscription.Client})
+
+	EndBlock(ctx.WithBlockHeight(110), k)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+
+	params.EscrowTimeout = 100
+	k.SetParams(ctx, params)
+
+	EndBlock(ctx.WithBlockHeight(100), k)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+
+	EndBlock(ctx.WithBlockHeight(105), k)
+	require.Equal(t, sdk.Coins(nil), bk.GetCoins(ctx, types.TestAddress2))
+
+	cctx := ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	EndBlock(cctx, k)
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress2))
+
+	released, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, types.StatusInactive, released.Status)
+	require.Equal(t, int64(110), released.StatusModifiedAt)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.ID)
+	require.Equal(t, types.StatusActive, subscription.Status)
+
+	events := eventsOfType(cctx.EventManager().Events(), types.EventTypeReleaseEscrow)
+	require.Len(t, events, 1)
+	require.Equal(t, types.TestSubscription.ID.String(), string(events[0].Attributes[0].Value))
+
+	_, broken := keeper.EscrowInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func Test_EndBlockSnapshotNodeStats(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.NodeStatsEpoch = 10
+	params.NodeStatsRetention = 20
+	k.SetParams(ctx, params)
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 100), nil, 1)
+
+	EndBlock(ctx.WithBlockHeight(5), k)
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 0)
+
+	for _, height := range []int64{10, 20, 30} {
+		EndBlock(ctx.WithBlockHeight(height), k)
+	}
+
+	_, found := k.GetNodeStatsSnapshot(ctx, 10, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+
+	EndBlock(ctx.WithBlockHeight(40), k)
+	_, found = k.GetNodeStatsSnapshot(ctx, 10, hub.NewNodeID(0))
+	require.Equal(t, false, found)
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 3)
+}
+
+func Test_EndBlockUpdateNodeReputations(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.ReputationEpoch = 10
+	k.SetParams(ctx, params)
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.AddNodeStats(ctx, node.ID, hub.NewBandwidthFromInt64(100, 100), nil, 100)
+
+	EndBlock(ctx.WithBlockHeight(5), k)
+	_, found := k.GetNodeReputation(ctx, node.ID)
+	require.Equal(t, false, found)
+
+	EndBlock(ctx.WithBlockHeight(10), k)
+	reputation, found := k.GetNodeReputation(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), reputation.Score)
+	require.Equal(t, int64(10), reputation.UpdatedAt)
+
+	params.ReputationEpoch = 0
+	k.SetParams(ctx, params)
+
+	EndBlock(ctx.WithBlockHeight(20), k)
+	reputation, _ = k.GetNodeReputation(ctx, node.ID)
+	require.Equal(t, int64(10), reputation.UpdatedAt)
+}
+
+func Test_EndBlockDistributeNodeRewards(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+
+	params := k.GetParams(ctx)
+	params.NodeStatsEpoch = 10
+	params.NodeStatsRetention = 20
+	k.SetParams(ctx, params)
+
+	for i := uint64(0); i < 3; i++ {
+		node := types.TestNode
+		node.ID = hub.NewNodeID(i)
+		node.Status = types.StatusRegistered
+		node.Jailed = i == 2
+		k.SetNode(ctx, node)
+	}
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 100), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(100, 0), nil, 1)
+	EndBlock(ctx.WithBlockHeight(10), k)
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(200, 100), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(100, 0), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(2), hub.NewBandwidthFromInt64(100, 100), nil, 1)
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 101)})
+	require.Nil(t, err)
+	err = dk.SendCoinsFromAccountToDeposit(ctx, types.TestAddress2, types.NodeRewardPoolAddress,
+		sdk.Coins{sdk.NewInt64Coin("stake", 101)})
+	require.Nil(t, err)
+
+	EndBlock(ctx.WithBlockHeight(20), k)
+
+	payout, found := k.GetPendingPayout(ctx, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 75)}, payout.Coins)
+
+	payout, found = k.GetPendingPayout(ctx, hub.NewNodeID(1))
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 25)}, payout.Coins)
+
+	_, found = k.GetPendingPayout(ctx, hub.NewNodeID(2))
+	require.Equal(t, false, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 1)}, k.GetNodeRewardPool(ctx))
+}
+
+func Test_EndBlockMinNodeVersion(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+
+	handler := NewHandler(k)
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	node.Version = "0.1.0"
+	k.SetNode(ctx, node)
+
+	_node := node
+	_node.ID = hub.NewNodeID(1)
+	_node.Version = "v0.3.0"
+	k.SetNode(ctx, _node)
+	k.SetNodesCount(ctx, 2)
+
+	EndBlock(ctx, k)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusRegistered, node.Status)
+
+	params := k.GetParams(ctx)
+	params.MinNodeVersion = "0.2.0"
+	k.SetParams(ctx, params)
+
+	EndBlock(ctx.WithBlockHeight(10), k)
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusInactive, node.Status)
+	require.Equal(t, int64(10), node.StatusModifiedAt)
+	require.Nil(t, node.IsValid())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, k.GetNetworkSummary(ctx).LockedDeposits)
+	_node, _ = k.GetNode(ctx, _node.ID)
+	require.Equal(t, StatusRegistered, _node.Status)
+	require.Equal(t, "0.2.0", k.GetEnforcedMinNodeVersion(ctx))
+
+	msg := NewMsgUpdateNodeInfo(node.Owner, node.ID, "", "0.2.1", "", nil, hub.Bandwidth{}, "", "", "", false)
+	res := handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, StatusRegistered, node.Status)
+
+	register := NewMsgRegisterNode(node.Owner, node.Type, "0.1.9", "", node.PricesPerGB, node.InternetSpeed, node.Encryption, "", "")
+	res = handler(ctx, *register)
+	require.True(t, res.IsOK())
+
+	node, _ = k.GetNode(ctx, hub.NewNodeID(2))
+	require.Equal(t, StatusInactive, node.Status)
+}
+
+func Test_handleOpenDispute(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+	handler := NewHandler(k)
+
+	msg := NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 1, sdk.NewInt64Coin("stake", 20))
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorSubscriptionDoesNotExist().Code(), res.Code)
+
+	for _, address := range []sdk.AccAddress{types.TestAddress1, types.TestAddress2} {
+		_, err := bk.AddCoins(ctx, address, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+		require.Nil(t, err)
+		require.Nil(t, k.AddDeposit(ctx, address, sdk.NewInt64Coin("stake", 100)))
+	}
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Paid = sdk.NewInt64Coin("stake", 100)
+	session.Status = StatusInactive
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, session.ID)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 20)))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidSessionStatus().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 1, sdk.NewInt64Coin("stake", 101)))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidDisputeAmount().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 1, sdk.NewInt64Coin("atom", 20)))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidDisputeAmount().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgOpenDispute(sdk.AccAddress("address"), types.TestSubscription.ID, 1, sdk.NewInt64Coin("stake", 20)))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx.WithBlockHeight(11+k.DisputeWindow(ctx)), *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorDisputeWindowClosed().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	events := eventsOfType(res.Events, types.EventTypeOpenDispute)
+	require.Len(t, events, 1)
+	require.Equal(t, types.DisputePartyClient, string(events[0].Attributes[3].Value))
+
+	dispute, found := k.GetDispute(ctx, session.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewDispute(session, 1, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 20), 20, 20+k.DisputeTimeout(ctx)), dispute)
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 80), node.Deposit)
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 80)}, deposit.Coins)
+	deposit, _ = dk.GetDeposit(ctx, types.DisputePoolAddress)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, deposit.Coins)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorDisputeAlreadyExists().Code(), res.Code)
+
+	session.ID = hub.NewSessionID(1)
+	session.Paid = sdk.NewInt64Coin("stake", 50)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress1, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 101)))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidDisputeAmount().Code(), res.Code)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress1, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 30)))
+	require.True(t, res.IsOK())
+
+	events = eventsOfType(res.Events, types.EventTypeOpenDispute)
+	require.Len(t, events, 1)
+	require.Equal(t, types.DisputePartyNode, string(events[0].Attributes[3].Value))
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 70), subscription.RemainingDeposit)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(hub.NewBandwidthFromInt64(350000000, 350000000)))
+
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 70)}, deposit.Coins)
+	deposit, _ = dk.GetDeposit(ctx, types.DisputePoolAddress)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 50)}, deposit.Coins)
+}
+
+func Test_handleSubmitDisputeEvidence(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+	handler := NewHandler(k)
+
+	for _, address := range []sdk.AccAddress{types.TestAddress1, types.TestAddress2} {
+		_, err := bk.AddCoins(ctx, address, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+		require.Nil(t, err)
+		require.Nil(t, k.AddDeposit(ctx, address, sdk.NewInt64Coin("stake", 100)))
+	}
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Paid = sdk.NewInt64Coin("stake", 100)
+	session.Status = StatusInactive
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, session.ID)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
+
+	msg := NewMsgSubmitDisputeEvidence(types.TestAddress1, session.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1)
+	res := handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorDisputeDoesNotExist().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 1, sdk.NewInt64Coin("stake", 20)))
+	require.True(t, res.IsOK())
+
+	res = handler(ctx, *NewMsgSubmitDisputeEvidence(types.TestAddress2, session.ID, types.TestBandwidthPos1, types.TestClientStdSignaturePos1))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorUnauthorized().Code(), res.Code)
+
+	res = handler(ctx, *NewMsgSubmitDisputeEvidence(types.TestAddress1, session.ID, types.TestBandwidthPos2, types.TestClientStdSignaturePos1))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidClientSignature().Code(), res.Code)
+
+	res = handler(ctx, *msg)
+	require.True(t, res.IsOK())
+
+	events := eventsOfType(res.Events, types.EventTypeResolveDispute)
+	require.Len(t, events, 1)
+	require.Equal(t, types.DisputeStatusRejected, string(events[0].Attributes[2].Value))
+	require.Equal(t, types.AttributeValueEvidence, string(events[0].Attributes[3].Value))
+
+	dispute, _ := k.GetDispute(ctx, session.ID)
+	require.Equal(t, types.DisputeStatusRejected, dispute.Status)
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), node.Deposit)
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+
+	res = handler(ctx, *msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidDisputeStatus().Code(), res.Code)
+
+	session.ID = hub.NewSessionID(1)
+	session.Paid = sdk.NewInt64Coin("stake", 50)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress1, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 30)))
+	require.True(t, res.IsOK())
+
+	bandwidth := hub.NewBandwidthFromInt64(250000000, 250000000)
+	signature, _ := types.TestPrivKey2.Sign(types.BandwidthSignBytes(types.TestSubscription.ID, 0, bandwidth))
+	res = handler(ctx, *NewMsgSubmitDisputeEvidence(types.TestAddress1, session.ID, bandwidth,
+		auth.StdSignature{PubKey: types.TestPubkey2, Signature: signature}))
+	require.False(t, res.IsOK())
+	require.Equal(t, types.ErrorInvalidEvidence().Code(), res.Code)
+
+	signature, _ = types.TestPrivKey2.Sign(types.BandwidthSignBytes(types.TestSubscription.ID, 0, types.TestBandwidthPos1))
+	res = handler(ctx, *NewMsgSubmitDisputeEvidence(types.TestAddress1, session.ID, types.TestBandwidthPos1,
+		auth.StdSignature{PubKey: types.TestPubkey2, Signature: signature}))
+	require.True(t, res.IsOK())
+
+	dispute, _ = k.GetDispute(ctx, session.ID)
+	require.Equal(t, types.DisputeStatusUpheld, dispute.Status)
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 80), session.Paid)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 30)}, bk.GetCoins(ctx, types.TestAddress1))
+
+	deposit, _ = dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 70)}, deposit.Coins)
+}
+
+func Test_EndBlockDisputeTimeout(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+	handler := NewHandler(k)
+
+	for _, address := range []sdk.AccAddress{types.TestAddress1, types.TestAddress2} {
+		_, err := bk.AddCoins(ctx, address, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+		require.Nil(t, err)
+		require.Nil(t, k.AddDeposit(ctx, address, sdk.NewInt64Coin("stake", 100)))
+	}
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Paid = sdk.NewInt64Coin("stake", 50)
+	session.Status = StatusInactive
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+
+	res := handler(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 20)))
+	require.True(t, res.IsOK())
+
+	session.ID = hub.NewSessionID(1)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, session.ID)
+	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
+
+	res = handler(ctx, *NewMsgOpenDispute(types.TestAddress1, types.TestSubscription.ID, 1, sdk.NewInt64Coin("stake", 30)))
+	require.True(t, res.IsOK())
+
+	deadline := 20 + k.DisputeTimeout(ctx)
+	EndBlock(ctx.WithBlockHeight(deadline-1), k)
+
+	dispute, _ := k.GetDispute(ctx, hub.NewSessionID(0))
+	require.Equal(t, types.DisputeStatusOpen, dispute.Status)
+
+	cctx := ctx.WithBlockHeight(deadline).WithEventManager(sdk.NewEventManager())
+	EndBlock(cctx, k)
+
+	events := eventsOfType(cctx.EventManager().Events(), types.EventTypeResolveDispute)
+	require.Len(t, events, 2)
+	require.Equal(t, types.AttributeValueTimeout, string(events[0].Attributes[3].Value))
+
+	dispute, _ = k.GetDispute(ctx, hub.NewSessionID(0))
+	require.Equal(t, types.DisputeStatusUpheld, dispute.Status)
+	require.Equal(t, deadline, dispute.StatusModifiedAt)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, bk.GetCoins(ctx, types.TestAddress2))
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 80), node.Deposit)
+
+	dispute, _ = k.GetDispute(ctx, hub.NewSessionID(1))
+	require.Equal(t, types.DisputeStatusRejected, dispute.Status)
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, types.TestSubscription.RemainingDeposit, subscription.RemainingDeposit)
+	require.True(t, subscription.RemainingBandwidth.AllEqual(types.TestSubscription.RemainingBandwidth))
+
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, deposit.Coins)
+	deposit, _ = dk.GetDeposit(ctx, types.DisputePoolAddress)
+	require.True(t, deposit.Coins.IsZero())
 }
@@ -2,6 +2,11 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
 func (k Keeper) AddDeposit(ctx sdk.Context, address sdk.AccAddress, coin sdk.Coin) sdk.Error {
@@ -12,6 +17,126 @@ func (k Keeper) SubtractDeposit(ctx sdk.Context, address sdk.AccAddress, coin sd
 	return k.deposit.Subtract(ctx, address, sdk.Coins{coin})
 }
 
+// WithdrawableNodeDeposit returns the part of the deposit of the node above the minimum deposit of
+// the registration. Nothing can be withdrawn from a jailed or a de-registered node, as its deposit
+// backs the penalties which are still outstanding.
+func (k Keeper) WithdrawableNodeDeposit(ctx sdk.Context, node types.Node) sdk.Coin {
+	withdrawable := sdk.NewCoin(node.Deposit.Denom, sdk.ZeroInt())
+	if node.Jailed || node.Status == types.StatusDeRegistered {
+		return withdrawable
+	}
+
+	min := k.Deposit(ctx)
+	if node.Deposit.Denom == min.Denom && node.Deposit.Amount.GT(min.Amount) {
+		withdrawable.Amount = node.Deposit.Amount.Sub(min.Amount)
+	}
+
+	return withdrawable
+}
+
 func (k Keeper) SendDeposit(ctx sdk.Context, from, toAddress sdk.AccAddress, coin sdk.Coin) sdk.Error {
 	return k.deposit.SendCoinsFromDepositToAccount(ctx, from, toAddress, sdk.Coins{coin})
 }
+
+// AddPendingPayout moves the coin from the deposit of the address to the payout pool
+// and adds it to the pending payout of the node, which is paid at the end of the epoch.
+func (k Keeper) AddPendingPayout(ctx sdk.Context, from sdk.AccAddress, id hub.NodeID, coin sdk.Coin) sdk.Error {
+	if err := k.deposit.SendCoinsFromDepositToDeposit(ctx, from, types.PayoutPoolAddress, sdk.Coins{coin}); err != nil {
+		return err
+	}
+
+	payout, found := k.GetPendingPayout(ctx, id)
+	if !found {
+		payout = types.NewPendingPayout(id, sdk.Coins{})
+	}
+
+	payout.Coins = payout.Coins.Add(sdk.Coins{coin})
+	k.SetPendingPayout(ctx, payout)
+
+	return nil
+}
+
+func (k Keeper) SendPendingPayout(ctx sdk.Context, toAddress sdk.AccAddress, coins sdk.Coins) sdk.Error {
+	return k.deposit.SendCoinsFromDepositToAccount(ctx, types.PayoutPoolAddress, toAddress, coins)
+}
+
+// FreezeDisputedDeposit moves the coin from the deposit of the address to the dispute pool.
+func (k Keeper) FreezeDisputedDeposit(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) sdk.Error {
+	return k.deposit.SendCoinsFromDepositToDeposit(ctx, from, types.DisputePoolAddress, sdk.Coins{coin})
+}
+
+// ReturnDisputedDeposit moves the frozen coin from the dispute pool to the deposit of the address.
+func (k Keeper) ReturnDisputedDeposit(ctx sdk.Context, to sdk.AccAddress, coin sdk.Coin) sdk.Error {
+	return k.deposit.SendCoinsFromDepositToDeposit(ctx, types.DisputePoolAddress, to, sdk.Coins{coin})
+}
+
+func (k Keeper) SendDisputedDeposit(ctx sdk.Context, toAddress sdk.AccAddress, coin sdk.Coin) sdk.Error {
+	return k.deposit.SendCoinsFromDepositToAccount(ctx, types.DisputePoolAddress, toAddress, sdk.Coins{coin})
+}
+
+// AddNodeRewards moves the coins from the fee collector to the node reward pool.
+func (k Keeper) AddNodeRewards(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	return k.deposit.SendCoinsFromModuleToDeposit(ctx, auth.FeeCollectorName, types.NodeRewardPoolAddress, coins)
+}
+
+func (k Keeper) GetNodeRewardPool(ctx sdk.Context) sdk.Coins {
+	deposit, found := k.deposit.GetDeposit(ctx, types.NodeRewardPoolAddress)
+	if !found {
+		return sdk.Coins{}
+	}
+
+	return deposit.Coins
+}
+
+// FundCommunityPool moves the coin from the deposit of the address to the community pool
+// and adds it to the protocol fees collected so far.
+func (k Keeper) FundCommunityPool(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) sdk.Error {
+	coins := sdk.Coins{coin}
+	if err := k.deposit.SendCoinsFromDepositToModule(ctx, from, distribution.ModuleName, coins); err != nil {
+		return err
+	}
+
+	feePool := k.distribution.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(coins))
+	k.distribution.SetFeePool(ctx, feePool)
+
+	k.SetProtocolFees(ctx, k.GetProtocolFees(ctx).Add(coins))
+	return nil
+}
+
+// GetDepositOfAddress returns the deposit of the address with the registered nodes and the
+// active subscriptions of the address which have a part of it locked.
+func (k Keeper) GetDepositOfAddress(ctx sdk.Context, address sdk.AccAddress) types.DepositOfAddress {
+	deposit := types.DepositOfAddress{
+		Address:       address,
+		Nodes:         []types.NodeDeposit{},
+		Subscriptions: []types.SubscriptionDeposit{},
+		Unbondings:    []types.NodeUnbonding{},
+	}
+
+	if _deposit, found := k.deposit.GetDeposit(ctx, address); found {
+		deposit.Coins = _deposit.Coins
+	}
+
+	for _, node := range k.GetNodesOfAddress(ctx, address) {
+		if node.Status != types.StatusDeRegistered && node.Deposit.IsPositive() {
+			deposit.Nodes = append(deposit.Nodes, types.NodeDeposit{
+				NodeID:  node.ID,
+				Deposit: node.Deposit,
+			})
+		} else if unbonding, found := k.GetNodeUnbonding(ctx, node.ID); found {
+			deposit.Unbondings = append(deposit.Unbondings, unbonding)
+		}
+	}
+
+	for _, subscription := range k.GetSubscriptionsOfAddress(ctx, address) {
+		if subscription.Status == types.StatusActive && subscription.RemainingDeposit.IsPositive() {
+			deposit.Subscriptions = append(deposit.Subscriptions, types.SubscriptionDeposit{
+				SubscriptionID: subscription.ID,
+				Deposit:        subscription.RemainingDeposit,
+			})
+		}
+	}
+
+	return deposit
+}
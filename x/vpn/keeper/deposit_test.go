@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
 
@@ -198,3 +199,67 @@ func TestKeeper_SendDeposit(t *testing.T) {
 	require.Equal(t, true, found)
 	require.Equal(t, sdk.Coins(nil), deposit.Coins)
 }
+
+func TestKeeper_AddPendingPayout(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+
+	err := k.AddPendingPayout(ctx, types.TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 40))
+	require.NotNil(t, err)
+	_, found := k.GetPendingPayout(ctx, hub.NewNodeID(0))
+	require.Equal(t, false, found)
+
+	_, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	err = k.AddPendingPayout(ctx, types.TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 40))
+	require.Nil(t, err)
+	err = k.AddPendingPayout(ctx, types.TestAddress2, hub.NewNodeID(0), sdk.NewInt64Coin("stake", 20))
+	require.Nil(t, err)
+
+	payout, found := k.GetPendingPayout(ctx, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, payout.Coins)
+	require.Equal(t, []types.PendingPayout{payout}, k.GetAllPendingPayouts(ctx))
+	require.True(t, bk.GetCoins(ctx, types.TestAddress1).IsZero())
+
+	err = k.SendPendingPayout(ctx, types.TestAddress1, payout.Coins)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 60)}, bk.GetCoins(ctx, types.TestAddress1))
+
+	err = k.SendPendingPayout(ctx, types.TestAddress1, payout.Coins)
+	require.NotNil(t, err)
+
+	k.DeletePendingPayout(ctx, hub.NewNodeID(0))
+	_, found = k.GetPendingPayout(ctx, hub.NewNodeID(0))
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_FundCommunityPool(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+
+	require.Equal(t, sdk.Coins{}, k.GetProtocolFees(ctx))
+
+	err := k.FundCommunityPool(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 10))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Coins{}, k.GetProtocolFees(ctx))
+
+	_, err = bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	err = k.FundCommunityPool(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 10))
+	require.Nil(t, err)
+	err = k.FundCommunityPool(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 5))
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, k.GetProtocolFees(ctx))
+
+	feePool := k.distribution.GetFeePool(ctx)
+	require.Equal(t, sdk.NewDecCoins(sdk.Coins{sdk.NewInt64Coin("stake", 15)}), feePool.CommunityPool)
+
+	err = k.FundCommunityPool(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, k.GetProtocolFees(ctx))
+}
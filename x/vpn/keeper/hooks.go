@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/inflation"
+)
+
+var _ inflation.MintHooks = Hooks{}
+
+type Hooks struct {
+	k Keeper
+}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterMintBlockProvision moves the node reward rate of the minted coins to the node reward pool,
+// which is distributed to the nodes at the end of each node stats epoch.
+func (h Hooks) AfterMintBlockProvision(ctx sdk.Context, minted sdk.Coins) {
+	rate := h.k.NodeRewardRate(ctx)
+	if !rate.IsPositive() {
+		return
+	}
+
+	rewards := sdk.Coins{}
+	for _, coin := range minted {
+		amount := rate.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.Coins{sdk.NewCoin(coin.Denom, amount)})
+		}
+	}
+
+	if rewards.Empty() {
+		return
+	}
+
+	if err := h.k.AddNodeRewards(ctx, rewards); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestHooks_AfterMintBlockProvision(t *testing.T) {
+	ctx, k, _, bk := CreateTestInput(t, false)
+	feeCollector := supply.NewModuleAddress(auth.FeeCollectorName)
+
+	_, err := bk.AddCoins(ctx, feeCollector, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+
+	k.Hooks().AfterMintBlockProvision(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Equal(t, sdk.Coins{}, k.GetNodeRewardPool(ctx))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, feeCollector))
+
+	params := k.GetParams(ctx)
+	params.NodeRewardRate = sdk.NewDecWithPrec(25, 2)
+	k.SetParams(ctx, params)
+
+	k.Hooks().AfterMintBlockProvision(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 3)})
+	require.Equal(t, sdk.Coins{}, k.GetNodeRewardPool(ctx))
+
+	k.Hooks().AfterMintBlockProvision(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 25)}, k.GetNodeRewardPool(ctx))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 75)}, bk.GetCoins(ctx, feeCollector))
+	require.True(t, bk.GetCoins(ctx, types.NodeRewardPoolAddress).IsZero())
+}
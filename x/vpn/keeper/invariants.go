@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "subscription-references", SubscriptionReferencesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "network-summary", NetworkSummaryInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "revenue-splits", RevenueSplitsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow", EscrowInvariant(k))
+}
+
+// SubscriptionReferencesInvariant checks that the sessions, the seats, the consumption rates, the
+// pending settlements and the lists of the nodes and the clients refer to the existing subscriptions,
+// so that only the inert subscriptions are removed by the garbage collection.
+func SubscriptionReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		check := func(id hub.SubscriptionID, what string) {
+			if _, found := k.GetSubscription(ctx, id); !found {
+				msg += fmt.Sprintf("\t%s refers to the missing subscription %s\n", what, id)
+				count++
+			}
+		}
+
+		for _, session := range k.GetAllSessions(ctx) {
+			check(session.SubscriptionID, "session "+session.ID.String())
+		}
+		for _, seat := range k.GetAllSeats(ctx) {
+			check(seat.SubscriptionID, fmt.Sprintf("seat %d", seat.Index))
+		}
+		for _, rate := range k.GetAllConsumptionRates(ctx) {
+			check(rate.SubscriptionID, "consumption rate")
+		}
+		for _, settlement := range k.GetAllPendingSettlements(ctx) {
+			check(settlement.SubscriptionID, "pending settlement")
+		}
+
+		store := k.store(ctx, k.subscriptionKey)
+		for _, prefix := range [][]byte{types.SubscriptionIDByNodeIDKeyPrefix, types.SubscriptionIDByAddressKeyPrefix} {
+			iter := sdk.KVStorePrefixIterator(store, prefix)
+			for ; iter.Valid(); iter.Next() {
+				var id hub.SubscriptionID
+				k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &id)
+				check(id, "list entry")
+			}
+
+			iter.Close()
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "subscription references",
+			fmt.Sprintf("found %d references to the missing subscriptions\n%s", count, msg)), count > 0
+	}
+}
+
+// NetworkSummaryInvariant checks that the counters of the network summary match the nodes,
+// the subscriptions and the sessions in the store.
+func NetworkSummaryInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := types.NewNetworkSummary()
+		for _, node := range k.GetAllNodes(ctx) {
+			expected = expected.AddNode(node)
+		}
+		for _, subscription := range k.GetAllSubscriptions(ctx) {
+			expected = expected.AddSubscription(subscription)
+		}
+		for _, session := range k.GetAllSessions(ctx) {
+			expected = expected.AddSession(session)
+		}
+
+		summary := k.GetNetworkSummary(ctx)
+		diff, _ := summary.LockedDeposits.SafeSub(expected.LockedDeposits)
+		broken := summary.ActiveNodes != expected.ActiveNodes ||
+			summary.InactiveNodes != expected.InactiveNodes ||
+			summary.ActiveSubscriptions != expected.ActiveSubscriptions ||
+			summary.ActiveSessions != expected.ActiveSessions ||
+			!diff.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, "network summary",
+			fmt.Sprintf("\tstored:\n%s\n\texpected:\n%s\n", summary, expected)), broken
+	}
+}
+
+// RevenueSplitsInvariant checks that the shares of the revenue splits of every node sum up to at
+// most one, so that the payouts of the splits and the node sum up to the whole of the earnings.
+func RevenueSplitsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, node := range k.GetAllNodes(ctx) {
+			if err := types.ValidateRevenueSplits(node.RevenueSplits); err != nil {
+				msg += fmt.Sprintf("\tnode %s: %s\n", node.ID, err)
+				count++
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "revenue splits",
+			fmt.Sprintf("found %d nodes with invalid revenue splits\n%s", count, msg)), count > 0
+	}
+}
+
+// EscrowInvariant checks that the deposit locked by every subscription is conserved, the total deposit
+// must be equal to the amounts paid by its sessions and frozen by the open disputes of the nodes plus
+// the remaining deposit, which is refunded when the subscription ends. The inactive subscriptions of
+// which the sessions are pruned or the remaining deposit is released by a proposal can not be checked,
+// and are skipped.
+func EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, subscription := range k.GetAllSubscriptions(ctx) {
+			inactive := subscription.Status == types.StatusInactive
+			if inactive && subscription.RemainingDeposit.IsZero() {
+				continue
+			}
+
+			paid, frozen, complete := sdk.ZeroInt(), sdk.ZeroInt(), true
+
+			next := k.GetNextSessionIndexOfSubscription(ctx, subscription.ID)
+			for i := uint64(0); i < next; i++ {
+				id, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, i)
+				if !found {
+					complete = false
+					continue
+				}
+
+				session, found := k.GetSession(ctx, id)
+				if !found {
+					complete = false
+					continue
+				}
+
+				paid = paid.Add(session.Paid.Amount)
+				if dispute, found := k.GetDispute(ctx, session.ID); found &&
+					dispute.IsOpen() && dispute.Party == types.DisputePartyNode {
+					frozen = frozen.Add(dispute.Amount.Amount)
+				}
+			}
+
+			if inactive && !complete {
+				continue
+			}
+
+			if !subscription.TotalDeposit.Amount.Equal(paid.Add(frozen).Add(subscription.RemainingDeposit.Amount)) {
+				msg += fmt.Sprintf("\tsubscription %s: total deposit %s, paid %s, frozen %s, remaining deposit %s\n",
+					subscription.ID, subscription.TotalDeposit, paid, frozen, subscription.RemainingDeposit)
+				count++
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow",
+			fmt.Sprintf("found %d subscriptions of which the deposit is not conserved\n%s", count, msg)), count > 0
+	}
+}
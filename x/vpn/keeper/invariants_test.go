@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestSubscriptionReferencesInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, broken := SubscriptionReferencesInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSubscriptionIDByNodeID(ctx, types.TestSubscription.NodeID, 0, types.TestSubscription.ID)
+	k.SetSession(ctx, types.TestSession)
+	_, broken = SubscriptionReferencesInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	k.SetSubscriptionIDByAddress(ctx, types.TestSubscription.Client, 0, hub.NewSubscriptionID(1))
+	_, broken = SubscriptionReferencesInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+	k.DeleteSubscriptionIDByAddress(ctx, types.TestSubscription.Client, 0)
+
+	session := types.TestSession
+	session.ID = hub.NewSessionID(1)
+	session.SubscriptionID = hub.NewSubscriptionID(1)
+	k.SetSession(ctx, session)
+	_, broken = SubscriptionReferencesInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+}
+
+func TestNetworkSummaryInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, broken := NetworkSummaryInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, types.TestSession)
+	_, broken = NetworkSummaryInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	summary := k.GetNetworkSummary(ctx)
+	summary.ActiveSessions++
+	k.SetNetworkSummary(ctx, summary)
+	_, broken = NetworkSummaryInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+}
+
+func TestRevenueSplitsInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	node := types.TestNode
+	k.SetNode(ctx, node)
+	_, broken := RevenueSplitsInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	node.RevenueSplits = []types.RevenueSplit{
+		types.NewRevenueSplit(types.RevenueRoleProvider, types.TestAddress2, sdk.NewDecWithPrec(6, 1)),
+		types.NewRevenueSplit(types.RevenueRoleResolver, types.TestAddress2, sdk.NewDecWithPrec(4, 1)),
+	}
+	k.SetNode(ctx, node)
+	_, broken = RevenueSplitsInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	node.RevenueSplits[1].Share = sdk.NewDecWithPrec(5, 1)
+	k.SetNode(ctx, node)
+	_, broken = RevenueSplitsInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+}
+
+func TestEscrowInvariant(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	subscription := types.TestSubscription
+	k.SetSubscription(ctx, subscription)
+	k.SetSession(ctx, types.TestSession)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, types.TestSession.ID)
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	_, broken := EscrowInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 90)
+	k.SetSubscription(ctx, subscription)
+	_, broken = EscrowInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+
+	session := types.TestSession
+	session.Paid = sdk.NewInt64Coin("stake", 10)
+	k.SetSession(ctx, session)
+	_, broken = EscrowInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+
+	k.DeleteSession(ctx, session.ID)
+	k.DeleteSessionIDBySubscriptionID(ctx, subscription.ID, 0)
+	k.DeleteOngoingSessionIndex(ctx, subscription.ID, types.TestSessionIndex.Address)
+	k.SetSessionsCountOfSubscription(ctx, subscription.ID, 1)
+	_, broken = EscrowInvariant(k)(ctx)
+	require.Equal(t, true, broken)
+
+	subscription.Status = types.StatusInactive
+	k.SetSubscription(ctx, subscription)
+	_, broken = EscrowInvariant(k)(ctx)
+	require.Equal(t, false, broken)
+}
@@ -3,11 +3,17 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/sentinel-official/hub/x/deposit"
 )
 
+// StoreListener wraps the stores of the module to observe the writes to them.
+type StoreListener interface {
+	Listen(ctx sdk.Context, key sdk.StoreKey, store sdk.KVStore) sdk.KVStore
+}
+
 type Keeper struct {
 	nodeKey         sdk.StoreKey
 	subscriptionKey sdk.StoreKey
@@ -15,10 +21,13 @@ type Keeper struct {
 	cdc             *codec.Codec
 	paramStore      params.Subspace
 	deposit         deposit.Keeper
+	distribution    distribution.Keeper
+	metrics         *Metrics
+	listener        StoreListener
 }
 
 func NewKeeper(cdc *codec.Codec, nodeKey, subscriptionKey, sessionKey sdk.StoreKey,
-	paramStore params.Subspace, dk deposit.Keeper) Keeper {
+	paramStore params.Subspace, dk deposit.Keeper, distrk distribution.Keeper) Keeper {
 	return Keeper{
 		nodeKey:         nodeKey,
 		subscriptionKey: subscriptionKey,
@@ -26,5 +35,22 @@ func NewKeeper(cdc *codec.Codec, nodeKey, subscriptionKey, sessionKey sdk.StoreK
 		cdc:             cdc,
 		paramStore:      paramStore.WithKeyTable(ParamKeyTable()),
 		deposit:         dk,
+		distribution:    distrk,
+		metrics:         NopMetrics(),
+	}
+}
+
+// WithListener returns the keeper with the writes to its stores observed by the listener.
+func (k Keeper) WithListener(l StoreListener) Keeper {
+	k.listener = l
+	return k
+}
+
+func (k Keeper) store(ctx sdk.Context, key sdk.StoreKey) sdk.KVStore {
+	store := ctx.KVStore(key)
+	if k.listener != nil {
+		return k.listener.Listen(ctx, key, store)
 	}
+
+	return store
 }
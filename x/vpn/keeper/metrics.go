@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+// Metrics are the prometheus metrics of the vpn module, which are served on the
+// prometheus endpoint of the tendermint node when the instrumentation is enabled.
+type Metrics struct {
+	SessionsSettled  metrics.Counter
+	SettledCoins     metrics.Counter
+	EndBlockDuration metrics.Histogram
+}
+
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		SessionsSettled: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: types.ModuleName,
+			Name:      "sessions_settled",
+			Help:      "Number of the settlements of the sessions.",
+		}, []string{}),
+		SettledCoins: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: types.ModuleName,
+			Name:      "settled_coins",
+			Help:      "Amount of the coins paid by the settlements of the sessions.",
+		}, []string{"denom"}),
+		EndBlockDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: types.ModuleName,
+			Name:      "end_block_duration_seconds",
+			Help:      "Time taken by the end blocker of the module.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, []string{}),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SessionsSettled:  discard.NewCounter(),
+		SettledCoins:     discard.NewCounter(),
+		EndBlockDuration: discard.NewHistogram(),
+	}
+}
+
+// WithMetrics returns the keeper reporting to the metrics, it has to be set before the
+// keeper is passed to the other keepers and the modules.
+func (k Keeper) WithMetrics(m *Metrics) Keeper {
+	k.metrics = m
+	return k
+}
+
+func (k Keeper) Metrics() *Metrics {
+	return k.metrics
+}
+
+// addCoin adds the amount of the coin to the counter, labeled by the denom.
+func addCoin(counter metrics.Counter, coin sdk.Coin) {
+	amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+	counter.With("denom", coin.Denom).Add(amount)
+}
+
+// ObserveSettlement records a settlement of a session which has paid the coin.
+func (k Keeper) ObserveSettlement(coin sdk.Coin) {
+	k.metrics.SessionsSettled.Add(1)
+	addCoin(k.metrics.SettledCoins, coin)
+}
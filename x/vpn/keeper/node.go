@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -10,12 +11,12 @@ import (
 func (k Keeper) SetNodesCount(ctx sdk.Context, count uint64) {
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 	store.Set(types.NodesCountKey, value)
 }
 
 func (k Keeper) GetNodesCount(ctx sdk.Context) (count uint64) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	value := store.Get(types.NodesCountKey)
 	if value == nil {
@@ -27,16 +28,22 @@ func (k Keeper) GetNodesCount(ctx sdk.Context) (count uint64) {
 }
 
 func (k Keeper) SetNode(ctx sdk.Context, node types.Node) {
+	summary := k.GetNetworkSummary(ctx)
+	if _node, found := k.GetNode(ctx, node.ID); found {
+		summary = summary.RemoveNode(_node)
+	}
+	k.SetNetworkSummary(ctx, summary.AddNode(node))
+
 	key := types.NodeKey(node.ID)
 
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(node)
 
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetNode(ctx sdk.Context, id hub.NodeID) (node types.Node, found bool) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	key := types.NodeKey(id)
 	value := store.Get(key)
@@ -52,12 +59,12 @@ func (k Keeper) SetNodesCountOfAddress(ctx sdk.Context, address sdk.AccAddress,
 	key := types.NodesCountOfAddressKey(address)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetNodesCountOfAddress(ctx sdk.Context, address sdk.AccAddress) (count uint64) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	key := types.NodesCountOfAddressKey(address)
 	value := store.Get(key)
@@ -73,12 +80,12 @@ func (k Keeper) SetNodeIDByAddress(ctx sdk.Context, address sdk.AccAddress, i ui
 	key := types.NodeIDByAddressKey(address, i)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
 
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetNodeIDByAddress(ctx sdk.Context, address sdk.AccAddress, i uint64) (id hub.NodeID, found bool) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	key := types.NodeIDByAddressKey(address, i)
 	value := store.Get(key)
@@ -90,18 +97,204 @@ func (k Keeper) GetNodeIDByAddress(ctx sdk.Context, address sdk.AccAddress, i ui
 	return id, true
 }
 
+func (k Keeper) SetNodeIDByMoniker(ctx sdk.Context, moniker string, id hub.NodeID) {
+	key := types.NodeIDByMonikerKey(moniker)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeIDByMoniker(ctx sdk.Context, moniker string) (id hub.NodeID, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeIDByMonikerKey(moniker)
+	value := store.Get(key)
+	if value == nil {
+		return hub.NewNodeID(0), false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+	return id, true
+}
+
+func (k Keeper) DeleteNodeIDByMoniker(ctx sdk.Context, moniker string) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeIDByMonikerKey(moniker)
+	store.Delete(key)
+}
+
+func (k Keeper) SetAllowedAddress(ctx sdk.Context, allowed types.AllowedAddress) {
+	key := types.AllowedAddressKey(allowed.NodeID, allowed.Address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(allowed)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) HasAllowedAddress(ctx sdk.Context, id hub.NodeID, address sdk.AccAddress) bool {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.AllowedAddressKey(id, address)
+	return store.Has(key)
+}
+
+func (k Keeper) DeleteAllowedAddress(ctx sdk.Context, id hub.NodeID, address sdk.AccAddress) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.AllowedAddressKey(id, address)
+	store.Delete(key)
+}
+
+func (k Keeper) SetFreeTrial(ctx sdk.Context, trial types.FreeTrial) {
+	key := types.FreeTrialKey(trial.NodeID, trial.Address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(trial)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) HasFreeTrial(ctx sdk.Context, id hub.NodeID, address sdk.AccAddress) bool {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.FreeTrialKey(id, address)
+	return store.Has(key)
+}
+
+func (k Keeper) SetDiscountPlan(ctx sdk.Context, plan types.DiscountPlan) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(plan)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(types.DiscountPlanKey(plan.NodeID), value)
+	store.Set(types.DiscountPlanByUntilKey(plan.Until, plan.NodeID), value)
+}
+
+func (k Keeper) GetDiscountPlan(ctx sdk.Context, id hub.NodeID) (plan types.DiscountPlan, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.DiscountPlanKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &plan)
+	return plan, true
+}
+
+func (k Keeper) DeleteDiscountPlan(ctx sdk.Context, plan types.DiscountPlan) {
+	store := k.store(ctx, k.nodeKey)
+	store.Delete(types.DiscountPlanKey(plan.NodeID))
+	store.Delete(types.DiscountPlanByUntilKey(plan.Until, plan.NodeID))
+}
+
+func (k Keeper) GetDiscountPlansByUntil(ctx sdk.Context, height int64) (plans []types.DiscountPlan) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DiscountPlansByUntilKey(height))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var plan types.DiscountPlan
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &plan)
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
+
+func (k Keeper) SetNodeUnbonding(ctx sdk.Context, unbonding types.NodeUnbonding) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(unbonding)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(types.NodeUnbondingKey(unbonding.NodeID), value)
+	store.Set(types.NodeUnbondingByHeightKey(unbonding.CompletesAt, unbonding.NodeID), value)
+}
+
+func (k Keeper) GetNodeUnbonding(ctx sdk.Context, id hub.NodeID) (unbonding types.NodeUnbonding, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeUnbondingKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return unbonding, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &unbonding)
+	return unbonding, true
+}
+
+func (k Keeper) DeleteNodeUnbonding(ctx sdk.Context, unbonding types.NodeUnbonding) {
+	store := k.store(ctx, k.nodeKey)
+	store.Delete(types.NodeUnbondingKey(unbonding.NodeID))
+	store.Delete(types.NodeUnbondingByHeightKey(unbonding.CompletesAt, unbonding.NodeID))
+}
+
+func (k Keeper) GetNodeUnbondingsByHeight(ctx sdk.Context, height int64) (unbondings []types.NodeUnbonding) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeUnbondingsByHeightKey(height))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.NodeUnbonding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+func (k Keeper) GetAllNodeUnbondings(ctx sdk.Context) (unbondings []types.NodeUnbonding) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeUnbondingKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.NodeUnbonding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+func (k Keeper) SetBlacklistedClient(ctx sdk.Context, blacklisted types.BlacklistedClient) {
+	key := types.BlacklistedClientKey(blacklisted.NodeID, blacklisted.Client)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(blacklisted)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) HasBlacklistedClient(ctx sdk.Context, id hub.NodeID, client sdk.AccAddress) bool {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.BlacklistedClientKey(id, client)
+	return store.Has(key)
+}
+
+func (k Keeper) DeleteBlacklistedClient(ctx sdk.Context, id hub.NodeID, client sdk.AccAddress) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.BlacklistedClientKey(id, client)
+	store.Delete(key)
+}
+
 func (k Keeper) SetActiveNodeIDs(ctx sdk.Context, height int64, ids hub.IDs) {
 	ids = ids.Sort()
 
 	key := types.ActiveNodeIDsKey(height)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(ids)
 
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetActiveNodeIDs(ctx sdk.Context, height int64) (ids hub.IDs) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	key := types.ActiveNodeIDsKey(height)
 	value := store.Get(key)
@@ -114,7 +307,7 @@ func (k Keeper) GetActiveNodeIDs(ctx sdk.Context, height int64) (ids hub.IDs) {
 }
 
 func (k Keeper) DeleteActiveNodeIDs(ctx sdk.Context, height int64) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	key := types.ActiveNodeIDsKey(height)
 	store.Delete(key)
@@ -135,7 +328,7 @@ func (k Keeper) GetNodesOfAddress(ctx sdk.Context, address sdk.AccAddress) (node
 }
 
 func (k Keeper) GetAllNodes(ctx sdk.Context) (nodes []types.Node) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	iter := sdk.KVStorePrefixIterator(store, types.NodeKeyPrefix)
 	defer iter.Close()
@@ -150,7 +343,7 @@ func (k Keeper) GetAllNodes(ctx sdk.Context) (nodes []types.Node) {
 }
 
 func (k Keeper) IterateNodes(ctx sdk.Context, fn func(index int64, node types.Node) (stop bool)) {
-	store := ctx.KVStore(k.nodeKey)
+	store := k.store(ctx, k.nodeKey)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.NodeKeyPrefix)
 	defer iterator.Close()
@@ -189,3 +382,566 @@ func (k Keeper) RemoveNodeIDFromActiveList(ctx sdk.Context, height int64, id hub
 	ids = ids.Delete(index)
 	k.SetActiveNodeIDs(ctx, height, ids)
 }
+
+func (k Keeper) GetAllowedAddressesOfNode(ctx sdk.Context, id hub.NodeID) (addresses []sdk.AccAddress) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AllowedAddressesOfNodeKey(id))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var allowed types.AllowedAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &allowed)
+		addresses = append(addresses, allowed.Address)
+	}
+
+	return addresses
+}
+
+func (k Keeper) GetAllAllowedAddresses(ctx sdk.Context) (allowed []types.AllowedAddress) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AllowedAddressKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var _allowed types.AllowedAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &_allowed)
+		allowed = append(allowed, _allowed)
+	}
+
+	return allowed
+}
+
+func (k Keeper) GetAllFreeTrials(ctx sdk.Context) (trials []types.FreeTrial) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FreeTrialKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var trial types.FreeTrial
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &trial)
+		trials = append(trials, trial)
+	}
+
+	return trials
+}
+
+func (k Keeper) GetAllDiscountPlans(ctx sdk.Context) (plans []types.DiscountPlan) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DiscountPlanKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var plan types.DiscountPlan
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &plan)
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
+
+func (k Keeper) GetBlacklistedClientsOfNode(ctx sdk.Context, id hub.NodeID) (clients []sdk.AccAddress) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.BlacklistedClientsOfNodeKey(id))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var blacklisted types.BlacklistedClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &blacklisted)
+		clients = append(clients, blacklisted.Client)
+	}
+
+	return clients
+}
+
+func (k Keeper) GetAllBlacklistedClients(ctx sdk.Context) (blacklisted []types.BlacklistedClient) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.BlacklistedClientKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var _blacklisted types.BlacklistedClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &_blacklisted)
+		blacklisted = append(blacklisted, _blacklisted)
+	}
+
+	return blacklisted
+}
+
+// PaginateNodes returns a page of the nodes of the category, an empty category matches every node.
+func (k Keeper) PaginateNodes(ctx sdk.Context, category types.NodeCategory,
+	page hub.PageRequest) (nodes []types.Node, res hub.PageResponse) {
+	return k.PaginateFilteredNodes(ctx, func(node types.Node) bool {
+		return category == "" || node.Type == category
+	}, page)
+}
+
+// PaginateFilteredNodes paginates the nodes, in the order of their IDs, which match the filter.
+func (k Keeper) PaginateFilteredNodes(ctx sdk.Context, filter func(node types.Node) bool,
+	page hub.PageRequest) (nodes []types.Node, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.nodeKey), types.NodeKeyPrefix)
+
+	res = hub.FilteredPaginate(store, page, func(_, value []byte, accumulate bool) bool {
+		var node types.Node
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &node)
+		if !filter(node) {
+			return false
+		}
+
+		if accumulate {
+			nodes = append(nodes, node)
+		}
+
+		return true
+	})
+
+	return nodes, res
+}
+
+func (k Keeper) PaginateNodesOfAddress(ctx sdk.Context, address sdk.AccAddress, category types.NodeCategory,
+	page hub.PageRequest) (nodes []types.Node, res hub.PageResponse) {
+	// An empty address would be a prefix of the keys of every address
+	if address.Empty() {
+		return nil, res
+	}
+
+	store := prefix.NewStore(k.store(ctx, k.nodeKey), types.NodeIDsOfAddressKey(address))
+
+	res = hub.FilteredPaginate(store, page, func(_, value []byte, accumulate bool) bool {
+		var id hub.NodeID
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+
+		node, _ := k.GetNode(ctx, id)
+		if category != "" && node.Type != category {
+			return false
+		}
+
+		if accumulate {
+			nodes = append(nodes, node)
+		}
+
+		return true
+	})
+
+	return nodes, res
+}
+
+func (k Keeper) PaginateAllowedAddressesOfNode(ctx sdk.Context, id hub.NodeID,
+	page hub.PageRequest) (addresses []sdk.AccAddress, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.nodeKey), types.AllowedAddressesOfNodeKey(id))
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var allowed types.AllowedAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &allowed)
+		addresses = append(addresses, allowed.Address)
+	})
+
+	return addresses, res
+}
+
+func (k Keeper) PaginateBlacklistedClientsOfNode(ctx sdk.Context, id hub.NodeID,
+	page hub.PageRequest) (clients []sdk.AccAddress, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.nodeKey), types.BlacklistedClientsOfNodeKey(id))
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var blacklisted types.BlacklistedClient
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &blacklisted)
+		clients = append(clients, blacklisted.Client)
+	})
+
+	return clients, res
+}
+
+func (k Keeper) SetPendingPayout(ctx sdk.Context, payout types.PendingPayout) {
+	key := types.PendingPayoutKey(payout.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(payout)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetPendingPayout(ctx sdk.Context, id hub.NodeID) (payout types.PendingPayout, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.PendingPayoutKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return payout, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &payout)
+	return payout, true
+}
+
+func (k Keeper) DeletePendingPayout(ctx sdk.Context, id hub.NodeID) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.PendingPayoutKey(id)
+	store.Delete(key)
+}
+
+func (k Keeper) GetAllPendingPayouts(ctx sdk.Context) (payouts []types.PendingPayout) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingPayoutKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var payout types.PendingPayout
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &payout)
+		payouts = append(payouts, payout)
+	}
+
+	return payouts
+}
+
+func (k Keeper) SetUsedQuote(ctx sdk.Context, quote types.UsedQuote) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(quote)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(types.UsedQuoteKey(quote.NodeID, quote.Nonce), value)
+	store.Set(types.UsedQuoteByExpiryKey(quote.Expiry, quote.NodeID, quote.Nonce), value)
+}
+
+func (k Keeper) HasUsedQuote(ctx sdk.Context, id hub.NodeID, nonce uint64) bool {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.UsedQuoteKey(id, nonce)
+	return store.Has(key)
+}
+
+func (k Keeper) DeleteUsedQuote(ctx sdk.Context, quote types.UsedQuote) {
+	store := k.store(ctx, k.nodeKey)
+	store.Delete(types.UsedQuoteKey(quote.NodeID, quote.Nonce))
+	store.Delete(types.UsedQuoteByExpiryKey(quote.Expiry, quote.NodeID, quote.Nonce))
+}
+
+func (k Keeper) GetUsedQuotesByExpiry(ctx sdk.Context, height int64) (quotes []types.UsedQuote) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.UsedQuotesByExpiryKey(height))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var quote types.UsedQuote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &quote)
+		quotes = append(quotes, quote)
+	}
+
+	return quotes
+}
+
+func (k Keeper) GetAllUsedQuotes(ctx sdk.Context) (quotes []types.UsedQuote) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.UsedQuoteKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var quote types.UsedQuote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &quote)
+		quotes = append(quotes, quote)
+	}
+
+	return quotes
+}
+
+func (k Keeper) SetFreeUpdatesCount(ctx sdk.Context, id hub.NodeID, count uint64) {
+	key := types.FreeUpdatesCountKey(ctx.BlockHeight(), id)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFreeUpdatesCount(ctx sdk.Context, id hub.NodeID) (count uint64) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.FreeUpdatesCountKey(ctx.BlockHeight(), id)
+	value := store.Get(key)
+	if value == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &count)
+	return count
+}
+
+func (k Keeper) DeleteFreeUpdatesCounts(ctx sdk.Context, height int64) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FreeUpdatesCountsKey(height))
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetNodeStats(ctx sdk.Context, stats types.NodeStats) {
+	key := types.NodeStatsKey(stats.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(stats)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeStats(ctx sdk.Context, id hub.NodeID) (stats types.NodeStats, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeStatsKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return stats, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &stats)
+	return stats, true
+}
+
+func (k Keeper) GetAllNodeStats(ctx sdk.Context) (stats []types.NodeStats) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeStatsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var _stats types.NodeStats
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &_stats)
+		stats = append(stats, _stats)
+	}
+
+	return stats
+}
+
+// AddNodeStats adds the bandwidth, the earnings and the sessions to the cumulative counters of the node.
+func (k Keeper) AddNodeStats(ctx sdk.Context, id hub.NodeID,
+	bandwidth hub.Bandwidth, earnings sdk.Coins, sessions uint64) {
+	stats, found := k.GetNodeStats(ctx, id)
+	if !found {
+		stats = types.NewNodeStats(id)
+	}
+
+	stats.Bandwidth = stats.Bandwidth.Add(bandwidth)
+	stats.Earnings = stats.Earnings.Add(earnings)
+	stats.SessionsCount += sessions
+	k.SetNodeStats(ctx, stats)
+}
+
+func (k Keeper) SetNodeQoS(ctx sdk.Context, qos types.NodeQoS) {
+	key := types.NodeQoSKey(qos.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(qos)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeQoS(ctx sdk.Context, id hub.NodeID) (qos types.NodeQoS, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeQoSKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return qos, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &qos)
+	return qos, true
+}
+
+func (k Keeper) GetAllNodeQoS(ctx sdk.Context) (qos []types.NodeQoS) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeQoSKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var _qos types.NodeQoS
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &_qos)
+		qos = append(qos, _qos)
+	}
+
+	return qos
+}
+
+// AddNodeQoS weighs the reported speed and latency into the QoS summary of the node.
+func (k Keeper) AddNodeQoS(ctx sdk.Context, id hub.NodeID, speed hub.Bandwidth, latency uint64) {
+	qos, found := k.GetNodeQoS(ctx, id)
+	if !found {
+		qos = types.NewNodeQoS(id)
+	}
+
+	k.SetNodeQoS(ctx, qos.Add(speed, latency, ctx.BlockHeight()))
+}
+
+func (k Keeper) SetNodeStatsSnapshot(ctx sdk.Context, snapshot types.NodeStatsSnapshot) {
+	key := types.NodeStatsSnapshotKey(snapshot.Height, snapshot.Stats.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(snapshot)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeStatsSnapshot(ctx sdk.Context,
+	height int64, id hub.NodeID) (snapshot types.NodeStatsSnapshot, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeStatsSnapshotKey(height, id)
+	value := store.Get(key)
+	if value == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &snapshot)
+	return snapshot, true
+}
+
+func (k Keeper) GetAllNodeStatsSnapshots(ctx sdk.Context) (snapshots []types.NodeStatsSnapshot) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeStatsSnapshotKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.NodeStatsSnapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// SnapshotNodeStats copies the counters of all the nodes at the height.
+func (k Keeper) SnapshotNodeStats(ctx sdk.Context, height int64) {
+	for _, stats := range k.GetAllNodeStats(ctx) {
+		k.SetNodeStatsSnapshot(ctx, types.NewNodeStatsSnapshot(height, stats))
+	}
+}
+
+// DeleteNodeStatsSnapshots deletes the snapshots which are taken before the height.
+func (k Keeper) DeleteNodeStatsSnapshots(ctx sdk.Context, height int64) {
+	if height <= 0 {
+		return
+	}
+
+	store := k.store(ctx, k.nodeKey)
+
+	iter := store.Iterator(types.NodeStatsSnapshotKeyPrefix, types.NodeStatsSnapshotsKey(height))
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetNodeUptime(ctx sdk.Context, uptime types.NodeUptime) {
+	key := types.NodeUptimeKey(uptime.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(uptime)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeUptime(ctx sdk.Context, id hub.NodeID) (uptime types.NodeUptime, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeUptimeKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return uptime, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &uptime)
+	return uptime, true
+}
+
+func (k Keeper) DeleteNodeUptime(ctx sdk.Context, id hub.NodeID) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeUptimeKey(id)
+	store.Delete(key)
+}
+
+func (k Keeper) GetAllNodeUptimes(ctx sdk.Context) (uptimes []types.NodeUptime) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeUptimeKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var uptime types.NodeUptime
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &uptime)
+		uptimes = append(uptimes, uptime)
+	}
+
+	return uptimes
+}
+
+// AddNodeHeartbeat records a heartbeat of the registered node at the current height, the uptime
+// of a node which is registered before the uptime tracking starts from its first heartbeat.
+func (k Keeper) AddNodeHeartbeat(ctx sdk.Context, id hub.NodeID) {
+	uptime, found := k.GetNodeUptime(ctx, id)
+	if !found {
+		k.SetNodeUptime(ctx, types.NewNodeUptime(id, ctx.BlockHeight()))
+		return
+	}
+	if uptime.Until > 0 {
+		return
+	}
+
+	k.SetNodeUptime(ctx, uptime.Heartbeat(ctx.BlockHeight(), k.NodeInactiveInterval(ctx)))
+}
+
+// JailNode jails the node until the jail cooldown is over, a jailed node can not accept
+// the new subscriptions and sessions. The jails are counted in the stats of the node.
+func (k Keeper) JailNode(ctx sdk.Context, node types.Node) types.Node {
+	node.Jailed = true
+	node.JailedUntil = ctx.BlockHeight() + k.NodeJailCooldown(ctx)
+	k.SetNode(ctx, node)
+
+	stats, found := k.GetNodeStats(ctx, node.ID)
+	if !found {
+		stats = types.NewNodeStats(node.ID)
+	}
+
+	stats.JailsCount++
+	k.SetNodeStats(ctx, stats)
+
+	return node
+}
+
+// SetEnforcedMinNodeVersion records the minimum node version which the statuses of the nodes
+// are last updated for.
+func (k Keeper) SetEnforcedMinNodeVersion(ctx sdk.Context, version string) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(version)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(types.EnforcedMinNodeVersionKey, value)
+}
+
+func (k Keeper) GetEnforcedMinNodeVersion(ctx sdk.Context) (version string) {
+	store := k.store(ctx, k.nodeKey)
+
+	value := store.Get(types.EnforcedMinNodeVersionKey)
+	if value == nil {
+		return ""
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &version)
+	return version
+}
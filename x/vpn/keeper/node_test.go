@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -122,6 +123,52 @@ func TestKeeper_GetNodeIDByAddress(t *testing.T) {
 	TestKeeper_SetNodeIDByAddress(t)
 }
 
+func TestKeeper_SetAllowedAddress(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	found := k.HasAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.SetAllowedAddress(ctx, types.NewAllowedAddress(hub.NewNodeID(0), types.TestAddress2))
+	found = k.HasAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	found = k.HasAllowedAddress(ctx, hub.NewNodeID(1), types.TestAddress2)
+	require.Equal(t, false, found)
+	found = k.HasAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress1)
+	require.Equal(t, false, found)
+
+	k.DeleteAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress2)
+	found = k.HasAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_HasAllowedAddress(t *testing.T) {
+	TestKeeper_SetAllowedAddress(t)
+}
+
+func TestKeeper_SetBlacklistedClient(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	found := k.HasBlacklistedClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.SetBlacklistedClient(ctx, types.NewBlacklistedClient(hub.NewNodeID(0), types.TestAddress2))
+	found = k.HasBlacklistedClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	found = k.HasBlacklistedClient(ctx, hub.NewNodeID(1), types.TestAddress2)
+	require.Equal(t, false, found)
+	found = k.HasAllowedAddress(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.DeleteBlacklistedClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	found = k.HasBlacklistedClient(ctx, hub.NewNodeID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_HasBlacklistedClient(t *testing.T) {
+	TestKeeper_SetBlacklistedClient(t)
+}
+
 func TestKeeper_SetActiveNodeIDs(t *testing.T) {
 	ctx, k, _, _ := CreateTestInput(t, false)
 
@@ -235,6 +282,45 @@ func TestKeeper_GetNodesOfAddress(t *testing.T) {
 	require.Equal(t, append([]types.Node{types.TestNode}, node), nodes)
 }
 
+func TestKeeper_PaginateNodesOfAddress(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	nodes, res := k.PaginateNodesOfAddress(ctx, types.TestAddress1, "", hub.PageRequest{})
+	require.Equal(t, []types.Node(nil), nodes)
+	require.Equal(t, hub.PageResponse{}, res)
+
+	node := types.TestNode
+	node.ID = hub.NewNodeID(1)
+	node.Type = types.NodeCategoryV2Ray
+	k.SetNode(ctx, types.TestNode)
+	k.SetNode(ctx, node)
+	k.SetNodeIDByAddress(ctx, types.TestAddress1, 0, types.TestNode.ID)
+	k.SetNodeIDByAddress(ctx, types.TestAddress1, 1, node.ID)
+	k.SetNodesCountOfAddress(ctx, types.TestAddress1, 2)
+
+	nodes, res = k.PaginateNodesOfAddress(ctx, types.TestAddress2, "", hub.PageRequest{})
+	require.Equal(t, []types.Node(nil), nodes)
+
+	nodes, res = k.PaginateNodesOfAddress(ctx, types.TestAddress1, "", hub.NewPageRequest(nil, 1, true))
+	require.Equal(t, []types.Node{types.TestNode}, nodes)
+	require.Equal(t, hub.PageResponse{NextKey: sdk.Uint64ToBigEndian(1), Total: 2}, res)
+
+	nodes, res = k.PaginateNodesOfAddress(ctx, types.TestAddress1, "", hub.NewPageRequest(res.NextKey, 1, false))
+	require.Equal(t, []types.Node{node}, nodes)
+	require.Equal(t, hub.PageResponse{}, res)
+
+	nodes, res = k.PaginateNodesOfAddress(ctx, types.TestAddress1, types.NodeCategoryV2Ray, hub.NewPageRequest(nil, 0, true))
+	require.Equal(t, []types.Node{node}, nodes)
+	require.Equal(t, hub.PageResponse{Total: 1}, res)
+
+	nodes, res = k.PaginateNodes(ctx, types.NodeCategoryWireGuard, hub.NewPageRequest(nil, 0, true))
+	require.Equal(t, []types.Node{types.TestNode}, nodes)
+	require.Equal(t, hub.PageResponse{Total: 1}, res)
+
+	nodes, res = k.PaginateNodes(ctx, types.NodeCategoryOpenVPN, hub.PageRequest{})
+	require.Equal(t, []types.Node(nil), nodes)
+}
+
 func TestKeeper_GetAllNodes(t *testing.T) {
 	ctx, k, _, _ := CreateTestInput(t, false)
 
@@ -297,3 +383,125 @@ func TestKeeper_RemoveNodeIDFromActiveList(t *testing.T) {
 	ids = k.GetActiveNodeIDs(ctx, 2)
 	require.Equal(t, hub.IDs(nil), ids)
 }
+
+func TestKeeper_GetAllowedAddressesOfNode(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	addresses := k.GetAllowedAddressesOfNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, []sdk.AccAddress(nil), addresses)
+
+	k.SetAllowedAddress(ctx, types.NewAllowedAddress(hub.NewNodeID(0), types.TestAddress2))
+	k.SetAllowedAddress(ctx, types.NewAllowedAddress(hub.NewNodeID(1), types.TestAddress1))
+
+	addresses = k.GetAllowedAddressesOfNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, []sdk.AccAddress{types.TestAddress2}, addresses)
+	addresses = k.GetAllowedAddressesOfNode(ctx, hub.NewNodeID(1))
+	require.Equal(t, []sdk.AccAddress{types.TestAddress1}, addresses)
+
+	allowed := k.GetAllAllowedAddresses(ctx)
+	require.Len(t, allowed, 2)
+}
+
+func TestKeeper_GetBlacklistedClientsOfNode(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	clients := k.GetBlacklistedClientsOfNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, []sdk.AccAddress(nil), clients)
+
+	k.SetBlacklistedClient(ctx, types.NewBlacklistedClient(hub.NewNodeID(0), types.TestAddress2))
+	k.SetBlacklistedClient(ctx, types.NewBlacklistedClient(hub.NewNodeID(1), types.TestAddress1))
+
+	clients = k.GetBlacklistedClientsOfNode(ctx, hub.NewNodeID(0))
+	require.Equal(t, []sdk.AccAddress{types.TestAddress2}, clients)
+
+	blacklisted := k.GetAllBlacklistedClients(ctx)
+	require.Len(t, blacklisted, 2)
+}
+
+func TestKeeper_SetFreeUpdatesCount(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx, hub.NewNodeID(0)))
+
+	k.SetFreeUpdatesCount(ctx, hub.NewNodeID(0), 2)
+	k.SetFreeUpdatesCount(ctx, hub.NewNodeID(1), 1)
+	require.Equal(t, uint64(2), k.GetFreeUpdatesCount(ctx, hub.NewNodeID(0)))
+	require.Equal(t, uint64(1), k.GetFreeUpdatesCount(ctx, hub.NewNodeID(1)))
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx.WithBlockHeight(11), hub.NewNodeID(0)))
+
+	k.SetFreeUpdatesCount(ctx.WithBlockHeight(11), hub.NewNodeID(0), 3)
+	k.DeleteFreeUpdatesCounts(ctx, 10)
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx, hub.NewNodeID(0)))
+	require.Equal(t, uint64(0), k.GetFreeUpdatesCount(ctx, hub.NewNodeID(1)))
+	require.Equal(t, uint64(3), k.GetFreeUpdatesCount(ctx.WithBlockHeight(11), hub.NewNodeID(0)))
+}
+
+func TestKeeper_AddNodeStats(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetNodeStats(ctx, hub.NewNodeID(0))
+	require.False(t, found)
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 200), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(0, 0), sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 0)
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(50, 50), sdk.Coins{sdk.NewInt64Coin("stake", 5)}, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(10, 10), nil, 1)
+
+	stats, found := k.GetNodeStats(ctx, hub.NewNodeID(0))
+	require.True(t, found)
+	require.True(t, stats.Bandwidth.AllEqual(hub.NewBandwidthFromInt64(150, 250)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 15)}, stats.Earnings)
+	require.Equal(t, uint64(2), stats.SessionsCount)
+
+	require.Len(t, k.GetAllNodeStats(ctx), 2)
+}
+
+func TestKeeper_SnapshotNodeStats(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 100), nil, 1)
+	k.SnapshotNodeStats(ctx, 10)
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(50, 50), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(10, 10), nil, 1)
+	k.SnapshotNodeStats(ctx, 20)
+
+	snapshot, found := k.GetNodeStatsSnapshot(ctx, 10, hub.NewNodeID(0))
+	require.True(t, found)
+	require.True(t, snapshot.Stats.Bandwidth.AllEqual(hub.NewBandwidthFromInt64(100, 100)))
+	_, found = k.GetNodeStatsSnapshot(ctx, 10, hub.NewNodeID(1))
+	require.False(t, found)
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 3)
+
+	k.DeleteNodeStatsSnapshots(ctx, -10)
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 3)
+
+	k.DeleteNodeStatsSnapshots(ctx, 20)
+	_, found = k.GetNodeStatsSnapshot(ctx, 10, hub.NewNodeID(0))
+	require.False(t, found)
+	require.Len(t, k.GetAllNodeStatsSnapshots(ctx), 2)
+}
+
+func TestKeeper_SetNodeUnbonding(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetNodeUnbonding(ctx, hub.NewNodeID(0))
+	require.False(t, found)
+
+	unbonding := types.NewNodeUnbonding(hub.NewNodeID(0), types.TestAddress1, sdk.NewInt64Coin("stake", 100), 10)
+	k.SetNodeUnbonding(ctx, unbonding)
+	k.SetNodeUnbonding(ctx, types.NewNodeUnbonding(hub.NewNodeID(1), types.TestAddress2, sdk.NewInt64Coin("stake", 50), 20))
+
+	result, found := k.GetNodeUnbonding(ctx, hub.NewNodeID(0))
+	require.True(t, found)
+	require.Equal(t, unbonding, result)
+	require.Equal(t, []types.NodeUnbonding{unbonding}, k.GetNodeUnbondingsByHeight(ctx, 10))
+	require.Len(t, k.GetNodeUnbondingsByHeight(ctx, 15), 0)
+	require.Len(t, k.GetAllNodeUnbondings(ctx), 2)
+
+	k.DeleteNodeUnbonding(ctx, unbonding)
+	_, found = k.GetNodeUnbonding(ctx, hub.NewNodeID(0))
+	require.False(t, found)
+	require.Len(t, k.GetNodeUnbondingsByHeight(ctx, 10), 0)
+	require.Len(t, k.GetAllNodeUnbondings(ctx), 1)
+}
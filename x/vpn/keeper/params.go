@@ -30,11 +30,165 @@ func (k Keeper) SessionInactiveInterval(ctx sdk.Context) (res int64) {
 	return
 }
 
+func (k Keeper) SessionPruningRetention(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySessionPruningRetention, &res)
+	return
+}
+
+func (k Keeper) PruneGasRefund(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.KeyPruneGasRefund, &res)
+	return
+}
+
+func (k Keeper) MinClientVersion(ctx sdk.Context) (res string) {
+	k.paramStore.Get(ctx, types.KeyMinClientVersion, &res)
+	return
+}
+
+func (k Keeper) MaintenanceBanners(ctx sdk.Context) (res []string) {
+	k.paramStore.Get(ctx, types.KeyMaintenanceBanners, &res)
+	return
+}
+
+func (k Keeper) SettlementInterval(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySettlementInterval, &res)
+	return
+}
+
+func (k Keeper) SettlementEpoch(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySettlementEpoch, &res)
+	return
+}
+
+func (k Keeper) ProtocolFeeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.KeyProtocolFeeRate, &res)
+	return
+}
+
+// FreeUpdatesPerBlock is read by the ante handler, so it is allowed to be unset before the genesis
+// of the module is initialized.
+func (k Keeper) FreeUpdatesPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramStore.GetIfExists(ctx, types.KeyFreeUpdatesPerBlock, &res)
+	return
+}
+
+func (k Keeper) SubscriptionGCEpoch(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySubscriptionGCEpoch, &res)
+	return
+}
+
+func (k Keeper) SubscriptionGCRetention(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySubscriptionGCRetention, &res)
+	return
+}
+
+func (k Keeper) SettlementGracePeriod(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeySettlementGracePeriod, &res)
+	return
+}
+
+func (k Keeper) NodeStatsEpoch(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyNodeStatsEpoch, &res)
+	return
+}
+
+func (k Keeper) NodeStatsRetention(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyNodeStatsRetention, &res)
+	return
+}
+
+func (k Keeper) NodeInactiveInterval(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyNodeInactiveInterval, &res)
+	return
+}
+
+func (k Keeper) NodeJailCooldown(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyNodeJailCooldown, &res)
+	return
+}
+
+// FeeDenoms is read by the ante handler for every transaction, so it is allowed to be unset
+// for the genesis transactions, which are delivered before the genesis of the module.
+func (k Keeper) FeeDenoms(ctx sdk.Context) (res []types.FeeDenom) {
+	k.paramStore.GetIfExists(ctx, types.KeyFeeDenoms, &res)
+	return
+}
+
+func (k Keeper) ReferralRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.KeyReferralRate, &res)
+	return
+}
+
+func (k Keeper) MinNodeVersion(ctx sdk.Context) (res string) {
+	k.paramStore.Get(ctx, types.KeyMinNodeVersion, &res)
+	return
+}
+
+func (k Keeper) NodeUnbondingPeriod(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyNodeUnbondingPeriod, &res)
+	return
+}
+
+func (k Keeper) NodeRewardRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.KeyNodeRewardRate, &res)
+	return
+}
+
+func (k Keeper) ReputationEpoch(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyReputationEpoch, &res)
+	return
+}
+
+func (k Keeper) DisputeWindow(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyDisputeWindow, &res)
+	return
+}
+
+func (k Keeper) DisputeTimeout(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyDisputeTimeout, &res)
+	return
+}
+
+func (k Keeper) EscrowTimeout(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyEscrowTimeout, &res)
+	return
+}
+
+func (k Keeper) EscrowReleaseEpoch(ctx sdk.Context) (res int64) {
+	k.paramStore.Get(ctx, types.KeyEscrowReleaseEpoch, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.FreeNodesCount(ctx),
 		k.Deposit(ctx),
 		k.SessionInactiveInterval(ctx),
+		k.SessionPruningRetention(ctx),
+		k.PruneGasRefund(ctx),
+		k.MinClientVersion(ctx),
+		k.MaintenanceBanners(ctx),
+		k.SettlementInterval(ctx),
+		k.SettlementEpoch(ctx),
+		k.ProtocolFeeRate(ctx),
+		k.FreeUpdatesPerBlock(ctx),
+		k.SubscriptionGCEpoch(ctx),
+		k.SubscriptionGCRetention(ctx),
+		k.SettlementGracePeriod(ctx),
+		k.NodeStatsEpoch(ctx),
+		k.NodeStatsRetention(ctx),
+		k.NodeInactiveInterval(ctx),
+		k.NodeJailCooldown(ctx),
+		k.FeeDenoms(ctx),
+		k.ReferralRate(ctx),
+		k.MinNodeVersion(ctx),
+		k.NodeUnbondingPeriod(ctx),
+		k.NodeRewardRate(ctx),
+		k.ReputationEpoch(ctx),
+		k.DisputeWindow(ctx),
+		k.DisputeTimeout(ctx),
+		k.EscrowTimeout(ctx),
+		k.EscrowReleaseEpoch(ctx),
 	)
 }
 
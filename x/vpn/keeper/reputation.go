@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func (k Keeper) SetNodeReputation(ctx sdk.Context, reputation types.NodeReputation) {
+	key := types.NodeReputationKey(reputation.NodeID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(reputation)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetNodeReputation(ctx sdk.Context, id hub.NodeID) (reputation types.NodeReputation, found bool) {
+	store := k.store(ctx, k.nodeKey)
+
+	key := types.NodeReputationKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return reputation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &reputation)
+	return reputation, true
+}
+
+func (k Keeper) GetAllNodeReputations(ctx sdk.Context) (reputations []types.NodeReputation) {
+	store := k.store(ctx, k.nodeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NodeReputationKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var reputation types.NodeReputation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &reputation)
+		reputations = append(reputations, reputation)
+	}
+
+	return reputations
+}
+
+// ComputeNodeReputation scores the node by its uptime percentage, its completed sessions, its rating
+// and its jails at the current height.
+func (k Keeper) ComputeNodeReputation(ctx sdk.Context, node types.Node) types.NodeReputation {
+	uptime, found := k.GetNodeUptime(ctx, node.ID)
+	if !found {
+		uptime = types.NodeUptime{NodeID: node.ID, Since: ctx.BlockHeight()}
+	}
+
+	stats, found := k.GetNodeStats(ctx, node.ID)
+	if !found {
+		stats = types.NewNodeStats(node.ID)
+	}
+
+	percentage := uptime.Percentage(ctx.BlockHeight(), k.NodeInactiveInterval(ctx))
+	return types.NewNodeReputation(node.ID, percentage, stats.SessionsCount, node.Rating,
+		stats.JailsCount, ctx.BlockHeight())
+}
+
+// UpdateNodeReputations scores the registered nodes, the de-registered nodes keep their last scores.
+func (k Keeper) UpdateNodeReputations(ctx sdk.Context) {
+	for _, node := range k.GetAllNodes(ctx) {
+		if node.Status == types.StatusRegistered {
+			k.SetNodeReputation(ctx, k.ComputeNodeReputation(ctx, node))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestKeeper_SetNodeReputation(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetNodeReputation(ctx, hub.NewNodeID(0))
+	require.Equal(t, false, found)
+
+	reputation := types.NewNodeReputation(hub.NewNodeID(0), sdk.OneDec(), 10, types.NodeRating{}, 0, 100)
+	k.SetNodeReputation(ctx, reputation)
+
+	result, found := k.GetNodeReputation(ctx, hub.NewNodeID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, reputation, result)
+
+	k.SetNodeReputation(ctx, types.NewNodeReputation(hub.NewNodeID(1), sdk.ZeroDec(), 0, types.NodeRating{}, 1, 100))
+	require.Len(t, k.GetAllNodeReputations(ctx), 2)
+}
+
+func TestKeeper_UpdateNodeReputations(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(120)
+
+	params := k.GetParams(ctx)
+	params.NodeInactiveInterval = 10
+	k.SetParams(ctx, params)
+
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	node.Rating = types.NodeRating{}.Add(5)
+	k.SetNode(ctx, node)
+
+	deregistered := types.TestNode
+	deregistered.ID = hub.NewNodeID(1)
+	k.SetNode(ctx, deregistered)
+
+	k.SetNodeUptime(ctx, types.NewNodeUptime(node.ID, 100))
+	k.AddNodeStats(ctx, node.ID, hub.NewBandwidthFromInt64(100, 100), nil, 100)
+	k.JailNode(ctx, node)
+
+	stats, _ := k.GetNodeStats(ctx, node.ID)
+	require.Equal(t, uint64(1), stats.JailsCount)
+
+	k.UpdateNodeReputations(ctx)
+
+	reputation, found := k.GetNodeReputation(ctx, node.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), reputation.Uptime)
+	require.Equal(t, uint64(100), reputation.Sessions)
+	require.Equal(t, node.Rating, reputation.Rating)
+	require.Equal(t, uint64(1), reputation.Jails)
+	require.Equal(t, sdk.NewDecWithPrec(325, 3), reputation.Score)
+	require.Equal(t, int64(120), reputation.UpdatedAt)
+
+	_, found = k.GetNodeReputation(ctx, deregistered.ID)
+	require.Equal(t, false, found)
+}
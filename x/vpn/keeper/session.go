@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -10,12 +11,12 @@ import (
 func (k Keeper) SetSessionsCount(ctx sdk.Context, count uint64) {
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 	store.Set(types.SessionsCountKey, value)
 }
 
 func (k Keeper) GetSessionsCount(ctx sdk.Context) (count uint64) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	value := store.Get(types.SessionsCountKey)
 	if value == nil {
@@ -26,16 +27,41 @@ func (k Keeper) GetSessionsCount(ctx sdk.Context) (count uint64) {
 	return count
 }
 
+func (k Keeper) SetProtocolFees(ctx sdk.Context, fees sdk.Coins) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(fees)
+
+	store := k.store(ctx, k.sessionKey)
+	store.Set(types.ProtocolFeesKey, value)
+}
+
+func (k Keeper) GetProtocolFees(ctx sdk.Context) (fees sdk.Coins) {
+	store := k.store(ctx, k.sessionKey)
+
+	value := store.Get(types.ProtocolFeesKey)
+	if value == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &fees)
+	return fees
+}
+
 func (k Keeper) SetSession(ctx sdk.Context, session types.Session) {
+	summary := k.GetNetworkSummary(ctx)
+	if _session, found := k.GetSession(ctx, session.ID); found {
+		summary = summary.RemoveSession(_session)
+	}
+	k.SetNetworkSummary(ctx, summary.AddSession(session))
+
 	key := types.SessionKey(session.ID)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(session)
 
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSession(ctx sdk.Context, id hub.SessionID) (session types.Session, found bool) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	key := types.SessionKey(id)
 	value := store.Get(key)
@@ -47,16 +73,43 @@ func (k Keeper) GetSession(ctx sdk.Context, id hub.SessionID) (session types.Ses
 	return session, true
 }
 
+func (k Keeper) HasSession(ctx sdk.Context, id hub.SessionID) bool {
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.SessionKey(id)
+	return store.Has(key)
+}
+
+// DeriveSessionID returns the first session ID of the index of the subscription which is not taken.
+func (k Keeper) DeriveSessionID(ctx sdk.Context, id hub.SubscriptionID, index uint64) hub.SessionID {
+	for nonce := uint64(0); ; nonce++ {
+		if _id := types.DeriveSessionID(id, index, nonce); !k.HasSession(ctx, _id) {
+			return _id
+		}
+	}
+}
+
+func (k Keeper) DeleteSession(ctx sdk.Context, id hub.SessionID) {
+	if session, found := k.GetSession(ctx, id); found {
+		k.SetNetworkSummary(ctx, k.GetNetworkSummary(ctx).RemoveSession(session))
+	}
+
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.SessionKey(id)
+	store.Delete(key)
+}
+
 func (k Keeper) SetSessionsCountOfSubscription(ctx sdk.Context, id hub.SubscriptionID, count uint64) {
 	key := types.SessionsCountOfSubscriptionKey(id)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSessionsCountOfSubscription(ctx sdk.Context, id hub.SubscriptionID) (count uint64) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	key := types.SessionsCountOfSubscriptionKey(id)
 	value := store.Get(key)
@@ -73,13 +126,13 @@ func (k Keeper) SetSessionIDBySubscriptionID(ctx sdk.Context, i hub.Subscription
 	key := types.SessionIDBySubscriptionIDKey(i, j)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
 
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSessionIDBySubscriptionID(ctx sdk.Context,
 	i hub.SubscriptionID, j uint64) (id hub.SessionID, found bool) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	key := types.SessionIDBySubscriptionIDKey(i, j)
 	value := store.Get(key)
@@ -91,18 +144,102 @@ func (k Keeper) GetSessionIDBySubscriptionID(ctx sdk.Context,
 	return id, true
 }
 
+func (k Keeper) DeleteSessionIDBySubscriptionID(ctx sdk.Context, i hub.SubscriptionID, j uint64) {
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.SessionIDBySubscriptionIDKey(i, j)
+	store.Delete(key)
+}
+
+// SetOngoingSessionIndex stores the index of the ongoing session of the address, so that the client and
+// each of the seats of the subscription hold a session of their own at the same time.
+func (k Keeper) SetOngoingSessionIndex(ctx sdk.Context, index types.SessionIndex) {
+	key := types.OngoingSessionIndexKey(index.SubscriptionID, index.Address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(index)
+
+	store := k.store(ctx, k.sessionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetOngoingSessionIndex(ctx sdk.Context,
+	id hub.SubscriptionID, address sdk.AccAddress) (index types.SessionIndex, found bool) {
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.OngoingSessionIndexKey(id, address)
+	value := store.Get(key)
+	if value == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &index)
+	return index, true
+}
+
+func (k Keeper) DeleteOngoingSessionIndex(ctx sdk.Context, id hub.SubscriptionID, address sdk.AccAddress) {
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.OngoingSessionIndexKey(id, address)
+	store.Delete(key)
+}
+
+func (k Keeper) GetOngoingSessionIndexesOfSubscription(ctx sdk.Context,
+	id hub.SubscriptionID) (indexes []types.SessionIndex) {
+	store := k.store(ctx, k.sessionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.OngoingSessionIndexesOfSubscriptionKey(id))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var index types.SessionIndex
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &index)
+		indexes = append(indexes, index)
+	}
+
+	return indexes
+}
+
+// GetNextSessionIndexOfSubscription returns the index of the next session of the subscription, which
+// follows both the ended sessions and the ongoing ones.
+func (k Keeper) GetNextSessionIndexOfSubscription(ctx sdk.Context, id hub.SubscriptionID) uint64 {
+	next := k.GetSessionsCountOfSubscription(ctx, id)
+	for _, index := range k.GetOngoingSessionIndexesOfSubscription(ctx, id) {
+		if index.Index >= next {
+			next = index.Index + 1
+		}
+	}
+
+	return next
+}
+
+// EndOngoingSession removes the session from the ongoing sessions of the subscription. The sessions
+// count is raised past the index of the session, as the sessions started after it are ongoing still.
+func (k Keeper) EndOngoingSession(ctx sdk.Context, id hub.SubscriptionID, sessionID hub.SessionID) {
+	for _, index := range k.GetOngoingSessionIndexesOfSubscription(ctx, id) {
+		if !index.SessionID.IsEqual(sessionID) {
+			continue
+		}
+
+		k.DeleteOngoingSessionIndex(ctx, id, index.Address)
+		if scs := k.GetSessionsCountOfSubscription(ctx, id); index.Index >= scs {
+			k.SetSessionsCountOfSubscription(ctx, id, index.Index+1)
+		}
+
+		return
+	}
+}
+
 func (k Keeper) SetActiveSessionIDs(ctx sdk.Context, height int64, ids hub.IDs) {
 	ids.Sort()
 
 	key := types.ActiveSessionIDsKey(height)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(ids)
 
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetActiveSessionIDs(ctx sdk.Context, height int64) (ids hub.IDs) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	key := types.ActiveSessionIDsKey(height)
 	value := store.Get(key)
@@ -115,7 +252,7 @@ func (k Keeper) GetActiveSessionIDs(ctx sdk.Context, height int64) (ids hub.IDs)
 }
 
 func (k Keeper) DeleteActiveSessionIDs(ctx sdk.Context, height int64) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	key := types.ActiveSessionIDsKey(height)
 	store.Delete(key)
@@ -126,7 +263,10 @@ func (k Keeper) GetSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID
 
 	sessions = make([]types.Session, 0, count)
 	for i := uint64(0); i < count; i++ {
-		_id, _ := k.GetSessionIDBySubscriptionID(ctx, id, i)
+		_id, found := k.GetSessionIDBySubscriptionID(ctx, id, i)
+		if !found {
+			continue
+		}
 
 		session, _ := k.GetSession(ctx, _id)
 		sessions = append(sessions, session)
@@ -136,7 +276,7 @@ func (k Keeper) GetSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID
 }
 
 func (k Keeper) GetAllSessions(ctx sdk.Context) (sessions []types.Session) {
-	store := ctx.KVStore(k.sessionKey)
+	store := k.store(ctx, k.sessionKey)
 
 	iter := sdk.KVStorePrefixIterator(store, types.SessionKeyPrefix)
 	defer iter.Close()
@@ -150,6 +290,26 @@ func (k Keeper) GetAllSessions(ctx sdk.Context) (sessions []types.Session) {
 	return sessions
 }
 
+// GetActiveSessions returns the sessions updated within the inactive interval, which are not timed out yet,
+// in the order of the heights they were updated at.
+func (k Keeper) GetActiveSessions(ctx sdk.Context) (sessions []types.Session) {
+	from := ctx.BlockHeight() - k.SessionInactiveInterval(ctx) + 1
+	if from < 0 {
+		from = 0
+	}
+
+	for height := from; height <= ctx.BlockHeight(); height++ {
+		for _, id := range k.GetActiveSessionIDs(ctx, height) {
+			session, found := k.GetSession(ctx, id.(hub.SessionID))
+			if found && session.Status == types.StatusActive {
+				sessions = append(sessions, session)
+			}
+		}
+	}
+
+	return sessions
+}
+
 func (k Keeper) AddSessionIDToActiveList(ctx sdk.Context, height int64, id hub.SessionID) {
 	ids := k.GetActiveSessionIDs(ctx, height)
 
@@ -173,3 +333,91 @@ func (k Keeper) RemoveSessionIDFromActiveList(ctx sdk.Context, height int64, id
 	ids = ids.Delete(index)
 	k.SetActiveSessionIDs(ctx, height, ids)
 }
+
+func (k Keeper) PaginateSessions(ctx sdk.Context,
+	page hub.PageRequest) (sessions []types.Session, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.sessionKey), types.SessionKeyPrefix)
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var session types.Session
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &session)
+		sessions = append(sessions, session)
+	})
+
+	return sessions, res
+}
+
+func (k Keeper) PaginateSessionsOfSubscription(ctx sdk.Context, id hub.SubscriptionID,
+	page hub.PageRequest) (sessions []types.Session, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.sessionKey), types.SessionIDsOfSubscriptionKey(id))
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var _id hub.SessionID
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &_id)
+
+		session, _ := k.GetSession(ctx, _id)
+		sessions = append(sessions, session)
+	})
+
+	return sessions, res
+}
+
+// SetDispute stores the dispute, and indexes it by its deadline while it is open.
+func (k Keeper) SetDispute(ctx sdk.Context, dispute types.Dispute) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(dispute)
+
+	store := k.store(ctx, k.sessionKey)
+	store.Set(types.DisputeKey(dispute.SessionID), value)
+	if dispute.IsOpen() {
+		store.Set(types.DisputeByDeadlineKey(dispute.Deadline, dispute.SessionID), value)
+	}
+}
+
+func (k Keeper) GetDispute(ctx sdk.Context, id hub.SessionID) (dispute types.Dispute, found bool) {
+	store := k.store(ctx, k.sessionKey)
+
+	key := types.DisputeKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return dispute, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &dispute)
+	return dispute, true
+}
+
+func (k Keeper) DeleteDispute(ctx sdk.Context, dispute types.Dispute) {
+	store := k.store(ctx, k.sessionKey)
+	store.Delete(types.DisputeKey(dispute.SessionID))
+	store.Delete(types.DisputeByDeadlineKey(dispute.Deadline, dispute.SessionID))
+}
+
+func (k Keeper) GetDisputesByDeadline(ctx sdk.Context, height int64) (disputes []types.Dispute) {
+	store := k.store(ctx, k.sessionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DisputesByDeadlineKey(height))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var dispute types.Dispute
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &dispute)
+		disputes = append(disputes, dispute)
+	}
+
+	return disputes
+}
+
+func (k Keeper) GetAllDisputes(ctx sdk.Context) (disputes []types.Dispute) {
+	store := k.store(ctx, k.sessionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DisputeKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var dispute types.Dispute
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &dispute)
+		disputes = append(disputes, dispute)
+	}
+
+	return disputes
+}
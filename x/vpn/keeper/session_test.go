@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -48,6 +49,40 @@ func TestKeeper_GetSession(t *testing.T) {
 	TestKeeper_SetNode(t)
 }
 
+func TestKeeper_DeriveSessionID(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	id := k.DeriveSessionID(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, types.DeriveSessionID(hub.NewSubscriptionID(0), 0, 0), id)
+	require.Equal(t, false, k.HasSession(ctx, id))
+
+	session := types.TestSession
+	session.ID = id
+	k.SetSession(ctx, session)
+	require.Equal(t, true, k.HasSession(ctx, id))
+
+	id = k.DeriveSessionID(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, types.DeriveSessionID(hub.NewSubscriptionID(0), 0, 1), id)
+	require.Equal(t, false, k.HasSession(ctx, id))
+}
+
+func TestKeeper_DeleteSession(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	k.DeleteSession(ctx, types.TestSession.ID)
+	_, found := k.GetSession(ctx, types.TestSession.ID)
+	require.Equal(t, false, found)
+
+	k.SetSession(ctx, types.TestSession)
+	k.DeleteSession(ctx, hub.NewSessionID(1))
+	_, found = k.GetSession(ctx, types.TestSession.ID)
+	require.Equal(t, true, found)
+
+	k.DeleteSession(ctx, types.TestSession.ID)
+	_, found = k.GetSession(ctx, types.TestSession.ID)
+	require.Equal(t, false, found)
+}
+
 func TestKeeper_SetSessionsCountOfSubscription(t *testing.T) {
 	ctx, k, _, _ := CreateTestInput(t, false)
 
@@ -102,6 +137,112 @@ func TestKeeper_GetSessionIDBySubscriptionID(t *testing.T) {
 	TestKeeper_SetSessionIDBySubscriptionID(t)
 }
 
+func TestKeeper_DeleteSessionIDBySubscriptionID(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	k.SetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 0, hub.NewSessionID(0))
+	k.SetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 1, hub.NewSessionID(1))
+
+	k.DeleteSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 0)
+	_, found := k.GetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, false, found)
+	id, found := k.GetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 1)
+	require.Equal(t, true, found)
+	require.Equal(t, hub.NewSessionID(1), id)
+}
+
+func TestKeeper_SetOngoingSessionIndex(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetOngoingSessionIndex(ctx, hub.NewSubscriptionID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	index, found := k.GetOngoingSessionIndex(ctx, hub.NewSubscriptionID(0), types.TestAddress2)
+	require.Equal(t, true, found)
+	require.Equal(t, types.TestSessionIndex, index)
+
+	_, found = k.GetOngoingSessionIndex(ctx, hub.NewSubscriptionID(0), types.TestAddress1)
+	require.Equal(t, false, found)
+	_, found = k.GetOngoingSessionIndex(ctx, hub.NewSubscriptionID(1), types.TestAddress2)
+	require.Equal(t, false, found)
+
+	k.DeleteOngoingSessionIndex(ctx, hub.NewSubscriptionID(0), types.TestAddress2)
+	_, found = k.GetOngoingSessionIndex(ctx, hub.NewSubscriptionID(0), types.TestAddress2)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_GetOngoingSessionIndex(t *testing.T) {
+	TestKeeper_SetOngoingSessionIndex(t)
+}
+
+func TestKeeper_DeleteOngoingSessionIndex(t *testing.T) {
+	TestKeeper_SetOngoingSessionIndex(t)
+}
+
+func TestKeeper_GetOngoingSessionIndexesOfSubscription(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	indexes := k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, []types.SessionIndex(nil), indexes)
+
+	index := types.TestSessionIndex
+	index.SubscriptionID = hub.NewSubscriptionID(1)
+	k.SetOngoingSessionIndex(ctx, index)
+	indexes = k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, []types.SessionIndex(nil), indexes)
+
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	index = types.TestSessionIndex
+	index.Index, index.SessionID, index.Address = 1, hub.NewSessionID(1), types.TestAddress1
+	k.SetOngoingSessionIndex(ctx, index)
+	indexes = k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Len(t, indexes, 2)
+	require.Contains(t, indexes, types.TestSessionIndex)
+	require.Contains(t, indexes, index)
+}
+
+func TestKeeper_GetNextSessionIndexOfSubscription(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	require.Equal(t, uint64(0), k.GetNextSessionIndexOfSubscription(ctx, hub.NewSubscriptionID(0)))
+
+	k.SetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0), 2)
+	require.Equal(t, uint64(2), k.GetNextSessionIndexOfSubscription(ctx, hub.NewSubscriptionID(0)))
+
+	index := types.TestSessionIndex
+	index.Index = 1
+	k.SetOngoingSessionIndex(ctx, index)
+	require.Equal(t, uint64(2), k.GetNextSessionIndexOfSubscription(ctx, hub.NewSubscriptionID(0)))
+
+	index.Index, index.Address = 3, types.TestAddress1
+	k.SetOngoingSessionIndex(ctx, index)
+	require.Equal(t, uint64(4), k.GetNextSessionIndexOfSubscription(ctx, hub.NewSubscriptionID(0)))
+	require.Equal(t, uint64(0), k.GetNextSessionIndexOfSubscription(ctx, hub.NewSubscriptionID(1)))
+}
+
+func TestKeeper_EndOngoingSession(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	first := types.TestSessionIndex
+	second := types.TestSessionIndex
+	second.Index, second.SessionID, second.Address = 1, hub.NewSessionID(1), types.TestAddress1
+	k.SetOngoingSessionIndex(ctx, first)
+	k.SetOngoingSessionIndex(ctx, second)
+
+	k.EndOngoingSession(ctx, hub.NewSubscriptionID(0), hub.NewSessionID(2))
+	require.Len(t, k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0)), 2)
+	require.Equal(t, uint64(0), k.GetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0)))
+
+	k.EndOngoingSession(ctx, hub.NewSubscriptionID(0), second.SessionID)
+	require.Equal(t, []types.SessionIndex{first}, k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0)))
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0)))
+
+	k.EndOngoingSession(ctx, hub.NewSubscriptionID(0), first.SessionID)
+	require.Equal(t, []types.SessionIndex(nil), k.GetOngoingSessionIndexesOfSubscription(ctx, hub.NewSubscriptionID(0)))
+	require.Equal(t, uint64(2), k.GetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0)))
+}
+
 func TestKeeper_SetActiveSessionIDs(t *testing.T) {
 	ctx, k, _, _ := CreateTestInput(t, false)
 
@@ -202,6 +343,11 @@ func TestKeeper_GetSessionsOfSubscription(t *testing.T) {
 	k.SetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0), 2)
 	sessions = k.GetSessionsOfSubscription(ctx, hub.NewSubscriptionID(0))
 	require.Equal(t, append([]types.Session{types.TestSession}, session), sessions)
+
+	k.DeleteSession(ctx, types.TestSession.ID)
+	k.DeleteSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 0)
+	sessions = k.GetSessionsOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, []types.Session{session}, sessions)
 }
 
 func TestKeeper_GetAllSessions(t *testing.T) {
@@ -225,6 +371,42 @@ func TestKeeper_GetAllSessions(t *testing.T) {
 	require.Equal(t, append([]types.Session{types.TestSession}, session), sessions)
 }
 
+func TestKeeper_GetActiveSessions(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(100)
+
+	sessions := k.GetActiveSessions(ctx)
+	require.Equal(t, []types.Session(nil), sessions)
+
+	session := types.TestSession
+	session.StatusModifiedAt = 99
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 99, session.ID)
+	sessions = k.GetActiveSessions(ctx)
+	require.Equal(t, []types.Session{session}, sessions)
+
+	expired := types.TestSession
+	expired.ID = hub.NewSessionID(1)
+	expired.StatusModifiedAt = 100 - k.SessionInactiveInterval(ctx)
+	k.SetSession(ctx, expired)
+	k.AddSessionIDToActiveList(ctx, expired.StatusModifiedAt, expired.ID)
+	sessions = k.GetActiveSessions(ctx)
+	require.Equal(t, []types.Session{session}, sessions)
+
+	latest := types.TestSession
+	latest.ID = hub.NewSessionID(2)
+	latest.StatusModifiedAt = 100
+	k.SetSession(ctx, latest)
+	k.AddSessionIDToActiveList(ctx, 100, latest.ID)
+	sessions = k.GetActiveSessions(ctx)
+	require.Equal(t, []types.Session{session, latest}, sessions)
+
+	latest.Status = types.StatusInactive
+	k.SetSession(ctx, latest)
+	sessions = k.GetActiveSessions(ctx)
+	require.Equal(t, []types.Session{session}, sessions)
+}
+
 func TestKeeper_AddSessionIDToActiveList(t *testing.T) {
 	ctx, k, _, _ := CreateTestInput(t, false)
 
@@ -269,3 +451,31 @@ func TestKeeper_RemoveSessionIDFromActiveList(t *testing.T) {
 	ids = k.GetActiveSessionIDs(ctx, 3)
 	require.Equal(t, hub.IDs(nil), ids)
 }
+
+func TestKeeper_SetDispute(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetDispute(ctx, hub.NewSessionID(0))
+	require.Equal(t, false, found)
+
+	dispute := types.NewDispute(types.TestSession, 0, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 10), 1, 100)
+	k.SetDispute(ctx, dispute)
+	result, found := k.GetDispute(ctx, hub.NewSessionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, dispute, result)
+	require.Equal(t, []types.Dispute{dispute}, k.GetDisputesByDeadline(ctx, 100))
+	require.Equal(t, []types.Dispute{dispute}, k.GetAllDisputes(ctx))
+
+	k.DeleteDispute(ctx, dispute)
+	dispute.Status = types.DisputeStatusRejected
+	dispute.StatusModifiedAt = 50
+	k.SetDispute(ctx, dispute)
+	require.Equal(t, []types.Dispute(nil), k.GetDisputesByDeadline(ctx, 100))
+	require.Equal(t, []types.Dispute{dispute}, k.GetAllDisputes(ctx))
+
+	k.DeleteDispute(ctx, dispute)
+	_, found = k.GetDispute(ctx, hub.NewSessionID(0))
+	require.Equal(t, false, found)
+	require.Equal(t, []types.Dispute(nil), k.GetAllDisputes(ctx))
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -10,12 +13,12 @@ import (
 func (k Keeper) SetSubscriptionsCount(ctx sdk.Context, count uint64) {
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(types.SubscriptionsCountKey, value)
 }
 
 func (k Keeper) GetSubscriptionsCount(ctx sdk.Context) (count uint64) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	value := store.Get(types.SubscriptionsCountKey)
 	if value == nil {
@@ -27,15 +30,21 @@ func (k Keeper) GetSubscriptionsCount(ctx sdk.Context) (count uint64) {
 }
 
 func (k Keeper) SetSubscription(ctx sdk.Context, subscription types.Subscription) {
+	summary := k.GetNetworkSummary(ctx)
+	if _subscription, found := k.GetSubscription(ctx, subscription.ID); found {
+		summary = summary.RemoveSubscription(_subscription)
+	}
+	k.SetNetworkSummary(ctx, summary.AddSubscription(subscription))
+
 	key := types.SubscriptionKey(subscription.ID)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(subscription)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSubscription(ctx sdk.Context, id hub.SubscriptionID) (subscription types.Subscription, found bool) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	key := types.SubscriptionKey(id)
 	value := store.Get(key)
@@ -47,16 +56,27 @@ func (k Keeper) GetSubscription(ctx sdk.Context, id hub.SubscriptionID) (subscri
 	return subscription, true
 }
 
+func (k Keeper) DeleteSubscription(ctx sdk.Context, id hub.SubscriptionID) {
+	if subscription, found := k.GetSubscription(ctx, id); found {
+		k.SetNetworkSummary(ctx, k.GetNetworkSummary(ctx).RemoveSubscription(subscription))
+	}
+
+	key := types.SubscriptionKey(id)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(key)
+}
+
 func (k Keeper) SetSubscriptionsCountOfNode(ctx sdk.Context, id hub.NodeID, count uint64) {
 	key := types.SubscriptionsCountOfNodeKey(id)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSubscriptionsCountOfNode(ctx sdk.Context, id hub.NodeID) (count uint64) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	key := types.SubscriptionsCountOfNodeKey(id)
 	value := store.Get(key)
@@ -72,12 +92,12 @@ func (k Keeper) SetSubscriptionIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint6
 	key := types.SubscriptionIDByNodeIDKey(i, j)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSubscriptionIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint64) (id hub.SubscriptionID, found bool) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	key := types.SubscriptionIDByNodeIDKey(i, j)
 	value := store.Get(key)
@@ -89,16 +109,23 @@ func (k Keeper) GetSubscriptionIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint6
 	return id, true
 }
 
+func (k Keeper) DeleteSubscriptionIDByNodeID(ctx sdk.Context, i hub.NodeID, j uint64) {
+	key := types.SubscriptionIDByNodeIDKey(i, j)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(key)
+}
+
 func (k Keeper) SetSubscriptionsCountOfAddress(ctx sdk.Context, address sdk.AccAddress, count uint64) {
 	key := types.SubscriptionsCountOfAddressKey(address)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(count)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSubscriptionsCountOfAddress(ctx sdk.Context, address sdk.AccAddress) (count uint64) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	key := types.SubscriptionsCountOfAddressKey(address)
 	value := store.Get(key)
@@ -114,13 +141,13 @@ func (k Keeper) SetSubscriptionIDByAddress(ctx sdk.Context, address sdk.AccAddre
 	key := types.SubscriptionIDByAddressKey(address, i)
 	value := k.cdc.MustMarshalBinaryLengthPrefixed(id)
 
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 	store.Set(key, value)
 }
 
 func (k Keeper) GetSubscriptionIDByAddress(ctx sdk.Context,
 	address sdk.AccAddress, i uint64) (id hub.SubscriptionID, found bool) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	key := types.SubscriptionIDByAddressKey(address, i)
 	value := store.Get(key)
@@ -132,12 +159,22 @@ func (k Keeper) GetSubscriptionIDByAddress(ctx sdk.Context,
 	return id, true
 }
 
+func (k Keeper) DeleteSubscriptionIDByAddress(ctx sdk.Context, address sdk.AccAddress, i uint64) {
+	key := types.SubscriptionIDByAddressKey(address, i)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(key)
+}
+
 func (k Keeper) GetSubscriptionsOfNode(ctx sdk.Context, id hub.NodeID) (subscriptions []types.Subscription) {
 	count := k.GetSubscriptionsCountOfNode(ctx, id)
 
 	subscriptions = make([]types.Subscription, 0, count)
 	for i := uint64(0); i < count; i++ {
-		_id, _ := k.GetSubscriptionIDByNodeID(ctx, id, i)
+		_id, found := k.GetSubscriptionIDByNodeID(ctx, id, i)
+		if !found {
+			continue
+		}
 
 		subscription, _ := k.GetSubscription(ctx, _id)
 		subscriptions = append(subscriptions, subscription)
@@ -152,7 +189,10 @@ func (k Keeper) GetSubscriptionsOfAddress(ctx sdk.Context,
 
 	subscriptions = make([]types.Subscription, 0, count)
 	for i := uint64(0); i < count; i++ {
-		id, _ := k.GetSubscriptionIDByAddress(ctx, address, i)
+		id, found := k.GetSubscriptionIDByAddress(ctx, address, i)
+		if !found {
+			continue
+		}
 
 		subscription, _ := k.GetSubscription(ctx, id)
 		subscriptions = append(subscriptions, subscription)
@@ -162,7 +202,7 @@ func (k Keeper) GetSubscriptionsOfAddress(ctx sdk.Context,
 }
 
 func (k Keeper) GetAllSubscriptions(ctx sdk.Context) (subscriptions []types.Subscription) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	iter := sdk.KVStorePrefixIterator(store, types.SubscriptionKeyPrefix)
 	defer iter.Close()
@@ -178,7 +218,7 @@ func (k Keeper) GetAllSubscriptions(ctx sdk.Context) (subscriptions []types.Subs
 
 func (k Keeper) IterateSubscriptions(ctx sdk.Context,
 	fn func(index int64, subscription types.Subscription) (stop bool)) {
-	store := ctx.KVStore(k.subscriptionKey)
+	store := k.store(ctx, k.subscriptionKey)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.SubscriptionKeyPrefix)
 	defer iterator.Close()
@@ -193,3 +233,492 @@ func (k Keeper) IterateSubscriptions(ctx sdk.Context,
 		i++
 	}
 }
+
+// IsSubscriptionInert reports whether the subscription has ended without ever having a
+// session, a seat or a consumption rate, so that nothing refers to it.
+func (k Keeper) IsSubscriptionInert(ctx sdk.Context, subscription types.Subscription) bool {
+	if subscription.Status != types.StatusInactive {
+		return false
+	}
+	if k.GetSessionsCountOfSubscription(ctx, subscription.ID) > 0 {
+		return false
+	}
+	if _, found := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, 0); found {
+		return false
+	}
+	if len(k.GetSeatsOfSubscription(ctx, subscription.ID)) > 0 {
+		return false
+	}
+	if _, found := k.GetConsumptionRate(ctx, subscription.ID); found {
+		return false
+	}
+
+	return true
+}
+
+// IsEscrowStranded reports whether the remaining deposit of the active subscription is locked with
+// neither a registered node nor an ongoing session since before the height, so that it can not be
+// spent anymore. The subscriptions which are already ending are not stranded.
+func (k Keeper) IsEscrowStranded(ctx sdk.Context, subscription types.Subscription, height int64) bool {
+	if subscription.Status != types.StatusActive || subscription.Trial || !subscription.RemainingDeposit.IsPositive() {
+		return false
+	}
+	if subscription.StatusModifiedAt >= height {
+		return false
+	}
+	if _, found := k.GetPendingSettlement(ctx, subscription.ID); found {
+		return false
+	}
+
+	node, found := k.GetNode(ctx, subscription.NodeID)
+	if found && (node.Status == types.StatusRegistered || node.StatusModifiedAt >= height) {
+		return false
+	}
+
+	if len(k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID)) > 0 {
+		return false
+	}
+
+	scs := k.GetSessionsCountOfSubscription(ctx, subscription.ID)
+	if scs > 0 {
+		id, _ := k.GetSessionIDBySubscriptionID(ctx, subscription.ID, scs-1)
+		if session, found := k.GetSession(ctx, id); found && session.StatusModifiedAt >= height {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RemoveSubscription deletes the subscription and its entries in the lists of the node and the
+// client. The counts of the lists are not decreased, as they are the indexes of the next entries.
+func (k Keeper) RemoveSubscription(ctx sdk.Context, subscription types.Subscription) {
+	count := k.GetSubscriptionsCountOfNode(ctx, subscription.NodeID)
+	for i := uint64(0); i < count; i++ {
+		id, found := k.GetSubscriptionIDByNodeID(ctx, subscription.NodeID, i)
+		if found && id.IsEqual(subscription.ID) {
+			k.DeleteSubscriptionIDByNodeID(ctx, subscription.NodeID, i)
+			break
+		}
+	}
+
+	count = k.GetSubscriptionsCountOfAddress(ctx, subscription.Client)
+	for i := uint64(0); i < count; i++ {
+		id, found := k.GetSubscriptionIDByAddress(ctx, subscription.Client, i)
+		if found && id.IsEqual(subscription.ID) {
+			k.DeleteSubscriptionIDByAddress(ctx, subscription.Client, i)
+			break
+		}
+	}
+
+	k.DeleteSubscription(ctx, subscription.ID)
+}
+
+func (k Keeper) SetSeat(ctx sdk.Context, seat types.Seat) {
+	key := types.SeatKey(seat.SubscriptionID, seat.Index)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(seat)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetSeat(ctx sdk.Context, id hub.SubscriptionID, i uint64) (seat types.Seat, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.SeatKey(id, i)
+	value := store.Get(key)
+	if value == nil {
+		return seat, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &seat)
+	return seat, true
+}
+
+func (k Keeper) DeleteSeat(ctx sdk.Context, id hub.SubscriptionID, i uint64) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.SeatKey(id, i)
+	store.Delete(key)
+}
+
+func (k Keeper) SetSeatIndexByAddress(ctx sdk.Context, id hub.SubscriptionID, address sdk.AccAddress, i uint64) {
+	key := types.SeatIndexByAddressKey(id, address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(i)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetSeatIndexByAddress(ctx sdk.Context,
+	id hub.SubscriptionID, address sdk.AccAddress) (i uint64, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.SeatIndexByAddressKey(id, address)
+	value := store.Get(key)
+	if value == nil {
+		return 0, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &i)
+	return i, true
+}
+
+func (k Keeper) DeleteSeatIndexByAddress(ctx sdk.Context, id hub.SubscriptionID, address sdk.AccAddress) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.SeatIndexByAddressKey(id, address)
+	store.Delete(key)
+}
+
+func (k Keeper) GetSeatsOfSubscription(ctx sdk.Context, id hub.SubscriptionID) (seats []types.Seat) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SeatsOfSubscriptionKey(id))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var seat types.Seat
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &seat)
+		seats = append(seats, seat)
+	}
+
+	return seats
+}
+
+func (k Keeper) GetAllSeats(ctx sdk.Context) (seats []types.Seat) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SeatKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var seat types.Seat
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &seat)
+		seats = append(seats, seat)
+	}
+
+	return seats
+}
+
+func (k Keeper) SetConsumptionRate(ctx sdk.Context, rate types.ConsumptionRate) {
+	key := types.ConsumptionRateKey(rate.SubscriptionID)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(rate)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetConsumptionRate(ctx sdk.Context, id hub.SubscriptionID) (rate types.ConsumptionRate, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.ConsumptionRateKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return rate, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &rate)
+	return rate, true
+}
+
+func (k Keeper) GetAllConsumptionRates(ctx sdk.Context) (rates []types.ConsumptionRate) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ConsumptionRateKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rate types.ConsumptionRate
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rate)
+		rates = append(rates, rate)
+	}
+
+	return rates
+}
+
+func (k Keeper) SetPendingSettlement(ctx sdk.Context, settlement types.PendingSettlement) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(settlement)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(types.PendingSettlementKey(settlement.SubscriptionID), value)
+	store.Set(types.PendingSettlementByHeightKey(settlement.Height, settlement.SubscriptionID), value)
+}
+
+func (k Keeper) GetPendingSettlement(ctx sdk.Context,
+	id hub.SubscriptionID) (settlement types.PendingSettlement, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.PendingSettlementKey(id)
+	value := store.Get(key)
+	if value == nil {
+		return settlement, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &settlement)
+	return settlement, true
+}
+
+func (k Keeper) DeletePendingSettlement(ctx sdk.Context, settlement types.PendingSettlement) {
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(types.PendingSettlementKey(settlement.SubscriptionID))
+	store.Delete(types.PendingSettlementByHeightKey(settlement.Height, settlement.SubscriptionID))
+}
+
+func (k Keeper) GetPendingSettlementsByHeight(ctx sdk.Context, height int64) (settlements []types.PendingSettlement) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingSettlementsByHeightKey(height))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.PendingSettlement
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &settlement)
+		settlements = append(settlements, settlement)
+	}
+
+	return settlements
+}
+
+func (k Keeper) GetAllPendingSettlements(ctx sdk.Context) (settlements []types.PendingSettlement) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingSettlementKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var settlement types.PendingSettlement
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &settlement)
+		settlements = append(settlements, settlement)
+	}
+
+	return settlements
+}
+
+func (k Keeper) SetReferralEarnings(ctx sdk.Context, earnings types.ReferralEarnings) {
+	key := types.ReferralEarningsKey(earnings.Address)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(earnings)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetReferralEarnings(ctx sdk.Context, address sdk.AccAddress) types.ReferralEarnings {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.ReferralEarningsKey(address)
+	value := store.Get(key)
+	if value == nil {
+		return types.NewReferralEarnings(address, sdk.Coins{})
+	}
+
+	var earnings types.ReferralEarnings
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &earnings)
+	return earnings
+}
+
+func (k Keeper) GetAllReferralEarnings(ctx sdk.Context) (earnings []types.ReferralEarnings) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ReferralEarningsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var _earnings types.ReferralEarnings
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &_earnings)
+		earnings = append(earnings, _earnings)
+	}
+
+	return earnings
+}
+
+func (k Keeper) SetFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	key := types.FeeAllowanceKey(allowance.Granter, allowance.Grantee)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(allowance)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (allowance types.FeeAllowance, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.FeeAllowanceKey(granter, grantee)
+	value := store.Get(key)
+	if value == nil {
+		return allowance, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &allowance)
+	return allowance, true
+}
+
+func (k Keeper) DeleteFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(key)
+}
+
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Context) (allowances []types.FeeAllowance) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.FeeAllowance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+
+	return allowances
+}
+
+func (k Keeper) SetAuthorization(ctx sdk.Context, authorization types.Authorization) {
+	key := types.AuthorizationKey(authorization.Granter, authorization.Grantee, authorization.MsgType)
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(authorization)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Set(key, value)
+}
+
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	msgType string) (authorization types.Authorization, found bool) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	key := types.AuthorizationKey(granter, grantee, msgType)
+	value := store.Get(key)
+	if value == nil {
+		return authorization, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &authorization)
+	return authorization, true
+}
+
+func (k Keeper) DeleteAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) {
+	key := types.AuthorizationKey(granter, grantee, msgType)
+
+	store := k.store(ctx, k.subscriptionKey)
+	store.Delete(key)
+}
+
+func (k Keeper) GetAuthorizations(ctx sdk.Context, granter, grantee sdk.AccAddress) (authorizations []types.Authorization) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AuthorizationsKey(granter, grantee))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var authorization types.Authorization
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &authorization)
+		authorizations = append(authorizations, authorization)
+	}
+
+	return authorizations
+}
+
+func (k Keeper) GetAllAuthorizations(ctx sdk.Context) (authorizations []types.Authorization) {
+	store := k.store(ctx, k.subscriptionKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AuthorizationKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var authorization types.Authorization
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &authorization)
+		authorizations = append(authorizations, authorization)
+	}
+
+	return authorizations
+}
+
+// AddReferralEarnings credits the coins to the referral earnings of the address.
+func (k Keeper) AddReferralEarnings(ctx sdk.Context, address sdk.AccAddress, coins sdk.Coins) {
+	earnings := k.GetReferralEarnings(ctx, address)
+	earnings.Earnings = earnings.Earnings.Add(coins)
+
+	k.SetReferralEarnings(ctx, earnings)
+}
+
+// UpdateConsumptionRate adds the bandwidth consumed at the current block to the rolling
+// consumption rate of the subscription, which starts from the height of the subscription.
+func (k Keeper) UpdateConsumptionRate(ctx sdk.Context, subscription types.Subscription, consumed sdk.Int) {
+	rate, found := k.GetConsumptionRate(ctx, subscription.ID)
+	if !found {
+		rate = types.NewConsumptionRate(subscription.ID, sdk.ZeroDec(), subscription.StatusModifiedAt, time.Time{})
+	}
+
+	k.SetConsumptionRate(ctx, rate.Update(consumed, ctx.BlockHeight(), ctx.BlockTime()))
+}
+
+func (k Keeper) PaginateSubscriptions(ctx sdk.Context,
+	page hub.PageRequest) (subscriptions []types.Subscription, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.subscriptionKey), types.SubscriptionKeyPrefix)
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var subscription types.Subscription
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &subscription)
+		subscriptions = append(subscriptions, subscription)
+	})
+
+	return subscriptions, res
+}
+
+// PaginateSubscriptionsOfNode pages through the subscriptions of the node, only the active ones if active is set.
+func (k Keeper) PaginateSubscriptionsOfNode(ctx sdk.Context, id hub.NodeID, active bool,
+	page hub.PageRequest) (subscriptions []types.Subscription, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.subscriptionKey), types.SubscriptionIDsOfNodeKey(id))
+
+	res = hub.FilteredPaginate(store, page, func(_, value []byte, accumulate bool) bool {
+		var _id hub.SubscriptionID
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &_id)
+
+		subscription, _ := k.GetSubscription(ctx, _id)
+		if active && subscription.Status != types.StatusActive {
+			return false
+		}
+
+		if accumulate {
+			subscriptions = append(subscriptions, subscription)
+		}
+
+		return true
+	})
+
+	return subscriptions, res
+}
+
+func (k Keeper) PaginateSubscriptionsOfAddress(ctx sdk.Context, address sdk.AccAddress,
+	page hub.PageRequest) (subscriptions []types.Subscription, res hub.PageResponse) {
+	// An empty address would be a prefix of the keys of every address
+	if address.Empty() {
+		return nil, res
+	}
+
+	store := prefix.NewStore(k.store(ctx, k.subscriptionKey), types.SubscriptionIDsOfAddressKey(address))
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var id hub.SubscriptionID
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &id)
+
+		subscription, _ := k.GetSubscription(ctx, id)
+		subscriptions = append(subscriptions, subscription)
+	})
+
+	return subscriptions, res
+}
+
+func (k Keeper) PaginateSeatsOfSubscription(ctx sdk.Context, id hub.SubscriptionID,
+	page hub.PageRequest) (seats []types.Seat, res hub.PageResponse) {
+	store := prefix.NewStore(k.store(ctx, k.subscriptionKey), types.SeatsOfSubscriptionKey(id))
+
+	res = hub.Paginate(store, page, func(_, value []byte) {
+		var seat types.Seat
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &seat)
+		seats = append(seats, seat)
+	})
+
+	return seats, res
+}
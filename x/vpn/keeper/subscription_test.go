@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -181,12 +183,14 @@ func TestKeeper_GetSubscriptionsOfNode(t *testing.T) {
 	require.Equal(t, []types.Subscription{}, subscriptions)
 
 	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSubscriptionIDByNodeID(ctx, hub.NewNodeID(0), 0, types.TestSubscription.ID)
 	k.SetSubscriptionsCountOfNode(ctx, hub.NewNodeID(0), 1)
 
 	subscriptions = k.GetSubscriptionsOfNode(ctx, hub.NewNodeID(0))
 	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
 
 	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSubscriptionIDByNodeID(ctx, hub.NewNodeID(0), 1, types.TestSubscription.ID)
 	k.SetSubscriptionsCountOfNode(ctx, hub.NewNodeID(0), 2)
 
 	subscriptions = k.GetSubscriptionsOfNode(ctx, hub.NewNodeID(0))
@@ -200,12 +204,14 @@ func TestKeeper_GetSubscriptionsOfAddress(t *testing.T) {
 	require.Equal(t, []types.Subscription{}, subscriptions)
 
 	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSubscriptionIDByNodeID(ctx, hub.NewNodeID(0), 0, types.TestSubscription.ID)
 	k.SetSubscriptionsCountOfNode(ctx, hub.NewNodeID(0), 1)
 
 	subscriptions = k.GetSubscriptionsOfNode(ctx, hub.NewNodeID(0))
 	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
 
 	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSubscriptionIDByNodeID(ctx, hub.NewNodeID(0), 1, types.TestSubscription.ID)
 	k.SetSubscriptionsCountOfNode(ctx, hub.NewNodeID(0), 2)
 
 	subscriptions = k.GetSubscriptionsOfNode(ctx, hub.NewNodeID(0))
@@ -232,3 +238,246 @@ func TestKeeper_GetAllSubscriptions(t *testing.T) {
 	subscriptions = k.GetAllSubscriptions(ctx)
 	require.Equal(t, append([]types.Subscription{types.TestSubscription}, subscription), subscriptions)
 }
+
+func TestKeeper_SetSeat(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetSeat(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, false, found)
+
+	seat := types.NewSeat(hub.NewSubscriptionID(0), 0, types.TestAddress1)
+	k.SetSeat(ctx, seat)
+	result, found := k.GetSeat(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, true, found)
+	require.Equal(t, seat, result)
+
+	_, found = k.GetSeat(ctx, hub.NewSubscriptionID(0), 1)
+	require.Equal(t, false, found)
+	_, found = k.GetSeat(ctx, hub.NewSubscriptionID(1), 0)
+	require.Equal(t, false, found)
+
+	k.DeleteSeat(ctx, hub.NewSubscriptionID(0), 0)
+	_, found = k.GetSeat(ctx, hub.NewSubscriptionID(0), 0)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_GetSeat(t *testing.T) {
+	TestKeeper_SetSeat(t)
+}
+
+func TestKeeper_SetSeatIndexByAddress(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetSeatIndexByAddress(ctx, hub.NewSubscriptionID(0), types.TestAddress1)
+	require.Equal(t, false, found)
+
+	k.SetSeatIndexByAddress(ctx, hub.NewSubscriptionID(0), types.TestAddress1, 2)
+	i, found := k.GetSeatIndexByAddress(ctx, hub.NewSubscriptionID(0), types.TestAddress1)
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(2), i)
+
+	_, found = k.GetSeatIndexByAddress(ctx, hub.NewSubscriptionID(1), types.TestAddress1)
+	require.Equal(t, false, found)
+
+	k.DeleteSeatIndexByAddress(ctx, hub.NewSubscriptionID(0), types.TestAddress1)
+	_, found = k.GetSeatIndexByAddress(ctx, hub.NewSubscriptionID(0), types.TestAddress1)
+	require.Equal(t, false, found)
+}
+
+func TestKeeper_GetSeatIndexByAddress(t *testing.T) {
+	TestKeeper_SetSeatIndexByAddress(t)
+}
+
+func TestKeeper_GetSeatsOfSubscription(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	seats := k.GetSeatsOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, []types.Seat(nil), seats)
+
+	seat1 := types.NewSeat(hub.NewSubscriptionID(0), 1, types.TestAddress1)
+	seat2 := types.NewSeat(hub.NewSubscriptionID(0), 0, types.TestAddress2)
+	seat3 := types.NewSeat(hub.NewSubscriptionID(1), 0, types.TestAddress1)
+	k.SetSeat(ctx, seat1)
+	k.SetSeat(ctx, seat2)
+	k.SetSeat(ctx, seat3)
+
+	seats = k.GetSeatsOfSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, []types.Seat{seat2, seat1}, seats)
+
+	seats = k.GetAllSeats(ctx)
+	require.Equal(t, []types.Seat{seat2, seat1, seat3}, seats)
+}
+
+func TestKeeper_SetConsumptionRate(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetConsumptionRate(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+
+	rate := types.NewConsumptionRate(hub.NewSubscriptionID(0), sdk.NewDec(10), 1, time.Unix(1000, 0).UTC())
+	k.SetConsumptionRate(ctx, rate)
+	result, found := k.GetConsumptionRate(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, rate, result)
+
+	_, found = k.GetConsumptionRate(ctx, hub.NewSubscriptionID(1))
+	require.Equal(t, false, found)
+
+	require.Equal(t, []types.ConsumptionRate{rate}, k.GetAllConsumptionRates(ctx))
+}
+
+func TestKeeper_GetConsumptionRate(t *testing.T) {
+	TestKeeper_SetConsumptionRate(t)
+}
+
+func TestKeeper_UpdateConsumptionRate(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	subscription := types.TestSubscription
+	subscription.StatusModifiedAt = 10
+
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(now)
+	k.UpdateConsumptionRate(ctx, subscription, sdk.NewInt(100))
+
+	rate, found := k.GetConsumptionRate(ctx, subscription.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewConsumptionRate(subscription.ID, sdk.NewDec(2), 20, now), rate)
+
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(now.Add(time.Second))
+	k.UpdateConsumptionRate(ctx, subscription, sdk.NewInt(50))
+
+	rate, found = k.GetConsumptionRate(ctx, subscription.ID)
+	require.Equal(t, true, found)
+	require.Equal(t, types.NewConsumptionRate(subscription.ID, sdk.NewDecWithPrec(116, 1), 21, now.Add(time.Second)), rate)
+}
+
+func TestKeeper_IsSubscriptionInert(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	subscription := types.TestSubscription
+	require.Equal(t, false, k.IsSubscriptionInert(ctx, subscription))
+
+	subscription.Status = types.StatusInactive
+	require.Equal(t, true, k.IsSubscriptionInert(ctx, subscription))
+
+	k.SetConsumptionRate(ctx, types.NewConsumptionRate(subscription.ID, sdk.NewDec(10), 1, time.Unix(1000, 0).UTC()))
+	require.Equal(t, false, k.IsSubscriptionInert(ctx, subscription))
+	require.Equal(t, true, k.IsSubscriptionInert(ctx, types.Subscription{ID: hub.NewSubscriptionID(1), Status: types.StatusInactive}))
+
+	k.SetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(1), 0, hub.NewSessionID(0))
+	require.Equal(t, false, k.IsSubscriptionInert(ctx, types.Subscription{ID: hub.NewSubscriptionID(1), Status: types.StatusInactive}))
+
+	k.SetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(2), 1)
+	require.Equal(t, false, k.IsSubscriptionInert(ctx, types.Subscription{ID: hub.NewSubscriptionID(2), Status: types.StatusInactive}))
+}
+
+func TestKeeper_RemoveSubscription(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	subscription := types.TestSubscription
+	for i := uint64(0); i < 2; i++ {
+		subscription.ID = hub.NewSubscriptionID(i)
+		k.SetSubscription(ctx, subscription)
+		k.SetSubscriptionIDByNodeID(ctx, subscription.NodeID, i, subscription.ID)
+		k.SetSubscriptionIDByAddress(ctx, subscription.Client, i, subscription.ID)
+	}
+	k.SetSubscriptionsCountOfNode(ctx, subscription.NodeID, 2)
+	k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, 2)
+
+	subscription.ID = hub.NewSubscriptionID(0)
+	k.RemoveSubscription(ctx, subscription)
+
+	_, found := k.GetSubscription(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	_, found = k.GetSubscriptionIDByNodeID(ctx, subscription.NodeID, 0)
+	require.Equal(t, false, found)
+	_, found = k.GetSubscriptionIDByAddress(ctx, subscription.Client, 0)
+	require.Equal(t, false, found)
+	require.Equal(t, uint64(2), k.GetSubscriptionsCountOfNode(ctx, subscription.NodeID))
+	require.Equal(t, uint64(2), k.GetSubscriptionsCountOfAddress(ctx, subscription.Client))
+
+	subscription.ID = hub.NewSubscriptionID(1)
+	require.Equal(t, []types.Subscription{subscription}, k.GetSubscriptionsOfNode(ctx, subscription.NodeID))
+	require.Equal(t, []types.Subscription{subscription}, k.GetSubscriptionsOfAddress(ctx, subscription.Client))
+}
+
+func TestKeeper_SetPendingSettlement(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	_, found := k.GetPendingSettlement(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+
+	settlement := types.NewPendingSettlement(hub.NewSubscriptionID(0), 10)
+	k.SetPendingSettlement(ctx, settlement)
+	result, found := k.GetPendingSettlement(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, true, found)
+	require.Equal(t, settlement, result)
+
+	k.SetPendingSettlement(ctx, types.NewPendingSettlement(hub.NewSubscriptionID(1), 20))
+	require.Equal(t, []types.PendingSettlement{settlement}, k.GetPendingSettlementsByHeight(ctx, 10))
+	require.Len(t, k.GetAllPendingSettlements(ctx), 2)
+
+	k.DeletePendingSettlement(ctx, settlement)
+	_, found = k.GetPendingSettlement(ctx, hub.NewSubscriptionID(0))
+	require.Equal(t, false, found)
+	require.Nil(t, k.GetPendingSettlementsByHeight(ctx, 10))
+	require.Equal(t, []types.PendingSettlement{types.NewPendingSettlement(hub.NewSubscriptionID(1), 20)},
+		k.GetAllPendingSettlements(ctx))
+}
+
+func TestKeeper_GetPendingSettlement(t *testing.T) {
+	TestKeeper_SetPendingSettlement(t)
+}
+
+func TestKeeper_IsEscrowStranded(t *testing.T) {
+	ctx, k, _, _ := CreateTestInput(t, false)
+
+	subscription := types.TestSubscription
+	require.Equal(t, true, k.IsEscrowStranded(ctx, subscription, 10))
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 0))
+
+	subscription.Status = types.StatusInactive
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	subscription = types.TestSubscription
+	subscription.Trial = true
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	subscription = types.TestSubscription
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 0)
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	subscription = types.TestSubscription
+	node := types.TestNode
+	k.SetNode(ctx, node)
+	require.Equal(t, true, k.IsEscrowStranded(ctx, subscription, 10))
+
+	node.StatusModifiedAt = 10
+	k.SetNode(ctx, node)
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	node.Status = types.StatusRegistered
+	node.StatusModifiedAt = 1
+	k.SetNode(ctx, node)
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSessionIDBySubscriptionID(ctx, subscription.ID, 0, hub.NewSessionID(0))
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	session := types.TestSession
+	session.Status = types.StatusInactive
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.EndOngoingSession(ctx, subscription.ID, session.ID)
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+
+	session.StatusModifiedAt = 5
+	k.SetSession(ctx, session)
+	require.Equal(t, true, k.IsEscrowStranded(ctx, subscription, 10))
+
+	k.SetPendingSettlement(ctx, types.NewPendingSettlement(subscription.ID, 20))
+	require.Equal(t, false, k.IsEscrowStranded(ctx, subscription, 10))
+}
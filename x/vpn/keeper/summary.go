@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func (k Keeper) SetNetworkSummary(ctx sdk.Context, summary types.NetworkSummary) {
+	value := k.cdc.MustMarshalBinaryLengthPrefixed(summary)
+
+	store := k.store(ctx, k.nodeKey)
+	store.Set(types.NetworkSummaryKey, value)
+}
+
+func (k Keeper) GetNetworkSummary(ctx sdk.Context) (summary types.NetworkSummary) {
+	store := k.store(ctx, k.nodeKey)
+
+	value := store.Get(types.NetworkSummaryKey)
+	if value == nil {
+		return types.NewNetworkSummary()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &summary)
+	if summary.LockedDeposits == nil {
+		summary.LockedDeposits = sdk.Coins{}
+	}
+
+	return summary
+}
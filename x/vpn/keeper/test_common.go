@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit
 	keyAccount := sdk.NewKVStoreKey(auth.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	keyDeposit := sdk.NewKVStoreKey(deposit.StoreKey)
+	keyDistribution := sdk.NewKVStoreKey(distribution.StoreKey)
 	keyNode := sdk.NewKVStoreKey(types.StoreKeyNode)
 	keySubscription := sdk.NewKVStoreKey(types.StoreKeySubscription)
 	keySession := sdk.NewKVStoreKey(types.StoreKeySession)
@@ -37,6 +39,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit
 	ms.MountStoreWithDB(keyAccount, sdk.StoreTypeIAVL, mdb)
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, mdb)
 	ms.MountStoreWithDB(keyDeposit, sdk.StoreTypeIAVL, mdb)
+	ms.MountStoreWithDB(keyDistribution, sdk.StoreTypeIAVL, mdb)
 	ms.MountStoreWithDB(keyNode, sdk.StoreTypeIAVL, mdb)
 	ms.MountStoreWithDB(keySubscription, sdk.StoreTypeIAVL, mdb)
 	ms.MountStoreWithDB(keySession, sdk.StoreTypeIAVL, mdb)
@@ -47,7 +50,9 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit
 	blacklist := make(map[string]bool)
 	blacklist[depositAccount.String()] = true
 	accountPermissions := map[string][]string{
-		deposit.ModuleName: nil,
+		auth.FeeCollectorName:   nil,
+		deposit.ModuleName:      nil,
+		distribution.ModuleName: nil,
 	}
 
 	cdc := MakeTestCodec()
@@ -58,9 +63,12 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, Keeper, deposit
 	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklist)
 	sk := supply.NewKeeper(cdc, keySupply, ak, bk, accountPermissions)
 	dk := deposit.NewKeeper(cdc, keyDeposit, sk)
-	vk := NewKeeper(cdc, keyNode, keySubscription, keySession, pk.Subspace(DefaultParamspace), dk)
+	distrk := distribution.NewKeeper(cdc, keyDistribution, pk.Subspace(distribution.DefaultParamspace), nil, sk,
+		distribution.DefaultCodespace, auth.FeeCollectorName, blacklist)
+	vk := NewKeeper(cdc, keyNode, keySubscription, keySession, pk.Subspace(DefaultParamspace), dk, distrk)
 
 	sk.SetModuleAccount(ctx, depositAccount)
+	distrk.SetFeePool(ctx, distribution.InitialFeePool())
 	vk.SetParams(ctx, types.DefaultParams())
 
 	return ctx, vk, dk, bk
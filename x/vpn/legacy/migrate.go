@@ -0,0 +1,106 @@
+package legacy
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func migrateBandwidth(b Bandwidth) hub.Bandwidth {
+	return hub.NewBandwidthFromInt64(b.Upload, b.Download)
+}
+
+func migrateNodeStatus(status string) (string, error) {
+	switch status {
+	case StatusRegistered:
+		return types.StatusRegistered, nil
+	case StatusDeRegistered:
+		return types.StatusDeRegistered, nil
+	default:
+		return "", fmt.Errorf("invalid node status %s", status)
+	}
+}
+
+// Migrate converts the vpn genesis of the previous chain to the current format. The block heights
+// of the previous chain are meaningless after the relaunch, so they are reset to zero and the
+// sessions which were in-flight at the export are closed.
+func Migrate(state GenesisState) (types.GenesisState, error) {
+	nodes := make([]types.Node, 0, len(state.Nodes))
+	for _, n := range state.Nodes {
+		status, err := migrateNodeStatus(n.Status)
+		if err != nil {
+			return types.GenesisState{}, err
+		}
+
+		// The types of the previous chain were free-form, only the ones of a known category are migrated
+		category, err := types.NewNodeCategoryFromString(n.NodeType)
+		if err != nil {
+			return types.GenesisState{}, err
+		}
+
+		nodes = append(nodes, types.Node{
+			ID:            hub.NewNodeID(n.ID),
+			Owner:         n.Owner,
+			Deposit:       n.LockedAmount,
+			Type:          category,
+			Version:       n.Version,
+			Moniker:       n.Moniker,
+			PricesPerGB:   n.PricesPerGB,
+			InternetSpeed: migrateBandwidth(n.NetSpeed),
+			Encryption:    n.EncMethod,
+			Status:        status,
+		})
+	}
+
+	denoms := make(map[uint64]string, len(state.Subscriptions))
+	subscriptions := make([]types.Subscription, 0, len(state.Subscriptions))
+	for _, s := range state.Subscriptions {
+		if s.Status != StatusActive && s.Status != StatusInactive {
+			return types.GenesisState{}, fmt.Errorf("invalid subscription status %s", s.Status)
+		}
+
+		denoms[s.ID] = s.PricePerGB.Denom
+		subscriptions = append(subscriptions, types.Subscription{
+			ID:                 hub.NewSubscriptionID(s.ID),
+			NodeID:             hub.NewNodeID(s.NodeID),
+			Client:             s.Client,
+			PricePerGB:         s.PricePerGB,
+			TotalDeposit:       s.TotalDeposit,
+			RemainingDeposit:   s.RemainingDeposit,
+			RemainingBandwidth: migrateBandwidth(s.RemainingBandwidth),
+			Status:             s.Status,
+		})
+	}
+
+	sessions := make([]types.Session, 0, len(state.Sessions))
+	for _, s := range state.Sessions {
+		denom, found := denoms[s.SubscriptionID]
+		if !found {
+			return types.GenesisState{}, fmt.Errorf("subscription %d of the session %d does not exist",
+				s.SubscriptionID, s.ID)
+		}
+
+		sessions = append(sessions, types.Session{
+			ID:             hub.NewSessionID(s.ID),
+			SubscriptionID: hub.NewSubscriptionID(s.SubscriptionID),
+			Bandwidth:      migrateBandwidth(s.Bandwidth),
+			Paid:           sdk.NewInt64Coin(denom, 0),
+			Status:         types.StatusInactive,
+		})
+	}
+
+	params := types.DefaultParams()
+	params.FreeNodesCount = state.Params.FreeNodesCount
+	params.Deposit = state.Params.Deposit
+	params.SessionInactiveInterval = state.Params.SessionInactiveInterval
+
+	return types.GenesisState{
+		Nodes:         nodes,
+		Subscriptions: subscriptions,
+		Sessions:      sessions,
+		Params:        params,
+	}, nil
+}
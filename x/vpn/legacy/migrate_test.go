@@ -0,0 +1,100 @@
+package legacy
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func TestMigrate(t *testing.T) {
+	state := GenesisState{
+		Nodes: []Node{{
+			ID:             5,
+			Owner:          types.TestAddress1,
+			LockedAmount:   sdk.NewInt64Coin("stake", 100),
+			PricesPerGB:    sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+			NetSpeed:       Bandwidth{Upload: 1024, Download: 2048},
+			EncMethod:      "encryption",
+			NodeType:       "openvpn",
+			Version:        "version",
+			Moniker:        "moniker",
+			Status:         StatusDeRegistered,
+			StatusAtHeight: 1000,
+		}},
+		Subscriptions: []Subscription{{
+			ID:                 7,
+			NodeID:             5,
+			Client:             types.TestAddress2,
+			PricePerGB:         sdk.NewInt64Coin("stake", 10),
+			TotalDeposit:       sdk.NewInt64Coin("stake", 100),
+			RemainingDeposit:   sdk.NewInt64Coin("stake", 50),
+			RemainingBandwidth: Bandwidth{Upload: 500, Download: 500},
+			Status:             StatusActive,
+			StatusAtHeight:     1000,
+		}},
+		Sessions: []Session{{
+			ID:             9,
+			SubscriptionID: 7,
+			Bandwidth:      Bandwidth{Upload: 100, Download: 200},
+			Status:         StatusActive,
+			StatusAtHeight: 1000,
+		}},
+		Params: Params{
+			FreeNodesCount:          3,
+			Deposit:                 sdk.NewInt64Coin("stake", 1000),
+			SessionInactiveInterval: 50,
+		},
+	}
+
+	migrated, err := Migrate(state)
+	require.Nil(t, err)
+
+	require.Len(t, migrated.Nodes, 1)
+	node := migrated.Nodes[0]
+	require.Equal(t, hub.NewNodeID(5), node.ID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), node.Deposit)
+	require.True(t, hub.NewBandwidthFromInt64(1024, 2048).AllEqual(node.InternetSpeed))
+	require.Equal(t, "encryption", node.Encryption)
+	require.Equal(t, types.NodeCategoryOpenVPN, node.Type)
+	require.Equal(t, types.StatusDeRegistered, node.Status)
+	require.Equal(t, int64(0), node.StatusModifiedAt)
+
+	require.Len(t, migrated.Subscriptions, 1)
+	subscription := migrated.Subscriptions[0]
+	require.Equal(t, hub.NewSubscriptionID(7), subscription.ID)
+	require.Equal(t, hub.NewNodeID(5), subscription.NodeID)
+	require.True(t, hub.NewBandwidthFromInt64(500, 500).AllEqual(subscription.RemainingBandwidth))
+	require.Equal(t, types.StatusActive, subscription.Status)
+	require.Nil(t, subscription.IsValid())
+
+	require.Len(t, migrated.Sessions, 1)
+	session := migrated.Sessions[0]
+	require.Equal(t, hub.NewSessionID(9), session.ID)
+	require.Equal(t, hub.NewSubscriptionID(7), session.SubscriptionID)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), session.Paid)
+	require.Equal(t, types.StatusInactive, session.Status)
+
+	require.Equal(t, uint64(3), migrated.Params.FreeNodesCount)
+	require.Equal(t, sdk.NewInt64Coin("stake", 1000), migrated.Params.Deposit)
+	require.Equal(t, int64(50), migrated.Params.SessionInactiveInterval)
+	require.Equal(t, types.DefaultNodeJailCooldown, migrated.Params.NodeJailCooldown)
+	require.Nil(t, migrated.Params.Validate())
+
+	state.Nodes[0].Status = "UNKNOWN"
+	_, err = Migrate(state)
+	require.NotNil(t, err)
+
+	state.Nodes[0].Status = StatusRegistered
+	state.Nodes[0].NodeType = "node_type"
+	_, err = Migrate(state)
+	require.NotNil(t, err)
+
+	state.Nodes[0].NodeType = "openvpn"
+	state.Sessions[0].SubscriptionID = 8
+	_, err = Migrate(state)
+	require.NotNil(t, err)
+}
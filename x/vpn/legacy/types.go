@@ -0,0 +1,66 @@
+// Package legacy holds the vpn genesis types of the previous, sentinel-sdk based chain and
+// migrates them to the current genesis format of the vpn module.
+package legacy
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	StatusRegistered   = "REGISTERED"
+	StatusDeRegistered = "DEREGISTERED"
+	StatusActive       = "ACTIVE"
+	StatusInactive     = "INACTIVE"
+)
+
+type Bandwidth struct {
+	Upload   int64 `json:"upload"`
+	Download int64 `json:"download"`
+}
+
+type Node struct {
+	ID             uint64         `json:"id"`
+	Owner          sdk.AccAddress `json:"owner"`
+	LockedAmount   sdk.Coin       `json:"locked_amount"`
+	PricesPerGB    sdk.Coins      `json:"prices_per_gb"`
+	NetSpeed       Bandwidth      `json:"net_speed"`
+	EncMethod      string         `json:"enc_method"`
+	NodeType       string         `json:"node_type"`
+	Version        string         `json:"version"`
+	Moniker        string         `json:"moniker"`
+	Status         string         `json:"status"`
+	StatusAtHeight int64          `json:"status_at_height"`
+}
+
+type Subscription struct {
+	ID                 uint64         `json:"id"`
+	NodeID             uint64         `json:"node_id"`
+	Client             sdk.AccAddress `json:"client"`
+	PricePerGB         sdk.Coin       `json:"price_per_gb"`
+	TotalDeposit       sdk.Coin       `json:"total_deposit"`
+	RemainingDeposit   sdk.Coin       `json:"remaining_deposit"`
+	RemainingBandwidth Bandwidth      `json:"remaining_bandwidth"`
+	Status             string         `json:"status"`
+	StatusAtHeight     int64          `json:"status_at_height"`
+}
+
+type Session struct {
+	ID             uint64    `json:"id"`
+	SubscriptionID uint64    `json:"subscription_id"`
+	Bandwidth      Bandwidth `json:"bandwidth"`
+	Status         string    `json:"status"`
+	StatusAtHeight int64     `json:"status_at_height"`
+}
+
+type Params struct {
+	FreeNodesCount          uint64   `json:"free_nodes_count"`
+	Deposit                 sdk.Coin `json:"deposit"`
+	SessionInactiveInterval int64    `json:"session_inactive_interval"`
+}
+
+type GenesisState struct {
+	Nodes         []Node         `json:"nodes"`
+	Subscriptions []Subscription `json:"subscriptions"`
+	Sessions      []Session      `json:"sessions"`
+	Params        Params         `json:"params"`
+}
@@ -79,7 +79,9 @@ func (a AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return ModuleCdc.MustMarshalJSON(state)
 }
 
-func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, a.keeper)
+}
 
 func (a AppModule) Route() string {
 	return RouterKey
@@ -0,0 +1,117 @@
+package vpn
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.ReleaseEscrowProposal:
+			return handleReleaseEscrowProposal(ctx, k, c)
+		case types.JailNodeProposal:
+			return handleJailNodeProposal(ctx, k, c)
+		case types.ResolveDisputeProposal:
+			return handleResolveDisputeProposal(ctx, k, c)
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized vpn proposal content type: %T", c))
+		}
+	}
+}
+
+// handleReleaseEscrowProposal sends the remaining deposit of the subscription to the recipient and ends
+// the subscription. The ongoing sessions are closed without the settlement, as the deposit is released.
+func handleReleaseEscrowProposal(ctx sdk.Context, k keeper.Keeper, p types.ReleaseEscrowProposal) sdk.Error {
+	subscription, found := k.GetSubscription(ctx, p.SubscriptionID)
+	if !found {
+		return types.ErrorSubscriptionDoesNotExist()
+	}
+	if subscription.RemainingDeposit.IsZero() {
+		return types.ErrorInvalidDeposit()
+	}
+
+	for _, ongoing := range k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID) {
+		session, _ := k.GetSession(ctx, ongoing.SessionID)
+		k.RemoveSessionIDFromActiveList(ctx, session.StatusModifiedAt, session.ID)
+
+		session.Status = types.StatusInactive
+		session.StatusModifiedAt = ctx.BlockHeight()
+		k.SetSession(ctx, session)
+		k.EndOngoingSession(ctx, subscription.ID, session.ID)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEndSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, session.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueReleaseEscrow),
+		))
+	}
+
+	if settlement, found := k.GetPendingSettlement(ctx, subscription.ID); found {
+		k.DeletePendingSettlement(ctx, settlement)
+	}
+
+	if err := k.SendDeposit(ctx, subscription.Client, p.Recipient, subscription.RemainingDeposit); err != nil {
+		return err
+	}
+
+	amount := subscription.RemainingDeposit
+
+	subscription.RemainingDeposit = sdk.NewInt64Coin(amount.Denom, 0)
+	subscription.Status = types.StatusInactive
+	subscription.StatusModifiedAt = ctx.BlockHeight()
+	k.SetSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeReleaseEscrow,
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyAddress, p.Recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+	emitDepositEvent(ctx, types.EventTypeSendDeposit, subscription.Client, sdk.Coins{amount},
+		sdk.NewAttribute(types.AttributeKeySubscriptionID, subscription.ID.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()))
+
+	return nil
+}
+
+func handleJailNodeProposal(ctx sdk.Context, k keeper.Keeper, p types.JailNodeProposal) sdk.Error {
+	node, found := k.GetNode(ctx, p.NodeID)
+	if !found {
+		return types.ErrorNodeDoesNotExist()
+	}
+	if node.Status != types.StatusRegistered {
+		return types.ErrorInvalidNodeStatus()
+	}
+	if node.Jailed {
+		return types.ErrorNodeJailed()
+	}
+
+	node = k.JailNode(ctx, node)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeJailNode,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.ID.String()),
+	))
+
+	return nil
+}
+
+func handleResolveDisputeProposal(ctx sdk.Context, k keeper.Keeper, p types.ResolveDisputeProposal) sdk.Error {
+	dispute, found := k.GetDispute(ctx, p.SessionID)
+	if !found {
+		return types.ErrorDisputeDoesNotExist()
+	}
+	if !dispute.IsOpen() {
+		return types.ErrorInvalidDisputeStatus()
+	}
+
+	resolveDispute(ctx, k, dispute, p.Upheld, types.AttributeValueGovernance)
+	return nil
+}
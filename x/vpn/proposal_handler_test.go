@@ -0,0 +1,147 @@
+package vpn
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func Test_handleReleaseEscrowProposal(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	handler := NewProposalHandler(k)
+
+	proposal := types.NewReleaseEscrowProposal("title", "description", types.TestSubscription.ID, types.TestAddress1)
+	require.NotNil(t, handler(ctx, proposal))
+
+	_, err := bk.AddCoins(ctx, types.TestAddress2, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress2, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.StatusModifiedAt = 2
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+	k.AddSessionIDToActiveList(ctx, 2, session.ID)
+	k.SetPendingSettlement(ctx, types.NewPendingSettlement(types.TestSubscription.ID, 20))
+
+	cctx := ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.Nil(t, handler(cctx, proposal))
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 100)}, bk.GetCoins(ctx, types.TestAddress1))
+	deposit, _ := dk.GetDeposit(ctx, types.TestAddress2)
+	require.True(t, deposit.Coins.IsZero())
+
+	subscription, _ := k.GetSubscription(ctx, types.TestSubscription.ID)
+	require.Equal(t, StatusInactive, subscription.Status)
+	require.Equal(t, int64(10), subscription.StatusModifiedAt)
+	require.True(t, subscription.RemainingDeposit.IsZero())
+
+	session, _ = k.GetSession(ctx, session.ID)
+	require.Equal(t, StatusInactive, session.Status)
+	require.True(t, session.Paid.IsZero())
+	require.Equal(t, uint64(1), k.GetSessionsCountOfSubscription(ctx, types.TestSubscription.ID))
+	require.Len(t, k.GetActiveSessionIDs(ctx, 2), 0)
+
+	_, found := k.GetPendingSettlement(ctx, types.TestSubscription.ID)
+	require.Equal(t, false, found)
+
+	require.Len(t, eventsOfType(cctx.EventManager().Events(), types.EventTypeEndSession), 1)
+	events := eventsOfType(cctx.EventManager().Events(), types.EventTypeReleaseEscrow)
+	require.Len(t, events, 1)
+	require.Equal(t, "100stake", string(events[0].Attributes[2].Value))
+
+	events = eventsOfType(cctx.EventManager().Events(), types.EventTypeSendDeposit)
+	require.Len(t, events, 1)
+	require.Equal(t, types.TestAddress1.String(), string(events[0].Attributes[3].Value))
+
+	require.NotNil(t, handler(ctx, proposal))
+
+	proposal.SubscriptionID = hub.NewSubscriptionID(1)
+	require.NotNil(t, handler(ctx, proposal))
+}
+
+func Test_handleJailNodeProposal(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	handler := NewProposalHandler(k)
+
+	params := k.GetParams(ctx)
+	params.NodeJailCooldown = 100
+	k.SetParams(ctx, params)
+
+	proposal := types.NewJailNodeProposal("title", "description", types.TestNode.ID)
+	require.Equal(t, types.ErrorNodeDoesNotExist(), handler(ctx, proposal))
+
+	k.SetNode(ctx, types.TestNode)
+	require.Equal(t, types.ErrorInvalidNodeStatus(), handler(ctx, proposal))
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+
+	cctx := ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.Nil(t, handler(cctx, proposal))
+
+	node, _ = k.GetNode(ctx, node.ID)
+	require.Equal(t, true, node.Jailed)
+	require.Equal(t, int64(110), node.JailedUntil)
+
+	events := cctx.EventManager().Events()
+	require.Equal(t, types.EventTypeJailNode, events[0].Type)
+
+	require.Equal(t, types.ErrorNodeJailed(), handler(cctx, proposal))
+}
+
+func Test_handleResolveDisputeProposal(t *testing.T) {
+	ctx, k, dk, bk := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(20)
+	handler := NewProposalHandler(k)
+
+	proposal := types.NewResolveDisputeProposal("title", "description", types.TestSession.ID, true)
+	require.Equal(t, types.ErrorDisputeDoesNotExist(), handler(ctx, proposal))
+
+	_, err := bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 100)})
+	require.Nil(t, err)
+	err = k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+
+	node := types.TestNode
+	node.Status = StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.Paid = sdk.NewInt64Coin("stake", 50)
+	session.Status = StatusInactive
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+
+	res := NewHandler(k)(ctx, *NewMsgOpenDispute(types.TestAddress2, types.TestSubscription.ID, 0, sdk.NewInt64Coin("stake", 20)))
+	require.True(t, res.IsOK())
+
+	cctx := ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	require.Nil(t, handler(cctx, proposal))
+
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, bk.GetCoins(ctx, types.TestAddress2))
+	deposit, _ := dk.GetDeposit(ctx, types.DisputePoolAddress)
+	require.True(t, deposit.Coins.IsZero())
+
+	dispute, _ := k.GetDispute(ctx, session.ID)
+	require.Equal(t, types.DisputeStatusUpheld, dispute.Status)
+	require.Equal(t, int64(30), dispute.StatusModifiedAt)
+
+	events := eventsOfType(cctx.EventManager().Events(), types.EventTypeResolveDispute)
+	require.Len(t, events, 1)
+	require.Equal(t, types.AttributeValueGovernance, string(events[0].Attributes[3].Value))
+
+	require.Equal(t, types.ErrorInvalidDisputeStatus(), handler(ctx, proposal))
+}
@@ -0,0 +1,25 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func queryDepositOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDepositOfAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	deposit := k.GetDepositOfAddress(ctx, params.Address)
+
+	res, err := types.ModuleCdc.MarshalJSON(deposit)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
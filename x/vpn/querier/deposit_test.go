@@ -0,0 +1,95 @@
+package querier
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func Test_queryDepositOfAddress(t *testing.T) {
+	ctx, k, _, bk := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var deposit types.DepositOfAddress
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDepositOfAddress),
+		Data: []byte{},
+	}
+
+	res, _err := queryDepositOfAddress(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryDepositOfAddressParams(types.TestAddress1))
+	require.Nil(t, err)
+
+	res, _err = queryDepositOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+	deposit = types.DepositOfAddress{}
+	require.Nil(t, cdc.UnmarshalJSON(res, &deposit))
+	require.True(t, deposit.Coins.IsZero())
+	require.Len(t, deposit.Nodes, 0)
+	require.Len(t, deposit.Subscriptions, 0)
+
+	_, err = bk.AddCoins(ctx, types.TestAddress1, sdk.Coins{sdk.NewInt64Coin("stake", 300)})
+	require.Nil(t, err)
+	require.Nil(t, k.AddDeposit(ctx, types.TestAddress1, sdk.NewInt64Coin("stake", 300)))
+
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	k.SetNode(ctx, node)
+	k.SetNodeIDByAddress(ctx, node.Owner, 0, node.ID)
+
+	deregistered := types.TestNode
+	deregistered.ID = hub.NewNodeID(1)
+	k.SetNode(ctx, deregistered)
+	k.SetNodeIDByAddress(ctx, node.Owner, 1, deregistered.ID)
+	k.SetNodesCountOfAddress(ctx, node.Owner, 2)
+
+	unbonding := types.NewNodeUnbonding(deregistered.ID, deregistered.Owner, sdk.NewInt64Coin("stake", 100), 10)
+	k.SetNodeUnbonding(ctx, unbonding)
+
+	subscription := types.TestSubscription
+	subscription.Client = types.TestAddress1
+	k.SetSubscription(ctx, subscription)
+	k.SetSubscriptionIDByAddress(ctx, subscription.Client, 0, subscription.ID)
+
+	inactive := subscription
+	inactive.ID = hub.NewSubscriptionID(1)
+	inactive.Status = types.StatusInactive
+	k.SetSubscription(ctx, inactive)
+	k.SetSubscriptionIDByAddress(ctx, subscription.Client, 1, inactive.ID)
+	k.SetSubscriptionsCountOfAddress(ctx, subscription.Client, 2)
+
+	res, _err = queryDepositOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+	deposit = types.DepositOfAddress{}
+	require.Nil(t, cdc.UnmarshalJSON(res, &deposit))
+	require.Equal(t, types.TestAddress1, deposit.Address)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 300)}, deposit.Coins)
+	require.Equal(t, []types.NodeDeposit{{NodeID: node.ID, Deposit: node.Deposit}}, deposit.Nodes)
+	require.Equal(t, []types.SubscriptionDeposit{
+		{SubscriptionID: subscription.ID, Deposit: subscription.RemainingDeposit},
+	}, deposit.Subscriptions)
+	require.Equal(t, []types.NodeUnbonding{unbonding}, deposit.Unbondings)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryDepositOfAddressParams(types.TestAddress2))
+	require.Nil(t, err)
+
+	res, _err = queryDepositOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+	deposit = types.DepositOfAddress{}
+	require.Nil(t, cdc.UnmarshalJSON(res, &deposit))
+	require.True(t, deposit.Coins.IsZero())
+	require.Len(t, deposit.Nodes, 0)
+	require.Len(t, deposit.Subscriptions, 0)
+}
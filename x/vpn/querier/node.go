@@ -1,9 +1,13 @@
 package querier
 
 import (
+	"math/big"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/keeper"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -28,15 +32,305 @@ func queryNode(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte,
 	return res, nil
 }
 
+func queryNodeByMoniker(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeByMonikerParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	id, found := k.GetNodeIDByMoniker(ctx, params.Moniker)
+	if !found {
+		return nil, nil
+	}
+
+	node, _ := k.GetNode(ctx, id)
+
+	res, err := types.ModuleCdc.MarshalJSON(node)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+// queryNodeStats returns the zero counters for a node which has no settled sessions yet.
+func queryNodeStats(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if _, found := k.GetNode(ctx, params.ID); !found {
+		return nil, nil
+	}
+
+	stats, found := k.GetNodeStats(ctx, params.ID)
+	if !found {
+		stats = types.NewNodeStats(params.ID)
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(stats)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryDiscountPlan(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	plan, found := k.GetDiscountPlan(ctx, params.ID)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(plan)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryNodeUptime(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if _, found := k.GetNode(ctx, params.ID); !found {
+		return nil, nil
+	}
+
+	uptime, found := k.GetNodeUptime(ctx, params.ID)
+	if !found {
+		uptime = types.NodeUptime{NodeID: params.ID, Since: ctx.BlockHeight()}
+	}
+
+	percentage := uptime.Percentage(ctx.BlockHeight(), k.NodeInactiveInterval(ctx))
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryNodeUptimeResponse(uptime, percentage))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryNodeQoS(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if _, found := k.GetNode(ctx, params.ID); !found {
+		return nil, nil
+	}
+
+	qos, found := k.GetNodeQoS(ctx, params.ID)
+	if !found {
+		qos = types.NewNodeQoS(params.ID)
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(qos)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+// queryNodeReputation returns the score of the node at the end of the last reputation epoch, the zero score
+// if the node is not scored yet.
+func queryNodeReputation(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if _, found := k.GetNode(ctx, params.ID); !found {
+		return nil, nil
+	}
+
+	reputation, found := k.GetNodeReputation(ctx, params.ID)
+	if !found {
+		reputation = types.NodeReputation{NodeID: params.ID, Score: sdk.ZeroDec(), Uptime: sdk.ZeroDec()}
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(reputation)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+// queryTopNodes ranks the registered nodes which are not jailed by the bandwidth served or the earnings
+// in the denom over the window, which starts at the first node stats snapshot within it. The window 0
+// ranks the nodes by their counters since the genesis.
+func queryTopNodes(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTopNodesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if params.By != types.RankByBandwidth && params.By != types.RankByEarnings {
+		return nil, types.ErrorInvalidField("by")
+	}
+
+	epoch := k.NodeStatsEpoch(ctx)
+	if params.Window < 0 || params.Window > k.NodeStatsRetention(ctx) || (params.Window > 0 && epoch == 0) {
+		return nil, types.ErrorInvalidField("window")
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = types.DefaultTopNodesLimit
+	}
+	if limit > types.MaxTopNodesLimit {
+		limit = types.MaxTopNodesLimit
+	}
+
+	denom := params.Denom
+	if denom == "" {
+		denom = k.Deposit(ctx).Denom
+	}
+
+	var height int64
+	if params.Window > 0 && ctx.BlockHeight() > params.Window {
+		height = ctx.BlockHeight() - params.Window
+		if height%epoch != 0 {
+			height += epoch - height%epoch
+		}
+	}
+
+	value := func(stats types.NodeStats) sdk.Int {
+		if params.By == types.RankByBandwidth {
+			return stats.Bandwidth.Sum()
+		}
+
+		return stats.Earnings.AmountOf(denom)
+	}
+
+	var items []types.NodeStats
+	for _, stats := range k.GetAllNodeStats(ctx) {
+		node, found := k.GetNode(ctx, stats.NodeID)
+		if !found || node.Status != types.StatusRegistered || node.Jailed {
+			continue
+		}
+
+		if height > 0 {
+			if snapshot, found := k.GetNodeStatsSnapshot(ctx, height, stats.NodeID); found {
+				stats = stats.Sub(snapshot.Stats)
+			}
+		}
+
+		if value(stats).IsPositive() {
+			items = append(items, stats)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return value(items[i]).GT(value(items[j]))
+	})
+	if uint64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(items)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
 func queryNodesOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
 	var params types.QueryNodesOfAddressPrams
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, types.ErrorUnmarshal()
 	}
 
-	nodes := k.GetNodesOfAddress(ctx, params.Address)
+	nodes, page := k.PaginateNodesOfAddress(ctx, params.Address, params.Category, params.Pagination)
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryNodesResponse(nodes, page))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+// queryAllNodes returns the nodes in the order of their IDs, or in the sort order of the params. The sorted
+// nodes are paginated by their offsets, as their order is not the order of the store.
+func queryAllNodes(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllNodesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if !types.IsValidNodeSortOrder(params.Sort) {
+		return nil, types.ErrorInvalidField("sort")
+	}
+	if params.MaxPrice > 0 && params.MinPrice > params.MaxPrice {
+		return nil, types.ErrorInvalidField("max_price")
+	}
+
+	denom := params.Denom
+	if denom == "" {
+		denom = k.Deposit(ctx).Denom
+	}
+
+	minPrice := sdk.NewIntFromBigInt(new(big.Int).SetUint64(params.MinPrice))
+	maxPrice := sdk.NewIntFromBigInt(new(big.Int).SetUint64(params.MaxPrice))
+	filter := func(node types.Node) bool {
+		if params.Category != "" && node.Type != params.Category {
+			return false
+		}
+		if !node.Location.Matches(params.Location) {
+			return false
+		}
+		if params.MinPrice == 0 && params.MaxPrice == 0 {
+			return true
+		}
+
+		price := node.PricesPerGB.AmountOf(denom)
+		return price.IsPositive() && price.GTE(minPrice) && (params.MaxPrice == 0 || price.LTE(maxPrice))
+	}
+
+	var (
+		nodes []types.Node
+		page  hub.PageResponse
+	)
+
+	if params.Sort == "" {
+		nodes, page = k.PaginateFilteredNodes(ctx, filter, params.Pagination)
+	} else {
+		k.IterateNodes(ctx, func(_ int64, node types.Node) bool {
+			if filter(node) {
+				nodes = append(nodes, node)
+			}
 
-	res, err := types.ModuleCdc.MarshalJSON(nodes)
+			return false
+		})
+
+		sortNodes(ctx, k, nodes, params.Sort, denom)
+
+		start, end, _page, err := hub.PaginateSlice(uint64(len(nodes)), params.Pagination)
+		if err != nil {
+			return nil, types.ErrorInvalidField("pagination")
+		}
+
+		nodes, page = nodes[start:end], _page
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryNodesResponse(nodes, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -44,13 +338,98 @@ func queryNodesOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper
 	return res, nil
 }
 
-func queryAllNodes(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
-	nodes := k.GetAllNodes(ctx)
+func queryAllowedAddressesOfNode(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllowedAddressesOfNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	addresses, page := k.PaginateAllowedAddressesOfNode(ctx, params.ID, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(nodes)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryAddressesResponse(addresses, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
 
 	return res, nil
 }
+
+func queryBlacklistedClientsOfNode(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlacklistedClientsOfNodeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	clients, page := k.PaginateBlacklistedClientsOfNode(ctx, params.ID, params.Pagination)
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryAddressesResponse(clients, page))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+// sortNodes sorts the nodes stably by the order, so that the nodes of the equal values stay in the order of
+// their IDs. The nodes without a price in the denom, or without a reputation, come after the others in both
+// the orders.
+func sortNodes(ctx sdk.Context, k keeper.Keeper, nodes []types.Node, order, denom string) {
+	type item struct {
+		value sdk.Dec
+		found bool
+	}
+
+	items := make([]item, len(nodes))
+	for i, node := range nodes {
+		switch order {
+		case types.SortNodesByPriceAsc, types.SortNodesByPriceDesc:
+			price := node.PricesPerGB.AmountOf(denom)
+			items[i] = item{value: sdk.NewDecFromInt(price), found: price.IsPositive()}
+		case types.SortNodesByBandwidthAsc, types.SortNodesByBandwidthDesc:
+			items[i] = item{value: sdk.NewDecFromInt(node.InternetSpeed.Sum()), found: true}
+		case types.SortNodesByUptimeAsc, types.SortNodesByUptimeDesc:
+			uptime, found := k.GetNodeUptime(ctx, node.ID)
+			if !found {
+				uptime = types.NodeUptime{NodeID: node.ID, Since: ctx.BlockHeight()}
+			}
+
+			items[i] = item{value: uptime.Percentage(ctx.BlockHeight(), k.NodeInactiveInterval(ctx)), found: true}
+		case types.SortNodesByReputationAsc, types.SortNodesByReputationDesc:
+			reputation, found := k.GetNodeReputation(ctx, node.ID)
+			if !found {
+				reputation.Score = sdk.ZeroDec()
+			}
+
+			items[i] = item{value: reputation.Score, found: found}
+		}
+	}
+
+	desc := order == types.SortNodesByPriceDesc ||
+		order == types.SortNodesByBandwidthDesc ||
+		order == types.SortNodesByUptimeDesc ||
+		order == types.SortNodesByReputationDesc
+
+	indexes := make([]int, len(nodes))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		x, y := items[indexes[i]], items[indexes[j]]
+		if x.found != y.found {
+			return x.found
+		}
+		if desc {
+			return x.value.GT(y.value)
+		}
+
+		return x.value.LT(y.value)
+	})
+
+	sorted := make([]types.Node, len(nodes))
+	for i, index := range indexes {
+		sorted[i] = nodes[index]
+	}
+
+	copy(nodes, sorted)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -52,11 +53,292 @@ func Test_queryNode(t *testing.T) {
 	require.Len(t, res, 0)
 }
 
+func Test_queryNodeStats(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var stats types.NodeStats
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeStats),
+		Data: []byte{},
+	}
+
+	res, _err := queryNodeStats(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodeParams(hub.NewNodeID(0)))
+	require.Nil(t, err)
+
+	res, _err = queryNodeStats(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetNode(ctx, types.TestNode)
+
+	res, _err = queryNodeStats(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), stats.SessionsCount)
+	require.True(t, stats.Earnings.Empty())
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 100), sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 1)
+
+	res, _err = queryNodeStats(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.True(t, stats.Bandwidth.AllEqual(hub.NewBandwidthFromInt64(100, 100)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, stats.Earnings)
+	require.Equal(t, uint64(1), stats.SessionsCount)
+}
+
+func Test_queryNodeUptime(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	ctx = ctx.WithBlockHeight(120)
+
+	params := k.GetParams(ctx)
+	params.NodeInactiveInterval = 10
+	k.SetParams(ctx, params)
+
+	var err error
+	var response types.QueryNodeUptimeResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeUptime),
+		Data: []byte{},
+	}
+
+	res, _err := queryNodeUptime(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodeParams(hub.NewNodeID(0)))
+	require.Nil(t, err)
+
+	res, _err = queryNodeUptime(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetNode(ctx, types.TestNode)
+
+	res, _err = queryNodeUptime(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &response)
+	require.Nil(t, err)
+	require.Equal(t, sdk.ZeroDec(), response.Percentage)
+
+	k.SetNodeUptime(ctx, types.NewNodeUptime(hub.NewNodeID(0), 100))
+
+	res, _err = queryNodeUptime(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &response)
+	require.Nil(t, err)
+	require.Equal(t, types.NewNodeUptime(hub.NewNodeID(0), 100), response.Uptime)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), response.Percentage)
+}
+
+func Test_queryNodeQoS(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeQoS),
+		Data: []byte{},
+	}
+
+	res, _err := queryNodeQoS(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodeParams(hub.NewNodeID(0)))
+	require.Nil(t, err)
+
+	res, _err = queryNodeQoS(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetNode(ctx, types.TestNode)
+
+	res, _err = queryNodeQoS(ctx, req, k)
+	require.Nil(t, _err)
+
+	var qos types.NodeQoS
+	err = cdc.UnmarshalJSON(res, &qos)
+	require.Nil(t, err)
+	require.Equal(t, types.NewNodeQoS(hub.NewNodeID(0)), qos)
+
+	k.AddNodeQoS(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(100, 200), 50)
+
+	res, _err = queryNodeQoS(ctx, req, k)
+	require.Nil(t, _err)
+
+	var _qos types.NodeQoS
+	err = cdc.UnmarshalJSON(res, &_qos)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), _qos.Reports)
+	require.Equal(t, hub.NewBandwidthFromInt64(100, 200), _qos.Speed)
+	require.Equal(t, sdk.NewInt(50), _qos.Latency)
+}
+
+func Test_queryNodeReputation(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodeReputation),
+		Data: []byte{},
+	}
+
+	res, _err := queryNodeReputation(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodeParams(hub.NewNodeID(0)))
+	require.Nil(t, err)
+
+	res, _err = queryNodeReputation(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetNode(ctx, types.TestNode)
+
+	res, _err = queryNodeReputation(ctx, req, k)
+	require.Nil(t, _err)
+
+	var reputation types.NodeReputation
+	err = cdc.UnmarshalJSON(res, &reputation)
+	require.Nil(t, err)
+	require.Equal(t, sdk.ZeroDec(), reputation.Score)
+
+	k.SetNodeReputation(ctx, types.NewNodeReputation(hub.NewNodeID(0), sdk.OneDec(), 10, types.NodeRating{}, 0, 100))
+
+	res, _err = queryNodeReputation(ctx, req, k)
+	require.Nil(t, _err)
+
+	var _reputation types.NodeReputation
+	err = cdc.UnmarshalJSON(res, &_reputation)
+	require.Nil(t, err)
+	require.Equal(t, types.NewNodeReputation(hub.NewNodeID(0), sdk.OneDec(), 10, types.NodeRating{}, 0, 100), _reputation)
+}
+
+func Test_queryTopNodes(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	params := k.GetParams(ctx)
+	params.NodeStatsEpoch = 10
+	params.NodeStatsRetention = 100
+	k.SetParams(ctx, params)
+
+	var err error
+	var stats []types.NodeStats
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTopNodes),
+		Data: []byte{},
+	}
+
+	res, _err := queryTopNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	for _, window := range []int64{-1, 101} {
+		req.Data, err = cdc.MarshalJSON(types.NewQueryTopNodesParams(types.RankByBandwidth, "", window, 0))
+		require.Nil(t, err)
+
+		_, _err = queryTopNodes(ctx, req, k)
+		require.Equal(t, types.ErrorInvalidField("window"), _err)
+	}
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryTopNodesParams("invalid", "", 0, 0))
+	require.Nil(t, err)
+
+	_, _err = queryTopNodes(ctx, req, k)
+	require.Equal(t, types.ErrorInvalidField("by"), _err)
+
+	for i := uint64(0); i < 3; i++ {
+		node := types.TestNode
+		node.ID = hub.NewNodeID(i)
+		node.Status = types.StatusRegistered
+		k.SetNode(ctx, node)
+	}
+
+	k.AddNodeStats(ctx, hub.NewNodeID(0), hub.NewBandwidthFromInt64(500, 500), sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(100, 100), sdk.Coins{sdk.NewInt64Coin("stake", 20)}, 1)
+	k.SnapshotNodeStats(ctx, 10)
+	k.AddNodeStats(ctx, hub.NewNodeID(1), hub.NewBandwidthFromInt64(200, 200), nil, 1)
+	k.AddNodeStats(ctx, hub.NewNodeID(2), hub.NewBandwidthFromInt64(50, 50), sdk.Coins{sdk.NewInt64Coin("stake", 5)}, 1)
+	ctx = ctx.WithBlockHeight(15)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryTopNodesParams(types.RankByBandwidth, "", 0, 2))
+	require.Nil(t, err)
+
+	res, _err = queryTopNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, hub.NewNodeID(0), stats[0].NodeID)
+	require.Equal(t, hub.NewNodeID(1), stats[1].NodeID)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryTopNodesParams(types.RankByBandwidth, "", 10, 0))
+	require.Nil(t, err)
+
+	res, _err = queryTopNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, hub.NewNodeID(1), stats[0].NodeID)
+	require.True(t, stats[0].Bandwidth.AllEqual(hub.NewBandwidthFromInt64(200, 200)))
+	require.Equal(t, uint64(1), stats[0].SessionsCount)
+	require.Equal(t, hub.NewNodeID(2), stats[1].NodeID)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryTopNodesParams(types.RankByEarnings, "", 0, 0))
+	require.Nil(t, err)
+
+	res, _err = queryTopNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.Len(t, stats, 3)
+	require.Equal(t, hub.NewNodeID(1), stats[0].NodeID)
+	require.Equal(t, hub.NewNodeID(0), stats[1].NodeID)
+	require.Equal(t, hub.NewNodeID(2), stats[2].NodeID)
+
+	k.SetNode(ctx, types.TestNode)
+
+	res, _err = queryTopNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &stats)
+	require.Nil(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, hub.NewNodeID(1), stats[0].NodeID)
+	require.Equal(t, hub.NewNodeID(2), stats[1].NodeID)
+}
+
 func Test_queryNodesOfAddress(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var nodes []types.Node
+	var nodes types.QueryNodesResponse
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNodesOfAddress),
@@ -72,7 +354,7 @@ func Test_queryNodesOfAddress(t *testing.T) {
 	k.SetNodesCountOfAddress(ctx, types.TestAddress1, 1)
 	k.SetNodeIDByAddress(ctx, types.TestAddress1, 0, hub.NewNodeID(0))
 
-	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams([]byte("")))
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams([]byte(""), "", hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = queryNodesOfAddress(ctx, req, k)
@@ -82,10 +364,10 @@ func Test_queryNodesOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Node{types.TestNode}, nodes)
+	require.NotEqual(t, []types.Node{types.TestNode}, nodes.Nodes)
 
 	k.SetNode(ctx, types.TestNode)
-	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams(types.TestAddress1))
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams(types.TestAddress1, "", hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = queryNodesOfAddress(ctx, req, k)
@@ -95,9 +377,9 @@ func Test_queryNodesOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.Equal(t, []types.Node{types.TestNode}, nodes)
+	require.Equal(t, []types.Node{types.TestNode}, nodes.Nodes)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams(types.TestAddress2))
+	req.Data, err = cdc.MarshalJSON(types.NewQueryNodesOfAddressParams(types.TestAddress2, "", hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = queryNodesOfAddress(ctx, req, k)
@@ -106,50 +388,294 @@ func Test_queryNodesOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Node{types.TestNode}, nodes)
+	require.NotEqual(t, []types.Node{types.TestNode}, nodes.Nodes)
 }
 
 func Test_queryAllNodes(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var nodes []types.Node
+	var nodes types.QueryNodesResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllNodes),
+		Data: []byte{},
+	}
 
-	res, _err := queryAllNodes(ctx, k)
+	res, _err := queryAllNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		"", "", 0, 0, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
 	require.Nil(t, _err)
-	require.Equal(t, []byte("null"), res)
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Node{types.TestNode}, nodes)
+	require.Len(t, nodes.Nodes, 0)
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Node{types.TestNode}, nodes)
+	require.NotEqual(t, []types.Node{types.TestNode}, nodes.Nodes)
+
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.NotEqual(t, []types.Node{types.TestNode}, nodes.Nodes)
 
 	k.SetNode(ctx, types.TestNode)
 	require.Nil(t, err)
 
-	res, _err = queryAllNodes(ctx, k)
+	res, _err = queryAllNodes(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.Equal(t, []types.Node{types.TestNode}, nodes)
+	require.Equal(t, []types.Node{types.TestNode}, nodes.Nodes)
 
 	node := types.TestNode
 	node.ID = hub.NewNodeID(1)
 	k.SetNode(ctx, node)
 	require.Nil(t, err)
 
-	res, _err = queryAllNodes(ctx, k)
+	res, _err = queryAllNodes(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &nodes)
 	require.Nil(t, err)
-	require.Equal(t, append([]types.Node{types.TestNode}, node), nodes)
+	require.Equal(t, append([]types.Node{types.TestNode}, node), nodes.Nodes)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		"", "", 0, 0, hub.NewPageRequest(nil, 1, true)))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{types.TestNode}, nodes.Nodes)
+	require.Equal(t, node.ID.Bytes(), nodes.Pagination.NextKey)
+	require.Equal(t, uint64(2), nodes.Pagination.Total)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		"", "", 0, 0, hub.NewPageRequest(nodes.Pagination.NextKey, 1, false)))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node}, nodes.Nodes)
+	require.Len(t, nodes.Pagination.NextKey, 0)
+
+	cheap := types.TestNode
+	cheap.ID = hub.NewNodeID(2)
+	cheap.PricesPerGB = sdk.Coins{sdk.NewInt64Coin("stake", 50)}
+	cheap.InternetSpeed = types.TestBandwidthPos2
+	k.SetNode(ctx, cheap)
+
+	unpriced := types.TestNode
+	unpriced.ID = hub.NewNodeID(3)
+	unpriced.PricesPerGB = sdk.Coins{sdk.NewInt64Coin("other", 10)}
+	k.SetNode(ctx, unpriced)
+
+	k.SetNodeReputation(ctx, types.NewNodeReputation(cheap.ID, sdk.OneDec(), types.ReputationSessionsHalf,
+		types.NodeRating{}, 0, 1))
+	k.SetNodeReputation(ctx, types.NewNodeReputation(unpriced.ID, sdk.NewDecWithPrec(5, 1), 0,
+		types.NodeRating{}, 0, 1))
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		"invalid", "", 0, 0, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	for _, tc := range []struct {
+		sort     string
+		expected []types.Node
+	}{
+		{types.SortNodesByPriceAsc, []types.Node{cheap, types.TestNode, node, unpriced}},
+		{types.SortNodesByPriceDesc, []types.Node{types.TestNode, node, cheap, unpriced}},
+		{types.SortNodesByBandwidthAsc, []types.Node{types.TestNode, node, unpriced, cheap}},
+		{types.SortNodesByBandwidthDesc, []types.Node{cheap, types.TestNode, node, unpriced}},
+		{types.SortNodesByUptimeDesc, []types.Node{types.TestNode, node, cheap, unpriced}},
+		{types.SortNodesByReputationAsc, []types.Node{unpriced, cheap, types.TestNode, node}},
+		{types.SortNodesByReputationDesc, []types.Node{cheap, unpriced, types.TestNode, node}},
+	} {
+		req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+			tc.sort, "", 0, 0, hub.PageRequest{}))
+		require.Nil(t, err)
+
+		res, _err = queryAllNodes(ctx, req, k)
+		require.Nil(t, _err)
+
+		err = cdc.UnmarshalJSON(res, &nodes)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, nodes.Nodes, tc.sort)
+	}
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		types.SortNodesByPriceAsc, "", 0, 0, hub.NewPageRequest(nil, 2, true)))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{cheap, types.TestNode}, nodes.Nodes)
+	require.Equal(t, sdk.Uint64ToBigEndian(2), nodes.Pagination.NextKey)
+	require.Equal(t, uint64(4), nodes.Pagination.Total)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		types.SortNodesByPriceAsc, "", 0, 0, hub.NewPageRequest(nodes.Pagination.NextKey, 2, false)))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &nodes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Node{node, unpriced}, nodes.Nodes)
+	require.Len(t, nodes.Pagination.NextKey, 0)
+
+	for _, tc := range []struct {
+		sort     string
+		denom    string
+		min, max uint64
+		expected []types.Node
+	}{
+		{"", "", 60, 0, []types.Node{types.TestNode, node}},
+		{"", "", 0, 60, []types.Node{cheap}},
+		{types.SortNodesByPriceDesc, "", 50, 100, []types.Node{types.TestNode, node, cheap}},
+		{"", "other", 0, 20, []types.Node{unpriced}},
+	} {
+		req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+			tc.sort, tc.denom, tc.min, tc.max, hub.PageRequest{}))
+		require.Nil(t, err)
+
+		res, _err = queryAllNodes(ctx, req, k)
+		require.Nil(t, _err)
+
+		err = cdc.UnmarshalJSON(res, &nodes)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, nodes.Nodes)
+	}
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", types.NodeLocation{},
+		"", "", 100, 50, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllNodes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	cheap.Location = types.NewNodeLocation("DE", "DE-BE", types.LatencyZoneEurope)
+	k.SetNode(ctx, cheap)
+	unpriced.Location = types.NewNodeLocation("US", "", types.LatencyZoneNorthAmerica)
+	k.SetNode(ctx, unpriced)
+
+	for _, tc := range []struct {
+		location types.NodeLocation
+		expected []types.Node
+	}{
+		{types.NewNodeLocation("DE", "", ""), []types.Node{cheap}},
+		{types.NewNodeLocation("", "DE-BE", ""), []types.Node{cheap}},
+		{types.NewNodeLocation("", "", types.LatencyZoneNorthAmerica), []types.Node{unpriced}},
+		{types.NewNodeLocation("US", "", types.LatencyZoneEurope), nil},
+	} {
+		req.Data, err = cdc.MarshalJSON(types.NewQueryAllNodesParams("", tc.location,
+			"", "", 0, 0, hub.PageRequest{}))
+		require.Nil(t, err)
+
+		res, _err = queryAllNodes(ctx, req, k)
+		require.Nil(t, _err)
+
+		var _nodes types.QueryNodesResponse
+		err = cdc.UnmarshalJSON(res, &_nodes)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, _nodes.Nodes)
+	}
+}
+
+func Test_queryAllowedAddressesOfNode(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var addresses types.QueryAddressesResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllowedAddressesOfNode),
+		Data: []byte{},
+	}
+
+	res, _err := queryAllowedAddressesOfNode(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllowedAddressesOfNodeParams(hub.NewNodeID(0), hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllowedAddressesOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &addresses)
+	require.Nil(t, err)
+	require.Len(t, addresses.Addresses, 0)
+
+	k.SetAllowedAddress(ctx, types.NewAllowedAddress(hub.NewNodeID(0), types.TestAddress2))
+
+	res, _err = queryAllowedAddressesOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &addresses)
+	require.Nil(t, err)
+	require.Equal(t, []sdk.AccAddress{types.TestAddress2}, addresses.Addresses)
+}
+
+func Test_queryBlacklistedClientsOfNode(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var clients types.QueryAddressesResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlacklistedClientsOfNode),
+		Data: []byte{},
+	}
+
+	res, _err := queryBlacklistedClientsOfNode(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryBlacklistedClientsOfNodeParams(hub.NewNodeID(0), hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryBlacklistedClientsOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &clients)
+	require.Nil(t, err)
+	require.Len(t, clients.Addresses, 0)
+
+	k.SetBlacklistedClient(ctx, types.NewBlacklistedClient(hub.NewNodeID(0), types.TestAddress2))
+
+	res, _err = queryBlacklistedClientsOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &clients)
+	require.Nil(t, err)
+	require.Equal(t, []sdk.AccAddress{types.TestAddress2}, clients.Addresses)
 }
@@ -0,0 +1,30 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func queryParams(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(params)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryProtocolFees(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	fees := k.GetProtocolFees(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(fees)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
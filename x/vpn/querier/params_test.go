@@ -0,0 +1,60 @@
+package querier
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func Test_queryParams(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var params types.Params
+
+	res, _err := queryParams(ctx, k)
+	require.Nil(t, _err)
+	require.NotNil(t, res)
+
+	err := cdc.UnmarshalJSON(res, &params)
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	_params := types.DefaultParams()
+	_params.MinClientVersion = "0.1.0"
+	_params.MaintenanceBanners = []string{"maintenance"}
+	k.SetParams(ctx, _params)
+
+	res, _err = queryParams(ctx, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &params)
+	require.Nil(t, err)
+	require.Equal(t, _params, params)
+}
+
+func Test_queryProtocolFees(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var fees sdk.Coins
+
+	res, _err := queryProtocolFees(ctx, k)
+	require.Nil(t, _err)
+
+	err := cdc.UnmarshalJSON(res, &fees)
+	require.Nil(t, err)
+	require.True(t, fees.IsZero())
+
+	k.SetProtocolFees(ctx, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	res, _err = queryProtocolFees(ctx, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &fees)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, fees)
+}
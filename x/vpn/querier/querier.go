@@ -11,12 +11,36 @@ import (
 func NewQuerier(k keeper.Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
 		switch path[0] {
+		case types.QueryParams:
+			return queryParams(ctx, k)
+		case types.QueryProtocolFees:
+			return queryProtocolFees(ctx, k)
+		case types.QueryNetworkSummary:
+			return queryNetworkSummary(ctx, k)
 		case types.QueryNode:
 			return queryNode(ctx, req, k)
+		case types.QueryNodeByMoniker:
+			return queryNodeByMoniker(ctx, req, k)
 		case types.QueryNodesOfAddress:
 			return queryNodesOfAddress(ctx, req, k)
 		case types.QueryAllNodes:
-			return queryAllNodes(ctx, k)
+			return queryAllNodes(ctx, req, k)
+		case types.QueryNodeStats:
+			return queryNodeStats(ctx, req, k)
+		case types.QueryTopNodes:
+			return queryTopNodes(ctx, req, k)
+		case types.QueryNodeUptime:
+			return queryNodeUptime(ctx, req, k)
+		case types.QueryNodeQoS:
+			return queryNodeQoS(ctx, req, k)
+		case types.QueryNodeReputation:
+			return queryNodeReputation(ctx, req, k)
+		case types.QueryDiscountPlan:
+			return queryDiscountPlan(ctx, req, k)
+		case types.QueryAllowedAddressesOfNode:
+			return queryAllowedAddressesOfNode(ctx, req, k)
+		case types.QueryBlacklistedClientsOfNode:
+			return queryBlacklistedClientsOfNode(ctx, req, k)
 		case types.QuerySubscription:
 			return querySubscription(ctx, req, k)
 		case types.QuerySubscriptionsOfNode:
@@ -24,9 +48,21 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 		case types.QuerySubscriptionsOfAddress:
 			return querySubscriptionsOfAddress(ctx, req, k)
 		case types.QueryAllSubscriptions:
-			return queryAllSubscriptions(ctx, k)
+			return queryAllSubscriptions(ctx, req, k)
 		case types.QuerySessionsCountOfSubscription:
 			return querySessionsCountOfSubscription(ctx, req, k)
+		case types.QuerySessionIndexOfAddress:
+			return querySessionIndexOfAddress(ctx, req, k)
+		case types.QuerySeatsOfSubscription:
+			return querySeatsOfSubscription(ctx, req, k)
+		case types.QuerySubscriptionForecast:
+			return querySubscriptionForecast(ctx, req, k)
+		case types.QueryReferralEarnings:
+			return queryReferralEarnings(ctx, req, k)
+		case types.QueryFeeAllowance:
+			return queryFeeAllowance(ctx, req, k)
+		case types.QueryAuthorizations:
+			return queryAuthorizations(ctx, req, k)
 		case types.QuerySession:
 			return querySession(ctx, req, k)
 		case types.QuerySessionOfSubscription:
@@ -34,7 +70,15 @@ func NewQuerier(k keeper.Keeper) sdk.Querier {
 		case types.QuerySessionsOfSubscription:
 			return querySessionsOfSubscription(ctx, req, k)
 		case types.QueryAllSessions:
-			return queryAllSessions(ctx, k)
+			return queryAllSessions(ctx, req, k)
+		case types.QueryActiveSessionsOfAddress:
+			return queryActiveSessionsOfAddress(ctx, req, k)
+		case types.QueryDispute:
+			return queryDispute(ctx, req, k)
+		case types.QueryAllDisputes:
+			return queryAllDisputes(ctx, req, k)
+		case types.QueryDepositOfAddress:
+			return queryDepositOfAddress(ctx, req, k)
 		default:
 			return nil, types.ErrorInvalidQueryType(path[0])
 		}
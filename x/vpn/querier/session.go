@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	hub "github.com/sentinel-official/hub/types"
 	"github.com/sentinel-official/hub/x/vpn/keeper"
 	"github.com/sentinel-official/hub/x/vpn/types"
 )
@@ -57,9 +58,9 @@ func querySessionsOfSubscription(ctx sdk.Context, req abci.RequestQuery, k keepe
 		return nil, types.ErrorUnmarshal()
 	}
 
-	sessions := k.GetSessionsOfSubscription(ctx, params.ID)
+	sessions, page := k.PaginateSessionsOfSubscription(ctx, params.ID, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(sessions)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySessionsResponse(sessions, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -67,10 +68,102 @@ func querySessionsOfSubscription(ctx sdk.Context, req abci.RequestQuery, k keepe
 	return res, nil
 }
 
-func queryAllSessions(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
-	sessions := k.GetAllSessions(ctx)
+// queryActiveSessionsOfAddress returns the active sessions of the subscriptions of the address, and of the
+// subscriptions to the nodes owned by the address.
+func queryActiveSessionsOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryActiveSessionsOfAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if params.Address.Empty() {
+		return nil, types.ErrorInvalidField("address")
+	}
+
+	var sessions []types.Session
+	for _, session := range k.GetActiveSessions(ctx) {
+		subscription, _ := k.GetSubscription(ctx, session.SubscriptionID)
+		if !subscription.Client.Equals(params.Address) {
+			node, _ := k.GetNode(ctx, subscription.NodeID)
+			if !node.Owner.Equals(params.Address) {
+				continue
+			}
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	start, end, page, err := hub.PaginateSlice(uint64(len(sessions)), params.Pagination)
+	if err != nil {
+		return nil, types.ErrorInvalidField("pagination")
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySessionsResponse(sessions[start:end], page))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryAllSessions(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllSessionsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	sessions, page := k.PaginateSessions(ctx, params.Pagination)
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySessionsResponse(sessions, page))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryDispute(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDisputeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	dispute, found := k.GetDispute(ctx, params.ID)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(dispute)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryAllDisputes(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllDisputesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	if params.Status != "" && !types.IsValidDisputeStatus(params.Status) {
+		return nil, types.ErrorInvalidField("status")
+	}
+
+	var disputes []types.Dispute
+	for _, dispute := range k.GetAllDisputes(ctx) {
+		if params.Status == "" || dispute.Status == params.Status {
+			disputes = append(disputes, dispute)
+		}
+	}
+
+	start, end, page, err := hub.PaginateSlice(uint64(len(disputes)), params.Pagination)
+	if err != nil {
+		return nil, types.ErrorInvalidField("pagination")
+	}
 
-	res, err := types.ModuleCdc.MarshalJSON(sessions)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQueryDisputesResponse(disputes[start:end], page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -70,7 +71,7 @@ func Test_querySessionOfSubscription(t *testing.T) {
 	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, types.TestSession.ID)
 	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0), hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySessionOfSubscription(ctx, req, k)
@@ -82,7 +83,7 @@ func Test_querySessionOfSubscription(t *testing.T) {
 	require.NotEqual(t, types.TestSession, session)
 
 	k.SetSession(ctx, types.TestSession)
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0), hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySessionOfSubscription(ctx, req, k)
@@ -94,7 +95,7 @@ func Test_querySessionOfSubscription(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, types.TestSession, session)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(1)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(1), hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySessionOfSubscription(ctx, req, k)
@@ -110,7 +111,7 @@ func Test_querySessionsOfSubscription(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var sessions []types.Session
+	var sessions types.QuerySessionsResponse
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySessionsOfSubscription),
@@ -127,7 +128,7 @@ func Test_querySessionsOfSubscription(t *testing.T) {
 	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, types.TestSession.ID)
 	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 1)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionsOfSubscriptionPrams(hub.NewSubscriptionID(0), hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySessionsOfSubscription(ctx, req, k)
@@ -136,12 +137,12 @@ func Test_querySessionsOfSubscription(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.Equal(t, []types.Session{types.TestSession}, sessions)
+	require.Equal(t, []types.Session{types.TestSession}, sessions.Sessions)
 
 	session := types.TestSession
 	session.ID = hub.NewSessionID(1)
 	k.SetSession(ctx, session)
-	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 0, session.ID)
+	k.SetSessionIDBySubscriptionID(ctx, types.TestSubscription.ID, 1, session.ID)
 	k.SetSessionsCountOfSubscription(ctx, types.TestSubscription.ID, 2)
 
 	res, _err = querySessionsOfSubscription(ctx, req, k)
@@ -150,50 +151,224 @@ func Test_querySessionsOfSubscription(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.Len(t, sessions, 2)
+	require.Len(t, sessions.Sessions, 2)
+}
+
+func Test_queryActiveSessionsOfAddress(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	cdc := keeper.MakeTestCodec()
+	var err error
+	var sessions types.QuerySessionsResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryActiveSessionsOfAddress),
+		Data: []byte{},
+	}
+
+	res, _err := queryActiveSessionsOfAddress(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryActiveSessionsOfAddressParams(nil, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryActiveSessionsOfAddress(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	session := types.TestSession
+	session.StatusModifiedAt = 10
+	k.SetSession(ctx, session)
+	k.AddSessionIDToActiveList(ctx, 10, session.ID)
+
+	for _, address := range []sdk.AccAddress{types.TestSubscription.Client, types.TestNode.Owner} {
+		req.Data, err = cdc.MarshalJSON(types.NewQueryActiveSessionsOfAddressParams(address, hub.PageRequest{}))
+		require.Nil(t, err)
+
+		res, _err = queryActiveSessionsOfAddress(ctx, req, k)
+		require.Nil(t, _err)
+
+		err = cdc.UnmarshalJSON(res, &sessions)
+		require.Nil(t, err)
+		require.Equal(t, []types.Session{session}, sessions.Sessions)
+	}
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryActiveSessionsOfAddressParams(
+		sdk.AccAddress([]byte("address-of-no-client")), hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryActiveSessionsOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+
+	var empty types.QuerySessionsResponse
+	err = cdc.UnmarshalJSON(res, &empty)
+	require.Nil(t, err)
+	require.Len(t, empty.Sessions, 0)
+
+	session.Status = types.StatusInactive
+	k.SetSession(ctx, session)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryActiveSessionsOfAddressParams(
+		types.TestSubscription.Client, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryActiveSessionsOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+
+	empty = types.QuerySessionsResponse{}
+	err = cdc.UnmarshalJSON(res, &empty)
+	require.Nil(t, err)
+	require.Len(t, empty.Sessions, 0)
 }
 
 func Test_queryAllSessions(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var sessions []types.Session
+	var sessions types.QuerySessionsResponse
 
-	res, _err := queryAllSessions(ctx, k)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllSessions),
+		Data: []byte{},
+	}
+
+	res, _err := queryAllSessions(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllSessionsParams(hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllSessions(ctx, req, k)
 	require.Nil(t, _err)
-	require.Equal(t, []byte("null"), res)
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Session{types.TestSession}, sessions)
+	require.Len(t, sessions.Sessions, 0)
+
+	err = cdc.UnmarshalJSON(res, &sessions)
+	require.Nil(t, err)
+	require.NotEqual(t, []types.Session{types.TestSession}, sessions.Sessions)
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Session{types.TestSession}, sessions)
+	require.NotEqual(t, []types.Session{types.TestSession}, sessions.Sessions)
 
 	k.SetSession(ctx, types.TestSession)
 	require.Nil(t, err)
 
-	res, _err = queryAllSessions(ctx, k)
+	res, _err = queryAllSessions(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.Equal(t, []types.Session{types.TestSession}, sessions)
+	require.Equal(t, []types.Session{types.TestSession}, sessions.Sessions)
 
 	session := types.TestSession
 	session.ID = hub.NewSessionID(1)
 	k.SetSession(ctx, session)
 	require.Nil(t, err)
 
-	res, _err = queryAllSessions(ctx, k)
+	res, _err = queryAllSessions(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &sessions)
 	require.Nil(t, err)
-	require.Equal(t, append([]types.Session{types.TestSession}, session), sessions)
+	require.Equal(t, append([]types.Session{types.TestSession}, session), sessions.Sessions)
+}
+
+func Test_queryDispute(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	var dispute types.Dispute
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDispute),
+		Data: []byte{},
+	}
+
+	res, _err := queryDispute(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	var err error
+	req.Data, err = cdc.MarshalJSON(types.NewQueryDisputeParams(hub.NewSessionID(0)))
+	require.Nil(t, err)
+
+	res, _err = queryDispute(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	want := types.NewDispute(types.TestSession, 0, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 10), 1, 100)
+	k.SetDispute(ctx, want)
+
+	res, _err = queryDispute(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &dispute)
+	require.Nil(t, err)
+	require.Equal(t, want, dispute)
+}
+
+func Test_queryAllDisputes(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	var disputes types.QueryDisputesResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDisputes),
+		Data: []byte{},
+	}
+
+	res, _err := queryAllDisputes(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	open := types.NewDispute(types.TestSession, 0, types.TestAddress2, types.DisputePartyClient,
+		sdk.NewInt64Coin("stake", 10), 1, 100)
+	k.SetDispute(ctx, open)
+
+	session := types.TestSession
+	session.ID = hub.NewSessionID(1)
+	rejected := types.NewDispute(session, 1, types.TestAddress1, types.DisputePartyNode,
+		sdk.NewInt64Coin("stake", 20), 1, 100)
+	rejected.Status = types.DisputeStatusRejected
+	k.SetDispute(ctx, rejected)
+
+	var err error
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllDisputesParams("", hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllDisputes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &disputes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Dispute{open, rejected}, disputes.Disputes)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllDisputesParams(types.DisputeStatusRejected, hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllDisputes(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &disputes)
+	require.Nil(t, err)
+	require.Equal(t, []types.Dispute{rejected}, disputes.Disputes)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllDisputesParams("closed", hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = queryAllDisputes(ctx, req, k)
+	require.Equal(t, types.ErrorInvalidField("status"), _err)
+	require.Equal(t, []byte(nil), res)
 }
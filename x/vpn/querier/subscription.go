@@ -1,6 +1,8 @@
 package querier
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -33,9 +35,9 @@ func querySubscriptionsOfNode(ctx sdk.Context, req abci.RequestQuery, k keeper.K
 		return nil, types.ErrorUnmarshal()
 	}
 
-	subscriptions := k.GetSubscriptionsOfNode(ctx, params.ID)
+	subscriptions, page := k.PaginateSubscriptionsOfNode(ctx, params.ID, params.Active, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(subscriptions)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySubscriptionsResponse(subscriptions, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -49,9 +51,9 @@ func querySubscriptionsOfAddress(ctx sdk.Context, req abci.RequestQuery, k keepe
 		return nil, types.ErrorUnmarshal()
 	}
 
-	subscriptions := k.GetSubscriptionsOfAddress(ctx, params.Address)
+	subscriptions, page := k.PaginateSubscriptionsOfAddress(ctx, params.Address, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(subscriptions)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySubscriptionsResponse(subscriptions, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -59,10 +61,15 @@ func querySubscriptionsOfAddress(ctx sdk.Context, req abci.RequestQuery, k keepe
 	return res, nil
 }
 
-func queryAllSubscriptions(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
-	subscriptions := k.GetAllSubscriptions(ctx)
+func queryAllSubscriptions(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllSubscriptionsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	subscriptions, page := k.PaginateSubscriptions(ctx, params.Pagination)
 
-	res, err := types.ModuleCdc.MarshalJSON(subscriptions)
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySubscriptionsResponse(subscriptions, page))
 	if err != nil {
 		return nil, types.ErrorMarshal()
 	}
@@ -85,3 +92,129 @@ func querySessionsCountOfSubscription(ctx sdk.Context, req abci.RequestQuery, k
 
 	return res, nil
 }
+
+// querySessionIndexOfAddress returns the index the client or the seat signs the bandwidth with, which is
+// the index of its ongoing session or else the index of the next session of the subscription.
+func querySessionIndexOfAddress(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySessionIndexOfAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	index := k.GetNextSessionIndexOfSubscription(ctx, params.ID)
+	if ongoing, found := k.GetOngoingSessionIndex(ctx, params.ID, params.Address); found {
+		index = ongoing.Index
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(index)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func querySubscriptionForecast(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySubscriptionForecastParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	subscription, found := k.GetSubscription(ctx, params.ID)
+	if !found {
+		return nil, nil
+	}
+
+	rate, found := k.GetConsumptionRate(ctx, subscription.ID)
+	if !found {
+		rate = types.NewConsumptionRate(subscription.ID, sdk.ZeroDec(), subscription.StatusModifiedAt, time.Time{})
+	}
+
+	// The bandwidth of the ongoing sessions is not deducted from the subscription until they end.
+	consumed := sdk.ZeroInt()
+	for _, ongoing := range k.GetOngoingSessionIndexesOfSubscription(ctx, subscription.ID) {
+		session, _ := k.GetSession(ctx, ongoing.SessionID)
+		consumed = consumed.Add(session.Bandwidth.Sum())
+	}
+
+	blocks := params.Blocks
+	if blocks <= 0 {
+		blocks = types.DefaultForecastBlocks
+	}
+
+	forecast := types.NewSubscriptionForecast(subscription, rate, consumed, ctx.BlockHeight(), ctx.BlockTime(), blocks)
+
+	res, err := types.ModuleCdc.MarshalJSON(forecast)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func querySeatsOfSubscription(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySeatsOfSubscriptionParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	seats, page := k.PaginateSeatsOfSubscription(ctx, params.ID, params.Pagination)
+
+	res, err := types.ModuleCdc.MarshalJSON(types.NewQuerySeatsResponse(seats, page))
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryReferralEarnings(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryReferralEarningsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	earnings := k.GetReferralEarnings(ctx, params.Address)
+
+	res, err := types.ModuleCdc.MarshalJSON(earnings)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryFeeAllowance(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeAllowanceParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	allowance, found := k.GetFeeAllowance(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := types.ModuleCdc.MarshalJSON(allowance)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
+
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, types.ErrorUnmarshal()
+	}
+
+	authorizations := k.GetAuthorizations(ctx, params.Granter, params.Grantee)
+
+	res, err := types.ModuleCdc.MarshalJSON(authorizations)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
@@ -3,7 +3,9 @@ package querier
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -54,7 +56,7 @@ func Test_querySubscriptionsOfNode(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var subscriptions []types.Subscription
+	var subscriptions types.QuerySubscriptionsResponse
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfNode),
@@ -70,7 +72,7 @@ func Test_querySubscriptionsOfNode(t *testing.T) {
 	k.SetSubscriptionsCountOfNode(ctx, types.TestNode.ID, 1)
 	k.SetSubscriptionIDByNodeID(ctx, types.TestNode.ID, 0, types.TestSubscription.ID)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(0)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(0), false, hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySubscriptionsOfNode(ctx, req, k)
@@ -79,9 +81,9 @@ func Test_querySubscriptionsOfNode(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(1)))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(1), false, hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySubscriptionsOfNode(ctx, req, k)
@@ -90,14 +92,45 @@ func Test_querySubscriptionsOfNode(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
+
+	inactive := types.TestSubscription
+	inactive.ID = hub.NewSubscriptionID(1)
+	inactive.Status = types.StatusInactive
+	k.SetSubscription(ctx, inactive)
+	k.SetSubscriptionsCountOfNode(ctx, types.TestNode.ID, 2)
+	k.SetSubscriptionIDByNodeID(ctx, types.TestNode.ID, 1, inactive.ID)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(0), false, hub.NewPageRequest(nil, 0, true)))
+	require.Nil(t, err)
+
+	res, _err = querySubscriptionsOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	subscriptions = types.QuerySubscriptionsResponse{}
+	err = cdc.UnmarshalJSON(res, &subscriptions)
+	require.Nil(t, err)
+	require.Equal(t, []types.Subscription{types.TestSubscription, inactive}, subscriptions.Subscriptions)
+	require.Equal(t, uint64(2), subscriptions.Pagination.Total)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfNodePrams(hub.NewNodeID(0), true, hub.NewPageRequest(nil, 0, true)))
+	require.Nil(t, err)
+
+	res, _err = querySubscriptionsOfNode(ctx, req, k)
+	require.Nil(t, _err)
+
+	subscriptions = types.QuerySubscriptionsResponse{}
+	err = cdc.UnmarshalJSON(res, &subscriptions)
+	require.Nil(t, err)
+	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
+	require.Equal(t, uint64(1), subscriptions.Pagination.Total)
 }
 
 func Test_querySubscriptionsOfAddress(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var subscriptions []types.Subscription
+	var subscriptions types.QuerySubscriptionsResponse
 
 	req := abci.RequestQuery{
 		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionsOfAddress),
@@ -113,7 +146,7 @@ func Test_querySubscriptionsOfAddress(t *testing.T) {
 	k.SetSubscriptionsCountOfAddress(ctx, types.TestAddress1, 1)
 	k.SetSubscriptionIDByAddress(ctx, types.TestAddress1, 0, types.TestSubscription.ID)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams([]byte("")))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams([]byte(""), hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySubscriptionsOfAddress(ctx, req, k)
@@ -122,9 +155,9 @@ func Test_querySubscriptionsOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams(types.TestAddress1))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams(types.TestAddress1, hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySubscriptionsOfAddress(ctx, req, k)
@@ -133,9 +166,9 @@ func Test_querySubscriptionsOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
-	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams(types.TestAddress2))
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionsOfAddressParams(types.TestAddress2, hub.PageRequest{}))
 	require.Nil(t, err)
 
 	res, _err = querySubscriptionsOfAddress(ctx, req, k)
@@ -144,52 +177,67 @@ func Test_querySubscriptionsOfAddress(t *testing.T) {
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 }
 
 func Test_queryAllSubscriptions(t *testing.T) {
 	ctx, k, _, _ := keeper.CreateTestInput(t, false)
 	cdc := keeper.MakeTestCodec()
 	var err error
-	var subscriptions []types.Subscription
+	var subscriptions types.QuerySubscriptionsResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllSubscriptions),
+		Data: []byte{},
+	}
+
+	res, _err := queryAllSubscriptions(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQueryAllSubscriptionsParams(hub.PageRequest{}))
+	require.Nil(t, err)
 
-	res, _err := queryAllSubscriptions(ctx, k)
+	res, _err = queryAllSubscriptions(ctx, req, k)
 	require.Nil(t, _err)
-	require.Equal(t, []byte("null"), res)
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.Len(t, subscriptions.Subscriptions, 0)
+
+	err = cdc.UnmarshalJSON(res, &subscriptions)
+	require.Nil(t, err)
+	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.NotEqual(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
 	k.SetSubscription(ctx, types.TestSubscription)
 	require.Nil(t, err)
 
-	res, _err = queryAllSubscriptions(ctx, k)
+	res, _err = queryAllSubscriptions(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions)
+	require.Equal(t, []types.Subscription{types.TestSubscription}, subscriptions.Subscriptions)
 
 	subscription := types.TestSubscription
 	subscription.ID = hub.NewSubscriptionID(1)
 	k.SetSubscription(ctx, subscription)
 	require.Nil(t, err)
 
-	res, _err = queryAllSubscriptions(ctx, k)
+	res, _err = queryAllSubscriptions(ctx, req, k)
 	require.Nil(t, _err)
 	require.NotEqual(t, []byte(nil), res)
 	require.NotNil(t, res)
 
 	err = cdc.UnmarshalJSON(res, &subscriptions)
 	require.Nil(t, err)
-	require.Equal(t, append([]types.Subscription{types.TestSubscription}, subscription), subscriptions)
+	require.Equal(t, append([]types.Subscription{types.TestSubscription}, subscription), subscriptions.Subscriptions)
 }
 
 func Test_querySessionsCountOfSubscription(t *testing.T) {
@@ -257,3 +305,152 @@ func Test_querySessionsCountOfSubscription(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, uint64(2), count)
 }
+
+func Test_querySeatsOfSubscription(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var seats types.QuerySeatsResponse
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySeatsOfSubscription),
+		Data: []byte{},
+	}
+
+	res, _err := querySeatsOfSubscription(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySeatsOfSubscriptionParams(hub.NewSubscriptionID(0), hub.PageRequest{}))
+	require.Nil(t, err)
+
+	res, _err = querySeatsOfSubscription(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &seats)
+	require.Nil(t, err)
+	require.Len(t, seats.Seats, 0)
+
+	seat := types.NewSeat(hub.NewSubscriptionID(0), 0, types.TestAddress1)
+	k.SetSeat(ctx, seat)
+
+	res, _err = querySeatsOfSubscription(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &seats)
+	require.Nil(t, err)
+	require.Equal(t, []types.Seat{seat}, seats.Seats)
+}
+
+func Test_querySessionIndexOfAddress(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+	var err error
+	var index uint64
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySessionIndexOfAddress),
+		Data: []byte{},
+	}
+
+	res, _err := querySessionIndexOfAddress(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	k.SetSessionsCountOfSubscription(ctx, hub.NewSubscriptionID(0), 1)
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionIndexOfAddressParams(hub.NewSubscriptionID(0), types.TestAddress2))
+	require.Nil(t, err)
+
+	res, _err = querySessionIndexOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &index)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), index)
+
+	ongoing := types.TestSessionIndex
+	ongoing.Index = 1
+	k.SetOngoingSessionIndex(ctx, ongoing)
+
+	res, _err = querySessionIndexOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &index)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), index)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySessionIndexOfAddressParams(hub.NewSubscriptionID(0), types.TestAddress1))
+	require.Nil(t, err)
+
+	res, _err = querySessionIndexOfAddress(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &index)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), index)
+}
+
+func Test_querySubscriptionForecast(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var err error
+	var forecast types.SubscriptionForecast
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubscriptionForecast),
+		Data: []byte{},
+	}
+
+	res, _err := querySubscriptionForecast(ctx, req, k)
+	require.NotNil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionForecastParams(hub.NewSubscriptionID(0), 0))
+	require.Nil(t, err)
+
+	res, _err = querySubscriptionForecast(ctx, req, k)
+	require.Nil(t, _err)
+	require.Equal(t, []byte(nil), res)
+
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now.Add(time.Minute))
+	k.SetSubscription(ctx, types.TestSubscription)
+
+	res, _err = querySubscriptionForecast(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &forecast)
+	require.Nil(t, err)
+	require.Equal(t, types.TestSubscription.RemainingBandwidth.Sum(), forecast.RemainingBandwidth)
+	require.Equal(t, int64(0), forecast.DepletionHeight)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), forecast.SuggestedTopUp)
+
+	k.SetConsumptionRate(ctx, types.NewConsumptionRate(hub.NewSubscriptionID(0), sdk.NewDec(1000000), 0, now))
+	session := types.TestSession
+	session.Bandwidth = hub.NewBandwidthFromInt64(200000000, 200000000)
+	k.SetSession(ctx, session)
+	k.SetSessionIDBySubscriptionID(ctx, hub.NewSubscriptionID(0), 0, session.ID)
+	k.SetOngoingSessionIndex(ctx, types.TestSessionIndex)
+
+	res, _err = querySubscriptionForecast(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &forecast)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(600000000), forecast.RemainingBandwidth)
+	require.Equal(t, int64(610), forecast.DepletionHeight)
+	require.Equal(t, now.Add(time.Minute).Add(3600*time.Second), forecast.DepletionTime.UTC())
+	require.Equal(t, sdk.NewInt64Coin("stake", 10020), forecast.SuggestedTopUp)
+
+	req.Data, err = cdc.MarshalJSON(types.NewQuerySubscriptionForecastParams(hub.NewSubscriptionID(0), 2000))
+	require.Nil(t, err)
+
+	res, _err = querySubscriptionForecast(ctx, req, k)
+	require.Nil(t, _err)
+
+	err = cdc.UnmarshalJSON(res, &forecast)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 140), forecast.SuggestedTopUp)
+}
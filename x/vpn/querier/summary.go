@@ -0,0 +1,19 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func queryNetworkSummary(ctx sdk.Context, k keeper.Keeper) ([]byte, sdk.Error) {
+	summary := k.GetNetworkSummary(ctx)
+
+	res, err := types.ModuleCdc.MarshalJSON(summary)
+	if err != nil {
+		return nil, types.ErrorMarshal()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,71 @@
+package querier
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+	"github.com/sentinel-official/hub/x/vpn/keeper"
+	"github.com/sentinel-official/hub/x/vpn/types"
+)
+
+func Test_queryNetworkSummary(t *testing.T) {
+	ctx, k, _, _ := keeper.CreateTestInput(t, false)
+	cdc := keeper.MakeTestCodec()
+
+	var summary types.NetworkSummary
+
+	res, _err := queryNetworkSummary(ctx, k)
+	require.Nil(t, _err)
+	require.NotNil(t, res)
+
+	err := cdc.UnmarshalJSON(res, &summary)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), summary.ActiveNodes)
+	require.True(t, summary.LockedDeposits.IsZero())
+
+	node := types.TestNode
+	node.Status = types.StatusRegistered
+	k.SetNode(ctx, node)
+	node.ID = hub.NewNodeID(1)
+	node.Jailed = true
+	k.SetNode(ctx, node)
+	k.SetNode(ctx, types.TestNode)
+	k.SetSubscription(ctx, types.TestSubscription)
+	k.SetSession(ctx, types.TestSession)
+
+	res, _err = queryNetworkSummary(ctx, k)
+	require.Nil(t, _err)
+
+	summary = types.NetworkSummary{}
+	err = cdc.UnmarshalJSON(res, &summary)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), summary.ActiveNodes)
+	require.Equal(t, uint64(2), summary.InactiveNodes)
+	require.Equal(t, uint64(1), summary.ActiveSubscriptions)
+	require.Equal(t, uint64(1), summary.ActiveSessions)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 200)}, summary.LockedDeposits)
+
+	session := types.TestSession
+	session.Status = types.StatusInactive
+	k.SetSession(ctx, session)
+	subscription := types.TestSubscription
+	subscription.RemainingDeposit = sdk.NewInt64Coin("stake", 40)
+	k.SetSubscription(ctx, subscription)
+	node.Jailed = false
+	k.SetNode(ctx, node)
+
+	res, _err = queryNetworkSummary(ctx, k)
+	require.Nil(t, _err)
+
+	summary = types.NetworkSummary{}
+	err = cdc.UnmarshalJSON(res, &summary)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), summary.ActiveNodes)
+	require.Equal(t, uint64(1), summary.InactiveNodes)
+	require.Equal(t, uint64(1), summary.ActiveSubscriptions)
+	require.Equal(t, uint64(0), summary.ActiveSessions)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 140)}, summary.LockedDeposits)
+}
@@ -21,7 +21,7 @@ func SimulateMsgRegisterNode(keeper vpn.Keeper) simulation.Operation {
 
 		msg := vpn.NewMsgRegisterNode(randomAcc.Address,
 			getRandomType(r), getRandomVersion(r), getRandomMoniker(r),
-			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r))
+			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r), "", "")
 
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(vpn.ModuleName), nil,
@@ -44,7 +44,7 @@ func SimulateMsgUpdateNodeInfo(keeper vpn.Keeper) simulation.Operation {
 		node := vpn.RandomNode(r, ctx, keeper)
 		msg := vpn.NewMsgUpdateNodeInfo(node.Owner, node.ID,
 			getRandomType(r), getRandomVersion(r), getRandomMoniker(r),
-			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r))
+			getRandomCoins(r), getRandomBandwidth(r), getRandomEncryption(r), "", "", false)
 
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(vpn.ModuleName), nil,
@@ -92,7 +92,102 @@ func SimulateMsgStartSubscription(keeper vpn.Keeper) simulation.Operation {
 		keeper.SetNode(ctx, node)
 
 		randomAcc := simulation.RandomAcc(r, accounts)
-		msg := vpn.NewMsgStartSubscription(randomAcc.Address, node.ID, getRandomCoin(r))
+		msg := vpn.NewMsgStartSubscription(randomAcc.Address, node.ID, getRandomCoin(r), 0, nil, nil)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(vpn.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		id := hub.NewSubscriptionID(keeper.GetSubscriptionsCount(ctx))
+
+		ok := handler(ctx, *msg).IsOK()
+		if !ok {
+			return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+		}
+
+		// The sessions of the subscription are updated in the next blocks, and the subscription is
+		// ended some blocks after the last update.
+		var futureOps []simulation.FutureOperation
+
+		height := int(ctx.BlockHeight())
+		if nodeOwnerAcc, found := findAccount(accounts, node.Owner); found {
+			updates := r.Intn(5)
+			for i := 0; i < updates; i++ {
+				height += 1 + r.Intn(5)
+				futureOps = append(futureOps, simulation.FutureOperation{
+					BlockHeight: height,
+					Op:          operationSimulateMsgUpdateSessionInfo(keeper, id, randomAcc, nodeOwnerAcc),
+				})
+			}
+		}
+
+		futureOps = append(futureOps, simulation.FutureOperation{
+			BlockHeight: height + 1 + r.Intn(10),
+			Op:          operationSimulateMsgEndSubscription(keeper, id, randomAcc),
+		})
+
+		return simulation.NewOperationMsg(msg, ok, ""), futureOps, nil
+	}
+}
+
+// operationSimulateMsgUpdateSessionInfo increases the bandwidth of the current session of the subscription
+// by a random part of the remaining bandwidth, signed by the client and the node owner.
+func operationSimulateMsgUpdateSessionInfo(keeper vpn.Keeper, id hub.SubscriptionID,
+	clientAcc, nodeOwnerAcc simulation.Account) simulation.Operation {
+	handler := vpn.NewHandler(keeper)
+
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		subscription, found := keeper.GetSubscription(ctx, id)
+		if !found || subscription.Status != vpn.StatusActive {
+			return simulation.NoOpMsg(vpn.ModuleName), nil, nil
+		}
+
+		remaining := subscription.RemainingBandwidth
+		if !remaining.AllPositive() {
+			return simulation.NoOpMsg(vpn.ModuleName), nil, nil
+		}
+
+		index := keeper.GetNextSessionIndexOfSubscription(ctx, id)
+
+		bandwidth := hub.NewBandwidthFromInt64(0, 0)
+		if ongoing, found := keeper.GetOngoingSessionIndex(ctx, id, clientAcc.Address); found {
+			session, _ := keeper.GetSession(ctx, ongoing.SessionID)
+			index, bandwidth = ongoing.Index, session.Bandwidth
+		}
+
+		bandwidth = bandwidth.Add(hub.NewBandwidth(
+			getRandomIntBetween(r, sdk.OneInt(), sdk.MinInt(remaining.Upload, hub.GB)),
+			getRandomIntBetween(r, sdk.OneInt(), sdk.MinInt(remaining.Download, hub.GB)),
+		))
+
+		data := vpn.BandwidthSignBytes(id, index, bandwidth)
+		msg := vpn.NewMsgUpdateSessionInfo(clientAcc.Address, id, bandwidth,
+			signBandwidth(nodeOwnerAcc, data), signBandwidth(clientAcc, data))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(vpn.ModuleName), nil,
+				fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ok := handler(ctx, *msg).IsOK()
+		return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+func operationSimulateMsgEndSubscription(keeper vpn.Keeper, id hub.SubscriptionID,
+	clientAcc simulation.Account) simulation.Operation {
+	handler := vpn.NewHandler(keeper)
+
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		subscription, found := keeper.GetSubscription(ctx, id)
+		if !found || subscription.Status != vpn.StatusActive {
+			return simulation.NoOpMsg(vpn.ModuleName), nil, nil
+		}
+
+		msg := vpn.NewMsgEndSubscription(clientAcc.Address, id)
 
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(vpn.ModuleName), nil,
@@ -145,16 +240,20 @@ func SimulateMsgUpdateSessionInfo(keeper vpn.Keeper) simulation.Operation {
 		subscription.Client = clientAccount.Address
 		keeper.SetSubscription(ctx, subscription)
 
-		scs := keeper.GetSessionsCountOfSubscription(ctx, subscription.ID)
+		index := keeper.GetNextSessionIndexOfSubscription(ctx, subscription.ID)
+		if ongoing, found := keeper.GetOngoingSessionIndex(ctx, subscription.ID, clientAccount.Address); found {
+			index = ongoing.Index
+		}
+
 		node, _ := keeper.GetNode(ctx, subscription.NodeID)
 		node.Owner = nodeOwnerAccount.Address
 		keeper.SetNode(ctx, node)
 
 		bandwidth := getRandomBandwidth(r)
 
-		bandWidthSignData := hub.NewBandwidthSignatureData(subscription.ID, scs, bandwidth)
-		clientAccountSignedData, _ := clientAccount.PrivKey.Sign(bandWidthSignData.Bytes())
-		nodeOwnerAccountSignedData, _ := nodeOwnerAccount.PrivKey.Sign(bandWidthSignData.Bytes())
+		bandWidthSignData := vpn.BandwidthSignBytes(subscription.ID, index, bandwidth)
+		clientAccountSignedData, _ := clientAccount.PrivKey.Sign(bandWidthSignData)
+		nodeOwnerAccountSignedData, _ := nodeOwnerAccount.PrivKey.Sign(bandWidthSignData)
 
 		clienStdSig := auth.StdSignature{
 			PubKey:    clientAccount.PubKey,
@@ -4,4 +4,20 @@ const (
 	FreeNodesCount          = "free_node_count"
 	Deposit                 = "deposit"
 	SessionInactiveInterval = "session_inactive_interval"
+	SessionPruningRetention = "session_pruning_retention"
+	PruneGasRefund          = "prune_gas_refund"
+	SettlementInterval      = "settlement_interval"
+	SettlementEpoch         = "settlement_epoch"
+	ProtocolFeeRate         = "protocol_fee_rate"
+	FreeUpdatesPerBlock     = "free_updates_per_block"
+	SubscriptionGCEpoch     = "subscription_gc_epoch"
+	SubscriptionGCRetention = "subscription_gc_retention"
+	SettlementGracePeriod   = "settlement_grace_period"
+	NodeStatsEpoch          = "node_stats_epoch"
+	NodeStatsRetention      = "node_stats_retention"
+	NodeInactiveInterval    = "node_inactive_interval"
+	NodeJailCooldown        = "node_jail_cooldown"
+	FeeDenoms               = "fee_denoms"
+	ReferralRate            = "referral_rate"
+	NodeUnbondingPeriod     = "node_unbonding_period"
 )
@@ -1,9 +1,11 @@
 package simulation
 
 import (
+	"math/big"
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	hub "github.com/sentinel-official/hub/types"
@@ -45,8 +47,9 @@ func getRandomEncryption(r *rand.Rand) string {
 	return simulation.RandStringOfLength(r, 10)
 }
 
-func getRandomType(r *rand.Rand) string {
-	return simulation.RandStringOfLength(r, 10)
+func getRandomType(r *rand.Rand) types.NodeCategory {
+	index := r.Intn(len(types.NodeCategories))
+	return types.NodeCategories[index]
 }
 
 func getRandomVersion(r *rand.Rand) string {
@@ -78,6 +81,11 @@ func getRandomCoins(r *rand.Rand) (coins sdk.Coins) {
 	return coins.Sort()
 }
 
+// getRandomIntBetween returns a random int in [min, max].
+func getRandomIntBetween(r *rand.Rand, min, max sdk.Int) sdk.Int {
+	return min.Add(sdk.NewIntFromBigInt(new(big.Int).Rand(r, max.Sub(min).AddRaw(1).BigInt())))
+}
+
 func getRandomBandwidth(r *rand.Rand) hub.Bandwidth {
 	upload := r.Int63n(hub.GB.Int64())
 	download := r.Int63n(hub.GB.Int64())
@@ -85,6 +93,25 @@ func getRandomBandwidth(r *rand.Rand) hub.Bandwidth {
 	return hub.NewBandwidthFromInt64(upload, download)
 }
 
+func findAccount(accounts []simulation.Account, address sdk.AccAddress) (simulation.Account, bool) {
+	for _, account := range accounts {
+		if account.Address.Equals(address) {
+			return account, true
+		}
+	}
+
+	return simulation.Account{}, false
+}
+
+func signBandwidth(account simulation.Account, data []byte) auth.StdSignature {
+	signature, _ := account.PrivKey.Sign(data)
+
+	return auth.StdSignature{
+		PubKey:    account.PubKey,
+		Signature: signature,
+	}
+}
+
 func GenerateRandomNode(r *rand.Rand) types.Node {
 	node := types.Node{
 		ID:               getRandomNodeID(r),
@@ -103,13 +130,16 @@ func GenerateRandomNode(r *rand.Rand) types.Node {
 }
 
 func GenerateRandomSubscription(r *rand.Rand, node types.Node) types.Subscription {
+	pricePerGB := getRandomCoin(r)
+	deposit := sdk.NewInt64Coin(pricePerGB.Denom, int64(simulation.RandIntBetween(r, 1, 1000)))
+
 	subscription := types.Subscription{
 		ID:                 getRandomSubscriptionID(r),
 		NodeID:             node.ID,
 		Client:             nil,
-		PricePerGB:         getRandomCoin(r),
-		TotalDeposit:       getRandomCoin(r),
-		RemainingDeposit:   getRandomCoin(r),
+		PricePerGB:         pricePerGB,
+		TotalDeposit:       deposit,
+		RemainingDeposit:   deposit,
 		RemainingBandwidth: getRandomBandwidth(r),
 		Status:             getRandomStatus(r),
 		StatusModifiedAt:   0,
@@ -118,11 +148,12 @@ func GenerateRandomSubscription(r *rand.Rand, node types.Node) types.Subscriptio
 	return subscription
 }
 
-func GenerateRandomSession(r *rand.Rand, id hub.SubscriptionID) types.Session {
+func GenerateRandomSession(r *rand.Rand, subscription types.Subscription) types.Session {
 	session := types.Session{
 		ID:               getRandomSessionID(r),
-		SubscriptionID:   id,
+		SubscriptionID:   subscription.ID,
 		Bandwidth:        getRandomBandwidth(r),
+		Paid:             sdk.NewInt64Coin(subscription.PricePerGB.Denom, 0),
 		Status:           getRandomStatus(r),
 		StatusModifiedAt: 0,
 	}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthorizableMsgTypes are the types of the messages a granter may authorize a grantee to execute
+// on its behalf.
+var AuthorizableMsgTypes = []string{
+	MsgStartSubscription{}.Type(),
+	MsgEndSubscription{}.Type(),
+	MsgUpdateSessionInfo{}.Type(),
+	MsgUpdateSessionsInfo{}.Type(),
+}
+
+func IsAuthorizableMsgType(_type string) bool {
+	for _, t := range AuthorizableMsgTypes {
+		if t == _type {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorization lets the grantee execute the messages of the type on behalf of the granter until
+// the expiry, zero expiry never expires.
+type Authorization struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+	Expiry  int64          `json:"expiry"`
+}
+
+func NewAuthorization(granter, grantee sdk.AccAddress, msgType string, expiry int64) Authorization {
+	return Authorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+		Expiry:  expiry,
+	}
+}
+
+func (a Authorization) String() string {
+	return fmt.Sprintf(`Authorization
+  Granter:  %s
+  Grantee:  %s
+  Msg Type: %s
+  Expiry:   %d`, a.Granter, a.Grantee, a.MsgType, a.Expiry)
+}
+
+func (a Authorization) IsValid() error {
+	if a.Granter == nil || a.Granter.Empty() {
+		return fmt.Errorf("invalid granter")
+	}
+	if a.Grantee == nil || a.Grantee.Empty() || a.Grantee.Equals(a.Granter) {
+		return fmt.Errorf("invalid grantee")
+	}
+	if !IsAuthorizableMsgType(a.MsgType) {
+		return fmt.Errorf("invalid msg type %s", a.MsgType)
+	}
+	if a.Expiry < 0 {
+		return fmt.Errorf("invalid expiry")
+	}
+
+	return nil
+}
+
+func (a Authorization) IsExpired(height int64) bool {
+	return a.Expiry > 0 && height >= a.Expiry
+}
@@ -0,0 +1,172 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExecMsgs is the maximum number of the messages executed by a MsgExecAuthorized.
+const MaxExecMsgs = 16
+
+var _ sdk.Msg = (*MsgGrantAuthorization)(nil)
+
+// MsgGrantAuthorization lets the grantee execute the messages of the type on behalf of the signer,
+// replacing an existing authorization.
+type MsgGrantAuthorization struct {
+	From    sdk.AccAddress `json:"from"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+	Expiry  int64          `json:"expiry"`
+}
+
+func (msg MsgGrantAuthorization) Type() string {
+	return "grant_authorization"
+}
+
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Grantee == nil || msg.Grantee.Empty() || msg.Grantee.Equals(msg.From) {
+		return ErrorInvalidField("grantee")
+	}
+	if !IsAuthorizableMsgType(msg.MsgType) {
+		return ErrorInvalidField("msg_type")
+	}
+	if msg.Expiry < 0 {
+		return ErrorInvalidField("expiry")
+	}
+
+	return nil
+}
+
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgGrantAuthorization) Route() string {
+	return RouterKey
+}
+
+func NewMsgGrantAuthorization(from, grantee sdk.AccAddress, msgType string, expiry int64) *MsgGrantAuthorization {
+	return &MsgGrantAuthorization{
+		From:    from,
+		Grantee: grantee,
+		MsgType: msgType,
+		Expiry:  expiry,
+	}
+}
+
+var _ sdk.Msg = (*MsgRevokeAuthorization)(nil)
+
+type MsgRevokeAuthorization struct {
+	From    sdk.AccAddress `json:"from"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+func (msg MsgRevokeAuthorization) Type() string {
+	return "revoke_authorization"
+}
+
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Grantee == nil || msg.Grantee.Empty() {
+		return ErrorInvalidField("grantee")
+	}
+	if msg.MsgType == "" {
+		return ErrorInvalidField("msg_type")
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRevokeAuthorization) Route() string {
+	return RouterKey
+}
+
+func NewMsgRevokeAuthorization(from, grantee sdk.AccAddress, msgType string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		From:    from,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+var _ sdk.Msg = (*MsgExecAuthorized)(nil)
+
+// MsgExecAuthorized executes the messages of the granters signed by the grantee only, each message
+// needs an authorization of its signer for the grantee.
+type MsgExecAuthorized struct {
+	From sdk.AccAddress `json:"from"`
+	Msgs []sdk.Msg      `json:"msgs"`
+}
+
+func (msg MsgExecAuthorized) Type() string {
+	return "exec_authorized"
+}
+
+func (msg MsgExecAuthorized) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxExecMsgs {
+		return ErrorInvalidField("msgs")
+	}
+
+	for _, _msg := range msg.Msgs {
+		if _msg.Route() != RouterKey || !IsAuthorizableMsgType(_msg.Type()) || len(_msg.GetSigners()) != 1 {
+			return ErrorInvalidField("msgs")
+		}
+		if err := _msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes uses the codec of the module, as the messages are encoded along with their types.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgExecAuthorized) Route() string {
+	return RouterKey
+}
+
+func NewMsgExecAuthorized(from sdk.AccAddress, msgs []sdk.Msg) *MsgExecAuthorized {
+	return &MsgExecAuthorized{
+		From: from,
+		Msgs: msgs,
+	}
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestMsgGrantAuthorization_ValidateBasic(t *testing.T) {
+	_type := MsgStartSubscription{}.Type()
+	tests := []struct {
+		name string
+		msg  *MsgGrantAuthorization
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgGrantAuthorization(nil, TestAddress2, _type, 0),
+			ErrorInvalidField("from"),
+		}, {
+			"grantee is nil",
+			NewMsgGrantAuthorization(TestAddress1, nil, _type, 0),
+			ErrorInvalidField("grantee"),
+		}, {
+			"grantee is from",
+			NewMsgGrantAuthorization(TestAddress1, TestAddress1, _type, 0),
+			ErrorInvalidField("grantee"),
+		}, {
+			"msg type is not authorizable",
+			NewMsgGrantAuthorization(TestAddress1, TestAddress2, MsgWithdrawNodeDeposit{}.Type(), 0),
+			ErrorInvalidField("msg_type"),
+		}, {
+			"expiry is negative",
+			NewMsgGrantAuthorization(TestAddress1, TestAddress2, _type, -1),
+			ErrorInvalidField("expiry"),
+		}, {
+			"valid",
+			NewMsgGrantAuthorization(TestAddress1, TestAddress2, _type, 100),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRevokeAuthorization
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRevokeAuthorization(nil, TestAddress2, MsgStartSubscription{}.Type()),
+			ErrorInvalidField("from"),
+		}, {
+			"grantee is nil",
+			NewMsgRevokeAuthorization(TestAddress1, nil, MsgStartSubscription{}.Type()),
+			ErrorInvalidField("grantee"),
+		}, {
+			"msg type is empty",
+			NewMsgRevokeAuthorization(TestAddress1, TestAddress2, ""),
+			ErrorInvalidField("msg_type"),
+		}, {
+			"valid",
+			NewMsgRevokeAuthorization(TestAddress1, TestAddress2, MsgStartSubscription{}.Type()),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgExecAuthorized_ValidateBasic(t *testing.T) {
+	msg := *NewMsgEndSubscription(TestAddress2, hub.NewSubscriptionID(0))
+	tests := []struct {
+		name string
+		msg  *MsgExecAuthorized
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgExecAuthorized(nil, []sdk.Msg{msg}),
+			ErrorInvalidField("from"),
+		}, {
+			"msgs is empty",
+			NewMsgExecAuthorized(TestAddress1, nil),
+			ErrorInvalidField("msgs"),
+		}, {
+			"msgs is above the limit",
+			NewMsgExecAuthorized(TestAddress1, make([]sdk.Msg, MaxExecMsgs+1)),
+			ErrorInvalidField("msgs"),
+		}, {
+			"msg is not authorizable",
+			NewMsgExecAuthorized(TestAddress1, []sdk.Msg{*NewMsgUnjailNode(TestAddress2, hub.NewNodeID(0))}),
+			ErrorInvalidField("msgs"),
+		}, {
+			"msg is invalid",
+			NewMsgExecAuthorized(TestAddress1, []sdk.Msg{*NewMsgEndSubscription(nil, hub.NewSubscriptionID(0))}),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgExecAuthorized(TestAddress1, []sdk.Msg{msg}),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgExecAuthorized_GetSignBytes(t *testing.T) {
+	msg := NewMsgExecAuthorized(TestAddress1, []sdk.Msg{*NewMsgEndSubscription(TestAddress2, hub.NewSubscriptionID(0))})
+	require.Contains(t, string(msg.GetSignBytes()), "x/vpn/MsgEndSubscription")
+}
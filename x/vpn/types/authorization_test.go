@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorization_IsValid(t *testing.T) {
+	_type := MsgEndSubscription{}.Type()
+	require.NotNil(t, NewAuthorization(nil, TestAddress2, _type, 0).IsValid())
+	require.NotNil(t, NewAuthorization(TestAddress1, nil, _type, 0).IsValid())
+	require.NotNil(t, NewAuthorization(TestAddress1, TestAddress1, _type, 0).IsValid())
+	require.NotNil(t, NewAuthorization(TestAddress1, TestAddress2, MsgRegisterNode{}.Type(), 0).IsValid())
+	require.NotNil(t, NewAuthorization(TestAddress1, TestAddress2, _type, -1).IsValid())
+	require.Nil(t, NewAuthorization(TestAddress1, TestAddress2, _type, 0).IsValid())
+	require.Nil(t, NewAuthorization(TestAddress1, TestAddress2, _type, 10).IsValid())
+}
+
+func TestAuthorization_IsExpired(t *testing.T) {
+	_type := MsgEndSubscription{}.Type()
+	require.False(t, NewAuthorization(TestAddress1, TestAddress2, _type, 0).IsExpired(100))
+	require.False(t, NewAuthorization(TestAddress1, TestAddress2, _type, 10).IsExpired(9))
+	require.True(t, NewAuthorization(TestAddress1, TestAddress2, _type, 10).IsExpired(10))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -12,13 +13,47 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRegisterNode{}, "x/vpn/MsgRegisterNode", nil)
 	cdc.RegisterConcrete(MsgUpdateNodeInfo{}, "x/vpn/MsgUpdateNodeInfo", nil)
 	cdc.RegisterConcrete(MsgDeregisterNode{}, "x/vpn/MsgDeregisterNode", nil)
+	cdc.RegisterConcrete(MsgPruneNodeHistory{}, "x/vpn/MsgPruneNodeHistory", nil)
+	cdc.RegisterConcrete(MsgSetNodePrivate{}, "x/vpn/MsgSetNodePrivate", nil)
+	cdc.RegisterConcrete(MsgSetNodeLocation{}, "x/vpn/MsgSetNodeLocation", nil)
+	cdc.RegisterConcrete(MsgAddAllowedAddress{}, "x/vpn/MsgAddAllowedAddress", nil)
+	cdc.RegisterConcrete(MsgRemoveAllowedAddress{}, "x/vpn/MsgRemoveAllowedAddress", nil)
+	cdc.RegisterConcrete(MsgBlacklistClient{}, "x/vpn/MsgBlacklistClient", nil)
+	cdc.RegisterConcrete(MsgUnblacklistClient{}, "x/vpn/MsgUnblacklistClient", nil)
+	cdc.RegisterConcrete(MsgSetPayoutRoutes{}, "x/vpn/MsgSetPayoutRoutes", nil)
+	cdc.RegisterConcrete(MsgSetRevenueSplits{}, "x/vpn/MsgSetRevenueSplits", nil)
+	cdc.RegisterConcrete(MsgSetNodeFreeTrial{}, "x/vpn/MsgSetNodeFreeTrial", nil)
+	cdc.RegisterConcrete(MsgSetDiscountPlan{}, "x/vpn/MsgSetDiscountPlan", nil)
+	cdc.RegisterConcrete(MsgUnjailNode{}, "x/vpn/MsgUnjailNode", nil)
+	cdc.RegisterConcrete(MsgWithdrawNodeDeposit{}, "x/vpn/MsgWithdrawNodeDeposit", nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "x/vpn/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "x/vpn/MsgRevokeFeeAllowance", nil)
 	cdc.RegisterConcrete(MsgStartSubscription{}, "x/vpn/MsgStartSubscription", nil)
+	cdc.RegisterConcrete(MsgStartFreeTrial{}, "x/vpn/MsgStartFreeTrial", nil)
 	cdc.RegisterConcrete(MsgEndSubscription{}, "x/vpn/MsgEndSubscription", nil)
+	cdc.RegisterConcrete(MsgAssignSeat{}, "x/vpn/MsgAssignSeat", nil)
+	cdc.RegisterConcrete(MsgUnassignSeat{}, "x/vpn/MsgUnassignSeat", nil)
+	cdc.RegisterConcrete(MsgPauseSubscription{}, "x/vpn/MsgPauseSubscription", nil)
+	cdc.RegisterConcrete(MsgResumeSubscription{}, "x/vpn/MsgResumeSubscription", nil)
+	cdc.RegisterConcrete(MsgTopUpSubscription{}, "x/vpn/MsgTopUpSubscription", nil)
+	cdc.RegisterConcrete(MsgSubmitQoSReport{}, "x/vpn/MsgSubmitQoSReport", nil)
+	cdc.RegisterConcrete(MsgRateNode{}, "x/vpn/MsgRateNode", nil)
 	cdc.RegisterConcrete(MsgUpdateSessionInfo{}, "x/vpn/MsgUpdateSessionInfo", nil)
+	cdc.RegisterConcrete(MsgUpdateSessionsInfo{}, "x/vpn/MsgUpdateSessionsInfo", nil)
+	cdc.RegisterConcrete(MsgOpenDispute{}, "x/vpn/MsgOpenDispute", nil)
+	cdc.RegisterConcrete(MsgSubmitDisputeEvidence{}, "x/vpn/MsgSubmitDisputeEvidence", nil)
+	cdc.RegisterConcrete(MsgGrantAuthorization{}, "x/vpn/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(MsgRevokeAuthorization{}, "x/vpn/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(MsgExecAuthorized{}, "x/vpn/MsgExecAuthorized", nil)
+
+	cdc.RegisterConcrete(ReleaseEscrowProposal{}, "x/vpn/ReleaseEscrowProposal", nil)
+	cdc.RegisterConcrete(JailNodeProposal{}, "x/vpn/JailNodeProposal", nil)
+	cdc.RegisterConcrete(ResolveDisputeProposal{}, "x/vpn/ResolveDisputeProposal", nil)
 }
 
 func init() {
 	ModuleCdc = codec.New()
+	sdk.RegisterCodec(ModuleCdc)
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
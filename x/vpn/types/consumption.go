@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	// DefaultForecastBlocks is the number of blocks the suggested top-up of a forecast covers.
+	DefaultForecastBlocks int64 = 100800
+)
+
+var (
+	// ConsumptionRateSmoothing is the weight of the latest update in the rolling consumption rate.
+	ConsumptionRateSmoothing = sdk.NewDecWithPrec(2, 1)
+)
+
+// ConsumptionRate is the rolling average of the bandwidth consumed by a subscription per block.
+type ConsumptionRate struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Rate           sdk.Dec            `json:"rate"`
+	Height         int64              `json:"height"`
+	Time           time.Time          `json:"time"`
+}
+
+func NewConsumptionRate(id hub.SubscriptionID, rate sdk.Dec, height int64, t time.Time) ConsumptionRate {
+	return ConsumptionRate{
+		SubscriptionID: id,
+		Rate:           rate,
+		Height:         height,
+		Time:           t,
+	}
+}
+
+func (c ConsumptionRate) String() string {
+	return fmt.Sprintf(`ConsumptionRate
+  Subscription ID: %s
+  Rate:            %s
+  Height:          %d
+  Time:            %s`, c.SubscriptionID, c.Rate, c.Height, c.Time)
+}
+
+// Update returns the consumption rate after the bandwidth is consumed at the height,
+// which is averaged over the blocks since the last update.
+func (c ConsumptionRate) Update(consumed sdk.Int, height int64, t time.Time) ConsumptionRate {
+	blocks := height - c.Height
+	if blocks < 1 {
+		blocks = 1
+	}
+
+	rate := consumed.ToDec().QuoInt64(blocks)
+	c.Rate = c.Rate.Add(rate.Sub(c.Rate).Mul(ConsumptionRateSmoothing))
+	c.Height = height
+	c.Time = t
+
+	return c
+}
+
+type SubscriptionForecast struct {
+	SubscriptionID     hub.SubscriptionID `json:"subscription_id"`
+	Rate               sdk.Dec            `json:"rate"`
+	RemainingBandwidth sdk.Int            `json:"remaining_bandwidth"`
+	DepletionHeight    int64              `json:"depletion_height"`
+	DepletionTime      time.Time          `json:"depletion_time"`
+	SuggestedTopUp     sdk.Coin           `json:"suggested_top_up"`
+}
+
+// NewSubscriptionForecast estimates when the remaining bandwidth of the subscription runs out at
+// the consumption rate, and the deposit which is needed to keep it running for the given blocks.
+// The depletion height and time are zero if they can not be estimated.
+func NewSubscriptionForecast(subscription Subscription, rate ConsumptionRate, consumed sdk.Int,
+	height int64, t time.Time, blocks int64) SubscriptionForecast {
+	forecast := SubscriptionForecast{
+		SubscriptionID:     subscription.ID,
+		Rate:               rate.Rate,
+		RemainingBandwidth: subscription.RemainingBandwidth.Sum().Sub(consumed),
+		SuggestedTopUp:     sdk.NewInt64Coin(subscription.PricePerGB.Denom, 0),
+	}
+	if forecast.RemainingBandwidth.IsNegative() {
+		forecast.RemainingBandwidth = sdk.ZeroInt()
+	}
+	if !rate.Rate.IsPositive() {
+		return forecast
+	}
+
+	left := forecast.RemainingBandwidth.ToDec().Quo(rate.Rate).TruncateInt()
+	if left.IsInt64() && left.Int64() <= math.MaxInt64-height {
+		forecast.DepletionHeight = height + left.Int64()
+
+		if height > rate.Height && !rate.Time.IsZero() && t.After(rate.Time) {
+			interval := t.Sub(rate.Time) / time.Duration(height-rate.Height)
+			if interval > 0 && left.Int64() <= math.MaxInt64/int64(interval) {
+				forecast.DepletionTime = t.Add(interval * time.Duration(left.Int64()))
+			}
+		}
+	}
+
+	needed := rate.Rate.MulInt64(blocks).Ceil().TruncateInt().Sub(forecast.RemainingBandwidth)
+	if needed.IsPositive() {
+		amount := needed.Mul(subscription.PricePerGB.Amount).Add(hub.GB).SubRaw(1).Quo(hub.GB)
+		forecast.SuggestedTopUp = sdk.NewCoin(subscription.PricePerGB.Denom, amount)
+	}
+
+	return forecast
+}
+
+func (f SubscriptionForecast) String() string {
+	return fmt.Sprintf(`SubscriptionForecast
+  Subscription ID:     %s
+  Rate:                %s
+  Remaining Bandwidth: %s
+  Depletion Height:    %d
+  Depletion Time:      %s
+  Suggested Top Up:    %s`, f.SubscriptionID, f.Rate, f.RemainingBandwidth, f.DepletionHeight,
+		f.DepletionTime, f.SuggestedTopUp)
+}
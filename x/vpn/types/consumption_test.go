@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestConsumptionRate_Update(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	rate := NewConsumptionRate(hub.NewSubscriptionID(0), sdk.ZeroDec(), 0, time.Time{})
+
+	rate = rate.Update(sdk.NewInt(100), 10, now)
+	require.Equal(t, sdk.NewDec(2), rate.Rate)
+	require.Equal(t, int64(10), rate.Height)
+	require.Equal(t, now, rate.Time)
+
+	rate = rate.Update(sdk.NewInt(50), 10, now)
+	require.Equal(t, sdk.NewDecWithPrec(116, 1), rate.Rate)
+	require.Equal(t, int64(10), rate.Height)
+
+	rate = rate.Update(sdk.ZeroInt(), 20, now.Add(time.Minute))
+	require.Equal(t, sdk.NewDecWithPrec(928, 2), rate.Rate)
+	require.Equal(t, int64(20), rate.Height)
+	require.Equal(t, now.Add(time.Minute), rate.Time)
+}
+
+func TestNewSubscriptionForecast(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	rate := NewConsumptionRate(TestSubscription.ID, sdk.NewDec(1000000), 0, now)
+
+	forecast := NewSubscriptionForecast(TestSubscription, rate, sdk.ZeroInt(), 10, now.Add(time.Minute), 2000)
+	require.Equal(t, TestSubscription.ID, forecast.SubscriptionID)
+	require.Equal(t, sdk.NewInt(1000000000), forecast.RemainingBandwidth)
+	require.Equal(t, int64(1010), forecast.DepletionHeight)
+	require.Equal(t, now.Add(time.Minute).Add(6000*time.Second), forecast.DepletionTime)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), forecast.SuggestedTopUp)
+
+	forecast = NewSubscriptionForecast(TestSubscription, rate, sdk.NewInt(400000000), 10, now.Add(time.Minute), 2000)
+	require.Equal(t, sdk.NewInt(600000000), forecast.RemainingBandwidth)
+	require.Equal(t, int64(610), forecast.DepletionHeight)
+	require.Equal(t, sdk.NewInt64Coin("stake", 140), forecast.SuggestedTopUp)
+
+	forecast = NewSubscriptionForecast(TestSubscription, rate, sdk.ZeroInt(), 10, now.Add(time.Minute), 100)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), forecast.SuggestedTopUp)
+
+	forecast = NewSubscriptionForecast(TestSubscription, rate, sdk.NewInt(2000000000), 10, now.Add(time.Minute), 1)
+	require.Equal(t, sdk.ZeroInt(), forecast.RemainingBandwidth)
+	require.Equal(t, int64(10), forecast.DepletionHeight)
+	require.Equal(t, sdk.NewInt64Coin("stake", 1), forecast.SuggestedTopUp)
+
+	rate = NewConsumptionRate(TestSubscription.ID, sdk.NewDec(1000000), 0, time.Time{})
+	forecast = NewSubscriptionForecast(TestSubscription, rate, sdk.ZeroInt(), 10, now, 2000)
+	require.Equal(t, int64(1010), forecast.DepletionHeight)
+	require.True(t, forecast.DepletionTime.IsZero())
+
+	rate = NewConsumptionRate(TestSubscription.ID, sdk.ZeroDec(), 0, now)
+	forecast = NewSubscriptionForecast(TestSubscription, rate, sdk.ZeroInt(), 10, now.Add(time.Minute), 2000)
+	require.Equal(t, int64(0), forecast.DepletionHeight)
+	require.True(t, forecast.DepletionTime.IsZero())
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), forecast.SuggestedTopUp)
+}
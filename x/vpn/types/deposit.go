@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+type NodeDeposit struct {
+	NodeID  hub.NodeID `json:"node_id"`
+	Deposit sdk.Coin   `json:"deposit"`
+}
+
+type SubscriptionDeposit struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Deposit        sdk.Coin           `json:"deposit"`
+}
+
+// DepositOfAddress is the deposit locked by an address along with the registered nodes and
+// the active subscriptions which it backs, and the deposits of the de-registered nodes which
+// are not released yet.
+type DepositOfAddress struct {
+	Address       sdk.AccAddress        `json:"address"`
+	Coins         sdk.Coins             `json:"coins"`
+	Nodes         []NodeDeposit         `json:"nodes"`
+	Subscriptions []SubscriptionDeposit `json:"subscriptions"`
+	Unbondings    []NodeUnbonding       `json:"unbondings"`
+}
+
+func (d DepositOfAddress) String() string {
+	var nodes, subscriptions, unbondings strings.Builder
+	for _, node := range d.Nodes {
+		nodes.WriteString(fmt.Sprintf("\n    %s: %s", node.NodeID, node.Deposit))
+	}
+	for _, subscription := range d.Subscriptions {
+		subscriptions.WriteString(fmt.Sprintf("\n    %s: %s", subscription.SubscriptionID, subscription.Deposit))
+	}
+	for _, unbonding := range d.Unbondings {
+		unbondings.WriteString(fmt.Sprintf("\n    %s: %s at %d", unbonding.NodeID, unbonding.Deposit, unbonding.CompletesAt))
+	}
+
+	return fmt.Sprintf(`Deposit
+  Address:       %s
+  Coins:         %s
+  Nodes:%s
+  Subscriptions:%s
+  Unbondings:%s`, d.Address, d.Coins, nodes.String(), subscriptions.String(), unbondings.String())
+}
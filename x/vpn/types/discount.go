@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// DiscountPlan is a promotion of the node, the subscriptions started until the height inclusive
+// are priced at the rate off the prices of the node.
+type DiscountPlan struct {
+	NodeID hub.NodeID `json:"node_id"`
+	Rate   sdk.Dec    `json:"rate"`
+	Until  int64      `json:"until"`
+}
+
+func NewDiscountPlan(id hub.NodeID, rate sdk.Dec, until int64) DiscountPlan {
+	return DiscountPlan{
+		NodeID: id,
+		Rate:   rate,
+		Until:  until,
+	}
+}
+
+func (d DiscountPlan) String() string {
+	return fmt.Sprintf(`DiscountPlan
+  Node ID: %s
+  Rate:    %s
+  Until:   %d`, d.NodeID, d.Rate, d.Until)
+}
+
+func (d DiscountPlan) IsValid() error {
+	if d.Rate.IsNil() || !d.Rate.IsPositive() || d.Rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("invalid rate")
+	}
+	if d.Until <= 0 {
+		return fmt.Errorf("invalid until")
+	}
+
+	return nil
+}
+
+func (d DiscountPlan) IsActive(height int64) bool {
+	return height <= d.Until
+}
+
+// Apply returns the price at the discount, it is rounded up so that the price stays positive.
+func (d DiscountPlan) Apply(price sdk.Coin) sdk.Coin {
+	amount := sdk.OneDec().Sub(d.Rate).MulInt(price.Amount).Ceil().TruncateInt()
+	return sdk.NewCoin(price.Denom, amount)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestDiscountPlan_IsValid(t *testing.T) {
+	plan := NewDiscountPlan(hub.NewNodeID(1), sdk.NewDecWithPrec(25, 2), 100)
+	require.Nil(t, plan.IsValid())
+
+	invalid := plan
+	invalid.Rate = sdk.ZeroDec()
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = plan
+	invalid.Rate = sdk.OneDec()
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = plan
+	invalid.Until = 0
+	require.NotNil(t, invalid.IsValid())
+}
+
+func TestDiscountPlan_IsActive(t *testing.T) {
+	plan := NewDiscountPlan(hub.NewNodeID(1), sdk.NewDecWithPrec(25, 2), 100)
+	require.True(t, plan.IsActive(99))
+	require.True(t, plan.IsActive(100))
+	require.False(t, plan.IsActive(101))
+}
+
+func TestDiscountPlan_Apply(t *testing.T) {
+	plan := NewDiscountPlan(hub.NewNodeID(1), sdk.NewDecWithPrec(25, 2), 100)
+	require.Equal(t, sdk.NewInt64Coin("stake", 75), plan.Apply(sdk.NewInt64Coin("stake", 100)))
+	require.Equal(t, sdk.NewInt64Coin("stake", 1), plan.Apply(sdk.NewInt64Coin("stake", 1)))
+
+	plan.Rate = sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, sdk.NewInt64Coin("stake", 2), plan.Apply(sdk.NewInt64Coin("stake", 3)))
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	DisputePartyClient = "CLIENT"
+	DisputePartyNode   = "NODE"
+
+	DisputeStatusOpen     = "OPEN"
+	DisputeStatusUpheld   = "UPHELD"
+	DisputeStatusRejected = "REJECTED"
+)
+
+// Dispute contests the settlement of an ended session. The client claims that the amount was overcharged,
+// and it is frozen from the deposit of the node, or the node claims that the amount was underpaid, and it is
+// frozen from the remaining deposit of the subscription. The frozen amount is held by the dispute pool until
+// the dispute is resolved by the counter evidence of the node, by a governance decision or by the timeout at
+// the deadline, which resolves it in favour of the client.
+type Dispute struct {
+	SessionID        hub.SessionID      `json:"session_id"`
+	SubscriptionID   hub.SubscriptionID `json:"subscription_id"`
+	Index            uint64             `json:"index"`
+	From             sdk.AccAddress     `json:"from"`
+	Party            string             `json:"party"`
+	Amount           sdk.Coin           `json:"amount"`
+	Deadline         int64              `json:"deadline"`
+	Status           string             `json:"status"`
+	StatusModifiedAt int64              `json:"status_modified_at"`
+}
+
+func NewDispute(session Session, index uint64, from sdk.AccAddress, party string,
+	amount sdk.Coin, height, deadline int64) Dispute {
+	return Dispute{
+		SessionID:        session.ID,
+		SubscriptionID:   session.SubscriptionID,
+		Index:            index,
+		From:             from,
+		Party:            party,
+		Amount:           amount,
+		Deadline:         deadline,
+		Status:           DisputeStatusOpen,
+		StatusModifiedAt: height,
+	}
+}
+
+func (d Dispute) String() string {
+	return fmt.Sprintf(`Dispute
+  Session ID:         %s
+  Subscription ID:    %s
+  Index:              %d
+  From:               %s
+  Party:              %s
+  Amount:             %s
+  Deadline:           %d
+  Status:             %s
+  Status Modified At: %d`, d.SessionID, d.SubscriptionID, d.Index, d.From, d.Party, d.Amount,
+		d.Deadline, d.Status, d.StatusModifiedAt)
+}
+
+func (d Dispute) IsOpen() bool {
+	return d.Status == DisputeStatusOpen
+}
+
+func (d Dispute) IsValid() error {
+	if d.From == nil || d.From.Empty() {
+		return fmt.Errorf("invalid from")
+	}
+	if d.Party != DisputePartyClient && d.Party != DisputePartyNode {
+		return fmt.Errorf("invalid party")
+	}
+	if !d.Amount.IsValid() || !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount")
+	}
+	if d.Deadline <= 0 {
+		return fmt.Errorf("invalid deadline")
+	}
+	if !IsValidDisputeStatus(d.Status) {
+		return fmt.Errorf("invalid status")
+	}
+	if d.StatusModifiedAt < 0 {
+		return fmt.Errorf("invalid status modified at")
+	}
+
+	return nil
+}
+
+func IsValidDisputeStatus(status string) bool {
+	return status == DisputeStatusOpen || status == DisputeStatusUpheld || status == DisputeStatusRejected
+}
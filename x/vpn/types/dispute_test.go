@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispute_IsValid(t *testing.T) {
+	dispute := NewDispute(TestSession, 0, TestAddress2, DisputePartyClient, sdk.NewInt64Coin("stake", 10), 1, 101)
+	require.True(t, dispute.IsOpen())
+	require.Nil(t, dispute.IsValid())
+
+	invalid := dispute
+	invalid.From = nil
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = dispute
+	invalid.Party = "OWNER"
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = dispute
+	invalid.Amount = sdk.NewInt64Coin("stake", 0)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = dispute
+	invalid.Deadline = 0
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = dispute
+	invalid.Status = StatusActive
+	require.NotNil(t, invalid.IsValid())
+
+	dispute.Status = DisputeStatusRejected
+	require.False(t, dispute.IsOpen())
+	require.Nil(t, dispute.IsValid())
+}
+
+func TestIsValidDisputeStatus(t *testing.T) {
+	require.True(t, IsValidDisputeStatus(DisputeStatusOpen))
+	require.True(t, IsValidDisputeStatus(DisputeStatusUpheld))
+	require.True(t, IsValidDisputeStatus(DisputeStatusRejected))
+	require.False(t, IsValidDisputeStatus(""))
+	require.False(t, IsValidDisputeStatus("open"))
+}
@@ -23,6 +23,42 @@ const (
 	errCodeInvalidBandwidthSignature = 112
 	errCodeSessionAlreadyExists      = 113
 	errCodeInvalidSessionStatus      = 114
+	errCodeAddressNotAllowed         = 115
+	errCodeClientBlacklisted         = 116
+	errCodeClientNotBlacklisted      = 117
+	errCodeInvalidSeat               = 118
+	errCodeSeatAlreadyAssigned       = 119
+	errCodeInvalidQuote              = 120
+	errCodeInvalidQuoteSignature     = 121
+	errCodeQuoteExpired              = 122
+	errCodeQuoteAlreadyUsed          = 123
+	errCodeStaleBandwidth            = 124
+	errCodeInvalidClientSignature    = 125
+	errCodeInvalidNodeSignature      = 126
+	errCodeSubscriptionEnding        = 127
+	errCodeSubscriptionPaused        = 128
+	errCodeSubscriptionNotPaused     = 129
+	errCodeNodeJailed                = 130
+	errCodeNodeNotJailed             = 131
+	errCodeNodeJailCooldown          = 132
+	errCodeInvalidFeeDenom           = 133
+	errCodeInvalidReferrer           = 134
+	errCodeFreeTrialNotOffered       = 135
+	errCodeFreeTrialAlreadyUsed      = 136
+	errCodeInvalidDiscountPlan       = 137
+	errCodeMonikerAlreadyTaken       = 138
+	errCodeInsufficientNodeDeposit   = 139
+	errCodeFeeAllowanceDoesNotExist  = 140
+	errCodeAuthorizationDoesNotExist = 141
+	errCodeQoSAlreadyReported        = 142
+	errCodeSubscriptionNotCompleted  = 143
+	errCodeNodeAlreadyRated          = 144
+	errCodeDisputeDoesNotExist       = 145
+	errCodeDisputeAlreadyExists      = 146
+	errCodeDisputeWindowClosed       = 147
+	errCodeInvalidDisputeStatus      = 148
+	errCodeInvalidDisputeAmount      = 149
+	errCodeInvalidEvidence           = 150
 
 	errMsgUnknownMsgType            = "Unknown message type: "
 	errMsgUnknownQueryType          = "Invalid query type: "
@@ -38,6 +74,42 @@ const (
 	errMsgInvalidBandwidthSignature = "Invalid bandwidth signature"
 	errMsgSessionAlreadyExists      = "Session is active"
 	errMsgInvalidSessionStatus      = "Invalid session status"
+	errMsgAddressNotAllowed         = "Address is not allowed"
+	errMsgClientBlacklisted         = "Client is blacklisted"
+	errMsgClientNotBlacklisted      = "Client is not blacklisted"
+	errMsgInvalidSeat               = "Invalid seat"
+	errMsgSeatAlreadyAssigned       = "Address already occupies a seat"
+	errMsgInvalidQuote              = "Invalid quote"
+	errMsgInvalidQuoteSignature     = "Invalid quote signature"
+	errMsgQuoteExpired              = "Quote is expired"
+	errMsgQuoteAlreadyUsed          = "Quote is already used"
+	errMsgStaleBandwidth            = "Bandwidth is not greater than the last update of the session"
+	errMsgInvalidClientSignature    = "Invalid client signature of the bandwidth"
+	errMsgInvalidNodeSignature      = "Invalid node signature of the bandwidth"
+	errMsgSubscriptionEnding        = "Subscription is ending"
+	errMsgSubscriptionPaused        = "Subscription is paused"
+	errMsgSubscriptionNotPaused     = "Subscription is not paused"
+	errMsgNodeJailed                = "Node is jailed"
+	errMsgNodeNotJailed             = "Node is not jailed"
+	errMsgNodeJailCooldown          = "Jail cooldown of the node is not over"
+	errMsgInvalidFeeDenom           = "Fee denom is not accepted: "
+	errMsgInvalidReferrer           = "Referrer can not be the client or the node owner"
+	errMsgFreeTrialNotOffered       = "Node does not offer a free trial"
+	errMsgFreeTrialAlreadyUsed      = "Free trial of the node is already used by the address"
+	errMsgInvalidDiscountPlan       = "Discount plan must end after the current height"
+	errMsgMonikerAlreadyTaken       = "Moniker is already taken by another node"
+	errMsgInsufficientNodeDeposit   = "Amount exceeds the deposit of the node above the minimum deposit"
+	errMsgFeeAllowanceDoesNotExist  = "Fee allowance does not exist"
+	errMsgAuthorizationDoesNotExist = "Authorization does not exist"
+	errMsgQoSAlreadyReported        = "Quality of the last session of the subscription is already reported"
+	errMsgSubscriptionNotCompleted  = "Subscription is not completed"
+	errMsgNodeAlreadyRated          = "Node is already rated for the subscription"
+	errMsgDisputeDoesNotExist       = "Dispute does not exist"
+	errMsgDisputeAlreadyExists      = "Session is already disputed"
+	errMsgDisputeWindowClosed       = "Dispute window of the session is closed"
+	errMsgInvalidDisputeStatus      = "Invalid dispute status"
+	errMsgInvalidDisputeAmount      = "Amount exceeds the disputable amount of the session"
+	errMsgInvalidEvidence           = "Evidence does not resolve the dispute"
 )
 
 func ErrorMarshal() sdk.Error {
@@ -103,3 +175,147 @@ func ErrorSessionAlreadyExists() sdk.Error {
 func ErrorInvalidSessionStatus() sdk.Error {
 	return sdk.NewError(Codespace, errCodeInvalidSessionStatus, errMsgInvalidSessionStatus)
 }
+
+func ErrorAddressNotAllowed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeAddressNotAllowed, errMsgAddressNotAllowed)
+}
+
+func ErrorClientBlacklisted() sdk.Error {
+	return sdk.NewError(Codespace, errCodeClientBlacklisted, errMsgClientBlacklisted)
+}
+
+func ErrorClientNotBlacklisted() sdk.Error {
+	return sdk.NewError(Codespace, errCodeClientNotBlacklisted, errMsgClientNotBlacklisted)
+}
+
+func ErrorInvalidSeat() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidSeat, errMsgInvalidSeat)
+}
+
+func ErrorSeatAlreadyAssigned() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSeatAlreadyAssigned, errMsgSeatAlreadyAssigned)
+}
+
+func ErrorInvalidQuote() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidQuote, errMsgInvalidQuote)
+}
+
+func ErrorInvalidQuoteSignature() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidQuoteSignature, errMsgInvalidQuoteSignature)
+}
+
+func ErrorQuoteExpired() sdk.Error {
+	return sdk.NewError(Codespace, errCodeQuoteExpired, errMsgQuoteExpired)
+}
+
+func ErrorQuoteAlreadyUsed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeQuoteAlreadyUsed, errMsgQuoteAlreadyUsed)
+}
+
+func ErrorStaleBandwidth() sdk.Error {
+	return sdk.NewError(Codespace, errCodeStaleBandwidth, errMsgStaleBandwidth)
+}
+
+func ErrorInvalidClientSignature() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidClientSignature, errMsgInvalidClientSignature)
+}
+
+func ErrorInvalidNodeSignature() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidNodeSignature, errMsgInvalidNodeSignature)
+}
+
+func ErrorSubscriptionEnding() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSubscriptionEnding, errMsgSubscriptionEnding)
+}
+
+func ErrorSubscriptionPaused() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSubscriptionPaused, errMsgSubscriptionPaused)
+}
+
+func ErrorSubscriptionNotPaused() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSubscriptionNotPaused, errMsgSubscriptionNotPaused)
+}
+
+func ErrorNodeJailed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeJailed, errMsgNodeJailed)
+}
+
+func ErrorNodeNotJailed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeNotJailed, errMsgNodeNotJailed)
+}
+
+func ErrorNodeJailCooldown() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeJailCooldown, errMsgNodeJailCooldown)
+}
+
+func ErrorInvalidFeeDenom(denom string) sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidFeeDenom, errMsgInvalidFeeDenom+denom)
+}
+
+func ErrorInvalidReferrer() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidReferrer, errMsgInvalidReferrer)
+}
+
+func ErrorFreeTrialNotOffered() sdk.Error {
+	return sdk.NewError(Codespace, errCodeFreeTrialNotOffered, errMsgFreeTrialNotOffered)
+}
+
+func ErrorFreeTrialAlreadyUsed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeFreeTrialAlreadyUsed, errMsgFreeTrialAlreadyUsed)
+}
+
+func ErrorInvalidDiscountPlan() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidDiscountPlan, errMsgInvalidDiscountPlan)
+}
+
+func ErrorMonikerAlreadyTaken() sdk.Error {
+	return sdk.NewError(Codespace, errCodeMonikerAlreadyTaken, errMsgMonikerAlreadyTaken)
+}
+
+func ErrorInsufficientNodeDeposit() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInsufficientNodeDeposit, errMsgInsufficientNodeDeposit)
+}
+
+func ErrorFeeAllowanceDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeFeeAllowanceDoesNotExist, errMsgFeeAllowanceDoesNotExist)
+}
+
+func ErrorAuthorizationDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeAuthorizationDoesNotExist, errMsgAuthorizationDoesNotExist)
+}
+
+func ErrorQoSAlreadyReported() sdk.Error {
+	return sdk.NewError(Codespace, errCodeQoSAlreadyReported, errMsgQoSAlreadyReported)
+}
+
+func ErrorSubscriptionNotCompleted() sdk.Error {
+	return sdk.NewError(Codespace, errCodeSubscriptionNotCompleted, errMsgSubscriptionNotCompleted)
+}
+
+func ErrorNodeAlreadyRated() sdk.Error {
+	return sdk.NewError(Codespace, errCodeNodeAlreadyRated, errMsgNodeAlreadyRated)
+}
+
+func ErrorDisputeDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, errCodeDisputeDoesNotExist, errMsgDisputeDoesNotExist)
+}
+
+func ErrorDisputeAlreadyExists() sdk.Error {
+	return sdk.NewError(Codespace, errCodeDisputeAlreadyExists, errMsgDisputeAlreadyExists)
+}
+
+func ErrorDisputeWindowClosed() sdk.Error {
+	return sdk.NewError(Codespace, errCodeDisputeWindowClosed, errMsgDisputeWindowClosed)
+}
+
+func ErrorInvalidDisputeStatus() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidDisputeStatus, errMsgInvalidDisputeStatus)
+}
+
+func ErrorInvalidDisputeAmount() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidDisputeAmount, errMsgInvalidDisputeAmount)
+}
+
+func ErrorInvalidEvidence() sdk.Error {
+	return sdk.NewError(Codespace, errCodeInvalidEvidence, errMsgInvalidEvidence)
+}
@@ -0,0 +1,54 @@
+package types
+
+const (
+	EventTypePruneNodeHistory  = "prune_node_history"
+	EventTypeSettleSession     = "settle_session"
+	EventTypePayoutNode        = "payout_node"
+	EventTypeUpdateSessionInfo = "update_session_info"
+	EventTypeCompactEvents     = "compact_events"
+	EventTypeGCSubscription    = "gc_subscription"
+	EventTypeEndSession        = "end_session"
+	EventTypeEndSubscription   = "end_subscription"
+	EventTypeReleaseEscrow     = "release_escrow"
+	EventTypeTopUpSubscription = "top_up_subscription"
+	EventTypeJailNode          = "jail_node"
+	EventTypeUnjailNode        = "unjail_node"
+	EventTypeUpdateNodeStatus  = "update_node_status"
+	EventTypeSubmitQoSReport   = "submit_qos_report"
+	EventTypeRateNode          = "rate_node"
+	EventTypeOpenDispute       = "open_dispute"
+	EventTypeResolveDispute    = "resolve_dispute"
+
+	EventTypePauseSubscription  = "pause_subscription"
+	EventTypeResumeSubscription = "resume_subscription"
+	EventTypeSplitRevenue       = "split_revenue"
+	EventTypeReferralReward     = "referral_reward"
+
+	EventTypeLockDeposit    = "lock_deposit"
+	EventTypeReleaseDeposit = "release_deposit"
+	EventTypeSendDeposit    = "send_deposit"
+
+	AttributeKeyNodeID         = "node_id"
+	AttributeKeyCount          = "count"
+	AttributeKeySessionID      = "session_id"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyFinal          = "final"
+	AttributeKeyAddress        = "address"
+	AttributeKeySubscriptionID = "subscription_id"
+	AttributeKeySuccess        = "success"
+	AttributeKeyCode           = "code"
+	AttributeKeyFee            = "fee"
+	AttributeKeyVersion        = "version"
+	AttributeKeyData           = "data"
+	AttributeKeyReason         = "reason"
+	AttributeKeyRole           = "role"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyStatus         = "status"
+
+	AttributeValueTimeout         = "timeout"
+	AttributeValueEndSubscription = "end_subscription"
+	AttributeValueReleaseEscrow   = "release_escrow"
+	AttributeValueExport          = "export"
+	AttributeValueEvidence        = "evidence"
+	AttributeValueGovernance      = "governance"
+)
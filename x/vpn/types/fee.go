@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeDenom is a denom accepted for the fees of the transactions along with the staking denom,
+// the rate is the amount of the denom which is worth one unit of the staking denom.
+type FeeDenom struct {
+	Denom string  `json:"denom"`
+	Rate  sdk.Dec `json:"rate"`
+}
+
+func NewFeeDenom(denom string, rate sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+func (f FeeDenom) String() string {
+	return fmt.Sprintf("%s%s", f.Rate, f.Denom)
+}
+
+func (f FeeDenom) Validate() error {
+	if !(sdk.Coin{Denom: f.Denom, Amount: sdk.ZeroInt()}).IsValid() {
+		return fmt.Errorf("invalid denom %s", f.Denom)
+	}
+	if f.Rate.IsNil() || !f.Rate.IsPositive() {
+		return fmt.Errorf("rate of the denom %s should be positive", f.Denom)
+	}
+
+	return nil
+}
+
+// ConvertMinGasPrices adds the min gas prices of the fee denoms, converted from the min gas
+// price of the staking denom, to the min gas prices which have no price of the fee denom.
+func ConvertMinGasPrices(prices sdk.DecCoins, stakingDenom string, feeDenoms []FeeDenom) sdk.DecCoins {
+	price := prices.AmountOf(stakingDenom)
+	if !price.IsPositive() {
+		return prices
+	}
+
+	converted := append(sdk.DecCoins{}, prices...)
+	for _, feeDenom := range feeDenoms {
+		if prices.AmountOf(feeDenom.Denom).IsZero() {
+			converted = append(converted, sdk.NewDecCoinFromDec(feeDenom.Denom, price.Mul(feeDenom.Rate)))
+		}
+	}
+
+	return converted.Sort()
+}
+
+func HasFeeDenom(feeDenoms []FeeDenom, denom string) bool {
+	for _, feeDenom := range feeDenoms {
+		if feeDenom.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
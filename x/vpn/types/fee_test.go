@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeDenom_Validate(t *testing.T) {
+	require.NotNil(t, NewFeeDenom("", sdk.OneDec()).Validate())
+	require.NotNil(t, NewFeeDenom("usent", sdk.Dec{}).Validate())
+	require.NotNil(t, NewFeeDenom("usent", sdk.ZeroDec()).Validate())
+	require.NotNil(t, NewFeeDenom("usent", sdk.NewDec(-1)).Validate())
+	require.Nil(t, NewFeeDenom("usent", sdk.NewDecWithPrec(5, 1)).Validate())
+}
+
+func TestConvertMinGasPrices(t *testing.T) {
+	feeDenoms := []FeeDenom{
+		NewFeeDenom("usent", sdk.NewDec(4)),
+		NewFeeDenom("atom", sdk.NewDecWithPrec(5, 1)),
+	}
+
+	require.Equal(t, sdk.DecCoins{}, ConvertMinGasPrices(sdk.DecCoins{}, "stake", feeDenoms))
+
+	prices := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))}
+	require.Equal(t, prices, ConvertMinGasPrices(prices, "stake", nil))
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(5, 3)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("usent", sdk.NewDecWithPrec(4, 2)),
+	}, ConvertMinGasPrices(prices, "stake", feeDenoms))
+
+	prices = sdk.DecCoins{
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("usent", sdk.NewDecWithPrec(1, 1)),
+	}
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(5, 3)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("usent", sdk.NewDecWithPrec(1, 1)),
+	}, ConvertMinGasPrices(prices, "stake", feeDenoms))
+}
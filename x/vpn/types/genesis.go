@@ -1,18 +1,73 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type GenesisState struct {
-	Nodes         []Node         `json:"nodes"`
-	Subscriptions []Subscription `json:"subscriptions"`
-	Sessions      []Session      `json:"sessions"`
-	Params        Params         `json:"params"`
+	Nodes              []Node              `json:"nodes"`
+	AllowedAddresses   []AllowedAddress    `json:"allowed_addresses"`
+	BlacklistedClients []BlacklistedClient `json:"blacklisted_clients"`
+	FreeTrials         []FreeTrial         `json:"free_trials"`
+	DiscountPlans      []DiscountPlan      `json:"discount_plans"`
+	Subscriptions      []Subscription      `json:"subscriptions"`
+	Seats              []Seat              `json:"seats"`
+	Sessions           []Session           `json:"sessions"`
+	SessionIndexes     []SessionIndex      `json:"session_indexes"`
+	SessionsCounts     []SessionsCount     `json:"sessions_counts"`
+	PendingPayouts     []PendingPayout     `json:"pending_payouts"`
+	UsedQuotes         []UsedQuote         `json:"used_quotes"`
+	ConsumptionRates   []ConsumptionRate   `json:"consumption_rates"`
+	PendingSettlements []PendingSettlement `json:"pending_settlements"`
+	Disputes           []Dispute           `json:"disputes"`
+	NodeStats          []NodeStats         `json:"node_stats"`
+	NodeStatsSnapshots []NodeStatsSnapshot `json:"node_stats_snapshots"`
+	NodeUptimes        []NodeUptime        `json:"node_uptimes"`
+	NodeQoS            []NodeQoS           `json:"node_qos"`
+	NodeReputations    []NodeReputation    `json:"node_reputations"`
+	NodeUnbondings     []NodeUnbonding     `json:"node_unbondings"`
+	ProtocolFees       sdk.Coins           `json:"protocol_fees"`
+	ReferralEarnings   []ReferralEarnings  `json:"referral_earnings"`
+	FeeAllowances      []FeeAllowance      `json:"fee_allowances"`
+	Authorizations     []Authorization     `json:"authorizations"`
+	Params             Params              `json:"params"`
 }
 
-func NewGenesisState(nodes []Node, subscriptions []Subscription, sessions []Session, params Params) GenesisState {
+func NewGenesisState(nodes []Node, allowedAddresses []AllowedAddress, blacklistedClients []BlacklistedClient,
+	freeTrials []FreeTrial, discountPlans []DiscountPlan, subscriptions []Subscription, seats []Seat,
+	sessions []Session, sessionIndexes []SessionIndex, sessionsCounts []SessionsCount, pendingPayouts []PendingPayout,
+	usedQuotes []UsedQuote, consumptionRates []ConsumptionRate, pendingSettlements []PendingSettlement,
+	disputes []Dispute, nodeStats []NodeStats, nodeStatsSnapshots []NodeStatsSnapshot, nodeUptimes []NodeUptime,
+	nodeQoS []NodeQoS, nodeReputations []NodeReputation, nodeUnbondings []NodeUnbonding, protocolFees sdk.Coins,
+	referralEarnings []ReferralEarnings, feeAllowances []FeeAllowance, authorizations []Authorization,
+	params Params) GenesisState {
 	return GenesisState{
-		Nodes:         nodes,
-		Subscriptions: subscriptions,
-		Sessions:      sessions,
-		Params:        params,
+		Nodes:              nodes,
+		AllowedAddresses:   allowedAddresses,
+		BlacklistedClients: blacklistedClients,
+		FreeTrials:         freeTrials,
+		DiscountPlans:      discountPlans,
+		Subscriptions:      subscriptions,
+		Seats:              seats,
+		Sessions:           sessions,
+		SessionIndexes:     sessionIndexes,
+		SessionsCounts:     sessionsCounts,
+		PendingPayouts:     pendingPayouts,
+		UsedQuotes:         usedQuotes,
+		ConsumptionRates:   consumptionRates,
+		PendingSettlements: pendingSettlements,
+		Disputes:           disputes,
+		NodeStats:          nodeStats,
+		NodeStatsSnapshots: nodeStatsSnapshots,
+		NodeUptimes:        nodeUptimes,
+		NodeQoS:            nodeQoS,
+		NodeReputations:    nodeReputations,
+		NodeUnbondings:     nodeUnbondings,
+		ProtocolFees:       protocolFees,
+		ReferralEarnings:   referralEarnings,
+		FeeAllowances:      feeAllowances,
+		Authorizations:     authorizations,
+		Params:             params,
 	}
 }
 
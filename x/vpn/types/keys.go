@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	hub "github.com/sentinel-official/hub/types"
 )
@@ -23,10 +26,40 @@ const (
 )
 
 var (
-	NodesCountKey                = []byte{0x00}
-	NodeKeyPrefix                = []byte{0x01}
-	NodesCountOfAddressKeyPrefix = []byte{0x02}
-	NodeIDByAddressKeyPrefix     = []byte{0x03}
+	// PayoutPoolAddress holds the deposits which are settled but not paid to the nodes yet.
+	PayoutPoolAddress = supply.NewModuleAddress(ModuleName + "/payout_pool")
+
+	// NodeRewardPoolAddress holds the share of the block provisions which is not distributed to the nodes yet.
+	NodeRewardPoolAddress = supply.NewModuleAddress(ModuleName + "/node_reward_pool")
+
+	// DisputePoolAddress holds the amounts which are frozen by the open disputes.
+	DisputePoolAddress = supply.NewModuleAddress(ModuleName + "/dispute_pool")
+)
+
+var (
+	NodesCountKey                  = []byte{0x00}
+	NodeKeyPrefix                  = []byte{0x01}
+	NodesCountOfAddressKeyPrefix   = []byte{0x02}
+	NodeIDByAddressKeyPrefix       = []byte{0x03}
+	AllowedAddressKeyPrefix        = []byte{0x04}
+	BlacklistedClientKeyPrefix     = []byte{0x05}
+	PendingPayoutKeyPrefix         = []byte{0x06}
+	UsedQuoteKeyPrefix             = []byte{0x07}
+	UsedQuoteByExpiryKeyPrefix     = []byte{0x08}
+	FreeUpdatesCountKeyPrefix      = []byte{0x09}
+	NodeStatsKeyPrefix             = []byte{0x0A}
+	NodeStatsSnapshotKeyPrefix     = []byte{0x0B}
+	NodeUptimeKeyPrefix            = []byte{0x0C}
+	NetworkSummaryKey              = []byte{0x0D}
+	FreeTrialKeyPrefix             = []byte{0x0E}
+	DiscountPlanKeyPrefix          = []byte{0x0F}
+	DiscountPlanByUntilKeyPrefix   = []byte{0x10}
+	NodeIDByMonikerKeyPrefix       = []byte{0x11}
+	EnforcedMinNodeVersionKey      = []byte{0x12}
+	NodeUnbondingKeyPrefix         = []byte{0x13}
+	NodeUnbondingByHeightKeyPrefix = []byte{0x14}
+	NodeQoSKeyPrefix               = []byte{0x15}
+	NodeReputationKeyPrefix        = []byte{0x16}
 
 	SubscriptionsCountKey                = []byte{0x00}
 	SubscriptionKeyPrefix                = []byte{0x01}
@@ -34,11 +67,23 @@ var (
 	SubscriptionIDByNodeIDKeyPrefix      = []byte{0x03}
 	SubscriptionsCountOfAddressKeyPrefix = []byte{0x04}
 	SubscriptionIDByAddressKeyPrefix     = []byte{0x05}
+	SeatKeyPrefix                        = []byte{0x06}
+	SeatIndexByAddressKeyPrefix          = []byte{0x07}
+	ConsumptionRateKeyPrefix             = []byte{0x08}
+	PendingSettlementKeyPrefix           = []byte{0x09}
+	PendingSettlementByHeightKeyPrefix   = []byte{0x0A}
+	ReferralEarningsKeyPrefix            = []byte{0x0B}
+	FeeAllowanceKeyPrefix                = []byte{0x0C}
+	AuthorizationKeyPrefix               = []byte{0x0D}
 
 	SessionsCountKey                     = []byte{0x00}
 	SessionKeyPrefix                     = []byte{0x01}
 	SessionsCountOfSubscriptionKeyPrefix = []byte{0x02}
 	SessionIDBySubscriptionIDKeyPrefix   = []byte{0x03}
+	ProtocolFeesKey                      = []byte{0x04}
+	DisputeKeyPrefix                     = []byte{0x05}
+	DisputeByDeadlineKeyPrefix           = []byte{0x06}
+	OngoingSessionIndexKeyPrefix         = []byte{0x07}
 )
 
 func NodeKey(id hub.NodeID) []byte {
@@ -49,9 +94,112 @@ func NodesCountOfAddressKey(address sdk.AccAddress) []byte {
 	return append(NodesCountOfAddressKeyPrefix, address.Bytes()...)
 }
 
+func NodeIDsOfAddressKey(address sdk.AccAddress) []byte {
+	return append(NodeIDByAddressKeyPrefix, address.Bytes()...)
+}
+
 func NodeIDByAddressKey(address sdk.AccAddress, i uint64) []byte {
-	return append(NodeIDByAddressKeyPrefix,
-		append(address.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+	return append(NodeIDsOfAddressKey(address), sdk.Uint64ToBigEndian(i)...)
+}
+
+func AllowedAddressesOfNodeKey(id hub.NodeID) []byte {
+	return append(AllowedAddressKeyPrefix, id.Bytes()...)
+}
+
+func AllowedAddressKey(id hub.NodeID, address sdk.AccAddress) []byte {
+	return append(AllowedAddressesOfNodeKey(id), address.Bytes()...)
+}
+
+func BlacklistedClientsOfNodeKey(id hub.NodeID) []byte {
+	return append(BlacklistedClientKeyPrefix, id.Bytes()...)
+}
+
+func BlacklistedClientKey(id hub.NodeID, client sdk.AccAddress) []byte {
+	return append(BlacklistedClientsOfNodeKey(id), client.Bytes()...)
+}
+
+func PendingPayoutKey(id hub.NodeID) []byte {
+	return append(PendingPayoutKeyPrefix, id.Bytes()...)
+}
+
+func UsedQuoteKey(id hub.NodeID, nonce uint64) []byte {
+	return append(UsedQuoteKeyPrefix,
+		append(id.Bytes(), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+func UsedQuotesByExpiryKey(height int64) []byte {
+	return append(UsedQuoteByExpiryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func UsedQuoteByExpiryKey(height int64, id hub.NodeID, nonce uint64) []byte {
+	return append(UsedQuotesByExpiryKey(height),
+		append(id.Bytes(), sdk.Uint64ToBigEndian(nonce)...)...)
+}
+
+func FreeUpdatesCountsKey(height int64) []byte {
+	return append(FreeUpdatesCountKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func FreeUpdatesCountKey(height int64, id hub.NodeID) []byte {
+	return append(FreeUpdatesCountsKey(height), id.Bytes()...)
+}
+
+func NodeStatsKey(id hub.NodeID) []byte {
+	return append(NodeStatsKeyPrefix, id.Bytes()...)
+}
+
+func NodeStatsSnapshotsKey(height int64) []byte {
+	return append(NodeStatsSnapshotKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func NodeStatsSnapshotKey(height int64, id hub.NodeID) []byte {
+	return append(NodeStatsSnapshotsKey(height), id.Bytes()...)
+}
+
+func NodeUptimeKey(id hub.NodeID) []byte {
+	return append(NodeUptimeKeyPrefix, id.Bytes()...)
+}
+
+func NodeQoSKey(id hub.NodeID) []byte {
+	return append(NodeQoSKeyPrefix, id.Bytes()...)
+}
+
+func NodeReputationKey(id hub.NodeID) []byte {
+	return append(NodeReputationKeyPrefix, id.Bytes()...)
+}
+
+func FreeTrialKey(id hub.NodeID, address sdk.AccAddress) []byte {
+	return append(FreeTrialKeyPrefix,
+		append(id.Bytes(), address.Bytes()...)...)
+}
+
+func DiscountPlanKey(id hub.NodeID) []byte {
+	return append(DiscountPlanKeyPrefix, id.Bytes()...)
+}
+
+func DiscountPlansByUntilKey(height int64) []byte {
+	return append(DiscountPlanByUntilKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func DiscountPlanByUntilKey(height int64, id hub.NodeID) []byte {
+	return append(DiscountPlansByUntilKey(height), id.Bytes()...)
+}
+
+func NodeUnbondingKey(id hub.NodeID) []byte {
+	return append(NodeUnbondingKeyPrefix, id.Bytes()...)
+}
+
+func NodeUnbondingsByHeightKey(height int64) []byte {
+	return append(NodeUnbondingByHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func NodeUnbondingByHeightKey(height int64, id hub.NodeID) []byte {
+	return append(NodeUnbondingsByHeightKey(height), id.Bytes()...)
+}
+
+// NodeIDByMonikerKey is case insensitive, so the monikers which differ only in the case are the same.
+func NodeIDByMonikerKey(moniker string) []byte {
+	return append(NodeIDByMonikerKeyPrefix, []byte(strings.ToLower(moniker))...)
 }
 
 func SubscriptionKey(id hub.SubscriptionID) []byte {
@@ -62,18 +210,69 @@ func SubscriptionsCountOfNodeKey(id hub.NodeID) []byte {
 	return append(SubscriptionsCountOfNodeKeyPrefix, id.Bytes()...)
 }
 
+func SubscriptionIDsOfNodeKey(id hub.NodeID) []byte {
+	return append(SubscriptionIDByNodeIDKeyPrefix, id.Bytes()...)
+}
+
 func SubscriptionIDByNodeIDKey(id hub.NodeID, i uint64) []byte {
-	return append(SubscriptionIDByNodeIDKeyPrefix,
-		append(id.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+	return append(SubscriptionIDsOfNodeKey(id), sdk.Uint64ToBigEndian(i)...)
 }
 
 func SubscriptionsCountOfAddressKey(address sdk.AccAddress) []byte {
 	return append(SubscriptionsCountOfAddressKeyPrefix, address.Bytes()...)
 }
 
+func SubscriptionIDsOfAddressKey(address sdk.AccAddress) []byte {
+	return append(SubscriptionIDByAddressKeyPrefix, address.Bytes()...)
+}
+
 func SubscriptionIDByAddressKey(address sdk.AccAddress, i uint64) []byte {
-	return append(SubscriptionIDByAddressKeyPrefix,
-		append(address.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+	return append(SubscriptionIDsOfAddressKey(address), sdk.Uint64ToBigEndian(i)...)
+}
+
+func SeatsOfSubscriptionKey(id hub.SubscriptionID) []byte {
+	return append(SeatKeyPrefix, id.Bytes()...)
+}
+
+func SeatKey(id hub.SubscriptionID, i uint64) []byte {
+	return append(SeatsOfSubscriptionKey(id), sdk.Uint64ToBigEndian(i)...)
+}
+
+func SeatIndexByAddressKey(id hub.SubscriptionID, address sdk.AccAddress) []byte {
+	return append(SeatIndexByAddressKeyPrefix,
+		append(id.Bytes(), address.Bytes()...)...)
+}
+
+func ConsumptionRateKey(id hub.SubscriptionID) []byte {
+	return append(ConsumptionRateKeyPrefix, id.Bytes()...)
+}
+
+func PendingSettlementKey(id hub.SubscriptionID) []byte {
+	return append(PendingSettlementKeyPrefix, id.Bytes()...)
+}
+
+func PendingSettlementsByHeightKey(height int64) []byte {
+	return append(PendingSettlementByHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func PendingSettlementByHeightKey(height int64, id hub.SubscriptionID) []byte {
+	return append(PendingSettlementsByHeightKey(height), id.Bytes()...)
+}
+
+func ReferralEarningsKey(address sdk.AccAddress) []byte {
+	return append(ReferralEarningsKeyPrefix, address.Bytes()...)
+}
+
+func FeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, append(granter.Bytes(), grantee.Bytes()...)...)
+}
+
+func AuthorizationsKey(granter, grantee sdk.AccAddress) []byte {
+	return append(AuthorizationKeyPrefix, append(granter.Bytes(), grantee.Bytes()...)...)
+}
+
+func AuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(AuthorizationsKey(granter, grantee), []byte(msgType)...)
 }
 
 func SessionKey(id hub.SessionID) []byte {
@@ -84,9 +283,32 @@ func SessionsCountOfSubscriptionKey(id hub.SubscriptionID) []byte {
 	return append(SessionsCountOfSubscriptionKeyPrefix, id.Bytes()...)
 }
 
+func SessionIDsOfSubscriptionKey(id hub.SubscriptionID) []byte {
+	return append(SessionIDBySubscriptionIDKeyPrefix, id.Bytes()...)
+}
+
 func SessionIDBySubscriptionIDKey(id hub.SubscriptionID, i uint64) []byte {
-	return append(SessionIDBySubscriptionIDKeyPrefix,
-		append(id.Bytes(), sdk.Uint64ToBigEndian(i)...)...)
+	return append(SessionIDsOfSubscriptionKey(id), sdk.Uint64ToBigEndian(i)...)
+}
+
+func OngoingSessionIndexesOfSubscriptionKey(id hub.SubscriptionID) []byte {
+	return append(OngoingSessionIndexKeyPrefix, id.Bytes()...)
+}
+
+func OngoingSessionIndexKey(id hub.SubscriptionID, address sdk.AccAddress) []byte {
+	return append(OngoingSessionIndexesOfSubscriptionKey(id), address.Bytes()...)
+}
+
+func DisputeKey(id hub.SessionID) []byte {
+	return append(DisputeKeyPrefix, id.Bytes()...)
+}
+
+func DisputesByDeadlineKey(height int64) []byte {
+	return append(DisputeByDeadlineKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func DisputeByDeadlineKey(height int64, id hub.SessionID) []byte {
+	return append(DisputesByDeadlineKey(height), id.Bytes()...)
 }
 
 func ActiveNodeIDsKey(height int64) []byte {
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeLocation_Validate(t *testing.T) {
+	require.Nil(t, NodeLocation{}.Validate())
+	require.Nil(t, NewNodeLocation("us", "us-ca", "na").Validate())
+	require.Nil(t, NewNodeLocation("DE", "", "").Validate())
+	require.Nil(t, NewNodeLocation("", "", LatencyZoneAsia).Validate())
+
+	require.NotNil(t, NewNodeLocation("XX", "", "").Validate())
+	require.NotNil(t, NewNodeLocation("USA", "", "").Validate())
+	require.NotNil(t, NewNodeLocation("", "US-CA", "").Validate())
+	require.NotNil(t, NewNodeLocation("DE", "US-CA", "").Validate())
+	require.NotNil(t, NewNodeLocation("US", "US-CALI", "").Validate())
+	require.NotNil(t, NewNodeLocation("US", "", "EUROPE").Validate())
+}
+
+func TestNodeLocation_Matches(t *testing.T) {
+	location := NewNodeLocation("US", "US-CA", LatencyZoneNorthAmerica)
+
+	require.True(t, location.Matches(NodeLocation{}))
+	require.True(t, location.Matches(NewNodeLocation("US", "", "")))
+	require.True(t, location.Matches(NewNodeLocation("US", "US-CA", LatencyZoneNorthAmerica)))
+	require.False(t, location.Matches(NewNodeLocation("US", "US-NY", "")))
+	require.False(t, location.Matches(NewNodeLocation("", "", LatencyZoneEurope)))
+	require.False(t, NodeLocation{}.Matches(NewNodeLocation("US", "", "")))
+}
@@ -1,25 +1,126 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"sort"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hub "github.com/sentinel-official/hub/types"
 )
 
+const (
+	MaxMetadataURILength = 256
+	MetadataHashLength   = 64
+	MaxPayoutRoutes      = 16
+
+	RankByBandwidth = "bandwidth"
+	RankByEarnings  = "earnings"
+
+	SortNodesByPriceAsc       = "price_asc"
+	SortNodesByPriceDesc      = "price_desc"
+	SortNodesByBandwidthAsc   = "bandwidth_asc"
+	SortNodesByBandwidthDesc  = "bandwidth_desc"
+	SortNodesByUptimeAsc      = "uptime_asc"
+	SortNodesByUptimeDesc     = "uptime_desc"
+	SortNodesByReputationAsc  = "reputation_asc"
+	SortNodesByReputationDesc = "reputation_desc"
+
+	RevenueRoleNode     = "node"
+	RevenueRoleProvider = "provider"
+	RevenueRoleResolver = "resolver"
+
+	DefaultTopNodesWindow int64  = 100800
+	DefaultTopNodesLimit  uint64 = 10
+	MaxTopNodesLimit      uint64 = 100
+)
+
+// NodeCategory is the VPN protocol a node serves its clients with.
+type NodeCategory string
+
+const (
+	NodeCategoryOpenVPN   NodeCategory = "OpenVPN"
+	NodeCategoryWireGuard NodeCategory = "WireGuard"
+	NodeCategoryV2Ray     NodeCategory = "V2Ray"
+)
+
+var (
+	NodeCategories = []NodeCategory{NodeCategoryOpenVPN, NodeCategoryWireGuard, NodeCategoryV2Ray}
+
+	NodeSortOrders = []string{
+		SortNodesByPriceAsc, SortNodesByPriceDesc,
+		SortNodesByBandwidthAsc, SortNodesByBandwidthDesc,
+		SortNodesByUptimeAsc, SortNodesByUptimeDesc,
+		SortNodesByReputationAsc, SortNodesByReputationDesc,
+	}
+)
+
+// IsValidNodeSortOrder reports whether the nodes can be sorted by the order, the empty order keeps
+// the nodes sorted by their IDs.
+func IsValidNodeSortOrder(order string) bool {
+	if order == "" {
+		return true
+	}
+
+	for _, _order := range NodeSortOrders {
+		if order == _order {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewNodeCategoryFromString returns the category of the name, the name is case-insensitive.
+func NewNodeCategoryFromString(s string) (NodeCategory, error) {
+	for _, category := range NodeCategories {
+		if strings.EqualFold(s, string(category)) {
+			return category, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid node category %s", s)
+}
+
+func (c NodeCategory) IsValid() bool {
+	for _, category := range NodeCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Node struct {
 	ID      hub.NodeID     `json:"id"`
 	Owner   sdk.AccAddress `json:"owner"`
 	Deposit sdk.Coin       `json:"deposit"`
 
-	Type          string        `json:"type"`
+	Type          NodeCategory  `json:"type"`
 	Version       string        `json:"version"`
 	Moniker       string        `json:"moniker"`
 	PricesPerGB   sdk.Coins     `json:"prices_per_gb"`
 	InternetSpeed hub.Bandwidth `json:"internet_speed"`
 	Encryption    string        `json:"encryption"`
+	MetadataURI   string        `json:"metadata_uri"`
+	MetadataHash  string        `json:"metadata_hash"`
+	Private       bool          `json:"private"`
+	Location      NodeLocation  `json:"location"`
+	Rating        NodeRating    `json:"rating"`
+	PayoutRoutes  []PayoutRoute `json:"payout_routes"`
+
+	RevenueSplits []RevenueSplit `json:"revenue_splits"`
+
+	// FreeTrialBytes is the upload and the download each new client address may consume for free
+	// before it subscribes to the node with a deposit, zero disables the free trial.
+	FreeTrialBytes uint64 `json:"free_trial_bytes"`
+
+	Jailed      bool  `json:"jailed"`
+	JailedUntil int64 `json:"jailed_until"`
 
 	Status           string `json:"status"`
 	StatusModifiedAt int64  `json:"status_modified_at"`
@@ -36,9 +137,22 @@ func (n Node) String() string {
   Price Per GB:        %s
   Internet Speed:      %s
   Encryption:          %s
+  Metadata URI:        %s
+  Metadata Hash:       %s
+  Private:             %t
+  Location:            %s
+  Rating:              %s
+  Payout Routes:       %s
+  Revenue Splits:      %s
+  Free Trial Bytes:    %d
+  Jailed:              %t
+  Jailed Until:        %d
   Status:              %s
   Status Modified At:  %d`, n.ID, n.Owner, n.Deposit, n.Type, n.Version,
 		n.Moniker, n.PricesPerGB, n.InternetSpeed, n.Encryption,
+		n.MetadataURI, n.MetadataHash, n.Private, n.Location, n.Rating, n.PayoutRoutes, n.RevenueSplits,
+		n.FreeTrialBytes,
+		n.Jailed, n.JailedUntil,
 		n.Status, n.StatusModifiedAt)
 }
 
@@ -62,6 +176,10 @@ func (n Node) UpdateInfo(_node Node) Node {
 	if _node.Encryption != "" {
 		n.Encryption = _node.Encryption
 	}
+	if _node.MetadataURI != "" && _node.MetadataHash != "" {
+		n.MetadataURI = _node.MetadataURI
+		n.MetadataHash = _node.MetadataHash
+	}
 
 	return n
 }
@@ -79,14 +197,25 @@ func (n Node) FindPricePerGB(denom string) (coin sdk.Coin) {
 	return n.PricesPerGB[index]
 }
 
+// PayoutAddress returns the address the earnings in the denom are sent to,
+// which is the owner if the node has no route for the denom.
+func (n Node) PayoutAddress(denom string) sdk.AccAddress {
+	for _, route := range n.PayoutRoutes {
+		if route.Denom == denom {
+			return route.Address
+		}
+	}
+
+	return n.Owner
+}
+
 func (n Node) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
 	pricePerGB := n.FindPricePerGB(deposit.Denom)
 	if pricePerGB.Denom == "" || pricePerGB.Amount.IsZero() {
 		return bandwidth, ErrorInvalidDeposit()
 	}
 
-	x := deposit.Amount.Mul(hub.MB500).Quo(pricePerGB.Amount)
-	return hub.NewBandwidth(x, x), nil
+	return hub.NewBandwidthFromCost(deposit.Amount, pricePerGB.Amount), nil
 }
 
 func (n Node) IsValid() error {
@@ -96,31 +225,375 @@ func (n Node) IsValid() error {
 	if n.Deposit.Denom == "" {
 		return fmt.Errorf("invalid deposit")
 	}
-	if n.Type == "" || len(n.Type) < 4 || len(n.Type) > 16 {
+	if !n.Type.IsValid() {
 		return fmt.Errorf("invalid type")
 	}
 
 	if n.Version == "" || len(n.Version) < 4 || len(n.Version) > 16 {
 		return fmt.Errorf("invalid version")
 	}
-	if n.Moniker == "" || len(n.Moniker) < 4 || len(n.Moniker) > 32 {
+	if n.Moniker != "" && (len(n.Moniker) < 4 || len(n.Moniker) > 32) {
 		return fmt.Errorf("invalid moniker")
 	}
 	if n.PricesPerGB == nil || !n.PricesPerGB.IsValid() {
 		return fmt.Errorf("invalid price per gb")
 	}
-	if n.InternetSpeed.AnyNil() || !n.InternetSpeed.AllPositive() {
+	if n.InternetSpeed.IsValid() != nil || !n.InternetSpeed.AllPositive() {
 		return fmt.Errorf("invalid internet speed")
 	}
 
 	if n.Encryption == "" || len(n.Encryption) < 4 || len(n.Encryption) > 16 {
 		return fmt.Errorf("invalid encryption")
 	}
+	if err := ValidateMetadata(n.MetadataURI, n.MetadataHash); err != nil {
+		return err
+	}
+	if err := n.Location.Validate(); err != nil {
+		return err
+	}
+	if err := n.Rating.IsValid(); err != nil {
+		return err
+	}
+	if err := ValidatePayoutRoutes(n.PayoutRoutes); err != nil {
+		return err
+	}
+	if err := ValidateRevenueSplits(n.RevenueSplits); err != nil {
+		return err
+	}
+
+	if n.JailedUntil < 0 || (!n.Jailed && n.JailedUntil != 0) {
+		return fmt.Errorf("invalid jailed until")
+	}
 
 	if n.Status != StatusRegistered &&
+		n.Status != StatusInactive &&
 		n.Status != StatusDeRegistered {
 		return fmt.Errorf("invalid status")
 	}
 
 	return nil
 }
+
+// ValidateMetadata checks the optional off-chain metadata of a node. Either both
+// the URI and the hex encoded SHA-256 hash of the document are set, or none.
+func ValidateMetadata(uri, hash string) error {
+	if uri == "" && hash == "" {
+		return nil
+	}
+
+	if uri == "" || len(uri) > MaxMetadataURILength {
+		return fmt.Errorf("invalid metadata uri")
+	}
+
+	u, err := url.ParseRequestURI(uri)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid metadata uri")
+	}
+
+	if len(hash) != MetadataHashLength {
+		return fmt.Errorf("invalid metadata hash")
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return fmt.Errorf("invalid metadata hash")
+	}
+
+	return nil
+}
+
+type PayoutRoute struct {
+	Denom   string         `json:"denom"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewPayoutRoute(denom string, address sdk.AccAddress) PayoutRoute {
+	return PayoutRoute{
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (p PayoutRoute) String() string {
+	return fmt.Sprintf("%s:%s", p.Denom, p.Address)
+}
+
+func ValidatePayoutRoutes(routes []PayoutRoute) error {
+	if len(routes) > MaxPayoutRoutes {
+		return fmt.Errorf("too many payout routes")
+	}
+
+	denoms := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		if !(sdk.Coin{Denom: route.Denom, Amount: sdk.ZeroInt()}).IsValid() || denoms[route.Denom] {
+			return fmt.Errorf("invalid payout route denom")
+		}
+		if route.Address == nil || route.Address.Empty() {
+			return fmt.Errorf("invalid payout route address")
+		}
+
+		denoms[route.Denom] = true
+	}
+
+	return nil
+}
+
+// RevenueSplit is the share of the earnings of the node paid to the address of a plan provider
+// or a resolver, the rest of the earnings is paid to the payout address of the node.
+type RevenueSplit struct {
+	Role    string         `json:"role"`
+	Address sdk.AccAddress `json:"address"`
+	Share   sdk.Dec        `json:"share"`
+}
+
+func NewRevenueSplit(role string, address sdk.AccAddress, share sdk.Dec) RevenueSplit {
+	return RevenueSplit{
+		Role:    role,
+		Address: address,
+		Share:   share,
+	}
+}
+
+func (r RevenueSplit) String() string {
+	return fmt.Sprintf("%s:%s:%s", r.Role, r.Address, r.Share)
+}
+
+// ValidateRevenueSplits checks that every role has at most one positive share and that the
+// shares sum up to at most one, the share of the node is the rest of the whole.
+func ValidateRevenueSplits(splits []RevenueSplit) error {
+	total := sdk.ZeroDec()
+	roles := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if (split.Role != RevenueRoleProvider && split.Role != RevenueRoleResolver) || roles[split.Role] {
+			return fmt.Errorf("invalid revenue split role")
+		}
+		if split.Address == nil || split.Address.Empty() {
+			return fmt.Errorf("invalid revenue split address")
+		}
+		if split.Share.IsNil() || !split.Share.IsPositive() {
+			return fmt.Errorf("invalid revenue split share")
+		}
+
+		roles[split.Role] = true
+		total = total.Add(split.Share)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("revenue split shares sum up to more than one")
+	}
+
+	return nil
+}
+
+type RevenuePayout struct {
+	Role    string
+	Address sdk.AccAddress
+	Coin    sdk.Coin
+}
+
+// SplitRevenue returns the non zero payouts of the revenue splits of the coin rounded down, and the
+// rest of it paid to the payout address of the node, so that the payouts sum up to the coin.
+func (n Node) SplitRevenue(coin sdk.Coin) (payouts []RevenuePayout) {
+	rest := coin
+	for _, split := range n.RevenueSplits {
+		amount := sdk.NewCoin(coin.Denom, split.Share.MulInt(coin.Amount).TruncateInt())
+		if amount.IsZero() {
+			continue
+		}
+
+		rest = rest.Sub(amount)
+		payouts = append(payouts, RevenuePayout{Role: split.Role, Address: split.Address, Coin: amount})
+	}
+
+	if !rest.IsZero() {
+		payouts = append([]RevenuePayout{{Role: RevenueRoleNode, Address: n.PayoutAddress(coin.Denom), Coin: rest}},
+			payouts...)
+	}
+
+	return payouts
+}
+
+type NodeMetadata struct {
+	URI  string `json:"uri"`
+	Hash string `json:"hash"`
+}
+
+func NewNodeMetadata(uri, hash string) NodeMetadata {
+	return NodeMetadata{
+		URI:  uri,
+		Hash: hash,
+	}
+}
+
+type AllowedAddress struct {
+	NodeID  hub.NodeID     `json:"node_id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewAllowedAddress(id hub.NodeID, address sdk.AccAddress) AllowedAddress {
+	return AllowedAddress{
+		NodeID:  id,
+		Address: address,
+	}
+}
+
+// FreeTrial records that the address has used the free trial of the node.
+type FreeTrial struct {
+	NodeID  hub.NodeID     `json:"node_id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewFreeTrial(id hub.NodeID, address sdk.AccAddress) FreeTrial {
+	return FreeTrial{
+		NodeID:  id,
+		Address: address,
+	}
+}
+
+type BlacklistedClient struct {
+	NodeID hub.NodeID     `json:"node_id"`
+	Client sdk.AccAddress `json:"client"`
+}
+
+func NewBlacklistedClient(id hub.NodeID, client sdk.AccAddress) BlacklistedClient {
+	return BlacklistedClient{
+		NodeID: id,
+		Client: client,
+	}
+}
+
+type PendingPayout struct {
+	NodeID hub.NodeID `json:"node_id"`
+	Coins  sdk.Coins  `json:"coins"`
+}
+
+func NewPendingPayout(id hub.NodeID, coins sdk.Coins) PendingPayout {
+	return PendingPayout{
+		NodeID: id,
+		Coins:  coins,
+	}
+}
+
+func (p PendingPayout) String() string {
+	return fmt.Sprintf("%s:%s", p.NodeID, p.Coins)
+}
+
+// NodeStats are the cumulative counters of the settled sessions of a node.
+type NodeStats struct {
+	NodeID        hub.NodeID    `json:"node_id"`
+	Bandwidth     hub.Bandwidth `json:"bandwidth"`
+	Earnings      sdk.Coins     `json:"earnings"`
+	SessionsCount uint64        `json:"sessions_count"`
+	JailsCount    uint64        `json:"jails_count"`
+}
+
+func NewNodeStats(id hub.NodeID) NodeStats {
+	return NodeStats{
+		NodeID:    id,
+		Bandwidth: hub.NewBandwidthFromInt64(0, 0),
+		Earnings:  sdk.Coins{},
+	}
+}
+
+func (s NodeStats) String() string {
+	return fmt.Sprintf(`NodeStats
+  Node ID:        %s
+  Bandwidth:      %s
+  Earnings:       %s
+  Sessions Count: %d
+  Jails Count:    %d`, s.NodeID, s.Bandwidth, s.Earnings, s.SessionsCount, s.JailsCount)
+}
+
+// Sub returns the counters accumulated since the earlier stats of the same node.
+func (s NodeStats) Sub(stats NodeStats) NodeStats {
+	s.Bandwidth = s.Bandwidth.Sub(stats.Bandwidth)
+	s.Earnings = s.Earnings.Sub(stats.Earnings)
+	s.SessionsCount -= stats.SessionsCount
+	s.JailsCount -= stats.JailsCount
+
+	return s
+}
+
+// NodeStatsSnapshot is the copy of the counters of a node at the end of a node stats epoch,
+// the counters over a recent window are the difference from the snapshot at its start.
+type NodeStatsSnapshot struct {
+	Height int64     `json:"height"`
+	Stats  NodeStats `json:"stats"`
+}
+
+func NewNodeStatsSnapshot(height int64, stats NodeStats) NodeStatsSnapshot {
+	return NodeStatsSnapshot{
+		Height: height,
+		Stats:  stats,
+	}
+}
+
+func (s NodeStatsSnapshot) String() string {
+	return fmt.Sprintf("%d:%s", s.Height, s.Stats.NodeID)
+}
+
+// NodeUptime tracks the blocks in which a registered node is online, a node is online
+// for the node inactive interval after each of its heartbeats.
+type NodeUptime struct {
+	NodeID        hub.NodeID `json:"node_id"`
+	Since         int64      `json:"since"`
+	Until         int64      `json:"until"`
+	LastHeartbeat int64      `json:"last_heartbeat"`
+	OnlineBlocks  int64      `json:"online_blocks"`
+}
+
+func NewNodeUptime(id hub.NodeID, height int64) NodeUptime {
+	return NodeUptime{
+		NodeID:        id,
+		Since:         height,
+		LastHeartbeat: height,
+	}
+}
+
+func (u NodeUptime) String() string {
+	return fmt.Sprintf(`NodeUptime
+  Node ID:        %s
+  Since:          %d
+  Until:          %d
+  Last Heartbeat: %d
+  Online Blocks:  %d`, u.NodeID, u.Since, u.Until, u.LastHeartbeat, u.OnlineBlocks)
+}
+
+func (u NodeUptime) onlineBlocksAt(height, interval int64) int64 {
+	if u.LastHeartbeat <= 0 || height <= u.LastHeartbeat {
+		return u.OnlineBlocks
+	}
+	if height-u.LastHeartbeat < interval {
+		return u.OnlineBlocks + height - u.LastHeartbeat
+	}
+
+	return u.OnlineBlocks + interval
+}
+
+// Heartbeat returns the uptime after a heartbeat of the node at the height.
+func (u NodeUptime) Heartbeat(height, interval int64) NodeUptime {
+	u.OnlineBlocks = u.onlineBlocksAt(height, interval)
+	u.LastHeartbeat = height
+
+	return u
+}
+
+// Stop returns the uptime after the node is deregistered at the height, which is not tracked anymore.
+func (u NodeUptime) Stop(height, interval int64) NodeUptime {
+	u.OnlineBlocks = u.onlineBlocksAt(height, interval)
+	u.LastHeartbeat = height
+	u.Until = height
+
+	return u
+}
+
+// Percentage returns the share of the blocks since the node is registered in which it is online.
+func (u NodeUptime) Percentage(height, interval int64) sdk.Dec {
+	if u.Until > 0 {
+		height = u.Until
+	}
+	if height <= u.Since {
+		return sdk.ZeroDec()
+	}
+
+	online := u.onlineBlocksAt(height, interval)
+	return sdk.NewDec(online).QuoInt64(height - u.Since)
+}
@@ -12,12 +12,14 @@ var _ sdk.Msg = (*MsgRegisterNode)(nil)
 
 type MsgRegisterNode struct {
 	From          sdk.AccAddress `json:"from"`
-	T             string         `json:"type"`
+	T             NodeCategory   `json:"type"`
 	Version       string         `json:"version"`
 	Moniker       string         `json:"moniker"`
 	PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
 	InternetSpeed hub.Bandwidth  `json:"internet_speed"`
 	Encryption    string         `json:"encryption"`
+	MetadataURI   string         `json:"metadata_uri"`
+	MetadataHash  string         `json:"metadata_hash"`
 }
 
 func (msg MsgRegisterNode) Type() string {
@@ -28,25 +30,28 @@ func (msg MsgRegisterNode) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
-	if msg.T == "" {
+	if !msg.T.IsValid() {
 		return ErrorInvalidField("type")
 	}
 	if msg.Version == "" {
 		return ErrorInvalidField("version")
 	}
-	if len(msg.Moniker) > 128 {
+	if msg.Moniker != "" && (len(msg.Moniker) < 4 || len(msg.Moniker) > 32) {
 		return ErrorInvalidField("moniker")
 	}
 	if msg.PricesPerGB == nil ||
 		msg.PricesPerGB.Len() == 0 || !msg.PricesPerGB.IsValid() {
 		return ErrorInvalidField("prices_per_gb")
 	}
-	if !msg.InternetSpeed.AllPositive() {
+	if msg.InternetSpeed.IsValid() != nil || !msg.InternetSpeed.AllPositive() {
 		return ErrorInvalidField("internet_speed")
 	}
 	if msg.Encryption == "" {
 		return ErrorInvalidField("encryption")
 	}
+	if err := ValidateMetadata(msg.MetadataURI, msg.MetadataHash); err != nil {
+		return ErrorInvalidField("metadata")
+	}
 
 	return nil
 }
@@ -69,8 +74,8 @@ func (msg MsgRegisterNode) Route() string {
 }
 
 func NewMsgRegisterNode(from sdk.AccAddress,
-	t, version, moniker string, pricesPerGB sdk.Coins,
-	internetSpeed hub.Bandwidth, encryption string) *MsgRegisterNode {
+	t NodeCategory, version, moniker string, pricesPerGB sdk.Coins,
+	internetSpeed hub.Bandwidth, encryption, metadataURI, metadataHash string) *MsgRegisterNode {
 	return &MsgRegisterNode{
 		From:          from,
 		T:             t,
@@ -79,6 +84,8 @@ func NewMsgRegisterNode(from sdk.AccAddress,
 		PricesPerGB:   pricesPerGB,
 		InternetSpeed: internetSpeed,
 		Encryption:    encryption,
+		MetadataURI:   metadataURI,
+		MetadataHash:  metadataHash,
 	}
 }
 
@@ -87,12 +94,15 @@ var _ sdk.Msg = (*MsgUpdateNodeInfo)(nil)
 type MsgUpdateNodeInfo struct {
 	From          sdk.AccAddress `json:"from"`
 	ID            hub.NodeID     `json:"id"`
-	T             string         `json:"type"`
+	T             NodeCategory   `json:"type"`
 	Version       string         `json:"version"`
 	Moniker       string         `json:"moniker"`
 	PricesPerGB   sdk.Coins      `json:"prices_per_gb"`
 	InternetSpeed hub.Bandwidth  `json:"internet_speed"`
 	Encryption    string         `json:"encryption"`
+	MetadataURI   string         `json:"metadata_uri"`
+	MetadataHash  string         `json:"metadata_hash"`
+	ClearMetadata bool           `json:"clear_metadata"`
 }
 
 func (msg MsgUpdateNodeInfo) Type() string {
@@ -103,16 +113,25 @@ func (msg MsgUpdateNodeInfo) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
-	if len(msg.Moniker) > 128 {
+	if msg.T != "" && !msg.T.IsValid() {
+		return ErrorInvalidField("type")
+	}
+	if msg.Moniker != "" && (len(msg.Moniker) < 4 || len(msg.Moniker) > 32) {
 		return ErrorInvalidField("moniker")
 	}
 	if msg.PricesPerGB != nil &&
 		(msg.PricesPerGB.Len() == 0 || !msg.PricesPerGB.IsValid()) {
 		return ErrorInvalidField("prices_per_gb")
 	}
-	if msg.InternetSpeed.AnyNegative() {
+	if !msg.InternetSpeed.AnyNil() && msg.InternetSpeed.AnyNegative() {
 		return ErrorInvalidField("internet_speed")
 	}
+	if err := ValidateMetadata(msg.MetadataURI, msg.MetadataHash); err != nil {
+		return ErrorInvalidField("metadata")
+	}
+	if msg.ClearMetadata && (msg.MetadataURI != "" || msg.MetadataHash != "") {
+		return ErrorInvalidField("metadata")
+	}
 
 	return nil
 }
@@ -135,8 +154,8 @@ func (msg MsgUpdateNodeInfo) Route() string {
 }
 
 func NewMsgUpdateNodeInfo(from sdk.AccAddress, id hub.NodeID,
-	t, version, moniker string, pricesPerGB sdk.Coins,
-	internetSpeed hub.Bandwidth, encryption string) *MsgUpdateNodeInfo {
+	t NodeCategory, version, moniker string, pricesPerGB sdk.Coins,
+	internetSpeed hub.Bandwidth, encryption, metadataURI, metadataHash string, clearMetadata bool) *MsgUpdateNodeInfo {
 	return &MsgUpdateNodeInfo{
 		From:          from,
 		ID:            id,
@@ -146,6 +165,9 @@ func NewMsgUpdateNodeInfo(from sdk.AccAddress, id hub.NodeID,
 		PricesPerGB:   pricesPerGB,
 		InternetSpeed: internetSpeed,
 		Encryption:    encryption,
+		MetadataURI:   metadataURI,
+		MetadataHash:  metadataHash,
+		ClearMetadata: clearMetadata,
 	}
 }
 
@@ -191,3 +213,724 @@ func NewMsgDeregisterNode(from sdk.AccAddress, id hub.NodeID) *MsgDeregisterNode
 		ID:   id,
 	}
 }
+
+var _ sdk.Msg = (*MsgPruneNodeHistory)(nil)
+
+type MsgPruneNodeHistory struct {
+	From sdk.AccAddress `json:"from"`
+	ID   hub.NodeID     `json:"id"`
+}
+
+func (msg MsgPruneNodeHistory) Type() string {
+	return "prune_node_history"
+}
+
+func (msg MsgPruneNodeHistory) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgPruneNodeHistory) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgPruneNodeHistory) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgPruneNodeHistory) Route() string {
+	return RouterKey
+}
+
+func NewMsgPruneNodeHistory(from sdk.AccAddress, id hub.NodeID) *MsgPruneNodeHistory {
+	return &MsgPruneNodeHistory{
+		From: from,
+		ID:   id,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetNodePrivate)(nil)
+
+type MsgSetNodePrivate struct {
+	From    sdk.AccAddress `json:"from"`
+	ID      hub.NodeID     `json:"id"`
+	Private bool           `json:"private"`
+}
+
+func (msg MsgSetNodePrivate) Type() string {
+	return "set_node_private"
+}
+
+func (msg MsgSetNodePrivate) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgSetNodePrivate) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetNodePrivate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetNodePrivate) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetNodePrivate(from sdk.AccAddress, id hub.NodeID, private bool) *MsgSetNodePrivate {
+	return &MsgSetNodePrivate{
+		From:    from,
+		ID:      id,
+		Private: private,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetNodeLocation)(nil)
+
+// MsgSetNodeLocation replaces the location of the node, the empty location clears it.
+type MsgSetNodeLocation struct {
+	From     sdk.AccAddress `json:"from"`
+	ID       hub.NodeID     `json:"id"`
+	Location NodeLocation   `json:"location"`
+}
+
+func (msg MsgSetNodeLocation) Type() string {
+	return "set_node_location"
+}
+
+func (msg MsgSetNodeLocation) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if err := msg.Location.Validate(); err != nil {
+		return ErrorInvalidField("location")
+	}
+
+	return nil
+}
+
+func (msg MsgSetNodeLocation) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetNodeLocation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetNodeLocation) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetNodeLocation(from sdk.AccAddress, id hub.NodeID, location NodeLocation) *MsgSetNodeLocation {
+	return &MsgSetNodeLocation{
+		From:     from,
+		ID:       id,
+		Location: location,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetNodeFreeTrial)(nil)
+
+type MsgSetNodeFreeTrial struct {
+	From  sdk.AccAddress `json:"from"`
+	ID    hub.NodeID     `json:"id"`
+	Bytes uint64         `json:"bytes"`
+}
+
+func (msg MsgSetNodeFreeTrial) Type() string {
+	return "set_node_free_trial"
+}
+
+func (msg MsgSetNodeFreeTrial) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgSetNodeFreeTrial) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetNodeFreeTrial) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetNodeFreeTrial) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetNodeFreeTrial(from sdk.AccAddress, id hub.NodeID, bytes uint64) *MsgSetNodeFreeTrial {
+	return &MsgSetNodeFreeTrial{
+		From:  from,
+		ID:    id,
+		Bytes: bytes,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetDiscountPlan)(nil)
+
+// MsgSetDiscountPlan replaces the discount plan of the node, a zero rate removes it.
+type MsgSetDiscountPlan struct {
+	From  sdk.AccAddress `json:"from"`
+	ID    hub.NodeID     `json:"id"`
+	Rate  sdk.Dec        `json:"rate"`
+	Until int64          `json:"until"`
+}
+
+func (msg MsgSetDiscountPlan) Type() string {
+	return "set_discount_plan"
+}
+
+func (msg MsgSetDiscountPlan) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Rate.IsNil() || msg.Rate.IsNegative() || msg.Rate.GTE(sdk.OneDec()) {
+		return ErrorInvalidField("rate")
+	}
+	if msg.Rate.IsPositive() && msg.Until <= 0 {
+		return ErrorInvalidField("until")
+	}
+
+	return nil
+}
+
+func (msg MsgSetDiscountPlan) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetDiscountPlan) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetDiscountPlan) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetDiscountPlan(from sdk.AccAddress, id hub.NodeID, rate sdk.Dec, until int64) *MsgSetDiscountPlan {
+	return &MsgSetDiscountPlan{
+		From:  from,
+		ID:    id,
+		Rate:  rate,
+		Until: until,
+	}
+}
+
+var _ sdk.Msg = (*MsgUnjailNode)(nil)
+
+type MsgUnjailNode struct {
+	From sdk.AccAddress `json:"from"`
+	ID   hub.NodeID     `json:"id"`
+}
+
+func (msg MsgUnjailNode) Type() string {
+	return "unjail_node"
+}
+
+func (msg MsgUnjailNode) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgUnjailNode) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgUnjailNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgUnjailNode) Route() string {
+	return RouterKey
+}
+
+func NewMsgUnjailNode(from sdk.AccAddress, id hub.NodeID) *MsgUnjailNode {
+	return &MsgUnjailNode{
+		From: from,
+		ID:   id,
+	}
+}
+
+var _ sdk.Msg = (*MsgAddAllowedAddress)(nil)
+
+type MsgAddAllowedAddress struct {
+	From    sdk.AccAddress `json:"from"`
+	ID      hub.NodeID     `json:"id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func (msg MsgAddAllowedAddress) Type() string {
+	return "add_allowed_address"
+}
+
+func (msg MsgAddAllowedAddress) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Address == nil || msg.Address.Empty() {
+		return ErrorInvalidField("address")
+	}
+
+	return nil
+}
+
+func (msg MsgAddAllowedAddress) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgAddAllowedAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgAddAllowedAddress) Route() string {
+	return RouterKey
+}
+
+func NewMsgAddAllowedAddress(from sdk.AccAddress, id hub.NodeID, address sdk.AccAddress) *MsgAddAllowedAddress {
+	return &MsgAddAllowedAddress{
+		From:    from,
+		ID:      id,
+		Address: address,
+	}
+}
+
+var _ sdk.Msg = (*MsgRemoveAllowedAddress)(nil)
+
+type MsgRemoveAllowedAddress struct {
+	From    sdk.AccAddress `json:"from"`
+	ID      hub.NodeID     `json:"id"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+func (msg MsgRemoveAllowedAddress) Type() string {
+	return "remove_allowed_address"
+}
+
+func (msg MsgRemoveAllowedAddress) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Address == nil || msg.Address.Empty() {
+		return ErrorInvalidField("address")
+	}
+
+	return nil
+}
+
+func (msg MsgRemoveAllowedAddress) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgRemoveAllowedAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRemoveAllowedAddress) Route() string {
+	return RouterKey
+}
+
+func NewMsgRemoveAllowedAddress(from sdk.AccAddress, id hub.NodeID, address sdk.AccAddress) *MsgRemoveAllowedAddress {
+	return &MsgRemoveAllowedAddress{
+		From:    from,
+		ID:      id,
+		Address: address,
+	}
+}
+
+var _ sdk.Msg = (*MsgBlacklistClient)(nil)
+
+type MsgBlacklistClient struct {
+	From   sdk.AccAddress `json:"from"`
+	ID     hub.NodeID     `json:"id"`
+	Client sdk.AccAddress `json:"client"`
+}
+
+func (msg MsgBlacklistClient) Type() string {
+	return "blacklist_client"
+}
+
+func (msg MsgBlacklistClient) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Client == nil || msg.Client.Empty() {
+		return ErrorInvalidField("client")
+	}
+
+	return nil
+}
+
+func (msg MsgBlacklistClient) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgBlacklistClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgBlacklistClient) Route() string {
+	return RouterKey
+}
+
+func NewMsgBlacklistClient(from sdk.AccAddress, id hub.NodeID, client sdk.AccAddress) *MsgBlacklistClient {
+	return &MsgBlacklistClient{
+		From:   from,
+		ID:     id,
+		Client: client,
+	}
+}
+
+var _ sdk.Msg = (*MsgUnblacklistClient)(nil)
+
+type MsgUnblacklistClient struct {
+	From   sdk.AccAddress `json:"from"`
+	ID     hub.NodeID     `json:"id"`
+	Client sdk.AccAddress `json:"client"`
+}
+
+func (msg MsgUnblacklistClient) Type() string {
+	return "unblacklist_client"
+}
+
+func (msg MsgUnblacklistClient) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Client == nil || msg.Client.Empty() {
+		return ErrorInvalidField("client")
+	}
+
+	return nil
+}
+
+func (msg MsgUnblacklistClient) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgUnblacklistClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgUnblacklistClient) Route() string {
+	return RouterKey
+}
+
+func NewMsgUnblacklistClient(from sdk.AccAddress, id hub.NodeID, client sdk.AccAddress) *MsgUnblacklistClient {
+	return &MsgUnblacklistClient{
+		From:   from,
+		ID:     id,
+		Client: client,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetPayoutRoutes)(nil)
+
+type MsgSetPayoutRoutes struct {
+	From   sdk.AccAddress `json:"from"`
+	ID     hub.NodeID     `json:"id"`
+	Routes []PayoutRoute  `json:"routes"`
+}
+
+func (msg MsgSetPayoutRoutes) Type() string {
+	return "set_payout_routes"
+}
+
+func (msg MsgSetPayoutRoutes) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if err := ValidatePayoutRoutes(msg.Routes); err != nil {
+		return ErrorInvalidField("routes")
+	}
+
+	return nil
+}
+
+func (msg MsgSetPayoutRoutes) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetPayoutRoutes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetPayoutRoutes) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetPayoutRoutes(from sdk.AccAddress, id hub.NodeID, routes []PayoutRoute) *MsgSetPayoutRoutes {
+	return &MsgSetPayoutRoutes{
+		From:   from,
+		ID:     id,
+		Routes: routes,
+	}
+}
+
+var _ sdk.Msg = (*MsgSetRevenueSplits)(nil)
+
+type MsgSetRevenueSplits struct {
+	From   sdk.AccAddress `json:"from"`
+	ID     hub.NodeID     `json:"id"`
+	Splits []RevenueSplit `json:"splits"`
+}
+
+func (msg MsgSetRevenueSplits) Type() string {
+	return "set_revenue_splits"
+}
+
+func (msg MsgSetRevenueSplits) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if err := ValidateRevenueSplits(msg.Splits); err != nil {
+		return ErrorInvalidField("splits")
+	}
+
+	return nil
+}
+
+func (msg MsgSetRevenueSplits) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSetRevenueSplits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSetRevenueSplits) Route() string {
+	return RouterKey
+}
+
+func NewMsgSetRevenueSplits(from sdk.AccAddress, id hub.NodeID, splits []RevenueSplit) *MsgSetRevenueSplits {
+	return &MsgSetRevenueSplits{
+		From:   from,
+		ID:     id,
+		Splits: splits,
+	}
+}
+
+var _ sdk.Msg = (*MsgWithdrawNodeDeposit)(nil)
+
+// MsgWithdrawNodeDeposit releases the amount of the deposit of the node above the minimum deposit,
+// the node stays registered with the rest of its deposit.
+type MsgWithdrawNodeDeposit struct {
+	From   sdk.AccAddress `json:"from"`
+	ID     hub.NodeID     `json:"id"`
+	Amount sdk.Coin       `json:"amount"`
+}
+
+func (msg MsgWithdrawNodeDeposit) Type() string {
+	return "withdraw_node_deposit"
+}
+
+func (msg MsgWithdrawNodeDeposit) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Amount.Denom == "" || !msg.Amount.IsPositive() {
+		return ErrorInvalidField("amount")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawNodeDeposit) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgWithdrawNodeDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgWithdrawNodeDeposit) Route() string {
+	return RouterKey
+}
+
+func NewMsgWithdrawNodeDeposit(from sdk.AccAddress, id hub.NodeID, amount sdk.Coin) *MsgWithdrawNodeDeposit {
+	return &MsgWithdrawNodeDeposit{
+		From:   from,
+		ID:     id,
+		Amount: amount,
+	}
+}
+
+var _ sdk.Msg = (*MsgGrantFeeAllowance)(nil)
+
+// MsgGrantFeeAllowance sets the allowance of the grantee for the fees of its transactions on the
+// subscriptions of the nodes of the signer, replacing an existing allowance.
+type MsgGrantFeeAllowance struct {
+	From       sdk.AccAddress `json:"from"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit"`
+	Expiry     int64          `json:"expiry"`
+}
+
+func (msg MsgGrantFeeAllowance) Type() string {
+	return "grant_fee_allowance"
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Grantee == nil || msg.Grantee.Empty() || msg.Grantee.Equals(msg.From) {
+		return ErrorInvalidField("grantee")
+	}
+	if msg.SpendLimit.Empty() || !msg.SpendLimit.IsValid() {
+		return ErrorInvalidField("spend_limit")
+	}
+	if msg.Expiry < 0 {
+		return ErrorInvalidField("expiry")
+	}
+
+	return nil
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgGrantFeeAllowance) Route() string {
+	return RouterKey
+}
+
+func NewMsgGrantFeeAllowance(from, grantee sdk.AccAddress, spendLimit sdk.Coins, expiry int64) *MsgGrantFeeAllowance {
+	return &MsgGrantFeeAllowance{
+		From:       from,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiry:     expiry,
+	}
+}
+
+var _ sdk.Msg = (*MsgRevokeFeeAllowance)(nil)
+
+type MsgRevokeFeeAllowance struct {
+	From    sdk.AccAddress `json:"from"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func (msg MsgRevokeFeeAllowance) Type() string {
+	return "revoke_fee_allowance"
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Grantee == nil || msg.Grantee.Empty() {
+		return ErrorInvalidField("grantee")
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRevokeFeeAllowance) Route() string {
+	return RouterKey
+}
+
+func NewMsgRevokeFeeAllowance(from, grantee sdk.AccAddress) *MsgRevokeFeeAllowance {
+	return &MsgRevokeFeeAllowance{
+		From:    from,
+		Grantee: grantee,
+	}
+}
@@ -20,55 +20,87 @@ func TestMsgRegisterNode_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgRegisterNode(nil, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(nil, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgRegisterNode([]byte(""), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode([]byte(""), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("from"),
 		}, {
 			"node_type is empty",
-			NewMsgRegisterNode(TestAddress1, "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
+			ErrorInvalidField("type"),
+		}, {
+			"node_type is unknown",
+			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("type"),
 		}, {
 			"version is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("version"),
 		}, {
-			"node_moniker length is greater than 128",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			"node_moniker length is greater than 32",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", strings.Repeat("X", 33), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
+			ErrorInvalidField("moniker"),
+		}, {
+			"node_moniker length is less than 4",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "XXX", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("moniker"),
 		}, {
 			"prices_per_gb is nil",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", nil, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is negative",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is zero",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption", "", ""),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"internet_speed is negative",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption", "", ""),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"internet_speed is zero",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption", "", ""),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"encryption is empty",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, ""),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "", "", ""),
 			ErrorInvalidField("encryption"),
+		}, {
+			"metadata_hash is empty",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "https://example.com/node.json", ""),
+			ErrorInvalidField("metadata"),
+		}, {
+			"metadata_hash is invalid",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "https://example.com/node.json", strings.Repeat("X", 64)),
+			ErrorInvalidField("metadata"),
+		}, {
+			"metadata_uri is invalid",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "node.json", strings.Repeat("a", 64)),
+			ErrorInvalidField("metadata"),
+		}, {
+			"metadata_uri length is greater than 256",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "https://example.com/"+strings.Repeat("X", 256), strings.Repeat("a", 64)),
+			ErrorInvalidField("metadata"),
+		}, {
+			"valid with metadata",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "https://example.com/node.json", strings.Repeat("a", 64)),
+			nil,
+		}, {
+			"valid without moniker",
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
+			nil,
 		}, {
 			"valid",
-			NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", ""),
 			nil,
 		},
 	}
@@ -83,7 +115,7 @@ func TestMsgRegisterNode_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgRegisterNode_GetSignBytes(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "")
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -93,17 +125,17 @@ func TestMsgRegisterNode_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgRegisterNode_GetSigners(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "")
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgRegisterNode_Type(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "")
 	require.Equal(t, "register_node", msg.Type())
 }
 
 func TestMsgRegisterNode_Route(t *testing.T) {
-	msg := NewMsgRegisterNode(TestAddress1, "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgRegisterNode(TestAddress1, NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "")
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -115,55 +147,71 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(nil, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo([]byte(""), hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("from"),
+		}, {
+			"node_type is unknown",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
+			ErrorInvalidField("type"),
+		}, {
+			"node_type is empty",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
+			nil,
 		}, {
 			"node_moniker length is greater than 128",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", strings.Repeat("X", 130), sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("moniker"),
 		}, {
 			"prices_per_gb is nil",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", nil, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", nil, TestBandwidthPos1, "encryption", "", "", false),
 			nil,
 		}, {
 			"prices_per_gb is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.Coin{"stake", sdk.NewInt(-100)}}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"prices_per_gb is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 0)}, TestBandwidthPos1, "encryption", "", "", false),
 			ErrorInvalidField("prices_per_gb"),
 		}, {
 			"internet_speed is zero",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthZero, "encryption", "", "", false),
 			nil,
 		}, {
 			"internet_speed is negative",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthNeg, "encryption", "", "", false),
 			ErrorInvalidField("internet_speed"),
 		}, {
 			"encryption is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, ""),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "", "", "", false),
 			nil,
 		}, {
 			"type is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
 			nil,
 		}, {
 			"version is empty",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
+			nil,
+		}, {
+			"clear metadata with metadata",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "https://example.com/node.json", strings.Repeat("a", 64), true),
+			ErrorInvalidField("metadata"),
+		}, {
+			"clear metadata",
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", true),
 			nil,
 		}, {
 			"valid",
-			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption"),
+			NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false),
 			nil,
 		},
 	}
@@ -178,7 +226,7 @@ func TestMsgUpdateNodeInfo_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgUpdateNode_GetSignBytes(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -188,17 +236,17 @@ func TestMsgUpdateNode_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgUpdateNode_GetSigners(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgUpdateNode_Type(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false)
 	require.Equal(t, "update_node_info", msg.Type())
 }
 
 func TestMsgUpdateNode_Route(t *testing.T) {
-	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), "node_type", "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption")
+	msg := NewMsgUpdateNodeInfo(TestAddress1, hub.NewNodeID(1), NodeCategoryWireGuard, "version", "moniker", sdk.Coins{sdk.NewInt64Coin("stake", 100)}, TestBandwidthPos1, "encryption", "", "", false)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
@@ -256,3 +304,576 @@ func TestMsgDeregisterNode_Route(t *testing.T) {
 	msg := NewMsgDeregisterNode(TestAddress1, hub.NewNodeID(1))
 	require.Equal(t, RouterKey, msg.Route())
 }
+
+func TestMsgPruneNodeHistory_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgPruneNodeHistory
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgPruneNodeHistory(nil, hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgPruneNodeHistory([]byte(""), hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgPruneNodeHistory(TestAddress1, hub.NewNodeID(1)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgPruneNodeHistory_GetSignBytes(t *testing.T) {
+	msg := NewMsgPruneNodeHistory(TestAddress1, hub.NewNodeID(1))
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	require.Equal(t, msgBytes, msg.GetSignBytes())
+}
+
+func TestMsgPruneNodeHistory_GetSigners(t *testing.T) {
+	msg := NewMsgPruneNodeHistory(TestAddress1, hub.NewNodeID(1))
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgPruneNodeHistory_Type(t *testing.T) {
+	msg := NewMsgPruneNodeHistory(TestAddress1, hub.NewNodeID(1))
+	require.Equal(t, "prune_node_history", msg.Type())
+}
+
+func TestMsgPruneNodeHistory_Route(t *testing.T) {
+	msg := NewMsgPruneNodeHistory(TestAddress1, hub.NewNodeID(1))
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgSetNodePrivate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetNodePrivate
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetNodePrivate(nil, hub.NewNodeID(1), true),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgSetNodePrivate([]byte(""), hub.NewNodeID(1), true),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgSetNodePrivate(TestAddress1, hub.NewNodeID(1), true),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgUnjailNode_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgUnjailNode
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgUnjailNode(nil, hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgUnjailNode([]byte(""), hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgUnjailNode(TestAddress1, hub.NewNodeID(1)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgAddAllowedAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgAddAllowedAddress
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgAddAllowedAddress(nil, hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgAddAllowedAddress([]byte(""), hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"address is nil",
+			NewMsgAddAllowedAddress(TestAddress1, hub.NewNodeID(1), nil),
+			ErrorInvalidField("address"),
+		}, {
+			"address is empty",
+			NewMsgAddAllowedAddress(TestAddress1, hub.NewNodeID(1), []byte("")),
+			ErrorInvalidField("address"),
+		}, {
+			"valid",
+			NewMsgAddAllowedAddress(TestAddress1, hub.NewNodeID(1), TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveAllowedAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRemoveAllowedAddress
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRemoveAllowedAddress(nil, hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"address is nil",
+			NewMsgRemoveAllowedAddress(TestAddress1, hub.NewNodeID(1), nil),
+			ErrorInvalidField("address"),
+		}, {
+			"valid",
+			NewMsgRemoveAllowedAddress(TestAddress1, hub.NewNodeID(1), TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgBlacklistClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgBlacklistClient
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgBlacklistClient(nil, hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgBlacklistClient([]byte(""), hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"client is nil",
+			NewMsgBlacklistClient(TestAddress1, hub.NewNodeID(1), nil),
+			ErrorInvalidField("client"),
+		}, {
+			"client is empty",
+			NewMsgBlacklistClient(TestAddress1, hub.NewNodeID(1), []byte("")),
+			ErrorInvalidField("client"),
+		}, {
+			"valid",
+			NewMsgBlacklistClient(TestAddress1, hub.NewNodeID(1), TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgUnblacklistClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgUnblacklistClient
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgUnblacklistClient(nil, hub.NewNodeID(1), TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"client is nil",
+			NewMsgUnblacklistClient(TestAddress1, hub.NewNodeID(1), nil),
+			ErrorInvalidField("client"),
+		}, {
+			"valid",
+			NewMsgUnblacklistClient(TestAddress1, hub.NewNodeID(1), TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetPayoutRoutes_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetPayoutRoutes
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetPayoutRoutes(nil, hub.NewNodeID(1), nil),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgSetPayoutRoutes([]byte(""), hub.NewNodeID(1), nil),
+			ErrorInvalidField("from"),
+		}, {
+			"routes denom is invalid",
+			NewMsgSetPayoutRoutes(TestAddress1, hub.NewNodeID(1), []PayoutRoute{NewPayoutRoute("", TestAddress2)}),
+			ErrorInvalidField("routes"),
+		}, {
+			"routes address is empty",
+			NewMsgSetPayoutRoutes(TestAddress1, hub.NewNodeID(1), []PayoutRoute{NewPayoutRoute("stake", nil)}),
+			ErrorInvalidField("routes"),
+		}, {
+			"routes denom is duplicate",
+			NewMsgSetPayoutRoutes(TestAddress1, hub.NewNodeID(1),
+				[]PayoutRoute{NewPayoutRoute("stake", TestAddress2), NewPayoutRoute("stake", TestAddress1)}),
+			ErrorInvalidField("routes"),
+		}, {
+			"routes is nil",
+			NewMsgSetPayoutRoutes(TestAddress1, hub.NewNodeID(1), nil),
+			nil,
+		}, {
+			"valid",
+			NewMsgSetPayoutRoutes(TestAddress1, hub.NewNodeID(1), []PayoutRoute{NewPayoutRoute("stake", TestAddress2)}),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetRevenueSplits_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetRevenueSplits
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetRevenueSplits(nil, hub.NewNodeID(1), nil),
+			ErrorInvalidField("from"),
+		}, {
+			"splits role is node",
+			NewMsgSetRevenueSplits(TestAddress1, hub.NewNodeID(1),
+				[]RevenueSplit{NewRevenueSplit(RevenueRoleNode, TestAddress2, sdk.NewDecWithPrec(1, 1))}),
+			ErrorInvalidField("splits"),
+		}, {
+			"splits share is zero",
+			NewMsgSetRevenueSplits(TestAddress1, hub.NewNodeID(1),
+				[]RevenueSplit{NewRevenueSplit(RevenueRoleProvider, TestAddress2, sdk.ZeroDec())}),
+			ErrorInvalidField("splits"),
+		}, {
+			"splits shares sum up to more than one",
+			NewMsgSetRevenueSplits(TestAddress1, hub.NewNodeID(1), []RevenueSplit{
+				NewRevenueSplit(RevenueRoleProvider, TestAddress2, sdk.NewDecWithPrec(6, 1)),
+				NewRevenueSplit(RevenueRoleResolver, TestAddress2, sdk.NewDecWithPrec(5, 1)),
+			}),
+			ErrorInvalidField("splits"),
+		}, {
+			"splits is nil",
+			NewMsgSetRevenueSplits(TestAddress1, hub.NewNodeID(1), nil),
+			nil,
+		}, {
+			"valid",
+			NewMsgSetRevenueSplits(TestAddress1, hub.NewNodeID(1), []RevenueSplit{
+				NewRevenueSplit(RevenueRoleProvider, TestAddress2, sdk.NewDecWithPrec(2, 1)),
+				NewRevenueSplit(RevenueRoleResolver, TestAddress1, sdk.NewDecWithPrec(1, 1)),
+			}),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetNodeLocation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetNodeLocation
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetNodeLocation(nil, hub.NewNodeID(1), NewNodeLocation("US", "", "")),
+			ErrorInvalidField("from"),
+		}, {
+			"location is invalid",
+			NewMsgSetNodeLocation(TestAddress1, hub.NewNodeID(1), NewNodeLocation("XX", "", "")),
+			ErrorInvalidField("location"),
+		}, {
+			"location is empty",
+			NewMsgSetNodeLocation(TestAddress1, hub.NewNodeID(1), NodeLocation{}),
+			nil,
+		}, {
+			"valid",
+			NewMsgSetNodeLocation(TestAddress1, hub.NewNodeID(1), NewNodeLocation("US", "US-CA", "NA")),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetNodeFreeTrial_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetNodeFreeTrial
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetNodeFreeTrial(nil, hub.NewNodeID(1), 1000),
+			ErrorInvalidField("from"),
+		}, {
+			"bytes is zero",
+			NewMsgSetNodeFreeTrial(TestAddress1, hub.NewNodeID(1), 0),
+			nil,
+		}, {
+			"valid",
+			NewMsgSetNodeFreeTrial(TestAddress1, hub.NewNodeID(1), 1000),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetDiscountPlan_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSetDiscountPlan
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSetDiscountPlan(nil, hub.NewNodeID(1), sdk.NewDecWithPrec(1, 1), 100),
+			ErrorInvalidField("from"),
+		}, {
+			"rate is nil",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.Dec{}, 100),
+			ErrorInvalidField("rate"),
+		}, {
+			"rate is negative",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.NewDecWithPrec(-1, 1), 100),
+			ErrorInvalidField("rate"),
+		}, {
+			"rate is one",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.OneDec(), 100),
+			ErrorInvalidField("rate"),
+		}, {
+			"until is zero",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.NewDecWithPrec(1, 1), 0),
+			ErrorInvalidField("until"),
+		}, {
+			"rate is zero",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.ZeroDec(), 0),
+			nil,
+		}, {
+			"valid",
+			NewMsgSetDiscountPlan(TestAddress1, hub.NewNodeID(1), sdk.NewDecWithPrec(1, 1), 100),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawNodeDeposit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgWithdrawNodeDeposit
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgWithdrawNodeDeposit(nil, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgWithdrawNodeDeposit([]byte(""), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			ErrorInvalidField("from"),
+		}, {
+			"amount is empty",
+			NewMsgWithdrawNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.Coin{}),
+			ErrorInvalidField("amount"),
+		}, {
+			"amount is zero",
+			NewMsgWithdrawNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 0)),
+			ErrorInvalidField("amount"),
+		}, {
+			"valid",
+			NewMsgWithdrawNodeDeposit(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgGrantFeeAllowance_ValidateBasic(t *testing.T) {
+	spendLimit := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	tests := []struct {
+		name string
+		msg  *MsgGrantFeeAllowance
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgGrantFeeAllowance(nil, TestAddress2, spendLimit, 0),
+			ErrorInvalidField("from"),
+		}, {
+			"grantee is nil",
+			NewMsgGrantFeeAllowance(TestAddress1, nil, spendLimit, 0),
+			ErrorInvalidField("grantee"),
+		}, {
+			"grantee is from",
+			NewMsgGrantFeeAllowance(TestAddress1, TestAddress1, spendLimit, 0),
+			ErrorInvalidField("grantee"),
+		}, {
+			"spend limit is empty",
+			NewMsgGrantFeeAllowance(TestAddress1, TestAddress2, nil, 0),
+			ErrorInvalidField("spend_limit"),
+		}, {
+			"spend limit is invalid",
+			NewMsgGrantFeeAllowance(TestAddress1, TestAddress2, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(0)}}, 0),
+			ErrorInvalidField("spend_limit"),
+		}, {
+			"expiry is negative",
+			NewMsgGrantFeeAllowance(TestAddress1, TestAddress2, spendLimit, -1),
+			ErrorInvalidField("expiry"),
+		}, {
+			"valid",
+			NewMsgGrantFeeAllowance(TestAddress1, TestAddress2, spendLimit, 100),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRevokeFeeAllowance
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRevokeFeeAllowance(nil, TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"grantee is empty",
+			NewMsgRevokeFeeAllowance(TestAddress1, []byte("")),
+			ErrorInvalidField("grantee"),
+		}, {
+			"valid",
+			NewMsgRevokeFeeAllowance(TestAddress1, TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
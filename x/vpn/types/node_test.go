@@ -2,6 +2,7 @@ package types
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -74,8 +75,16 @@ func TestNode_UpdateInfo(t *testing.T) {
 			Node{},
 		}, {
 			"node_type is valid",
-			Node{Type: "node_type"},
-			Node{Type: "node_type"},
+			Node{Type: NodeCategoryWireGuard},
+			Node{Type: NodeCategoryWireGuard},
+		}, {
+			"metadata_hash is empty",
+			Node{MetadataURI: "https://example.com/node.json"},
+			Node{},
+		}, {
+			"metadata is valid",
+			Node{MetadataURI: "https://example.com/node.json", MetadataHash: strings.Repeat("a", 64)},
+			Node{MetadataURI: "https://example.com/node.json", MetadataHash: strings.Repeat("a", 64)},
 		},
 	}
 
@@ -89,6 +98,34 @@ func TestNode_UpdateInfo(t *testing.T) {
 	}
 }
 
+func TestNewNodeCategoryFromString(t *testing.T) {
+	category, err := NewNodeCategoryFromString("wireguard")
+	require.Nil(t, err)
+	require.Equal(t, NodeCategoryWireGuard, category)
+
+	category, err = NewNodeCategoryFromString("V2Ray")
+	require.Nil(t, err)
+	require.Equal(t, NodeCategoryV2Ray, category)
+
+	_, err = NewNodeCategoryFromString("")
+	require.NotNil(t, err)
+
+	_, err = NewNodeCategoryFromString("node_type")
+	require.NotNil(t, err)
+}
+
+func TestNodeCategory_IsValid(t *testing.T) {
+	require.True(t, NodeCategoryOpenVPN.IsValid())
+	require.False(t, NodeCategory("openvpn").IsValid())
+	require.False(t, NodeCategory("").IsValid())
+}
+
+func TestIsValidNodeSortOrder(t *testing.T) {
+	require.True(t, IsValidNodeSortOrder(""))
+	require.True(t, IsValidNodeSortOrder(SortNodesByUptimeDesc))
+	require.False(t, IsValidNodeSortOrder("latency"))
+}
+
 func TestNode_FindPricePerGB(t *testing.T) {
 	var node Node
 	require.Equal(t, node.FindPricePerGB("stake"), sdk.Coin{})
@@ -103,6 +140,15 @@ func TestNode_FindPricePerGB(t *testing.T) {
 	require.Equal(t, node.FindPricePerGB("stake"), sdk.NewInt64Coin("stake", 100))
 }
 
+func TestNode_PayoutAddress(t *testing.T) {
+	node := Node{Owner: TestAddress1}
+	require.Equal(t, TestAddress1, node.PayoutAddress("stake"))
+
+	node.PayoutRoutes = []PayoutRoute{NewPayoutRoute("stake", TestAddress2)}
+	require.Equal(t, TestAddress2, node.PayoutAddress("stake"))
+	require.Equal(t, TestAddress1, node.PayoutAddress("coin"))
+}
+
 func TestNode_DepositToBandwidth(t *testing.T) {
 	node := Node{
 		PricesPerGB: sdk.Coins{sdk.NewInt64Coin("stake", 100)},
@@ -124,3 +170,58 @@ func TestNode_DepositToBandwidth(t *testing.T) {
 	require.Nil(t, err)
 	reflect.DeepEqual(TestBandwidthPos1, bandwidth)
 }
+
+func TestValidateMetadata(t *testing.T) {
+	require.Nil(t, ValidateMetadata("", ""))
+	require.NotNil(t, ValidateMetadata("https://example.com/node.json", ""))
+	require.NotNil(t, ValidateMetadata("", strings.Repeat("a", 64)))
+	require.NotNil(t, ValidateMetadata("node.json", strings.Repeat("a", 64)))
+	require.NotNil(t, ValidateMetadata("https://example.com/node.json", strings.Repeat("a", 63)))
+	require.NotNil(t, ValidateMetadata("https://example.com/node.json", strings.Repeat("x", 64)))
+	require.Nil(t, ValidateMetadata("https://example.com/node.json", strings.Repeat("a", 64)))
+	require.Nil(t, ValidateMetadata("ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", strings.Repeat("a", 64)))
+}
+
+func TestNodeUptime_Percentage(t *testing.T) {
+	uptime := NewNodeUptime(hub.NewNodeID(0), 100)
+	require.Equal(t, sdk.ZeroDec(), uptime.Percentage(100, 10))
+	require.Equal(t, sdk.OneDec(), uptime.Percentage(105, 10))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), uptime.Percentage(120, 10))
+
+	uptime = uptime.Heartbeat(110, 10)
+	require.Equal(t, int64(10), uptime.OnlineBlocks)
+	require.Equal(t, int64(110), uptime.LastHeartbeat)
+
+	uptime = uptime.Heartbeat(150, 10)
+	require.Equal(t, int64(20), uptime.OnlineBlocks)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), uptime.Percentage(200, 10))
+
+	uptime = uptime.Stop(155, 10)
+	require.Equal(t, int64(25), uptime.OnlineBlocks)
+	require.Equal(t, int64(155), uptime.Until)
+	require.Equal(t, sdk.NewDecWithPrec(454545454545454545, 18), uptime.Percentage(1000, 10))
+}
+
+func TestNode_SplitRevenue(t *testing.T) {
+	node := Node{Owner: TestAddress1}
+	require.Equal(t, []RevenuePayout{{RevenueRoleNode, TestAddress1, sdk.NewInt64Coin("stake", 100)}},
+		node.SplitRevenue(sdk.NewInt64Coin("stake", 100)))
+
+	node.RevenueSplits = []RevenueSplit{
+		NewRevenueSplit(RevenueRoleProvider, TestAddress2, sdk.NewDecWithPrec(15, 2)),
+		NewRevenueSplit(RevenueRoleResolver, TestAddress2, sdk.NewDecWithPrec(1, 3)),
+	}
+	require.Equal(t, []RevenuePayout{
+		{RevenueRoleNode, TestAddress1, sdk.NewInt64Coin("stake", 85)},
+		{RevenueRoleProvider, TestAddress2, sdk.NewInt64Coin("stake", 15)},
+	}, node.SplitRevenue(sdk.NewInt64Coin("stake", 100)))
+
+	node.RevenueSplits = []RevenueSplit{
+		NewRevenueSplit(RevenueRoleProvider, TestAddress2, sdk.NewDecWithPrec(6, 1)),
+		NewRevenueSplit(RevenueRoleResolver, TestAddress1, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.Equal(t, []RevenuePayout{
+		{RevenueRoleProvider, TestAddress2, sdk.NewInt64Coin("stake", 600)},
+		{RevenueRoleResolver, TestAddress1, sdk.NewInt64Coin("stake", 400)},
+	}, node.SplitRevenue(sdk.NewInt64Coin("stake", 1000)))
+}
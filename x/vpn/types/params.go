@@ -8,31 +8,146 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 )
 
+const (
+	MaxMinClientVersionLength  = 64
+	MaxMaintenanceBannerLength = 256
+
+	// MaxPruneGasRefund is below the gas of deleting a session and its index, so that the pruning
+	// is never free.
+	MaxPruneGasRefund uint64 = 2000
+)
+
 var (
 	DefaultFreeNodesCount          uint64 = 5
 	DefaultDeposit                        = sdk.NewInt64Coin("stake", 100)
 	DefaultSessionInactiveInterval int64  = 25
+	DefaultSessionPruningRetention int64  = 100800
+	DefaultPruneGasRefund          uint64 = 1000
+	DefaultMinClientVersion               = ""
+	DefaultMaintenanceBanners      []string
+	DefaultSettlementInterval      int64  = 0
+	DefaultSettlementEpoch         int64  = 0
+	DefaultProtocolFeeRate                = sdk.ZeroDec()
+	DefaultFreeUpdatesPerBlock     uint64 = 10
+	DefaultSubscriptionGCEpoch     int64  = 14400
+	DefaultSubscriptionGCRetention int64  = 100800
+	DefaultSettlementGracePeriod   int64  = 50
+	DefaultNodeStatsEpoch          int64  = 14400
+	DefaultNodeStatsRetention      int64  = 100800
+	DefaultNodeInactiveInterval    int64  = 200
+	DefaultNodeJailCooldown        int64  = 14400
+	DefaultFeeDenoms               []FeeDenom
+	DefaultReferralRate                  = sdk.ZeroDec()
+	DefaultMinNodeVersion                = ""
+	DefaultNodeUnbondingPeriod     int64 = 100800
+	DefaultNodeRewardRate                = sdk.ZeroDec()
+	DefaultReputationEpoch         int64 = 14400
+	DefaultDisputeWindow           int64 = 14400
+	DefaultDisputeTimeout          int64 = 100800
+	DefaultEscrowTimeout           int64 = 100800
+	DefaultEscrowReleaseEpoch      int64 = 600
 )
 
 var (
 	KeyFreeNodesCount          = []byte("FreeNodesCount")
 	KeyDeposit                 = []byte("Deposit")
 	KeySessionInactiveInterval = []byte("SessionInactiveInterval")
+	KeySessionPruningRetention = []byte("SessionPruningRetention")
+	KeyPruneGasRefund          = []byte("PruneGasRefund")
+	KeyMinClientVersion        = []byte("MinClientVersion")
+	KeyMaintenanceBanners      = []byte("MaintenanceBanners")
+	KeySettlementInterval      = []byte("SettlementInterval")
+	KeySettlementEpoch         = []byte("SettlementEpoch")
+	KeyProtocolFeeRate         = []byte("ProtocolFeeRate")
+	KeyFreeUpdatesPerBlock     = []byte("FreeUpdatesPerBlock")
+	KeySubscriptionGCEpoch     = []byte("SubscriptionGCEpoch")
+	KeySubscriptionGCRetention = []byte("SubscriptionGCRetention")
+	KeySettlementGracePeriod   = []byte("SettlementGracePeriod")
+	KeyNodeStatsEpoch          = []byte("NodeStatsEpoch")
+	KeyNodeStatsRetention      = []byte("NodeStatsRetention")
+	KeyNodeInactiveInterval    = []byte("NodeInactiveInterval")
+	KeyNodeJailCooldown        = []byte("NodeJailCooldown")
+	KeyFeeDenoms               = []byte("FeeDenoms")
+	KeyReferralRate            = []byte("ReferralRate")
+	KeyMinNodeVersion          = []byte("MinNodeVersion")
+	KeyNodeUnbondingPeriod     = []byte("NodeUnbondingPeriod")
+	KeyNodeRewardRate          = []byte("NodeRewardRate")
+	KeyReputationEpoch         = []byte("ReputationEpoch")
+	KeyDisputeWindow           = []byte("DisputeWindow")
+	KeyDisputeTimeout          = []byte("DisputeTimeout")
+	KeyEscrowTimeout           = []byte("EscrowTimeout")
+	KeyEscrowReleaseEpoch      = []byte("EscrowReleaseEpoch")
 )
 
 var _ params.ParamSet = (*Params)(nil)
 
 type Params struct {
-	FreeNodesCount          uint64   `json:"free_nodes_count"`
-	Deposit                 sdk.Coin `json:"deposit"`
-	SessionInactiveInterval int64    `json:"session_inactive_interval"`
+	FreeNodesCount          uint64     `json:"free_nodes_count"`
+	Deposit                 sdk.Coin   `json:"deposit"`
+	SessionInactiveInterval int64      `json:"session_inactive_interval"`
+	SessionPruningRetention int64      `json:"session_pruning_retention"`
+	PruneGasRefund          uint64     `json:"prune_gas_refund"`
+	MinClientVersion        string     `json:"min_client_version"`
+	MaintenanceBanners      []string   `json:"maintenance_banners"`
+	SettlementInterval      int64      `json:"settlement_interval"`
+	SettlementEpoch         int64      `json:"settlement_epoch"`
+	ProtocolFeeRate         sdk.Dec    `json:"protocol_fee_rate"`
+	FreeUpdatesPerBlock     uint64     `json:"free_updates_per_block"`
+	SubscriptionGCEpoch     int64      `json:"subscription_gc_epoch"`
+	SubscriptionGCRetention int64      `json:"subscription_gc_retention"`
+	SettlementGracePeriod   int64      `json:"settlement_grace_period"`
+	NodeStatsEpoch          int64      `json:"node_stats_epoch"`
+	NodeStatsRetention      int64      `json:"node_stats_retention"`
+	NodeInactiveInterval    int64      `json:"node_inactive_interval"`
+	NodeJailCooldown        int64      `json:"node_jail_cooldown"`
+	FeeDenoms               []FeeDenom `json:"fee_denoms"`
+	ReferralRate            sdk.Dec    `json:"referral_rate"`
+	MinNodeVersion          string     `json:"min_node_version"`
+	NodeUnbondingPeriod     int64      `json:"node_unbonding_period"`
+	NodeRewardRate          sdk.Dec    `json:"node_reward_rate"`
+	ReputationEpoch         int64      `json:"reputation_epoch"`
+	DisputeWindow           int64      `json:"dispute_window"`
+	DisputeTimeout          int64      `json:"dispute_timeout"`
+	EscrowTimeout           int64      `json:"escrow_timeout"`
+	EscrowReleaseEpoch      int64      `json:"escrow_release_epoch"`
 }
 
-func NewParams(freeNodesCount uint64, deposit sdk.Coin, sessionInactiveInterval int64) Params {
+func NewParams(freeNodesCount uint64, deposit sdk.Coin, sessionInactiveInterval,
+	sessionPruningRetention int64, pruneGasRefund uint64, minClientVersion string, maintenanceBanners []string,
+	settlementInterval, settlementEpoch int64, protocolFeeRate sdk.Dec, freeUpdatesPerBlock uint64,
+	subscriptionGCEpoch, subscriptionGCRetention, settlementGracePeriod, nodeStatsEpoch,
+	nodeStatsRetention, nodeInactiveInterval, nodeJailCooldown int64, feeDenoms []FeeDenom,
+	referralRate sdk.Dec, minNodeVersion string, nodeUnbondingPeriod int64, nodeRewardRate sdk.Dec,
+	reputationEpoch, disputeWindow, disputeTimeout, escrowTimeout, escrowReleaseEpoch int64) Params {
 	return Params{
 		FreeNodesCount:          freeNodesCount,
 		Deposit:                 deposit,
 		SessionInactiveInterval: sessionInactiveInterval,
+		SessionPruningRetention: sessionPruningRetention,
+		PruneGasRefund:          pruneGasRefund,
+		MinClientVersion:        minClientVersion,
+		MaintenanceBanners:      maintenanceBanners,
+		SettlementInterval:      settlementInterval,
+		SettlementEpoch:         settlementEpoch,
+		ProtocolFeeRate:         protocolFeeRate,
+		FreeUpdatesPerBlock:     freeUpdatesPerBlock,
+		SubscriptionGCEpoch:     subscriptionGCEpoch,
+		SubscriptionGCRetention: subscriptionGCRetention,
+		SettlementGracePeriod:   settlementGracePeriod,
+		NodeStatsEpoch:          nodeStatsEpoch,
+		NodeStatsRetention:      nodeStatsRetention,
+		NodeInactiveInterval:    nodeInactiveInterval,
+		NodeJailCooldown:        nodeJailCooldown,
+		FeeDenoms:               feeDenoms,
+		ReferralRate:            referralRate,
+		MinNodeVersion:          minNodeVersion,
+		NodeUnbondingPeriod:     nodeUnbondingPeriod,
+		NodeRewardRate:          nodeRewardRate,
+		ReputationEpoch:         reputationEpoch,
+		DisputeWindow:           disputeWindow,
+		DisputeTimeout:          disputeTimeout,
+		EscrowTimeout:           escrowTimeout,
+		EscrowReleaseEpoch:      escrowReleaseEpoch,
 	}
 }
 
@@ -40,7 +155,37 @@ func (p Params) String() string {
 	return fmt.Sprintf(`Params
   Free Nodes Count:          %d
   Deposit:                   %s
-  Session Inactive Interval: %d`, p.FreeNodesCount, p.Deposit, p.SessionInactiveInterval)
+  Session Inactive Interval: %d
+  Session Pruning Retention: %d
+  Prune Gas Refund:          %d
+  Min Client Version:        %s
+  Maintenance Banners:       %s
+  Settlement Interval:       %d
+  Settlement Epoch:          %d
+  Protocol Fee Rate:         %s
+  Free Updates Per Block:    %d
+  Subscription GC Epoch:     %d
+  Subscription GC Retention: %d
+  Settlement Grace Period:   %d
+  Node Stats Epoch:          %d
+  Node Stats Retention:      %d
+  Node Inactive Interval:    %d
+  Node Jail Cooldown:        %d
+  Fee Denoms:                %s
+  Referral Rate:             %s
+  Min Node Version:          %s
+  Node Unbonding Period:     %d
+  Node Reward Rate:          %s
+  Reputation Epoch:          %d
+  Dispute Window:            %d
+  Dispute Timeout:           %d
+  Escrow Timeout:            %d
+  Escrow Release Epoch:      %d`, p.FreeNodesCount, p.Deposit, p.SessionInactiveInterval,
+		p.SessionPruningRetention, p.PruneGasRefund, p.MinClientVersion, p.MaintenanceBanners, p.SettlementInterval,
+		p.SettlementEpoch, p.ProtocolFeeRate, p.FreeUpdatesPerBlock, p.SubscriptionGCEpoch, p.SubscriptionGCRetention,
+		p.SettlementGracePeriod, p.NodeStatsEpoch, p.NodeStatsRetention, p.NodeInactiveInterval, p.NodeJailCooldown, p.FeeDenoms,
+		p.ReferralRate, p.MinNodeVersion, p.NodeUnbondingPeriod, p.NodeRewardRate, p.ReputationEpoch,
+		p.DisputeWindow, p.DisputeTimeout, p.EscrowTimeout, p.EscrowReleaseEpoch)
 }
 
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
@@ -48,6 +193,31 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: KeyFreeNodesCount, Value: &p.FreeNodesCount},
 		{Key: KeyDeposit, Value: &p.Deposit},
 		{Key: KeySessionInactiveInterval, Value: &p.SessionInactiveInterval},
+		{Key: KeySessionPruningRetention, Value: &p.SessionPruningRetention},
+		{Key: KeyPruneGasRefund, Value: &p.PruneGasRefund},
+		{Key: KeyMinClientVersion, Value: &p.MinClientVersion},
+		{Key: KeyMaintenanceBanners, Value: &p.MaintenanceBanners},
+		{Key: KeySettlementInterval, Value: &p.SettlementInterval},
+		{Key: KeySettlementEpoch, Value: &p.SettlementEpoch},
+		{Key: KeyProtocolFeeRate, Value: &p.ProtocolFeeRate},
+		{Key: KeyFreeUpdatesPerBlock, Value: &p.FreeUpdatesPerBlock},
+		{Key: KeySubscriptionGCEpoch, Value: &p.SubscriptionGCEpoch},
+		{Key: KeySubscriptionGCRetention, Value: &p.SubscriptionGCRetention},
+		{Key: KeySettlementGracePeriod, Value: &p.SettlementGracePeriod},
+		{Key: KeyNodeStatsEpoch, Value: &p.NodeStatsEpoch},
+		{Key: KeyNodeStatsRetention, Value: &p.NodeStatsRetention},
+		{Key: KeyNodeInactiveInterval, Value: &p.NodeInactiveInterval},
+		{Key: KeyNodeJailCooldown, Value: &p.NodeJailCooldown},
+		{Key: KeyFeeDenoms, Value: &p.FeeDenoms},
+		{Key: KeyReferralRate, Value: &p.ReferralRate},
+		{Key: KeyMinNodeVersion, Value: &p.MinNodeVersion},
+		{Key: KeyNodeUnbondingPeriod, Value: &p.NodeUnbondingPeriod},
+		{Key: KeyNodeRewardRate, Value: &p.NodeRewardRate},
+		{Key: KeyReputationEpoch, Value: &p.ReputationEpoch},
+		{Key: KeyDisputeWindow, Value: &p.DisputeWindow},
+		{Key: KeyDisputeTimeout, Value: &p.DisputeTimeout},
+		{Key: KeyEscrowTimeout, Value: &p.EscrowTimeout},
+		{Key: KeyEscrowReleaseEpoch, Value: &p.EscrowReleaseEpoch},
 	}
 }
 
@@ -56,6 +226,31 @@ func DefaultParams() Params {
 		FreeNodesCount:          DefaultFreeNodesCount,
 		Deposit:                 DefaultDeposit,
 		SessionInactiveInterval: DefaultSessionInactiveInterval,
+		SessionPruningRetention: DefaultSessionPruningRetention,
+		PruneGasRefund:          DefaultPruneGasRefund,
+		MinClientVersion:        DefaultMinClientVersion,
+		MaintenanceBanners:      DefaultMaintenanceBanners,
+		SettlementInterval:      DefaultSettlementInterval,
+		SettlementEpoch:         DefaultSettlementEpoch,
+		ProtocolFeeRate:         DefaultProtocolFeeRate,
+		FreeUpdatesPerBlock:     DefaultFreeUpdatesPerBlock,
+		SubscriptionGCEpoch:     DefaultSubscriptionGCEpoch,
+		SubscriptionGCRetention: DefaultSubscriptionGCRetention,
+		SettlementGracePeriod:   DefaultSettlementGracePeriod,
+		NodeStatsEpoch:          DefaultNodeStatsEpoch,
+		NodeStatsRetention:      DefaultNodeStatsRetention,
+		NodeInactiveInterval:    DefaultNodeInactiveInterval,
+		NodeJailCooldown:        DefaultNodeJailCooldown,
+		FeeDenoms:               DefaultFeeDenoms,
+		ReferralRate:            DefaultReferralRate,
+		MinNodeVersion:          DefaultMinNodeVersion,
+		NodeUnbondingPeriod:     DefaultNodeUnbondingPeriod,
+		NodeRewardRate:          DefaultNodeRewardRate,
+		ReputationEpoch:         DefaultReputationEpoch,
+		DisputeWindow:           DefaultDisputeWindow,
+		DisputeTimeout:          DefaultDisputeTimeout,
+		EscrowTimeout:           DefaultEscrowTimeout,
+		EscrowReleaseEpoch:      DefaultEscrowReleaseEpoch,
 	}
 }
 
@@ -66,6 +261,91 @@ func (p Params) Validate() error {
 	if p.SessionInactiveInterval < 0 {
 		return fmt.Errorf("SessionInactiveInterval: %d should be positive interger", p.SessionInactiveInterval)
 	}
+	if p.SessionPruningRetention < 0 {
+		return fmt.Errorf("SessionPruningRetention: %d should be positive interger", p.SessionPruningRetention)
+	}
+	if p.PruneGasRefund > MaxPruneGasRefund {
+		return fmt.Errorf("PruneGasRefund: %d should not be greater than %d", p.PruneGasRefund, MaxPruneGasRefund)
+	}
+	if p.SettlementInterval < 0 {
+		return fmt.Errorf("SettlementInterval: %d should be positive interger", p.SettlementInterval)
+	}
+	if p.SettlementEpoch < 0 {
+		return fmt.Errorf("SettlementEpoch: %d should be positive interger", p.SettlementEpoch)
+	}
+	if p.SubscriptionGCEpoch < 0 {
+		return fmt.Errorf("SubscriptionGCEpoch: %d should be positive interger", p.SubscriptionGCEpoch)
+	}
+	if p.SubscriptionGCRetention < 0 {
+		return fmt.Errorf("SubscriptionGCRetention: %d should be positive interger", p.SubscriptionGCRetention)
+	}
+	if p.SettlementGracePeriod < 0 {
+		return fmt.Errorf("SettlementGracePeriod: %d should be positive interger", p.SettlementGracePeriod)
+	}
+	if p.NodeStatsEpoch < 0 {
+		return fmt.Errorf("NodeStatsEpoch: %d should be positive interger", p.NodeStatsEpoch)
+	}
+	if p.NodeStatsRetention < 0 {
+		return fmt.Errorf("NodeStatsRetention: %d should be positive interger", p.NodeStatsRetention)
+	}
+	if p.NodeInactiveInterval < 0 {
+		return fmt.Errorf("NodeInactiveInterval: %d should be positive interger", p.NodeInactiveInterval)
+	}
+	if p.NodeJailCooldown < 0 {
+		return fmt.Errorf("NodeJailCooldown: %d should be positive interger", p.NodeJailCooldown)
+	}
+	if p.NodeUnbondingPeriod < 0 {
+		return fmt.Errorf("NodeUnbondingPeriod: %d should be positive interger", p.NodeUnbondingPeriod)
+	}
+	if p.ReputationEpoch < 0 {
+		return fmt.Errorf("ReputationEpoch: %d should be positive interger", p.ReputationEpoch)
+	}
+	if p.DisputeWindow < 0 {
+		return fmt.Errorf("DisputeWindow: %d should be positive interger", p.DisputeWindow)
+	}
+	if p.DisputeTimeout < 0 || (p.DisputeWindow > 0 && p.DisputeTimeout == 0) {
+		return fmt.Errorf("DisputeTimeout: %d should be positive interger", p.DisputeTimeout)
+	}
+	if p.EscrowTimeout < 0 {
+		return fmt.Errorf("EscrowTimeout: %d should be positive interger", p.EscrowTimeout)
+	}
+	if p.EscrowReleaseEpoch < 0 || (p.EscrowTimeout > 0 && p.EscrowReleaseEpoch == 0) {
+		return fmt.Errorf("EscrowReleaseEpoch: %d should be positive interger", p.EscrowReleaseEpoch)
+	}
+	if p.ProtocolFeeRate.IsNil() || p.ProtocolFeeRate.IsNegative() || p.ProtocolFeeRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("ProtocolFeeRate: %s should be between 0 and 1", p.ProtocolFeeRate)
+	}
+	if p.ReferralRate.IsNil() || p.ReferralRate.IsNegative() ||
+		p.ReferralRate.Add(p.ProtocolFeeRate).GT(sdk.OneDec()) {
+		return fmt.Errorf("ReferralRate: %s should be between 0 and 1 minus ProtocolFeeRate", p.ReferralRate)
+	}
+	if p.NodeRewardRate.IsNil() || p.NodeRewardRate.IsNegative() || p.NodeRewardRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("NodeRewardRate: %s should be between 0 and 1", p.NodeRewardRate)
+	}
+	if len(p.MinClientVersion) > MaxMinClientVersionLength {
+		return fmt.Errorf("MinClientVersion: %s is too long", p.MinClientVersion)
+	}
+	if p.MinNodeVersion != "" {
+		if _, err := ParseVersion(p.MinNodeVersion); err != nil {
+			return fmt.Errorf("MinNodeVersion: %s", err)
+		}
+	}
+	for _, banner := range p.MaintenanceBanners {
+		if banner == "" || len(banner) > MaxMaintenanceBannerLength {
+			return fmt.Errorf("MaintenanceBanners: %s is invalid", banner)
+		}
+	}
+	for i, feeDenom := range p.FeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return fmt.Errorf("FeeDenoms: %s", err)
+		}
+
+		for _, _feeDenom := range p.FeeDenoms[i+1:] {
+			if feeDenom.Denom == _feeDenom.Denom {
+				return fmt.Errorf("FeeDenoms: duplicate denom %s", feeDenom.Denom)
+			}
+		}
+	}
 
 	return nil
 }
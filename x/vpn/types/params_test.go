@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	params := DefaultParams()
+	require.Nil(t, params.Validate())
+
+	params.PruneGasRefund = MaxPruneGasRefund
+	require.Nil(t, params.Validate())
+
+	params.PruneGasRefund = MaxPruneGasRefund + 1
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.EscrowReleaseEpoch = 0
+	require.NotNil(t, params.Validate())
+
+	params.EscrowTimeout = 0
+	require.Nil(t, params.Validate())
+}
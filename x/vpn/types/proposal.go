@@ -0,0 +1,175 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	ProposalTypeReleaseEscrow  = "ReleaseEscrow"
+	ProposalTypeJailNode       = "JailNode"
+	ProposalTypeResolveDispute = "ResolveDispute"
+)
+
+var (
+	_ govtypes.Content = ReleaseEscrowProposal{}
+	_ govtypes.Content = JailNodeProposal{}
+	_ govtypes.Content = ResolveDisputeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReleaseEscrow)
+	govtypes.RegisterProposalTypeCodec(ReleaseEscrowProposal{}, "x/vpn/ReleaseEscrowProposal")
+	govtypes.RegisterProposalType(ProposalTypeJailNode)
+	govtypes.RegisterProposalTypeCodec(JailNodeProposal{}, "x/vpn/JailNodeProposal")
+	govtypes.RegisterProposalType(ProposalTypeResolveDispute)
+	govtypes.RegisterProposalTypeCodec(ResolveDisputeProposal{}, "x/vpn/ResolveDisputeProposal")
+}
+
+// ReleaseEscrowProposal sends the remaining deposit of a subscription, which is stuck due to
+// an edge case, to the recipient. The ongoing session of the subscription is closed unsettled.
+type ReleaseEscrowProposal struct {
+	Title          string             `json:"title"`
+	Description    string             `json:"description"`
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Recipient      sdk.AccAddress     `json:"recipient"`
+}
+
+func NewReleaseEscrowProposal(title, description string,
+	id hub.SubscriptionID, recipient sdk.AccAddress) ReleaseEscrowProposal {
+	return ReleaseEscrowProposal{
+		Title:          title,
+		Description:    description,
+		SubscriptionID: id,
+		Recipient:      recipient,
+	}
+}
+
+func (p ReleaseEscrowProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p ReleaseEscrowProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p ReleaseEscrowProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p ReleaseEscrowProposal) ProposalType() string {
+	return ProposalTypeReleaseEscrow
+}
+
+func (p ReleaseEscrowProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(Codespace, p); err != nil {
+		return err
+	}
+	if p.Recipient == nil || p.Recipient.Empty() {
+		return ErrorInvalidField("recipient")
+	}
+
+	return nil
+}
+
+func (p ReleaseEscrowProposal) String() string {
+	return fmt.Sprintf(`Release Escrow Proposal
+  Title:           %s
+  Description:     %s
+  Subscription ID: %s
+  Recipient:       %s`, p.Title, p.Description, p.SubscriptionID, p.Recipient)
+}
+
+// JailNodeProposal bans a misbehaving node, which can not accept the new subscriptions and sessions
+// until its owner unjails it after the jail cooldown.
+type JailNodeProposal struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	NodeID      hub.NodeID `json:"node_id"`
+}
+
+func NewJailNodeProposal(title, description string, id hub.NodeID) JailNodeProposal {
+	return JailNodeProposal{
+		Title:       title,
+		Description: description,
+		NodeID:      id,
+	}
+}
+
+func (p JailNodeProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p JailNodeProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p JailNodeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p JailNodeProposal) ProposalType() string {
+	return ProposalTypeJailNode
+}
+
+func (p JailNodeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(Codespace, p)
+}
+
+func (p JailNodeProposal) String() string {
+	return fmt.Sprintf(`Jail Node Proposal
+  Title:       %s
+  Description: %s
+  Node ID:     %s`, p.Title, p.Description, p.NodeID)
+}
+
+// ResolveDisputeProposal decides the open dispute of a session, which the evidence of the bandwidth can not,
+// such as a claim of the client about the quality of the session. The claim of the party which opened the
+// dispute is upheld or rejected.
+type ResolveDisputeProposal struct {
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	SessionID   hub.SessionID `json:"session_id"`
+	Upheld      bool          `json:"upheld"`
+}
+
+func NewResolveDisputeProposal(title, description string, id hub.SessionID, upheld bool) ResolveDisputeProposal {
+	return ResolveDisputeProposal{
+		Title:       title,
+		Description: description,
+		SessionID:   id,
+		Upheld:      upheld,
+	}
+}
+
+func (p ResolveDisputeProposal) GetTitle() string {
+	return p.Title
+}
+
+func (p ResolveDisputeProposal) GetDescription() string {
+	return p.Description
+}
+
+func (p ResolveDisputeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (p ResolveDisputeProposal) ProposalType() string {
+	return ProposalTypeResolveDispute
+}
+
+func (p ResolveDisputeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(Codespace, p)
+}
+
+func (p ResolveDisputeProposal) String() string {
+	return fmt.Sprintf(`Resolve Dispute Proposal
+  Title:       %s
+  Description: %s
+  Session ID:  %s
+  Upheld:      %t`, p.Title, p.Description, p.SessionID, p.Upheld)
+}
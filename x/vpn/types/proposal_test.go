@@ -0,0 +1,66 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestReleaseEscrowProposal_ValidateBasic(t *testing.T) {
+	proposal := NewReleaseEscrowProposal("", "description", hub.NewSubscriptionID(1), TestAddress1)
+	require.NotNil(t, proposal.ValidateBasic())
+
+	proposal = NewReleaseEscrowProposal("title", "", hub.NewSubscriptionID(1), TestAddress1)
+	require.NotNil(t, proposal.ValidateBasic())
+
+	tests := []struct {
+		name     string
+		proposal ReleaseEscrowProposal
+		want     sdk.Error
+	}{
+		{
+			"recipient is nil",
+			NewReleaseEscrowProposal("title", "description", hub.NewSubscriptionID(1), nil),
+			ErrorInvalidField("recipient"),
+		}, {
+			"recipient is empty",
+			NewReleaseEscrowProposal("title", "description", hub.NewSubscriptionID(1), []byte("")),
+			ErrorInvalidField("recipient"),
+		}, {
+			"valid",
+			NewReleaseEscrowProposal("title", "description", hub.NewSubscriptionID(1), TestAddress1),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.proposal.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReleaseEscrowProposal_ProposalRoute(t *testing.T) {
+	proposal := NewReleaseEscrowProposal("title", "description", hub.NewSubscriptionID(1), TestAddress1)
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeReleaseEscrow, proposal.ProposalType())
+}
+
+func TestJailNodeProposal_ValidateBasic(t *testing.T) {
+	proposal := NewJailNodeProposal("", "description", hub.NewNodeID(1))
+	require.NotNil(t, proposal.ValidateBasic())
+
+	proposal = NewJailNodeProposal("title", "", hub.NewNodeID(1))
+	require.NotNil(t, proposal.ValidateBasic())
+
+	proposal = NewJailNodeProposal("title", "description", hub.NewNodeID(1))
+	require.Nil(t, proposal.ValidateBasic())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeJailNode, proposal.ProposalType())
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+const (
+	// QoSSummaryWindow is the number of the latest reports which the averages of a node are weighted over.
+	QoSSummaryWindow uint64 = 100
+
+	// MaxQoSLatency is the maximum latency in milliseconds a report can have.
+	MaxQoSLatency uint64 = 60000
+)
+
+// NodeQoS is the rolling summary of the quality reports of the clients of a node, the average speed
+// in bytes per second and the average latency in milliseconds. The averages are cumulative over the
+// first QoSSummaryWindow reports and exponentially weighted afterwards.
+type NodeQoS struct {
+	NodeID    hub.NodeID    `json:"node_id"`
+	Reports   uint64        `json:"reports"`
+	Speed     hub.Bandwidth `json:"speed"`
+	Latency   sdk.Int       `json:"latency"`
+	UpdatedAt int64         `json:"updated_at"`
+}
+
+func NewNodeQoS(id hub.NodeID) NodeQoS {
+	return NodeQoS{
+		NodeID:  id,
+		Speed:   hub.NewBandwidthFromInt64(0, 0),
+		Latency: sdk.ZeroInt(),
+	}
+}
+
+func (q NodeQoS) String() string {
+	return fmt.Sprintf(`NodeQoS
+  Node ID:     %s
+  Reports:     %d
+  Speed:       %s
+  Latency:     %s
+  Updated At:  %d`, q.NodeID, q.Reports, q.Speed, q.Latency, q.UpdatedAt)
+}
+
+// Add weighs the report into the averages at the height.
+func (q NodeQoS) Add(speed hub.Bandwidth, latency uint64, height int64) NodeQoS {
+	q.Reports++
+
+	weight := q.Reports
+	if weight > QoSSummaryWindow {
+		weight = QoSSummaryWindow
+	}
+
+	n := sdk.NewIntFromBigInt(new(big.Int).SetUint64(weight))
+	q.Speed = hub.NewBandwidth(
+		q.Speed.Upload.Add(speed.Upload.Sub(q.Speed.Upload).Quo(n)),
+		q.Speed.Download.Add(speed.Download.Sub(q.Speed.Download).Quo(n)),
+	)
+	q.Latency = q.Latency.Add(sdk.NewIntFromBigInt(new(big.Int).SetUint64(latency)).Sub(q.Latency).Quo(n))
+	q.UpdatedAt = height
+
+	return q
+}
+
+func (q NodeQoS) IsValid() error {
+	if q.Reports == 0 {
+		return fmt.Errorf("invalid reports")
+	}
+	if q.Speed.IsValid() != nil {
+		return fmt.Errorf("invalid speed")
+	}
+	if q.Latency == (sdk.Int{}) || q.Latency.IsNegative() {
+		return fmt.Errorf("invalid latency")
+	}
+	if q.UpdatedAt < 0 {
+		return fmt.Errorf("invalid updated at")
+	}
+
+	return nil
+}
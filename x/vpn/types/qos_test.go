@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestNodeQoS_Add(t *testing.T) {
+	qos := NewNodeQoS(hub.NewNodeID(1))
+	require.NotNil(t, qos.IsValid())
+
+	qos = qos.Add(hub.NewBandwidthFromInt64(100, 200), 50, 10)
+	require.Equal(t, uint64(1), qos.Reports)
+	require.Equal(t, hub.NewBandwidthFromInt64(100, 200), qos.Speed)
+	require.Equal(t, sdk.NewInt(50), qos.Latency)
+	require.Equal(t, int64(10), qos.UpdatedAt)
+	require.Nil(t, qos.IsValid())
+
+	qos = qos.Add(hub.NewBandwidthFromInt64(300, 400), 150, 20)
+	require.Equal(t, uint64(2), qos.Reports)
+	require.Equal(t, hub.NewBandwidthFromInt64(200, 300), qos.Speed)
+	require.Equal(t, sdk.NewInt(100), qos.Latency)
+	require.Equal(t, int64(20), qos.UpdatedAt)
+
+	qos.Reports = QoSSummaryWindow
+	qos = qos.Add(hub.NewBandwidthFromInt64(300, 200), 200, 30)
+	require.Equal(t, QoSSummaryWindow+1, qos.Reports)
+	require.Equal(t, hub.NewBandwidthFromInt64(201, 299), qos.Speed)
+	require.Equal(t, sdk.NewInt(101), qos.Latency)
+}
+
+func TestNodeQoS_IsValid(t *testing.T) {
+	qos := NewNodeQoS(hub.NewNodeID(1)).Add(hub.NewBandwidthFromInt64(100, 200), 50, 10)
+	require.Nil(t, qos.IsValid())
+
+	invalid := qos
+	invalid.Reports = 0
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = qos
+	invalid.Speed = hub.Bandwidth{}
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = qos
+	invalid.Latency = sdk.Int{}
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = qos
+	invalid.UpdatedAt = -1
+	require.NotNil(t, invalid.IsValid())
+}
@@ -7,20 +7,45 @@ import (
 )
 
 const (
+	QueryParams         = "params"
+	QueryProtocolFees   = "protocol_fees"
+	QueryNetworkSummary = "network_summary"
+
 	QueryNode           = "node"
+	QueryNodeByMoniker  = "node_by_moniker"
 	QueryNodesOfAddress = "nodes_of_address"
 	QueryAllNodes       = "all_nodes"
+	QueryNodeStats      = "node_stats"
+	QueryTopNodes       = "top_nodes"
+	QueryNodeUptime     = "node_uptime"
+	QueryNodeQoS        = "node_qos"
+	QueryNodeReputation = "node_reputation"
+	QueryDiscountPlan   = "discount_plan"
+
+	QueryAllowedAddressesOfNode   = "allowed_addresses_of_node"
+	QueryBlacklistedClientsOfNode = "blacklisted_clients_of_node"
 
 	QuerySubscription                = "subscription"
 	QuerySubscriptionsOfNode         = "subscriptions_of_node"
 	QuerySubscriptionsOfAddress      = "subscriptions_of_address"
 	QueryAllSubscriptions            = "all_subscriptions"
 	QuerySessionsCountOfSubscription = "sessions_count_of_subscription"
+	QuerySessionIndexOfAddress       = "session_index_of_address"
+	QuerySeatsOfSubscription         = "seats_of_subscription"
+	QuerySubscriptionForecast        = "subscription_forecast"
+	QueryReferralEarnings            = "referral_earnings"
+	QueryFeeAllowance                = "fee_allowance"
+	QueryAuthorizations              = "authorizations"
+
+	QuerySession                 = "session"
+	QuerySessionOfSubscription   = "session_of_subscription"
+	QuerySessionsOfSubscription  = "sessions_of_subscription"
+	QueryAllSessions             = "all_sessions"
+	QueryActiveSessionsOfAddress = "active_sessions_of_address"
+	QueryDispute                 = "dispute"
+	QueryAllDisputes             = "all_disputes"
 
-	QuerySession                = "session"
-	QuerySessionOfSubscription  = "session_of_subscription"
-	QuerySessionsOfSubscription = "sessions_of_subscription"
-	QueryAllSessions            = "all_sessions"
+	QueryDepositOfAddress = "deposit_of_address"
 )
 
 type QueryNodeParams struct {
@@ -33,13 +58,94 @@ func NewQueryNodeParams(id hub.NodeID) QueryNodeParams {
 	}
 }
 
+type QueryNodeByMonikerParams struct {
+	Moniker string
+}
+
+func NewQueryNodeByMonikerParams(moniker string) QueryNodeByMonikerParams {
+	return QueryNodeByMonikerParams{
+		Moniker: moniker,
+	}
+}
+
 type QueryNodesOfAddressPrams struct {
-	Address sdk.AccAddress
+	Address    sdk.AccAddress
+	Category   NodeCategory
+	Pagination hub.PageRequest
 }
 
-func NewQueryNodesOfAddressParams(address sdk.AccAddress) QueryNodesOfAddressPrams {
+func NewQueryNodesOfAddressParams(address sdk.AccAddress, category NodeCategory,
+	page hub.PageRequest) QueryNodesOfAddressPrams {
 	return QueryNodesOfAddressPrams{
-		Address: address,
+		Address:    address,
+		Category:   category,
+		Pagination: page,
+	}
+}
+
+// QueryAllNodesParams sorts the nodes by the order, with the prices in the denom, before paginating them.
+// The nodes are filtered by the category, by the set fields of the location, and by the price range in the
+// denom, both inclusive, when either of the prices is not zero. The zero max price does not bound the range.
+type QueryAllNodesParams struct {
+	Category   NodeCategory
+	Location   NodeLocation
+	Sort       string
+	Denom      string
+	MinPrice   uint64
+	MaxPrice   uint64
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllNodesParams(category NodeCategory, location NodeLocation, sort, denom string,
+	minPrice, maxPrice uint64, page hub.PageRequest) QueryAllNodesParams {
+	return QueryAllNodesParams{
+		Category:   category,
+		Location:   location,
+		Sort:       sort,
+		Denom:      denom,
+		MinPrice:   minPrice,
+		MaxPrice:   maxPrice,
+		Pagination: page,
+	}
+}
+
+type QueryTopNodesParams struct {
+	By     string
+	Denom  string
+	Window int64
+	Limit  uint64
+}
+
+func NewQueryTopNodesParams(by, denom string, window int64, limit uint64) QueryTopNodesParams {
+	return QueryTopNodesParams{
+		By:     by,
+		Denom:  denom,
+		Window: window,
+		Limit:  limit,
+	}
+}
+
+type QueryAllowedAddressesOfNodeParams struct {
+	ID         hub.NodeID
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllowedAddressesOfNodeParams(id hub.NodeID, page hub.PageRequest) QueryAllowedAddressesOfNodeParams {
+	return QueryAllowedAddressesOfNodeParams{
+		ID:         id,
+		Pagination: page,
+	}
+}
+
+type QueryBlacklistedClientsOfNodeParams struct {
+	ID         hub.NodeID
+	Pagination hub.PageRequest
+}
+
+func NewQueryBlacklistedClientsOfNodeParams(id hub.NodeID, page hub.PageRequest) QueryBlacklistedClientsOfNodeParams {
+	return QueryBlacklistedClientsOfNodeParams{
+		ID:         id,
+		Pagination: page,
 	}
 }
 
@@ -54,22 +160,52 @@ func NewQuerySubscriptionParams(id hub.SubscriptionID) QuerySubscriptionParams {
 }
 
 type QuerySubscriptionsOfNodePrams struct {
-	ID hub.NodeID
+	ID         hub.NodeID
+	Active     bool
+	Pagination hub.PageRequest
 }
 
-func NewQuerySubscriptionsOfNodePrams(id hub.NodeID) QuerySubscriptionsOfNodePrams {
+func NewQuerySubscriptionsOfNodePrams(id hub.NodeID, active bool,
+	page hub.PageRequest) QuerySubscriptionsOfNodePrams {
 	return QuerySubscriptionsOfNodePrams{
-		ID: id,
+		ID:         id,
+		Active:     active,
+		Pagination: page,
 	}
 }
 
 type QuerySubscriptionsOfAddressParams struct {
-	Address sdk.AccAddress
+	Address    sdk.AccAddress
+	Pagination hub.PageRequest
 }
 
-func NewQuerySubscriptionsOfAddressParams(address sdk.AccAddress) QuerySubscriptionsOfAddressParams {
+func NewQuerySubscriptionsOfAddressParams(address sdk.AccAddress,
+	page hub.PageRequest) QuerySubscriptionsOfAddressParams {
 	return QuerySubscriptionsOfAddressParams{
-		Address: address,
+		Address:    address,
+		Pagination: page,
+	}
+}
+
+type QueryAllSubscriptionsParams struct {
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllSubscriptionsParams(page hub.PageRequest) QueryAllSubscriptionsParams {
+	return QueryAllSubscriptionsParams{
+		Pagination: page,
+	}
+}
+
+type QuerySeatsOfSubscriptionParams struct {
+	ID         hub.SubscriptionID
+	Pagination hub.PageRequest
+}
+
+func NewQuerySeatsOfSubscriptionParams(id hub.SubscriptionID, page hub.PageRequest) QuerySeatsOfSubscriptionParams {
+	return QuerySeatsOfSubscriptionParams{
+		ID:         id,
+		Pagination: page,
 	}
 }
 
@@ -83,6 +219,65 @@ func NewQuerySessionsCountOfSubscriptionParams(id hub.SubscriptionID) QuerySessi
 	}
 }
 
+type QuerySessionIndexOfAddressParams struct {
+	ID      hub.SubscriptionID
+	Address sdk.AccAddress
+}
+
+func NewQuerySessionIndexOfAddressParams(id hub.SubscriptionID,
+	address sdk.AccAddress) QuerySessionIndexOfAddressParams {
+	return QuerySessionIndexOfAddressParams{
+		ID:      id,
+		Address: address,
+	}
+}
+
+type QuerySubscriptionForecastParams struct {
+	ID     hub.SubscriptionID
+	Blocks int64
+}
+
+func NewQuerySubscriptionForecastParams(id hub.SubscriptionID, blocks int64) QuerySubscriptionForecastParams {
+	return QuerySubscriptionForecastParams{
+		ID:     id,
+		Blocks: blocks,
+	}
+}
+
+type QueryReferralEarningsParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryReferralEarningsParams(address sdk.AccAddress) QueryReferralEarningsParams {
+	return QueryReferralEarningsParams{
+		Address: address,
+	}
+}
+
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress
+	Grantee sdk.AccAddress
+}
+
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress
+	Grantee sdk.AccAddress
+}
+
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
 type QuerySessionParams struct {
 	ID hub.SessionID
 }
@@ -106,11 +301,154 @@ func NewQuerySessionOfSubscriptionPrams(id hub.SubscriptionID, index uint64) Que
 }
 
 type QuerySessionsOfSubscriptionPrams struct {
-	ID hub.SubscriptionID
+	ID         hub.SubscriptionID
+	Pagination hub.PageRequest
 }
 
-func NewQuerySessionsOfSubscriptionPrams(id hub.SubscriptionID) QuerySessionsOfSubscriptionPrams {
+func NewQuerySessionsOfSubscriptionPrams(id hub.SubscriptionID,
+	page hub.PageRequest) QuerySessionsOfSubscriptionPrams {
 	return QuerySessionsOfSubscriptionPrams{
+		ID:         id,
+		Pagination: page,
+	}
+}
+
+type QueryAllSessionsParams struct {
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllSessionsParams(page hub.PageRequest) QueryAllSessionsParams {
+	return QueryAllSessionsParams{
+		Pagination: page,
+	}
+}
+
+type QueryActiveSessionsOfAddressParams struct {
+	Address    sdk.AccAddress
+	Pagination hub.PageRequest
+}
+
+func NewQueryActiveSessionsOfAddressParams(address sdk.AccAddress,
+	page hub.PageRequest) QueryActiveSessionsOfAddressParams {
+	return QueryActiveSessionsOfAddressParams{
+		Address:    address,
+		Pagination: page,
+	}
+}
+
+type QueryDisputeParams struct {
+	ID hub.SessionID
+}
+
+func NewQueryDisputeParams(id hub.SessionID) QueryDisputeParams {
+	return QueryDisputeParams{
 		ID: id,
 	}
 }
+
+// QueryAllDisputesParams filters the disputes by the status, when it is not empty.
+type QueryAllDisputesParams struct {
+	Status     string
+	Pagination hub.PageRequest
+}
+
+func NewQueryAllDisputesParams(status string, page hub.PageRequest) QueryAllDisputesParams {
+	return QueryAllDisputesParams{
+		Status:     status,
+		Pagination: page,
+	}
+}
+
+type QueryNodesResponse struct {
+	Nodes      []Node           `json:"nodes"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQueryNodesResponse(nodes []Node, page hub.PageResponse) QueryNodesResponse {
+	return QueryNodesResponse{
+		Nodes:      nodes,
+		Pagination: page,
+	}
+}
+
+type QueryNodeUptimeResponse struct {
+	Uptime     NodeUptime `json:"uptime"`
+	Percentage sdk.Dec    `json:"percentage"`
+}
+
+func NewQueryNodeUptimeResponse(uptime NodeUptime, percentage sdk.Dec) QueryNodeUptimeResponse {
+	return QueryNodeUptimeResponse{
+		Uptime:     uptime,
+		Percentage: percentage,
+	}
+}
+
+type QueryAddressesResponse struct {
+	Addresses  []sdk.AccAddress `json:"addresses"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQueryAddressesResponse(addresses []sdk.AccAddress, page hub.PageResponse) QueryAddressesResponse {
+	return QueryAddressesResponse{
+		Addresses:  addresses,
+		Pagination: page,
+	}
+}
+
+type QuerySubscriptionsResponse struct {
+	Subscriptions []Subscription   `json:"subscriptions"`
+	Pagination    hub.PageResponse `json:"pagination"`
+}
+
+func NewQuerySubscriptionsResponse(subscriptions []Subscription, page hub.PageResponse) QuerySubscriptionsResponse {
+	return QuerySubscriptionsResponse{
+		Subscriptions: subscriptions,
+		Pagination:    page,
+	}
+}
+
+type QuerySeatsResponse struct {
+	Seats      []Seat           `json:"seats"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQuerySeatsResponse(seats []Seat, page hub.PageResponse) QuerySeatsResponse {
+	return QuerySeatsResponse{
+		Seats:      seats,
+		Pagination: page,
+	}
+}
+
+type QuerySessionsResponse struct {
+	Sessions   []Session        `json:"sessions"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQuerySessionsResponse(sessions []Session, page hub.PageResponse) QuerySessionsResponse {
+	return QuerySessionsResponse{
+		Sessions:   sessions,
+		Pagination: page,
+	}
+}
+
+type QueryDisputesResponse struct {
+	Disputes   []Dispute        `json:"disputes"`
+	Pagination hub.PageResponse `json:"pagination"`
+}
+
+func NewQueryDisputesResponse(disputes []Dispute, page hub.PageResponse) QueryDisputesResponse {
+	return QueryDisputesResponse{
+		Disputes:   disputes,
+		Pagination: page,
+	}
+}
+
+type QueryDepositOfAddressParams struct {
+	Address sdk.AccAddress
+}
+
+func NewQueryDepositOfAddressParams(address sdk.AccAddress) QueryDepositOfAddressParams {
+	return QueryDepositOfAddressParams{
+		Address: address,
+	}
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// MaxQuoteLifetime is the maximum number of blocks a quote can be valid for.
+const MaxQuoteLifetime int64 = 1200
+
+type Quote struct {
+	NodeID     hub.NodeID     `json:"node_id"`
+	Client     sdk.AccAddress `json:"client"`
+	PricePerGB sdk.Coin       `json:"price_per_gb"`
+	Capacity   hub.Bandwidth  `json:"capacity"`
+	Nonce      uint64         `json:"nonce"`
+	Expiry     int64          `json:"expiry"`
+}
+
+func NewQuote(id hub.NodeID, client sdk.AccAddress, pricePerGB sdk.Coin, capacity hub.Bandwidth,
+	nonce uint64, expiry int64) Quote {
+	return Quote{
+		NodeID:     id,
+		Client:     client,
+		PricePerGB: pricePerGB,
+		Capacity:   capacity,
+		Nonce:      nonce,
+		Expiry:     expiry,
+	}
+}
+
+func (q Quote) String() string {
+	return fmt.Sprintf(`Quote
+  Node ID:       %s
+  Client:        %s
+  Price Per GB:  %s
+  Capacity:      %s
+  Nonce:         %d
+  Expiry:        %d`, q.NodeID, q.Client, q.PricePerGB, q.Capacity, q.Nonce, q.Expiry)
+}
+
+func (q Quote) IsValid() error {
+	if q.Client == nil || q.Client.Empty() {
+		return fmt.Errorf("invalid client")
+	}
+	if q.PricePerGB.Denom == "" || !q.PricePerGB.IsPositive() {
+		return fmt.Errorf("invalid price per gb")
+	}
+	if q.Capacity.IsValid() != nil || !q.Capacity.AllPositive() {
+		return fmt.Errorf("invalid capacity")
+	}
+	if q.Expiry <= 0 {
+		return fmt.Errorf("invalid expiry")
+	}
+
+	return nil
+}
+
+func (q Quote) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
+	if deposit.Denom != q.PricePerGB.Denom {
+		return bandwidth, ErrorInvalidDeposit()
+	}
+
+	return hub.NewBandwidthFromCost(deposit.Amount, q.PricePerGB.Amount), nil
+}
+
+// SignBytes returns the bytes signed by the node owner, anchored to the chain ID
+// so that a quote can not be replayed on another chain.
+func (q Quote) SignBytes(chainID string) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID string `json:"chain_id"`
+		Quote   Quote  `json:"quote"`
+	}{
+		ChainID: chainID,
+		Quote:   q,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+type SignedQuote struct {
+	Quote     Quote             `json:"quote"`
+	Signature auth.StdSignature `json:"signature"`
+}
+
+func NewSignedQuote(quote Quote, signature auth.StdSignature) *SignedQuote {
+	return &SignedQuote{
+		Quote:     quote,
+		Signature: signature,
+	}
+}
+
+// UsedQuote records a quote which is already used to start a subscription, it is kept
+// until the expiry of the quote to protect against the replays.
+type UsedQuote struct {
+	NodeID hub.NodeID `json:"node_id"`
+	Nonce  uint64     `json:"nonce"`
+	Expiry int64      `json:"expiry"`
+}
+
+func NewUsedQuote(id hub.NodeID, nonce uint64, expiry int64) UsedQuote {
+	return UsedQuote{
+		NodeID: id,
+		Nonce:  nonce,
+		Expiry: expiry,
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestQuote_IsValid(t *testing.T) {
+	quote := NewQuote(hub.NewNodeID(1), TestAddress1, sdk.NewInt64Coin("stake", 50), TestBandwidthPos1, 1, 100)
+	require.Nil(t, quote.IsValid())
+
+	invalid := quote
+	invalid.Client = nil
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = quote
+	invalid.PricePerGB = sdk.NewInt64Coin("stake", 0)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = quote
+	invalid.Capacity = TestBandwidthZero
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = quote
+	invalid.Capacity = hub.Bandwidth{}
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = quote
+	invalid.Expiry = 0
+	require.NotNil(t, invalid.IsValid())
+}
+
+func TestQuote_DepositToBandwidth(t *testing.T) {
+	quote := NewQuote(hub.NewNodeID(1), TestAddress1, sdk.NewInt64Coin("stake", 50), TestBandwidthPos1, 1, 100)
+
+	_, err := quote.DepositToBandwidth(sdk.NewInt64Coin("coin", 100))
+	require.NotNil(t, err)
+
+	bandwidth, err := quote.DepositToBandwidth(sdk.NewInt64Coin("stake", 50))
+	require.Nil(t, err)
+	require.True(t, TestBandwidthPos1.AllEqual(bandwidth))
+}
+
+func TestQuote_SignBytes(t *testing.T) {
+	quote := NewQuote(hub.NewNodeID(1), TestAddress1, sdk.NewInt64Coin("stake", 50), TestBandwidthPos1, 1, 100)
+	require.Equal(t, quote.SignBytes("chain-id"), quote.SignBytes("chain-id"))
+	require.NotEqual(t, quote.SignBytes("chain-id"), quote.SignBytes("other-chain-id"))
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MinRating uint64 = 1
+	MaxRating uint64 = 5
+)
+
+// NodeRating is the running average of the ratings the clients of the completed subscriptions
+// give to a node, kept as the count and the total of the ratings.
+type NodeRating struct {
+	Count uint64 `json:"count"`
+	Total uint64 `json:"total"`
+}
+
+func (r NodeRating) String() string {
+	return fmt.Sprintf("%s (%d)", r.Average(), r.Count)
+}
+
+func (r NodeRating) Add(rating uint64) NodeRating {
+	r.Count++
+	r.Total += rating
+
+	return r
+}
+
+// Average returns zero for a node which is not rated yet.
+func (r NodeRating) Average() sdk.Dec {
+	if r.Count == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(r.Total))).
+		QuoInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(r.Count)))
+}
+
+func (r NodeRating) IsValid() error {
+	if r.Total < r.Count*MinRating || r.Total > r.Count*MaxRating {
+		return fmt.Errorf("invalid rating")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeRating_Add(t *testing.T) {
+	rating := NodeRating{}
+	require.True(t, rating.Average().IsZero())
+	require.Nil(t, rating.IsValid())
+
+	rating = rating.Add(4)
+	require.Equal(t, NodeRating{Count: 1, Total: 4}, rating)
+	require.Equal(t, sdk.NewDec(4), rating.Average())
+
+	rating = rating.Add(MaxRating).Add(MinRating)
+	require.Equal(t, NodeRating{Count: 3, Total: 10}, rating)
+	require.Equal(t, sdk.NewDec(10).QuoInt64(3), rating.Average())
+	require.Nil(t, rating.IsValid())
+}
+
+func TestNodeRating_IsValid(t *testing.T) {
+	require.NotNil(t, NodeRating{Count: 0, Total: 1}.IsValid())
+	require.NotNil(t, NodeRating{Count: 2, Total: 1}.IsValid())
+	require.NotNil(t, NodeRating{Count: 2, Total: 11}.IsValid())
+	require.Nil(t, NodeRating{Count: 2, Total: 2}.IsValid())
+	require.Nil(t, NodeRating{Count: 2, Total: 10}.IsValid())
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+var (
+	// ReputationSessionsHalf is the count of the completed sessions at which the sessions factor of a node is one half.
+	ReputationSessionsHalf uint64 = 100
+
+	// ReputationUnratedFactor is the rating factor of a node which is not rated yet.
+	ReputationUnratedFactor = sdk.NewDecWithPrec(5, 1)
+
+	ReputationUptimeWeight   = sdk.NewDecWithPrec(4, 1)
+	ReputationSessionsWeight = sdk.NewDecWithPrec(3, 1)
+	ReputationRatingWeight   = sdk.NewDecWithPrec(3, 1)
+)
+
+// NodeReputation is the score of a node between 0 and 1 at the end of the last reputation epoch, the weighted
+// sum of its uptime, its completed sessions and its rating divided by one plus the times it is jailed.
+type NodeReputation struct {
+	NodeID    hub.NodeID `json:"node_id"`
+	Score     sdk.Dec    `json:"score"`
+	Uptime    sdk.Dec    `json:"uptime"`
+	Sessions  uint64     `json:"sessions"`
+	Rating    NodeRating `json:"rating"`
+	Jails     uint64     `json:"jails"`
+	UpdatedAt int64      `json:"updated_at"`
+}
+
+func NewNodeReputation(id hub.NodeID, uptime sdk.Dec, sessions uint64, rating NodeRating,
+	jails uint64, height int64) NodeReputation {
+	return NodeReputation{
+		NodeID:    id,
+		Score:     ReputationScore(uptime, sessions, rating, jails),
+		Uptime:    uptime,
+		Sessions:  sessions,
+		Rating:    rating,
+		Jails:     jails,
+		UpdatedAt: height,
+	}
+}
+
+func (r NodeReputation) String() string {
+	return fmt.Sprintf(`NodeReputation
+  Node ID:     %s
+  Score:       %s
+  Uptime:      %s
+  Sessions:    %d
+  Rating:      %s
+  Jails:       %d
+  Updated At:  %d`, r.NodeID, r.Score, r.Uptime, r.Sessions, r.Rating, r.Jails, r.UpdatedAt)
+}
+
+func (r NodeReputation) IsValid() error {
+	if r.Uptime.IsNil() || r.Uptime.IsNegative() || r.Uptime.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid uptime")
+	}
+	if err := r.Rating.IsValid(); err != nil {
+		return err
+	}
+	if r.Score.IsNil() || !r.Score.Equal(ReputationScore(r.Uptime, r.Sessions, r.Rating, r.Jails)) {
+		return fmt.Errorf("invalid score")
+	}
+	if r.UpdatedAt < 0 {
+		return fmt.Errorf("invalid updated at")
+	}
+
+	return nil
+}
+
+// ReputationScore weighs the uptime percentage, the sessions factor, which approaches 1 as the completed
+// sessions grow, and the rating factor, which maps the average rating linearly from 0 to 1, and divides
+// the sum by one plus the jails.
+func ReputationScore(uptime sdk.Dec, sessions uint64, rating NodeRating, jails uint64) sdk.Dec {
+	sessionsFactor := sdk.NewDecFromInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(sessions))).
+		QuoInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(sessions + ReputationSessionsHalf)))
+
+	ratingFactor := ReputationUnratedFactor
+	if rating.Count > 0 {
+		ratingFactor = sdk.NewDecFromInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(rating.Total - rating.Count*MinRating))).
+			QuoInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(rating.Count * (MaxRating - MinRating))))
+	}
+
+	score := ReputationUptimeWeight.Mul(uptime).
+		Add(ReputationSessionsWeight.Mul(sessionsFactor)).
+		Add(ReputationRatingWeight.Mul(ratingFactor))
+	return score.QuoInt(sdk.NewIntFromBigInt(new(big.Int).SetUint64(jails + 1)))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestReputationScore(t *testing.T) {
+	unrated, worst, best := NodeRating{}, NodeRating{}.Add(MinRating), NodeRating{}.Add(MaxRating)
+
+	require.True(t, ReputationScore(sdk.ZeroDec(), 0, worst, 0).IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), ReputationScore(sdk.ZeroDec(), 0, unrated, 0))
+	require.Equal(t, sdk.NewDecWithPrec(55, 2), ReputationScore(sdk.OneDec(), 0, unrated, 0))
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), ReputationScore(sdk.OneDec(), ReputationSessionsHalf, unrated, 0))
+	require.Equal(t, sdk.NewDecWithPrec(175, 3), ReputationScore(sdk.NewDecWithPrec(5, 1), 0, unrated, 1))
+	require.Equal(t, sdk.NewDecWithPrec(425, 3), ReputationScore(sdk.OneDec(), ReputationSessionsHalf, best, 1))
+}
+
+func TestNodeReputation_IsValid(t *testing.T) {
+	reputation := NewNodeReputation(hub.NewNodeID(1), sdk.NewDecWithPrec(5, 1), 10, NodeRating{}.Add(4), 1, 100)
+	require.Nil(t, reputation.IsValid())
+
+	invalid := reputation
+	invalid.Uptime = sdk.NewDecWithPrec(11, 1)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = reputation
+	invalid.Score = sdk.OneDec()
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = reputation
+	invalid.Rating.Total = 6
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = reputation
+	invalid.Jails = 0
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = reputation
+	invalid.UpdatedAt = -1
+	require.NotNil(t, invalid.IsValid())
+}
@@ -1,15 +1,28 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
 	hub "github.com/sentinel-official/hub/types"
 )
 
+const (
+	PubKeyTypeEd25519   = "ed25519"
+	PubKeyTypeSecp256k1 = "secp256k1"
+)
+
 type Session struct {
 	ID               hub.SessionID      `json:"id"`
 	SubscriptionID   hub.SubscriptionID `json:"subscription_id"`
 	Bandwidth        hub.Bandwidth      `json:"bandwidth"`
+	Paid             sdk.Coin           `json:"paid"`
 	Status           string             `json:"status"`
 	StatusModifiedAt int64              `json:"status_modified_at"`
 }
@@ -19,15 +32,87 @@ func (s Session) String() string {
   ID:                   %s
   Subscription ID:      %s
   Bandwidth:            %s
+  Paid:                 %s
   Status:               %s
-  Status Modified At:   %d`, s.ID, s.SubscriptionID, s.Bandwidth, s.Status, s.StatusModifiedAt)
+  Status Modified At:   %d`, s.ID, s.SubscriptionID, s.Bandwidth, s.Paid, s.Status, s.StatusModifiedAt)
+}
+
+// BandwidthSignBytes returns the canonical bytes which both the client and the node owner sign
+// for the bandwidth of the session at the index of the subscription.
+func BandwidthSignBytes(id hub.SubscriptionID, index uint64, bandwidth hub.Bandwidth) []byte {
+	return sdk.MustSortJSON(hub.NewBandwidthSignatureData(id, index, bandwidth).Bytes())
+}
+
+// SessionIndex is the index of the session in its subscription. The index is kept in the genesis state
+// instead of being derived from the order of the sessions, which leaves out the pruned ones. The address
+// is the client or the seat of which the session is ongoing, and it is empty once the session ends.
+type SessionIndex struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Index          uint64             `json:"index"`
+	SessionID      hub.SessionID      `json:"session_id"`
+	Address        sdk.AccAddress     `json:"address,omitempty"`
+}
+
+func (i SessionIndex) String() string {
+	return fmt.Sprintf(`SessionIndex
+  Subscription ID: %s
+  Index:           %d
+  Session ID:      %s
+  Address:         %s`, i.SubscriptionID, i.Index, i.SessionID, i.Address)
+}
+
+// SessionsCount is the number of the ended sessions of the subscription, the pruned ones included.
+type SessionsCount struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Count          uint64             `json:"count"`
+}
+
+func (c SessionsCount) String() string {
+	return fmt.Sprintf(`SessionsCount
+  Subscription ID: %s
+  Count:           %d`, c.SubscriptionID, c.Count)
+}
+
+// DeriveSessionID returns the session ID derived from the subscription ID, the index of the session
+// in the subscription and the nonce, which is non zero only if the IDs of the lower nonces are taken.
+func DeriveSessionID(id hub.SubscriptionID, index, nonce uint64) hub.SessionID {
+	bz := make([]byte, 0, len(id)+16)
+	bz = append(bz, id.Bytes()...)
+	bz = append(bz, sdk.Uint64ToBigEndian(index)...)
+	bz = append(bz, sdk.Uint64ToBigEndian(nonce)...)
+
+	hash := sha256.Sum256(bz)
+	return hub.SessionID(hash[:8])
+}
+
+// PubKeyType returns the type of the public key, or an empty string if the key
+// can not be used for the bandwidth signatures.
+func PubKeyType(pubKey crypto.PubKey) string {
+	switch pubKey.(type) {
+	case ed25519.PubKeyEd25519:
+		return PubKeyTypeEd25519
+	case secp256k1.PubKeySecp256k1:
+		return PubKeyTypeSecp256k1
+	default:
+		return ""
+	}
+}
+
+// VerifyBandwidthSignature reports whether the signature of the bandwidth sign bytes is
+// valid and is made with either an ed25519 or a secp256k1 key.
+func VerifyBandwidthSignature(signature auth.StdSignature, data []byte) bool {
+	if PubKeyType(signature.PubKey) == "" {
+		return false
+	}
+
+	return signature.PubKey.VerifyBytes(data, signature.Signature)
 }
 
 func (s Session) IsValid() error {
-	if s.Bandwidth.AnyNil() {
-		return fmt.Errorf("invalid bandwidth")
+	if err := s.Bandwidth.IsValid(); err != nil {
+		return err
 	}
-	if s.Status != StatusRegistered && s.Status != StatusDeRegistered {
+	if s.Status != StatusActive && s.Status != StatusInactive {
 		return fmt.Errorf("invalid status")
 	}
 
@@ -27,13 +27,13 @@ func (msg MsgUpdateSessionInfo) ValidateBasic() sdk.Error {
 	if msg.From == nil || msg.From.Empty() {
 		return ErrorInvalidField("from")
 	}
-	if !msg.Bandwidth.AllPositive() {
+	if msg.Bandwidth.IsValid() != nil || !msg.Bandwidth.AllPositive() {
 		return ErrorInvalidField("bandwidth")
 	}
-	if msg.NodeOwnerSignature.Signature == nil || msg.NodeOwnerSignature.PubKey == nil {
+	if msg.NodeOwnerSignature.Signature == nil || PubKeyType(msg.NodeOwnerSignature.PubKey) == "" {
 		return ErrorInvalidField("node_owner_signature")
 	}
-	if msg.ClientSignature.Signature == nil || msg.ClientSignature.PubKey == nil {
+	if msg.ClientSignature.Signature == nil || PubKeyType(msg.ClientSignature.PubKey) == "" {
 		return ErrorInvalidField("client_signature")
 	}
 
@@ -68,3 +68,185 @@ func NewMsgUpdateSessionInfo(from sdk.AccAddress,
 		ClientSignature:    clientSignature,
 	}
 }
+
+// MaxSessionInfoUpdates is the maximum number of updates in a single MsgUpdateSessionsInfo.
+const MaxSessionInfoUpdates = 256
+
+type SessionInfoUpdate struct {
+	SubscriptionID     hub.SubscriptionID `json:"subscription_id"`
+	Bandwidth          hub.Bandwidth      `json:"bandwidth"`
+	NodeOwnerSignature auth.StdSignature  `json:"node_owner_signature"`
+	ClientSignature    auth.StdSignature  `json:"client_signature"`
+}
+
+func NewSessionInfoUpdate(subscriptionID hub.SubscriptionID, bandwidth hub.Bandwidth,
+	nodeOwnerSignature, clientSignature auth.StdSignature) SessionInfoUpdate {
+	return SessionInfoUpdate{
+		SubscriptionID:     subscriptionID,
+		Bandwidth:          bandwidth,
+		NodeOwnerSignature: nodeOwnerSignature,
+		ClientSignature:    clientSignature,
+	}
+}
+
+var _ sdk.Msg = (*MsgUpdateSessionsInfo)(nil)
+
+type MsgUpdateSessionsInfo struct {
+	From    sdk.AccAddress      `json:"from"`
+	Updates []SessionInfoUpdate `json:"updates"`
+}
+
+func (msg MsgUpdateSessionsInfo) Type() string {
+	return "update_sessions_info"
+}
+
+func (msg MsgUpdateSessionsInfo) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if len(msg.Updates) == 0 || len(msg.Updates) > MaxSessionInfoUpdates {
+		return ErrorInvalidField("updates")
+	}
+
+	for _, update := range msg.Updates {
+		if err := NewMsgUpdateSessionInfo(msg.From, update.SubscriptionID, update.Bandwidth,
+			update.NodeOwnerSignature, update.ClientSignature).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateSessionsInfo) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgUpdateSessionsInfo) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgUpdateSessionsInfo) Route() string {
+	return RouterKey
+}
+
+func NewMsgUpdateSessionsInfo(from sdk.AccAddress, updates []SessionInfoUpdate) *MsgUpdateSessionsInfo {
+	return &MsgUpdateSessionsInfo{
+		From:    from,
+		Updates: updates,
+	}
+}
+
+var _ sdk.Msg = (*MsgOpenDispute)(nil)
+
+// MsgOpenDispute contests the settlement of the ended session at the index of the subscription, the client
+// claims the amount as overcharged and the node owner claims it as underpaid.
+type MsgOpenDispute struct {
+	From           sdk.AccAddress     `json:"from"`
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Index          uint64             `json:"index"`
+	Amount         sdk.Coin           `json:"amount"`
+}
+
+func (msg MsgOpenDispute) Type() string {
+	return "open_dispute"
+}
+
+func (msg MsgOpenDispute) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrorInvalidField("amount")
+	}
+
+	return nil
+}
+
+func (msg MsgOpenDispute) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgOpenDispute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgOpenDispute) Route() string {
+	return RouterKey
+}
+
+func NewMsgOpenDispute(from sdk.AccAddress, subscriptionID hub.SubscriptionID,
+	index uint64, amount sdk.Coin) *MsgOpenDispute {
+	return &MsgOpenDispute{
+		From:           from,
+		SubscriptionID: subscriptionID,
+		Index:          index,
+		Amount:         amount,
+	}
+}
+
+var _ sdk.Msg = (*MsgSubmitDisputeEvidence)(nil)
+
+// MsgSubmitDisputeEvidence resolves the open dispute of the session by the bandwidth of the session
+// signed by the client, the node owner submits it to counter the claim of the client or to prove its own.
+type MsgSubmitDisputeEvidence struct {
+	From            sdk.AccAddress    `json:"from"`
+	SessionID       hub.SessionID     `json:"session_id"`
+	Bandwidth       hub.Bandwidth     `json:"bandwidth"`
+	ClientSignature auth.StdSignature `json:"client_signature"`
+}
+
+func (msg MsgSubmitDisputeEvidence) Type() string {
+	return "submit_dispute_evidence"
+}
+
+func (msg MsgSubmitDisputeEvidence) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Bandwidth.IsValid() != nil || !msg.Bandwidth.AllPositive() {
+		return ErrorInvalidField("bandwidth")
+	}
+	if msg.ClientSignature.Signature == nil || PubKeyType(msg.ClientSignature.PubKey) == "" {
+		return ErrorInvalidField("client_signature")
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitDisputeEvidence) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSubmitDisputeEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSubmitDisputeEvidence) Route() string {
+	return RouterKey
+}
+
+func NewMsgSubmitDisputeEvidence(from sdk.AccAddress, sessionID hub.SessionID,
+	bandwidth hub.Bandwidth, clientSignature auth.StdSignature) *MsgSubmitDisputeEvidence {
+	return &MsgSubmitDisputeEvidence{
+		From:            from,
+		SessionID:       sessionID,
+		Bandwidth:       bandwidth,
+		ClientSignature: clientSignature,
+	}
+}
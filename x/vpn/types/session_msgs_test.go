@@ -30,6 +30,10 @@ func TestMsgUpdateSessionInfo_ValidateBasic(t *testing.T) {
 			"bandwidth is zero",
 			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
 			ErrorInvalidField("bandwidth"),
+		}, {
+			"bandwidth is nil",
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), hub.Bandwidth{}, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
+			ErrorInvalidField("bandwidth"),
 		}, {
 			"bandwidth is neg",
 			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthNeg, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
@@ -46,6 +50,14 @@ func TestMsgUpdateSessionInfo_ValidateBasic(t *testing.T) {
 			"client sign is empty  ",
 			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{}),
 			ErrorInvalidField("client_signature"),
+		}, {
+			"node owner sign is multisig",
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, auth.StdSignature{PubKey: TestMultisigPubkey, Signature: TestNodeOwnerStdSignaturePos1.Signature}, TestClientStdSignaturePos1),
+			ErrorInvalidField("node_owner_signature"),
+		}, {
+			"client sign is multisig",
+			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{PubKey: TestMultisigPubkey, Signature: TestClientStdSignaturePos1.Signature}),
+			ErrorInvalidField("client_signature"),
 		}, {
 			"valid ",
 			NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1),
@@ -86,3 +98,126 @@ func TestMsgUpdateSessionInfo_Route(t *testing.T) {
 	msg := NewMsgUpdateSessionInfo(TestAddress1, hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
 	require.Equal(t, RouterKey, msg.Route())
 }
+
+func TestMsgUpdateSessionsInfo_ValidateBasic(t *testing.T) {
+	update := NewSessionInfoUpdate(hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)
+	updates := make([]SessionInfoUpdate, MaxSessionInfoUpdates+1)
+	for i := range updates {
+		updates[i] = update
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgUpdateSessionsInfo
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgUpdateSessionsInfo(nil, []SessionInfoUpdate{update}),
+			ErrorInvalidField("from"),
+		}, {
+			"updates is nil",
+			NewMsgUpdateSessionsInfo(TestAddress1, nil),
+			ErrorInvalidField("updates"),
+		}, {
+			"updates is greater than max",
+			NewMsgUpdateSessionsInfo(TestAddress1, updates),
+			ErrorInvalidField("updates"),
+		}, {
+			"update bandwidth is zero",
+			NewMsgUpdateSessionsInfo(TestAddress1, []SessionInfoUpdate{update,
+				NewSessionInfoUpdate(hub.NewSubscriptionID(1), TestBandwidthZero, TestNodeOwnerStdSignaturePos1, TestClientStdSignaturePos1)}),
+			ErrorInvalidField("bandwidth"),
+		}, {
+			"update client sign is empty",
+			NewMsgUpdateSessionsInfo(TestAddress1, []SessionInfoUpdate{
+				NewSessionInfoUpdate(hub.NewSubscriptionID(1), TestBandwidthPos1, TestNodeOwnerStdSignaturePos1, auth.StdSignature{})}),
+			ErrorInvalidField("client_signature"),
+		}, {
+			"valid",
+			NewMsgUpdateSessionsInfo(TestAddress1, updates[:MaxSessionInfoUpdates]),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgOpenDispute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgOpenDispute
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgOpenDispute(nil, hub.NewSubscriptionID(1), 0, sdk.NewInt64Coin("stake", 10)),
+			ErrorInvalidField("from"),
+		}, {
+			"amount is empty",
+			NewMsgOpenDispute(TestAddress1, hub.NewSubscriptionID(1), 0, sdk.Coin{}),
+			ErrorInvalidField("amount"),
+		}, {
+			"amount is zero",
+			NewMsgOpenDispute(TestAddress1, hub.NewSubscriptionID(1), 0, sdk.NewInt64Coin("stake", 0)),
+			ErrorInvalidField("amount"),
+		}, {
+			"valid",
+			NewMsgOpenDispute(TestAddress1, hub.NewSubscriptionID(1), 0, sdk.NewInt64Coin("stake", 10)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitDisputeEvidence_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSubmitDisputeEvidence
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSubmitDisputeEvidence(nil, hub.NewSessionID(0), TestBandwidthPos1, TestClientStdSignaturePos1),
+			ErrorInvalidField("from"),
+		}, {
+			"bandwidth is zero",
+			NewMsgSubmitDisputeEvidence(TestAddress1, hub.NewSessionID(0), TestBandwidthZero, TestClientStdSignaturePos1),
+			ErrorInvalidField("bandwidth"),
+		}, {
+			"client sign is empty",
+			NewMsgSubmitDisputeEvidence(TestAddress1, hub.NewSessionID(0), TestBandwidthPos1, auth.StdSignature{}),
+			ErrorInvalidField("client_signature"),
+		}, {
+			"client sign is multisig",
+			NewMsgSubmitDisputeEvidence(TestAddress1, hub.NewSessionID(0), TestBandwidthPos1,
+				auth.StdSignature{PubKey: TestMultisigPubkey, Signature: TestClientStdSignaturePos1.Signature}),
+			ErrorInvalidField("client_signature"),
+		}, {
+			"valid",
+			NewMsgSubmitDisputeEvidence(TestAddress1, hub.NewSessionID(0), TestBandwidthPos1, TestClientStdSignaturePos1),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
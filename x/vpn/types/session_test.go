@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestBandwidthSignBytes(t *testing.T) {
+	bz := BandwidthSignBytes(hub.NewSubscriptionID(10), 2, hub.NewBandwidthFromInt64(100, 200))
+	require.Equal(t, `{"bandwidth":{"download":"200","upload":"100"},"id":"subsa","index":2}`, string(bz))
+
+	require.NotEqual(t, bz, BandwidthSignBytes(hub.NewSubscriptionID(10), 3, hub.NewBandwidthFromInt64(100, 200)))
+	require.NotEqual(t, bz, BandwidthSignBytes(hub.NewSubscriptionID(11), 2, hub.NewBandwidthFromInt64(100, 200)))
+	require.NotEqual(t, bz, BandwidthSignBytes(hub.NewSubscriptionID(10), 2, hub.NewBandwidthFromInt64(200, 100)))
+
+	require.True(t, TestNodeOwnerStdSignaturePos1.VerifyBytes(TestBandWidthSignDataPos1, TestNodeOwnerStdSignaturePos1.Signature))
+	require.True(t, TestClientStdSignaturePos1.VerifyBytes(TestBandWidthSignDataPos1, TestClientStdSignaturePos1.Signature))
+	require.False(t, TestClientStdSignaturePos1.VerifyBytes(TestBandWidthSignDataPos2, TestClientStdSignaturePos1.Signature))
+}
+
+func TestDeriveSessionID(t *testing.T) {
+	id := DeriveSessionID(hub.NewSubscriptionID(10), 2, 0)
+	require.Equal(t, 8, len(id))
+	require.Equal(t, id, DeriveSessionID(hub.NewSubscriptionID(10), 2, 0))
+
+	require.NotEqual(t, id, DeriveSessionID(hub.NewSubscriptionID(10), 3, 0))
+	require.NotEqual(t, id, DeriveSessionID(hub.NewSubscriptionID(11), 2, 0))
+	require.NotEqual(t, id, DeriveSessionID(hub.NewSubscriptionID(10), 2, 1))
+}
+
+func TestPubKeyType(t *testing.T) {
+	require.Equal(t, PubKeyTypeEd25519, PubKeyType(ed25519.GenPrivKey().PubKey()))
+	require.Equal(t, PubKeyTypeSecp256k1, PubKeyType(secp256k1.GenPrivKey().PubKey()))
+	require.Equal(t, "", PubKeyType(TestMultisigPubkey))
+	require.Equal(t, "", PubKeyType(nil))
+}
+
+func TestVerifyBandwidthSignature(t *testing.T) {
+	data := BandwidthSignBytes(hub.NewSubscriptionID(0), 0, TestBandwidthPos1)
+
+	for _, privKey := range []crypto.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey()} {
+		sig, err := privKey.Sign(data)
+		require.Nil(t, err)
+
+		signature := auth.StdSignature{PubKey: privKey.PubKey(), Signature: sig}
+		require.True(t, VerifyBandwidthSignature(signature, data))
+		require.False(t, VerifyBandwidthSignature(signature, BandwidthSignBytes(hub.NewSubscriptionID(0), 1, TestBandwidthPos1)))
+
+		signature.Signature = append([]byte{}, sig...)
+		signature.Signature[0] ^= 0xff
+		require.False(t, VerifyBandwidthSignature(signature, data))
+	}
+
+	signature := auth.StdSignature{PubKey: TestMultisigPubkey, Signature: []byte("signature")}
+	require.False(t, VerifyBandwidthSignature(signature, data))
+}
@@ -8,24 +8,49 @@ import (
 	hub "github.com/sentinel-official/hub/types"
 )
 
+const (
+	MaxSubscriptionSeats = 1024
+)
+
 type Subscription struct {
 	ID                 hub.SubscriptionID `json:"id"`
 	NodeID             hub.NodeID         `json:"node_id"`
 	Client             sdk.AccAddress     `json:"client"`
+	Referrer           sdk.AccAddress     `json:"referrer,omitempty"`
 	PricePerGB         sdk.Coin           `json:"price_per_gb"`
 	TotalDeposit       sdk.Coin           `json:"total_deposit"`
 	RemainingDeposit   sdk.Coin           `json:"remaining_deposit"`
 	RemainingBandwidth hub.Bandwidth      `json:"remaining_bandwidth"`
-	Status             string             `json:"status"`
-	StatusModifiedAt   int64              `json:"status_modified_at"`
+	Seats              uint64             `json:"seats"`
+	Trial              bool               `json:"trial"`
+	Paused             bool               `json:"paused"`
+
+	// QoSReportedSessions is the count of the sessions of the subscription when its quality was last reported.
+	QoSReportedSessions uint64 `json:"qos_reported_sessions"`
+
+	// Rated is set once the client rates the node of the completed subscription.
+	Rated bool `json:"rated"`
+
+	Status           string `json:"status"`
+	StatusModifiedAt int64  `json:"status_modified_at"`
 }
 
 func (s Subscription) TotalBandwidth() hub.Bandwidth {
-	x := s.TotalDeposit.Amount.
-		Mul(hub.MB500).
-		Quo(s.PricePerGB.Amount)
+	// The bandwidth of a free trial is not bought with a deposit, only what is left of it is known.
+	if s.Trial {
+		return s.RemainingBandwidth
+	}
 
-	return hub.NewBandwidth(x, x)
+	return hub.NewBandwidthFromCost(s.TotalDeposit.Amount, s.PricePerGB.Amount)
+}
+
+// DepositToBandwidth returns the bandwidth of the deposit at the price of the subscription.
+func (s Subscription) DepositToBandwidth(deposit sdk.Coin) (bandwidth hub.Bandwidth, err sdk.Error) {
+	if deposit.Denom != s.PricePerGB.Denom || s.PricePerGB.Amount.IsZero() {
+		return bandwidth, ErrorInvalidDeposit()
+	}
+
+	return hub.NewBandwidthFromCost(deposit.Amount, s.PricePerGB.Amount), nil
 }
 
 func (s Subscription) String() string {
@@ -33,36 +58,157 @@ func (s Subscription) String() string {
   ID:                  %s
   Node ID:             %s
   Client Address:      %s
+  Referrer Address:    %s
   Price Per GB:        %s
   Total Deposit:       %s
   Total Bandwidth:     %s
   Remaining Deposit:   %s
   Remaining Bandwidth: %s
+  Seats:               %d
+  Trial:               %t
+  Paused:              %t
+  QoS Reported:        %d
+  Rated:               %t
   Status:              %s
   Status Modified At:  %d`, s.ID, s.NodeID, s.Client,
-		s.PricePerGB, s.TotalDeposit, s.TotalBandwidth(),
-		s.RemainingDeposit, s.RemainingBandwidth, s.Status, s.StatusModifiedAt)
+		s.Referrer, s.PricePerGB, s.TotalDeposit, s.TotalBandwidth(),
+		s.RemainingDeposit, s.RemainingBandwidth, s.Seats, s.Trial, s.Paused, s.QoSReportedSessions,
+		s.Rated, s.Status, s.StatusModifiedAt)
 }
 
 func (s Subscription) IsValid() error {
 	if s.Client == nil || s.Client.Empty() {
 		return fmt.Errorf("invalid client")
 	}
-	if s.PricePerGB.Denom == "" || s.PricePerGB.IsZero() {
+	if s.Referrer != nil && (s.Referrer.Empty() || s.Referrer.Equals(s.Client)) {
+		return fmt.Errorf("invalid referrer")
+	}
+	if s.PricePerGB.Denom == "" || s.PricePerGB.IsZero() != s.Trial {
 		return fmt.Errorf("invalid price per gb")
 	}
-	if s.TotalDeposit.Denom != s.PricePerGB.Denom || s.TotalDeposit.IsZero() {
+	if s.TotalDeposit.Denom != s.PricePerGB.Denom || s.TotalDeposit.IsZero() != s.Trial {
 		return fmt.Errorf("invalid total deposit")
 	}
 	if s.RemainingDeposit.Denom != s.TotalDeposit.Denom || s.TotalDeposit.IsLT(s.RemainingDeposit) {
 		return fmt.Errorf("invalid remaining deposit")
 	}
-	if s.RemainingBandwidth.AnyNil() || s.TotalBandwidth().AnyLT(s.RemainingBandwidth) {
+	if s.RemainingBandwidth.IsValid() != nil || s.TotalBandwidth().AnyLT(s.RemainingBandwidth) {
 		return fmt.Errorf("invalid total remaining bandwidth")
 	}
+	if s.Seats > MaxSubscriptionSeats {
+		return fmt.Errorf("invalid seats")
+	}
 	if s.Status != StatusActive && s.Status != StatusInactive {
 		return fmt.Errorf("invalid status")
 	}
 
 	return nil
 }
+
+type Seat struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Index          uint64             `json:"index"`
+	Address        sdk.AccAddress     `json:"address"`
+}
+
+func NewSeat(id hub.SubscriptionID, index uint64, address sdk.AccAddress) Seat {
+	return Seat{
+		SubscriptionID: id,
+		Index:          index,
+		Address:        address,
+	}
+}
+
+func (s Seat) String() string {
+	return fmt.Sprintf(`Seat
+  Subscription ID: %s
+  Index:           %d
+  Address:         %s`, s.SubscriptionID, s.Index, s.Address)
+}
+
+// PendingSettlement is a subscription which is ended by the client, it is finalized at the height
+// after the node has had the grace period to submit the final bandwidth of the ongoing session.
+type PendingSettlement struct {
+	SubscriptionID hub.SubscriptionID `json:"subscription_id"`
+	Height         int64              `json:"height"`
+}
+
+func NewPendingSettlement(id hub.SubscriptionID, height int64) PendingSettlement {
+	return PendingSettlement{
+		SubscriptionID: id,
+		Height:         height,
+	}
+}
+
+func (p PendingSettlement) String() string {
+	return fmt.Sprintf(`PendingSettlement
+  Subscription ID: %s
+  Height:          %d`, p.SubscriptionID, p.Height)
+}
+
+// ReferralEarnings is the total of the payments credited to the referrer of the subscriptions.
+type ReferralEarnings struct {
+	Address  sdk.AccAddress `json:"address"`
+	Earnings sdk.Coins      `json:"earnings"`
+}
+
+func NewReferralEarnings(address sdk.AccAddress, earnings sdk.Coins) ReferralEarnings {
+	return ReferralEarnings{
+		Address:  address,
+		Earnings: earnings,
+	}
+}
+
+func (r ReferralEarnings) String() string {
+	return fmt.Sprintf(`ReferralEarnings
+  Address:  %s
+  Earnings: %s`, r.Address, r.Earnings)
+}
+
+// FeeAllowance lets the owner of the nodes pay the fees of the transactions of the grantee on
+// the subscriptions of the nodes, up to the spend limit and until the expiry.
+type FeeAllowance struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit"`
+	Expiry     int64          `json:"expiry"`
+}
+
+func NewFeeAllowance(granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiry int64) FeeAllowance {
+	return FeeAllowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiry:     expiry,
+	}
+}
+
+func (f FeeAllowance) String() string {
+	return fmt.Sprintf(`FeeAllowance
+  Granter:     %s
+  Grantee:     %s
+  Spend Limit: %s
+  Expiry:      %d`, f.Granter, f.Grantee, f.SpendLimit, f.Expiry)
+}
+
+func (f FeeAllowance) IsValid() error {
+	if f.Granter == nil || f.Granter.Empty() {
+		return fmt.Errorf("invalid granter")
+	}
+	if f.Grantee == nil || f.Grantee.Empty() || f.Grantee.Equals(f.Granter) {
+		return fmt.Errorf("invalid grantee")
+	}
+	if f.SpendLimit.Empty() || !f.SpendLimit.IsValid() {
+		return fmt.Errorf("invalid spend limit")
+	}
+	if f.Expiry < 0 {
+		return fmt.Errorf("invalid expiry")
+	}
+
+	return nil
+}
+
+// IsExpired returns whether the allowance can not be used at the height, zero expiry never expires.
+func (f FeeAllowance) IsExpired(height int64) bool {
+	return f.Expiry > 0 && height >= f.Expiry
+}
@@ -11,9 +11,12 @@ import (
 var _ sdk.Msg = (*MsgStartSubscription)(nil)
 
 type MsgStartSubscription struct {
-	From    sdk.AccAddress `json:"from"`
-	NodeID  hub.NodeID     `json:"node_id"`
-	Deposit sdk.Coin       `json:"deposit"`
+	From     sdk.AccAddress `json:"from"`
+	NodeID   hub.NodeID     `json:"node_id"`
+	Deposit  sdk.Coin       `json:"deposit"`
+	Seats    uint64         `json:"seats"`
+	Quote    *SignedQuote   `json:"quote,omitempty"`
+	Referrer sdk.AccAddress `json:"referrer,omitempty"`
 }
 
 func (msg MsgStartSubscription) Type() string {
@@ -27,6 +30,25 @@ func (msg MsgStartSubscription) ValidateBasic() sdk.Error {
 	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
 		return ErrorInvalidField("deposit")
 	}
+	if msg.Seats > MaxSubscriptionSeats {
+		return ErrorInvalidField("seats")
+	}
+	if msg.Quote != nil {
+		quote := msg.Quote.Quote
+		if err := quote.IsValid(); err != nil {
+			return ErrorInvalidField("quote")
+		}
+		if !quote.NodeID.IsEqual(msg.NodeID) || !quote.Client.Equals(msg.From) ||
+			quote.PricePerGB.Denom != msg.Deposit.Denom {
+			return ErrorInvalidField("quote")
+		}
+		if msg.Quote.Signature.PubKey == nil || len(msg.Quote.Signature.Signature) == 0 {
+			return ErrorInvalidField("quote")
+		}
+	}
+	if msg.Referrer != nil && (msg.Referrer.Empty() || msg.Referrer.Equals(msg.From)) {
+		return ErrorInvalidField("referrer")
+	}
 
 	return nil
 }
@@ -48,11 +70,58 @@ func (msg MsgStartSubscription) Route() string {
 	return RouterKey
 }
 
-func NewMsgStartSubscription(from sdk.AccAddress, nodeID hub.NodeID, deposit sdk.Coin) *MsgStartSubscription {
+func NewMsgStartSubscription(from sdk.AccAddress, nodeID hub.NodeID, deposit sdk.Coin, seats uint64,
+	quote *SignedQuote, referrer sdk.AccAddress) *MsgStartSubscription {
 	return &MsgStartSubscription{
-		From:    from,
-		NodeID:  nodeID,
-		Deposit: deposit,
+		From:     from,
+		NodeID:   nodeID,
+		Deposit:  deposit,
+		Seats:    seats,
+		Quote:    quote,
+		Referrer: referrer,
+	}
+}
+
+var _ sdk.Msg = (*MsgStartFreeTrial)(nil)
+
+type MsgStartFreeTrial struct {
+	From   sdk.AccAddress `json:"from"`
+	NodeID hub.NodeID     `json:"node_id"`
+}
+
+func (msg MsgStartFreeTrial) Type() string {
+	return "start_free_trial"
+}
+
+func (msg MsgStartFreeTrial) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgStartFreeTrial) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgStartFreeTrial) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgStartFreeTrial) Route() string {
+	return RouterKey
+}
+
+func NewMsgStartFreeTrial(from sdk.AccAddress, nodeID hub.NodeID) *MsgStartFreeTrial {
+	return &MsgStartFreeTrial{
+		From:   from,
+		NodeID: nodeID,
 	}
 }
 
@@ -98,3 +167,346 @@ func NewMsgEndSubscription(from sdk.AccAddress, id hub.SubscriptionID) *MsgEndSu
 		ID:   id,
 	}
 }
+
+var _ sdk.Msg = (*MsgAssignSeat)(nil)
+
+type MsgAssignSeat struct {
+	From    sdk.AccAddress     `json:"from"`
+	ID      hub.SubscriptionID `json:"id"`
+	Index   uint64             `json:"index"`
+	Address sdk.AccAddress     `json:"address"`
+}
+
+func (msg MsgAssignSeat) Type() string {
+	return "assign_seat"
+}
+
+func (msg MsgAssignSeat) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Index >= MaxSubscriptionSeats {
+		return ErrorInvalidField("index")
+	}
+	if msg.Address == nil || msg.Address.Empty() {
+		return ErrorInvalidField("address")
+	}
+
+	return nil
+}
+
+func (msg MsgAssignSeat) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgAssignSeat) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgAssignSeat) Route() string {
+	return RouterKey
+}
+
+func NewMsgAssignSeat(from sdk.AccAddress, id hub.SubscriptionID, index uint64, address sdk.AccAddress) *MsgAssignSeat {
+	return &MsgAssignSeat{
+		From:    from,
+		ID:      id,
+		Index:   index,
+		Address: address,
+	}
+}
+
+var _ sdk.Msg = (*MsgUnassignSeat)(nil)
+
+type MsgUnassignSeat struct {
+	From  sdk.AccAddress     `json:"from"`
+	ID    hub.SubscriptionID `json:"id"`
+	Index uint64             `json:"index"`
+}
+
+func (msg MsgUnassignSeat) Type() string {
+	return "unassign_seat"
+}
+
+func (msg MsgUnassignSeat) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Index >= MaxSubscriptionSeats {
+		return ErrorInvalidField("index")
+	}
+
+	return nil
+}
+
+func (msg MsgUnassignSeat) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgUnassignSeat) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgUnassignSeat) Route() string {
+	return RouterKey
+}
+
+func NewMsgUnassignSeat(from sdk.AccAddress, id hub.SubscriptionID, index uint64) *MsgUnassignSeat {
+	return &MsgUnassignSeat{
+		From:  from,
+		ID:    id,
+		Index: index,
+	}
+}
+
+var _ sdk.Msg = (*MsgPauseSubscription)(nil)
+
+type MsgPauseSubscription struct {
+	From sdk.AccAddress     `json:"from"`
+	ID   hub.SubscriptionID `json:"id"`
+}
+
+func (msg MsgPauseSubscription) Type() string {
+	return "pause_subscription"
+}
+
+func (msg MsgPauseSubscription) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgPauseSubscription) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgPauseSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgPauseSubscription) Route() string {
+	return RouterKey
+}
+
+func NewMsgPauseSubscription(from sdk.AccAddress, id hub.SubscriptionID) *MsgPauseSubscription {
+	return &MsgPauseSubscription{
+		From: from,
+		ID:   id,
+	}
+}
+
+var _ sdk.Msg = (*MsgResumeSubscription)(nil)
+
+type MsgResumeSubscription struct {
+	From sdk.AccAddress     `json:"from"`
+	ID   hub.SubscriptionID `json:"id"`
+}
+
+func (msg MsgResumeSubscription) Type() string {
+	return "resume_subscription"
+}
+
+func (msg MsgResumeSubscription) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+
+	return nil
+}
+
+func (msg MsgResumeSubscription) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgResumeSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgResumeSubscription) Route() string {
+	return RouterKey
+}
+
+func NewMsgResumeSubscription(from sdk.AccAddress, id hub.SubscriptionID) *MsgResumeSubscription {
+	return &MsgResumeSubscription{
+		From: from,
+		ID:   id,
+	}
+}
+
+var _ sdk.Msg = (*MsgTopUpSubscription)(nil)
+
+// MsgTopUpSubscription locks the additional deposit in the subscription, which extends the remaining
+// bandwidth at the price of the subscription.
+type MsgTopUpSubscription struct {
+	From    sdk.AccAddress     `json:"from"`
+	ID      hub.SubscriptionID `json:"id"`
+	Deposit sdk.Coin           `json:"deposit"`
+}
+
+func (msg MsgTopUpSubscription) Type() string {
+	return "top_up_subscription"
+}
+
+func (msg MsgTopUpSubscription) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Deposit.Denom == "" || !msg.Deposit.IsPositive() {
+		return ErrorInvalidField("deposit")
+	}
+
+	return nil
+}
+
+func (msg MsgTopUpSubscription) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgTopUpSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgTopUpSubscription) Route() string {
+	return RouterKey
+}
+
+func NewMsgTopUpSubscription(from sdk.AccAddress, id hub.SubscriptionID, deposit sdk.Coin) *MsgTopUpSubscription {
+	return &MsgTopUpSubscription{
+		From:    from,
+		ID:      id,
+		Deposit: deposit,
+	}
+}
+
+var _ sdk.Msg = (*MsgSubmitQoSReport)(nil)
+
+// MsgSubmitQoSReport reports the average speed, in bytes per second, and the average latency, in milliseconds,
+// the client measured on the node of the subscription. A subscription reports once for each of its sessions.
+type MsgSubmitQoSReport struct {
+	From    sdk.AccAddress     `json:"from"`
+	ID      hub.SubscriptionID `json:"id"`
+	Speed   hub.Bandwidth      `json:"speed"`
+	Latency uint64             `json:"latency"`
+}
+
+func (msg MsgSubmitQoSReport) Type() string {
+	return "submit_qos_report"
+}
+
+func (msg MsgSubmitQoSReport) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Speed.IsValid() != nil || !msg.Speed.AllPositive() {
+		return ErrorInvalidField("speed")
+	}
+	if msg.Latency == 0 || msg.Latency > MaxQoSLatency {
+		return ErrorInvalidField("latency")
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitQoSReport) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgSubmitQoSReport) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgSubmitQoSReport) Route() string {
+	return RouterKey
+}
+
+func NewMsgSubmitQoSReport(from sdk.AccAddress, id hub.SubscriptionID,
+	speed hub.Bandwidth, latency uint64) *MsgSubmitQoSReport {
+	return &MsgSubmitQoSReport{
+		From:    from,
+		ID:      id,
+		Speed:   speed,
+		Latency: latency,
+	}
+}
+
+var _ sdk.Msg = (*MsgRateNode)(nil)
+
+// MsgRateNode rates the node of the completed subscription from MinRating to MaxRating,
+// a subscription rates its node once.
+type MsgRateNode struct {
+	From   sdk.AccAddress     `json:"from"`
+	ID     hub.SubscriptionID `json:"id"`
+	Rating uint64             `json:"rating"`
+}
+
+func (msg MsgRateNode) Type() string {
+	return "rate_node"
+}
+
+func (msg MsgRateNode) ValidateBasic() sdk.Error {
+	if msg.From == nil || msg.From.Empty() {
+		return ErrorInvalidField("from")
+	}
+	if msg.Rating < MinRating || msg.Rating > MaxRating {
+		return ErrorInvalidField("rating")
+	}
+
+	return nil
+}
+
+func (msg MsgRateNode) GetSignBytes() []byte {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func (msg MsgRateNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+func (msg MsgRateNode) Route() string {
+	return RouterKey
+}
+
+func NewMsgRateNode(from sdk.AccAddress, id hub.SubscriptionID, rating uint64) *MsgRateNode {
+	return &MsgRateNode{
+		From:   from,
+		ID:     id,
+		Rating: rating,
+	}
+}
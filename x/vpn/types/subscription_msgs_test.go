@@ -6,12 +6,20 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
 
 	hub "github.com/sentinel-official/hub/types"
 )
 
 func TestMsgStartSubscription_ValidateBasic(t *testing.T) {
+	quote := NewQuote(hub.NewNodeID(1), TestAddress1, sdk.NewInt64Coin("stake", 50), TestBandwidthPos1, 1, 100)
+	signature, _ := TestPrivKey2.Sign(quote.SignBytes("chain-id"))
+	signedQuote := NewSignedQuote(quote, auth.StdSignature{PubKey: TestPubkey2, Signature: signature})
+
+	otherQuote := quote
+	otherQuote.NodeID = hub.NewNodeID(2)
+
 	tests := []struct {
 		name string
 		msg  *MsgStartSubscription
@@ -19,23 +27,70 @@ func TestMsgStartSubscription_ValidateBasic(t *testing.T) {
 	}{
 		{
 			"from is nil",
-			NewMsgStartSubscription(nil, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(nil, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil),
 			ErrorInvalidField("from"),
 		}, {
 			"from is empty",
-			NewMsgStartSubscription([]byte(""), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription([]byte(""), hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil),
 			ErrorInvalidField("from"),
 		}, {
 			"deposit is empty",
-			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.Coin{}),
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.Coin{}, 0, nil, nil),
 			ErrorInvalidField("deposit"),
 		}, {
 			"deposit is zero",
-			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 0)),
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 0), 0, nil, nil),
 			ErrorInvalidField("deposit"),
+		}, {
+			"seats is greater than max",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), MaxSubscriptionSeats+1, nil, nil),
+			ErrorInvalidField("seats"),
 		}, {
 			"valid",
-			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100)),
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil),
+			nil,
+		}, {
+			"valid with seats",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), MaxSubscriptionSeats, nil, nil),
+			nil,
+		}, {
+			"referrer is empty",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, []byte("")),
+			ErrorInvalidField("referrer"),
+		}, {
+			"referrer is from",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, TestAddress1),
+			ErrorInvalidField("referrer"),
+		}, {
+			"referrer is valid",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, TestAddress2),
+			nil,
+		}, {
+			"quote is invalid",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0,
+				NewSignedQuote(Quote{}, signedQuote.Signature), nil),
+			ErrorInvalidField("quote"),
+		}, {
+			"quote is of other node",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0,
+				NewSignedQuote(otherQuote, signedQuote.Signature), nil),
+			ErrorInvalidField("quote"),
+		}, {
+			"quote is of other client",
+			NewMsgStartSubscription(TestAddress2, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, signedQuote, nil),
+			ErrorInvalidField("quote"),
+		}, {
+			"quote is of other denom",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("coin", 100), 0, signedQuote, nil),
+			ErrorInvalidField("quote"),
+		}, {
+			"quote signature is empty",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0,
+				NewSignedQuote(quote, auth.StdSignature{}), nil),
+			ErrorInvalidField("quote"),
+		}, {
+			"valid with quote",
+			NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, signedQuote, nil),
 			nil,
 		},
 	}
@@ -50,7 +105,7 @@ func TestMsgStartSubscription_ValidateBasic(t *testing.T) {
 }
 
 func TestMsgStartSubscription_GetSignBytes(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	msgBytes, err := json.Marshal(msg)
 	if err != nil {
 		panic(err)
@@ -60,20 +115,50 @@ func TestMsgStartSubscription_GetSignBytes(t *testing.T) {
 }
 
 func TestMsgStartSubscription_GetSigners(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
 }
 
 func TestMsgStartSubscription_Type(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	require.Equal(t, "start_subscription", msg.Type())
 }
 
 func TestMsgStartSubscription_Route(t *testing.T) {
-	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100))
+	msg := NewMsgStartSubscription(TestAddress1, hub.NewNodeID(1), sdk.NewInt64Coin("stake", 100), 0, nil, nil)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
+func TestMsgStartFreeTrial_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgStartFreeTrial
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgStartFreeTrial(nil, hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgStartFreeTrial([]byte(""), hub.NewNodeID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgStartFreeTrial(TestAddress1, hub.NewNodeID(1)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestMsgEndSubscription_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -128,3 +213,345 @@ func TestMsgEndSubscription_Route(t *testing.T) {
 	msg := NewMsgEndSubscription(TestAddress1, hub.NewSubscriptionID(1))
 	require.Equal(t, RouterKey, msg.Route())
 }
+
+func TestMsgAssignSeat_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgAssignSeat
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgAssignSeat(nil, hub.NewSubscriptionID(1), 0, TestAddress2),
+			ErrorInvalidField("from"),
+		}, {
+			"index is out of range",
+			NewMsgAssignSeat(TestAddress1, hub.NewSubscriptionID(1), MaxSubscriptionSeats, TestAddress2),
+			ErrorInvalidField("index"),
+		}, {
+			"address is nil",
+			NewMsgAssignSeat(TestAddress1, hub.NewSubscriptionID(1), 0, nil),
+			ErrorInvalidField("address"),
+		}, {
+			"valid",
+			NewMsgAssignSeat(TestAddress1, hub.NewSubscriptionID(1), 0, TestAddress2),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgUnassignSeat_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgUnassignSeat
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgUnassignSeat(nil, hub.NewSubscriptionID(1), 0),
+			ErrorInvalidField("from"),
+		}, {
+			"index is out of range",
+			NewMsgUnassignSeat(TestAddress1, hub.NewSubscriptionID(1), MaxSubscriptionSeats),
+			ErrorInvalidField("index"),
+		}, {
+			"valid",
+			NewMsgUnassignSeat(TestAddress1, hub.NewSubscriptionID(1), 0),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgPauseSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgPauseSubscription
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgPauseSubscription(nil, hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgPauseSubscription([]byte(""), hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgPauseSubscription(TestAddress1, hub.NewSubscriptionID(1)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgPauseSubscription_GetSignBytes(t *testing.T) {
+	msg := NewMsgPauseSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	require.Equal(t, msgBytes, msg.GetSignBytes())
+}
+
+func TestMsgPauseSubscription_GetSigners(t *testing.T) {
+	msg := NewMsgPauseSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgPauseSubscription_Type(t *testing.T) {
+	msg := NewMsgPauseSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, "pause_subscription", msg.Type())
+}
+
+func TestMsgPauseSubscription_Route(t *testing.T) {
+	msg := NewMsgPauseSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgResumeSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgResumeSubscription
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgResumeSubscription(nil, hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgResumeSubscription([]byte(""), hub.NewSubscriptionID(1)),
+			ErrorInvalidField("from"),
+		}, {
+			"valid",
+			NewMsgResumeSubscription(TestAddress1, hub.NewSubscriptionID(1)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgResumeSubscription_GetSignBytes(t *testing.T) {
+	msg := NewMsgResumeSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	require.Equal(t, msgBytes, msg.GetSignBytes())
+}
+
+func TestMsgResumeSubscription_GetSigners(t *testing.T) {
+	msg := NewMsgResumeSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgResumeSubscription_Type(t *testing.T) {
+	msg := NewMsgResumeSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, "resume_subscription", msg.Type())
+}
+
+func TestMsgResumeSubscription_Route(t *testing.T) {
+	msg := NewMsgResumeSubscription(TestAddress1, hub.NewSubscriptionID(1))
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgTopUpSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgTopUpSubscription
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgTopUpSubscription(nil, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100)),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgTopUpSubscription([]byte(""), hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100)),
+			ErrorInvalidField("from"),
+		}, {
+			"deposit is empty",
+			NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.Coin{}),
+			ErrorInvalidField("deposit"),
+		}, {
+			"deposit is zero",
+			NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 0)),
+			ErrorInvalidField("deposit"),
+		}, {
+			"valid",
+			NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100)),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgTopUpSubscription_GetSignBytes(t *testing.T) {
+	msg := NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100))
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	require.Equal(t, msgBytes, msg.GetSignBytes())
+}
+
+func TestMsgTopUpSubscription_GetSigners(t *testing.T) {
+	msg := NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100))
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgTopUpSubscription_Type(t *testing.T) {
+	msg := NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100))
+	require.Equal(t, "top_up_subscription", msg.Type())
+}
+
+func TestMsgTopUpSubscription_Route(t *testing.T) {
+	msg := NewMsgTopUpSubscription(TestAddress1, hub.NewSubscriptionID(1), sdk.NewInt64Coin("stake", 100))
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgSubmitQoSReport_ValidateBasic(t *testing.T) {
+	speed := hub.NewBandwidthFromInt64(100, 200)
+	tests := []struct {
+		name string
+		msg  *MsgSubmitQoSReport
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgSubmitQoSReport(nil, hub.NewSubscriptionID(1), speed, 50),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgSubmitQoSReport([]byte(""), hub.NewSubscriptionID(1), speed, 50),
+			ErrorInvalidField("from"),
+		}, {
+			"speed is empty",
+			NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), hub.Bandwidth{}, 50),
+			ErrorInvalidField("speed"),
+		}, {
+			"speed is zero",
+			NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), hub.NewBandwidthFromInt64(100, 0), 50),
+			ErrorInvalidField("speed"),
+		}, {
+			"latency is zero",
+			NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), speed, 0),
+			ErrorInvalidField("latency"),
+		}, {
+			"latency is above the max",
+			NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), speed, MaxQoSLatency+1),
+			ErrorInvalidField("latency"),
+		}, {
+			"valid",
+			NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), speed, MaxQoSLatency),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitQoSReport_GetSigners(t *testing.T) {
+	msg := NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), hub.NewBandwidthFromInt64(100, 200), 50)
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgSubmitQoSReport_Type(t *testing.T) {
+	msg := NewMsgSubmitQoSReport(TestAddress1, hub.NewSubscriptionID(1), hub.NewBandwidthFromInt64(100, 200), 50)
+	require.Equal(t, "submit_qos_report", msg.Type())
+}
+
+func TestMsgRateNode_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRateNode
+		want sdk.Error
+	}{
+		{
+			"from is nil",
+			NewMsgRateNode(nil, hub.NewSubscriptionID(1), MaxRating),
+			ErrorInvalidField("from"),
+		}, {
+			"from is empty",
+			NewMsgRateNode([]byte(""), hub.NewSubscriptionID(1), MaxRating),
+			ErrorInvalidField("from"),
+		}, {
+			"rating is below the min",
+			NewMsgRateNode(TestAddress1, hub.NewSubscriptionID(1), MinRating-1),
+			ErrorInvalidField("rating"),
+		}, {
+			"rating is above the max",
+			NewMsgRateNode(TestAddress1, hub.NewSubscriptionID(1), MaxRating+1),
+			ErrorInvalidField("rating"),
+		}, {
+			"valid",
+			NewMsgRateNode(TestAddress1, hub.NewSubscriptionID(1), MinRating),
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.msg.ValidateBasic(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("\ngot = %vwant = %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMsgRateNode_GetSigners(t *testing.T) {
+	msg := NewMsgRateNode(TestAddress1, hub.NewSubscriptionID(1), MaxRating)
+	require.Equal(t, []sdk.AccAddress{TestAddress1}, msg.GetSigners())
+}
+
+func TestMsgRateNode_Type(t *testing.T) {
+	msg := NewMsgRateNode(TestAddress1, hub.NewSubscriptionID(1), MaxRating)
+	require.Equal(t, "rate_node", msg.Type())
+}
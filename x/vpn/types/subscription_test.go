@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscription_DepositToBandwidth(t *testing.T) {
+	_, err := TestSubscription.DepositToBandwidth(sdk.Coin{})
+	require.NotNil(t, err)
+
+	_, err = TestSubscription.DepositToBandwidth(sdk.NewInt64Coin("sent", 100))
+	require.NotNil(t, err)
+
+	bandwidth, err := TestSubscription.DepositToBandwidth(sdk.NewInt64Coin("stake", 0))
+	require.Nil(t, err)
+	require.True(t, bandwidth.AllEqual(TestBandwidthZero))
+
+	bandwidth, err = TestSubscription.DepositToBandwidth(sdk.NewInt64Coin("stake", 100))
+	require.Nil(t, err)
+	require.True(t, bandwidth.AllEqual(TestBandwidthPos1))
+}
+
+func TestSubscription_IsValid(t *testing.T) {
+	require.Nil(t, TestSubscription.IsValid())
+
+	trial := TestSubscription
+	trial.Trial = true
+	require.NotNil(t, trial.IsValid())
+
+	trial.PricePerGB = sdk.NewInt64Coin("stake", 0)
+	trial.TotalDeposit = sdk.NewInt64Coin("stake", 0)
+	trial.RemainingDeposit = sdk.NewInt64Coin("stake", 0)
+	require.Nil(t, trial.IsValid())
+	require.True(t, trial.TotalBandwidth().AllEqual(trial.RemainingBandwidth))
+
+	trial.Trial = false
+	require.NotNil(t, trial.IsValid())
+}
+
+func TestFeeAllowance_IsValid(t *testing.T) {
+	spendLimit := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	require.NotNil(t, NewFeeAllowance(nil, TestAddress2, spendLimit, 0).IsValid())
+	require.NotNil(t, NewFeeAllowance(TestAddress1, nil, spendLimit, 0).IsValid())
+	require.NotNil(t, NewFeeAllowance(TestAddress1, TestAddress1, spendLimit, 0).IsValid())
+	require.NotNil(t, NewFeeAllowance(TestAddress1, TestAddress2, nil, 0).IsValid())
+	require.NotNil(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, -1).IsValid())
+	require.Nil(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, 0).IsValid())
+	require.Nil(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, 10).IsValid())
+}
+
+func TestFeeAllowance_IsExpired(t *testing.T) {
+	spendLimit := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	require.False(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, 0).IsExpired(100))
+	require.False(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, 10).IsExpired(9))
+	require.True(t, NewFeeAllowance(TestAddress1, TestAddress2, spendLimit, 10).IsExpired(10))
+}
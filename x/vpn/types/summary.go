@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NetworkSummary holds the counters of the network which are updated on every change of
+// the nodes, the subscriptions and the sessions. The jailed nodes are counted as inactive.
+type NetworkSummary struct {
+	ActiveNodes         uint64    `json:"active_nodes"`
+	InactiveNodes       uint64    `json:"inactive_nodes"`
+	ActiveSubscriptions uint64    `json:"active_subscriptions"`
+	ActiveSessions      uint64    `json:"active_sessions"`
+	LockedDeposits      sdk.Coins `json:"locked_deposits"`
+}
+
+func NewNetworkSummary() NetworkSummary {
+	return NetworkSummary{
+		LockedDeposits: sdk.Coins{},
+	}
+}
+
+func (n NetworkSummary) String() string {
+	return fmt.Sprintf(`Network Summary
+  Active Nodes:          %d
+  Inactive Nodes:        %d
+  Active Subscriptions:  %d
+  Active Sessions:       %d
+  Locked Deposits:       %s`, n.ActiveNodes, n.InactiveNodes, n.ActiveSubscriptions,
+		n.ActiveSessions, n.LockedDeposits)
+}
+
+func (n NetworkSummary) AddNode(node Node) NetworkSummary {
+	if node.Status == StatusRegistered && !node.Jailed {
+		n.ActiveNodes++
+	} else {
+		n.InactiveNodes++
+	}
+
+	if node.Status != StatusDeRegistered && node.Deposit.IsPositive() {
+		n.LockedDeposits = n.LockedDeposits.Add(sdk.Coins{node.Deposit})
+	}
+
+	return n
+}
+
+func (n NetworkSummary) RemoveNode(node Node) NetworkSummary {
+	if node.Status == StatusRegistered && !node.Jailed {
+		n.ActiveNodes--
+	} else {
+		n.InactiveNodes--
+	}
+
+	if node.Status != StatusDeRegistered && node.Deposit.IsPositive() {
+		n.LockedDeposits = n.LockedDeposits.Sub(sdk.Coins{node.Deposit})
+	}
+
+	return n
+}
+
+func (n NetworkSummary) AddSubscription(subscription Subscription) NetworkSummary {
+	if subscription.Status != StatusActive {
+		return n
+	}
+
+	n.ActiveSubscriptions++
+	if subscription.RemainingDeposit.IsPositive() {
+		n.LockedDeposits = n.LockedDeposits.Add(sdk.Coins{subscription.RemainingDeposit})
+	}
+
+	return n
+}
+
+func (n NetworkSummary) RemoveSubscription(subscription Subscription) NetworkSummary {
+	if subscription.Status != StatusActive {
+		return n
+	}
+
+	n.ActiveSubscriptions--
+	if subscription.RemainingDeposit.IsPositive() {
+		n.LockedDeposits = n.LockedDeposits.Sub(sdk.Coins{subscription.RemainingDeposit})
+	}
+
+	return n
+}
+
+func (n NetworkSummary) AddSession(session Session) NetworkSummary {
+	if session.Status == StatusActive {
+		n.ActiveSessions++
+	}
+
+	return n
+}
+
+func (n NetworkSummary) RemoveSession(session Session) NetworkSummary {
+	if session.Status == StatusActive {
+		n.ActiveSessions--
+	}
+
+	return n
+}
@@ -4,7 +4,9 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 
 	hub "github.com/sentinel-official/hub/types"
 )
@@ -20,7 +22,7 @@ var (
 		ID:               hub.NewNodeID(0),
 		Owner:            TestAddress1,
 		Deposit:          sdk.NewInt64Coin("stake", 100),
-		Type:             "node_type",
+		Type:             NodeCategoryWireGuard,
 		Version:          "version",
 		Moniker:          "moniker",
 		PricesPerGB:      sdk.Coins{sdk.NewInt64Coin("stake", 100)},
@@ -44,31 +46,40 @@ var (
 		ID:               hub.NewSessionID(0),
 		SubscriptionID:   hub.NewSubscriptionID(0),
 		Bandwidth:        TestBandwidthPos1,
+		Paid:             sdk.NewInt64Coin("stake", 0),
 		Status:           StatusActive,
 		StatusModifiedAt: 0,
 	}
+	TestSessionIndex = SessionIndex{
+		SubscriptionID: hub.NewSubscriptionID(0),
+		Index:          0,
+		SessionID:      hub.NewSessionID(0),
+		Address:        TestAddress2,
+	}
 	TestBandwidthNeg                  = hub.NewBandwidth(sdk.NewInt(-500000000), sdk.NewInt(-500000000))
 	TestBandwidthZero                 = hub.NewBandwidth(sdk.NewInt(0), sdk.NewInt(0))
 	TestBandwidthPos1                 = hub.NewBandwidth(sdk.NewInt(500000000), sdk.NewInt(500000000))
 	TestBandwidthPos2                 = TestBandwidthPos1.Add(TestBandwidthPos1)
-	TestBandWidthSignDataNeg          = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 0, TestBandwidthNeg)
-	TestNodeOwnerSignBandWidthNeg, _  = TestPrivKey1.Sign(TestBandWidthSignDataNeg.Bytes())
+	TestBandWidthSignDataNeg          = BandwidthSignBytes(hub.NewSubscriptionID(0), 0, TestBandwidthNeg)
+	TestNodeOwnerSignBandWidthNeg, _  = TestPrivKey1.Sign(TestBandWidthSignDataNeg)
 	TestNodeOwnerStdSignatureNeg      = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthNeg}
-	TestClientSignBandWidthNeg, _     = TestPrivKey2.Sign(TestBandWidthSignDataNeg.Bytes())
+	TestClientSignBandWidthNeg, _     = TestPrivKey2.Sign(TestBandWidthSignDataNeg)
 	TestClientStdSignatureNeg         = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthNeg}
-	TestBandWidthSignDataZero         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 0, TestBandwidthZero)
-	TestNodeOwnerSignBandWidthZero, _ = TestPrivKey1.Sign(TestBandWidthSignDataZero.Bytes())
+	TestBandWidthSignDataZero         = BandwidthSignBytes(hub.NewSubscriptionID(0), 0, TestBandwidthZero)
+	TestNodeOwnerSignBandWidthZero, _ = TestPrivKey1.Sign(TestBandWidthSignDataZero)
 	TestNodeOwnerStdSignatureZero     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthZero}
-	TestClientSignBandWidthZero, _    = TestPrivKey2.Sign(TestBandWidthSignDataZero.Bytes())
+	TestClientSignBandWidthZero, _    = TestPrivKey2.Sign(TestBandWidthSignDataZero)
 	TestClientStdSignatureZero        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthZero}
-	TestBandWidthSignDataPos1         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 1, TestBandwidthPos1)
-	TestNodeOwnerSignBandWidthPos1, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos1.Bytes())
+	TestBandWidthSignDataPos1         = BandwidthSignBytes(hub.NewSubscriptionID(0), 1, TestBandwidthPos1)
+	TestNodeOwnerSignBandWidthPos1, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos1)
 	TestNodeOwnerStdSignaturePos1     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthPos1}
-	TestClientSignBandWidthPos1, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos1.Bytes())
+	TestClientSignBandWidthPos1, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos1)
 	TestClientStdSignaturePos1        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthPos1}
-	TestBandWidthSignDataPos2         = hub.NewBandwidthSignatureData(hub.NewSubscriptionID(0), 1, TestBandwidthPos2)
-	TestNodeOwnerSignBandWidthPos2, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos2.Bytes())
+	TestBandWidthSignDataPos2         = BandwidthSignBytes(hub.NewSubscriptionID(0), 1, TestBandwidthPos2)
+	TestNodeOwnerSignBandWidthPos2, _ = TestPrivKey1.Sign(TestBandWidthSignDataPos2)
 	TestNodeOwnerStdSignaturePos2     = auth.StdSignature{PubKey: TestPubkey1, Signature: TestNodeOwnerSignBandWidthPos2}
-	TestClientSignBandWidthPos2, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos2.Bytes())
+	TestClientSignBandWidthPos2, _    = TestPrivKey2.Sign(TestBandWidthSignDataPos2)
 	TestClientStdSignaturePos2        = auth.StdSignature{PubKey: TestPubkey2, Signature: TestClientSignBandWidthPos2}
+
+	TestMultisigPubkey = multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{TestPubkey1, TestPubkey2})
 )
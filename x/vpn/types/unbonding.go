@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+// NodeUnbonding is the deposit of a de-registered node, it stays locked until the completion
+// height so that the node can still be penalized for the misbehavior which is found late.
+type NodeUnbonding struct {
+	NodeID      hub.NodeID     `json:"node_id"`
+	Address     sdk.AccAddress `json:"address"`
+	Deposit     sdk.Coin       `json:"deposit"`
+	CompletesAt int64          `json:"completes_at"`
+}
+
+func NewNodeUnbonding(id hub.NodeID, address sdk.AccAddress, deposit sdk.Coin, completesAt int64) NodeUnbonding {
+	return NodeUnbonding{
+		NodeID:      id,
+		Address:     address,
+		Deposit:     deposit,
+		CompletesAt: completesAt,
+	}
+}
+
+func (u NodeUnbonding) String() string {
+	return fmt.Sprintf(`NodeUnbonding
+  Node ID:      %s
+  Address:      %s
+  Deposit:      %s
+  Completes At: %d`, u.NodeID, u.Address, u.Deposit, u.CompletesAt)
+}
+
+func (u NodeUnbonding) IsValid() error {
+	if u.Address == nil || u.Address.Empty() {
+		return fmt.Errorf("invalid address")
+	}
+	if !u.Deposit.IsValid() || !u.Deposit.IsPositive() {
+		return fmt.Errorf("invalid deposit")
+	}
+	if u.CompletesAt <= 0 {
+		return fmt.Errorf("invalid completes at")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	hub "github.com/sentinel-official/hub/types"
+)
+
+func TestNodeUnbonding_IsValid(t *testing.T) {
+	unbonding := NewNodeUnbonding(hub.NewNodeID(1), TestAddress1, sdk.NewInt64Coin("stake", 100), 10)
+	require.Nil(t, unbonding.IsValid())
+
+	invalid := unbonding
+	invalid.Address = nil
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = unbonding
+	invalid.Deposit = sdk.NewInt64Coin("stake", 0)
+	require.NotNil(t, invalid.IsValid())
+
+	invalid = unbonding
+	invalid.CompletesAt = 0
+	require.NotNil(t, invalid.IsValid())
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseVersion parses a version of the form [v]MAJOR[.MINOR[.PATCH]][-SUFFIX] into its numeric parts,
+// the suffix is ignored.
+func ParseVersion(s string) ([]uint64, error) {
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid version %s", s)
+	}
+
+	version := make([]uint64, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %s", s)
+		}
+
+		version = append(version, n)
+	}
+
+	return version, nil
+}
+
+// CompareVersions returns -1, 0 or 1 if the version a is lower than, equal to or greater than the
+// version b, the missing parts of the shorter version are zero.
+func CompareVersions(a, b []uint64) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y uint64
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// IsVersionBelow returns true if the version is lower than the minimum version, a version which
+// can not be parsed is below any minimum. An empty minimum version is not enforced.
+func IsVersionBelow(version, min string) bool {
+	if min == "" {
+		return false
+	}
+
+	_min, err := ParseVersion(min)
+	if err != nil {
+		return false
+	}
+
+	_version, err := ParseVersion(version)
+	if err != nil {
+		return true
+	}
+
+	return CompareVersions(_version, _min) < 0
+}
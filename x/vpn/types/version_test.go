@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	version, err := ParseVersion("v0.2.1")
+	require.Nil(t, err)
+	require.Equal(t, []uint64{0, 2, 1}, version)
+
+	version, err = ParseVersion("1.4-rc1")
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 4}, version)
+
+	_, err = ParseVersion("")
+	require.NotNil(t, err)
+
+	_, err = ParseVersion("version")
+	require.NotNil(t, err)
+
+	_, err = ParseVersion("1.2.3.4")
+	require.NotNil(t, err)
+}
+
+func TestCompareVersions(t *testing.T) {
+	require.Equal(t, 0, CompareVersions([]uint64{1, 2}, []uint64{1, 2, 0}))
+	require.Equal(t, -1, CompareVersions([]uint64{1, 2}, []uint64{1, 10}))
+	require.Equal(t, 1, CompareVersions([]uint64{2}, []uint64{1, 9, 9}))
+}
+
+func TestIsVersionBelow(t *testing.T) {
+	require.False(t, IsVersionBelow("0.1.0", ""))
+	require.True(t, IsVersionBelow("0.1.0", "0.2.0"))
+	require.False(t, IsVersionBelow("v0.2.0", "0.2.0"))
+	require.True(t, IsVersionBelow("version", "0.2.0"))
+}